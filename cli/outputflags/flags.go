@@ -12,6 +12,7 @@ import (
 	"github.com/brimdata/super/pkg/storage"
 	"github.com/brimdata/super/pkg/terminal"
 	"github.com/brimdata/super/pkg/terminal/color"
+	"github.com/brimdata/super/vng"
 	"github.com/brimdata/super/zbuf"
 	"github.com/brimdata/super/zio"
 	"github.com/brimdata/super/zio/anyio"
@@ -31,6 +32,10 @@ type Flags struct {
 	zsonShortcut  bool
 	zsonPretty    bool
 	zsonPersist   string
+	zngCompress   bool
+	zngCompFmt    string
+	vngCompress   string
+	compress      string
 	color         bool
 	pretty        int
 	unbuffered    bool
@@ -44,9 +49,14 @@ func (f *Flags) setFlags(fs *flag.FlagSet) {
 	// zio stuff
 	fs.BoolVar(&f.color, "color", true, "enable/disable color formatting for -Z and lake text output")
 	f.ZNG = &zngio.WriterOpts{}
-	fs.BoolVar(&f.ZNG.Compress, "zng.compress", true, "compress ZNG frames")
+	fs.BoolVar(&f.zngCompress, "zng.compress", true, "compress ZNG frames (deprecated: -zng.compress=false is -zng.compression=none)")
+	fs.StringVar(&f.zngCompFmt, "zng.compression", "lz4", "compression format for ZNG frames [lz4,zstd,none]")
 	fs.IntVar(&f.ZNG.FrameThresh, "zng.framethresh", zngio.DefaultFrameThresh,
 		"minimum ZNG frame size in uncompressed bytes")
+	f.VNG = &vng.WriterOpts{}
+	fs.StringVar(&f.vngCompress, "vng.compress", "lz4", "compression format for VNG segments [lz4,zstd,none]")
+	fs.BoolVar(&f.VNG.Delta, "vng.delta", false, "delta encode VNG integer and time segments (requires -vng.compress zstd)")
+	fs.StringVar(&f.compress, "compress", "none", "compression format for text output [gzip,xz,zstd,none]")
 	fs.IntVar(&f.pretty, "pretty", 4,
 		"tab size to pretty print JSON/ZSON output (0 for newline-delimited JSON/ZSON")
 	fs.StringVar(&f.zsonPersist, "persist", "",
@@ -75,7 +85,7 @@ func (f *Flags) SetFormatFlags(fs *flag.FlagSet) {
 	if f.DefaultFormat == "" {
 		f.DefaultFormat = "zng"
 	}
-	fs.StringVar(&f.Format, "f", f.DefaultFormat, "format for output data [arrows,csv,json,lake,parquet,table,text,tsv,vng,zeek,zjson,zng,zson]")
+	fs.StringVar(&f.Format, "f", f.DefaultFormat, "format for output data [arrows,avro,csv,json,lake,logfmt,parquet,table,text,tsv,vng,zeek,zjson,zng,zson]")
 	fs.BoolVar(&f.jsonShortcut, "j", false, "use line-oriented JSON output independent of -f option")
 	fs.BoolVar(&f.jsonPretty, "J", false, "use formatted JSON output independent of -f option")
 	fs.BoolVar(&f.zsonShortcut, "z", false, "use line-oriented ZSON output independent of -f option")
@@ -85,6 +95,42 @@ func (f *Flags) SetFormatFlags(fs *flag.FlagSet) {
 
 func (f *Flags) Init() error {
 	f.JSON.Pretty, f.ZSON.Pretty = f.pretty, f.pretty
+	switch f.zngCompFmt {
+	case "lz4", "":
+		f.ZNG.Compress = f.zngCompress
+		f.ZNG.CompressionFormat = zngio.CompressionFormatLZ4
+	case "zstd":
+		if !f.zngCompress {
+			return errors.New("-zng.compress=false conflicts with -zng.compression zstd")
+		}
+		f.ZNG.Compress = true
+		f.ZNG.CompressionFormat = zngio.CompressionFormatZstd
+	case "none":
+		f.ZNG.Compress = false
+	default:
+		return fmt.Errorf("unknown ZNG compression format: %q", f.zngCompFmt)
+	}
+	switch f.vngCompress {
+	case "lz4", "":
+		f.VNG.CompressionFormat = vng.CompressionFormatLZ4
+	case "zstd":
+		f.VNG.CompressionFormat = vng.CompressionFormatZstd
+	case "none":
+		f.VNG.CompressionFormat = vng.CompressionFormatNone
+	default:
+		return fmt.Errorf("unknown VNG compression format: %q", f.vngCompress)
+	}
+	if f.VNG.Delta && f.VNG.CompressionFormat != vng.CompressionFormatZstd {
+		return errors.New("-vng.delta requires -vng.compress zstd")
+	}
+	switch f.compress {
+	case "gzip", "xz", "zstd":
+		f.Compress = f.compress
+	case "none", "":
+		f.Compress = ""
+	default:
+		return fmt.Errorf("unknown compression format: %q", f.compress)
+	}
 	if f.zsonPersist != "" {
 		re, err := regexp.Compile(f.zsonPersist)
 		if err != nil {
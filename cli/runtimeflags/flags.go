@@ -6,7 +6,9 @@ import (
 
 	"github.com/brimdata/super/cli/auto"
 	"github.com/brimdata/super/runtime/sam/expr/agg"
+	"github.com/brimdata/super/runtime/sam/expr/function"
 	"github.com/brimdata/super/runtime/sam/op/fuse"
+	"github.com/brimdata/super/runtime/sam/op/join"
 	"github.com/brimdata/super/runtime/sam/op/sort"
 	"github.com/pbnjay/memory"
 )
@@ -33,6 +35,9 @@ type Flags struct {
 	aggMemMax  auto.Bytes
 	sortMemMax auto.Bytes
 	fuseMemMax auto.Bytes
+	joinMemMax auto.Bytes
+	geoIPDB    string
+	asnDB      string
 }
 
 func (f *Flags) SetFlags(fs *flag.FlagSet) {
@@ -43,6 +48,10 @@ func (f *Flags) SetFlags(fs *flag.FlagSet) {
 	fs.Var(&f.sortMemMax, "sortmem", "maximum memory used by sort in MiB, MB, etc")
 	f.fuseMemMax = auto.NewBytes(def)
 	fs.Var(&f.fuseMemMax, "fusemem", "maximum memory used by fuse in MiB, MB, etc")
+	f.joinMemMax = auto.NewBytes(def)
+	fs.Var(&f.joinMemMax, "joinmem", "maximum memory used by hash join in MiB, MB, etc")
+	fs.StringVar(&f.geoIPDB, "geoipdb", "", "path of MaxMind DB file used by geoip function")
+	fs.StringVar(&f.asnDB, "asndb", "", "path of MaxMind DB file used by asn function")
 }
 
 func (f *Flags) Init() error {
@@ -58,5 +67,11 @@ func (f *Flags) Init() error {
 		return errors.New("fusemem value must be greater than zero")
 	}
 	fuse.MemMaxBytes = int(f.fuseMemMax.Bytes)
+	if f.joinMemMax.Bytes <= 0 {
+		return errors.New("joinmem value must be greater than zero")
+	}
+	join.MemMaxBytes = int(f.joinMemMax.Bytes)
+	function.GeoIPDatabase = f.geoIPDB
+	function.ASNDatabase = f.asnDB
 	return nil
 }
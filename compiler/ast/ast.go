@@ -546,6 +546,16 @@ type (
 		KeywordPos int    `json:"keyword_pos"`
 		Expr       Expr   `json:"expr"`
 	}
+	// A Window operator sorts its input by Keys and then Sort and appends
+	// the values of Funcs, computed over each partition of input values
+	// having equal Keys, to every value.
+	Window struct {
+		Kind       string       `json:"kind" unpack:""`
+		KeywordPos int          `json:"keyword_pos"`
+		Funcs      []WindowFunc `json:"funcs"`
+		Keys       []Expr       `json:"keys"`
+		Sort       []SortExpr   `json:"sort"`
+	}
 	Where struct {
 		Kind       string `json:"kind" unpack:""`
 		KeywordPos int    `json:"keyword_pos"`
@@ -584,8 +594,8 @@ type (
 		KeywordPos int         `json:"keyword_pos"`
 		Style      string      `json:"style"`
 		RightInput Seq         `json:"right_input"`
-		LeftKey    Expr        `json:"left_key"`
-		RightKey   Expr        `json:"right_key"`
+		Keys       []JoinKey   `json:"keys"`
+		Cond       Expr        `json:"cond"`
 		Args       Assignments `json:"args"`
 	}
 	Sample struct {
@@ -703,6 +713,26 @@ func (s SortExpr) End() int {
 	return s.Expr.End()
 }
 
+// A WindowFunc is a window function or an aggregate function computed by
+// the Window operator.  A running aggregate function is computed over the
+// values of a partition up to and including the current value rather than
+// over the entire partition.
+type WindowFunc struct {
+	Kind    string `json:"kind" unpack:""`
+	LHS     Expr   `json:"lhs"`
+	Running bool   `json:"running"`
+	Func    *Agg   `json:"func"`
+}
+
+func (w WindowFunc) Pos() int {
+	if w.LHS != nil {
+		return w.LHS.Pos()
+	}
+	return w.Func.Pos()
+}
+
+func (w WindowFunc) End() int { return w.Func.End() }
+
 type Trunk struct {
 	Kind   string `json:"kind" unpack:""`
 	Source Source `json:"source"`
@@ -743,6 +773,23 @@ type Assignments []Assignment
 func (a Assignments) Pos() int { return a[0].Pos() }
 func (a Assignments) End() int { return a[len(a)-1].End() }
 
+// JoinKey is an equality condition of a join.  Right is nil when the
+// left and right keys are the same expression.
+type JoinKey struct {
+	Kind  string `json:"kind" unpack:""`
+	Left  Expr   `json:"left"`
+	Right Expr   `json:"right"`
+}
+
+func (j *JoinKey) Pos() int { return j.Left.Pos() }
+
+func (j *JoinKey) End() int {
+	if j.Right != nil {
+		return j.Right.End()
+	}
+	return j.Left.End()
+}
+
 // Def is like Assignment but the LHS is an identifier that may be later
 // referenced.  This is used for const blocks in Sequential and var blocks
 // in a let scope.
@@ -786,6 +833,7 @@ func (*From) OpAST()         {}
 func (*Explode) OpAST()      {}
 func (*Merge) OpAST()        {}
 func (*Over) OpAST()         {}
+func (*Window) OpAST()       {}
 func (*Search) OpAST()       {}
 func (*Where) OpAST()        {}
 func (*Yield) OpAST()        {}
@@ -823,6 +871,7 @@ func (x *From) Pos() int         { return x.KeywordPos }
 func (x *Explode) Pos() int      { return x.KeywordPos }
 func (x *Merge) Pos() int        { return x.KeywordPos }
 func (x *Over) Pos() int         { return x.KeywordPos }
+func (x *Window) Pos() int       { return x.KeywordPos }
 func (x *Search) Pos() int       { return x.KeywordPos }
 func (x *Where) Pos() int        { return x.KeywordPos }
 func (x *Yield) Pos() int        { return x.KeywordPos }
@@ -905,10 +954,10 @@ func (x *Join) End() int {
 	switch {
 	case len(x.Args) > 0:
 		return x.Args[len(x.Args)-1].End()
-	case x.RightKey != nil:
-		return x.RightKey.End()
+	case x.Cond != nil:
+		return x.Cond.End()
 	}
-	return x.LeftKey.End()
+	return x.Keys[len(x.Keys)-1].End()
 }
 func (x *Shape) End() int { return x.KeywordPos + 6 }
 func (x *From) End() int  { return x.Rparen + 1 }
@@ -919,6 +968,15 @@ func (x *Explode) End() int {
 	return x.Type.End()
 }
 func (x *Merge) End() int { return x.Expr.End() }
+func (x *Window) End() int {
+	if len(x.Sort) > 0 {
+		return x.Sort[len(x.Sort)-1].End()
+	}
+	if len(x.Keys) > 0 {
+		return x.Keys[len(x.Keys)-1].End()
+	}
+	return x.Funcs[len(x.Funcs)-1].End()
+}
 func (x *Over) End() int {
 	if x.KeywordPos != -1 {
 		return x.KeywordPos
@@ -958,6 +1016,7 @@ type Agg struct {
 	Name    string `json:"name"`
 	NamePos int    `json:"name_pos"`
 	Expr    Expr   `json:"expr"`
+	Args    []Expr `json:"args"` // Arguments following Expr
 	Rparen  int    `json:"rparen"`
 	Where   Expr   `json:"where"`
 }
@@ -21,6 +21,7 @@ type (
 		Kind  string `json:"kind" unpack:""`
 		Name  string `json:"name"`
 		Expr  Expr   `json:"expr"`
+		Args  []Expr `json:"args"` // Arguments following Expr
 		Where Expr   `json:"where"`
 	}
 	ArrayExpr struct {
@@ -79,6 +80,11 @@ type (
 		Expr  Expr   `json:"expr"`
 		Inner Expr   `json:"inner"`
 	}
+	MapFilter struct {
+		Kind  string `json:"kind" unpack:""`
+		Expr  Expr   `json:"expr"`
+		Inner Expr   `json:"inner"`
+	}
 	MapExpr struct {
 		Kind    string  `json:"kind" unpack:""`
 		Entries []Entry `json:"entries"`
@@ -150,6 +156,7 @@ func (*Func) ExprDAG()         {}
 func (*IndexExpr) ExprDAG()    {}
 func (*Literal) ExprDAG()      {}
 func (*MapCall) ExprDAG()      {}
+func (*MapFilter) ExprDAG()    {}
 func (*MapExpr) ExprDAG()      {}
 func (*OverExpr) ExprDAG()     {}
 func (*RecordExpr) ExprDAG()   {}
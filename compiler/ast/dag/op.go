@@ -63,14 +63,19 @@ type (
 		Kind  string `json:"kind" unpack:""`
 		Count int    `json:"count"`
 	}
+	// A Join combines values of its two parents whose keys are equal and
+	// for which Cond, if present, is true.  LeftDir and RightDir are the
+	// sort orders of the parents with respect to the first keys.
 	Join struct {
-		Kind     string          `json:"kind" unpack:""`
-		Style    string          `json:"style"`
-		LeftKey  Expr            `json:"left_key"`
-		LeftDir  order.Direction `json:"left_dir"`
-		RightKey Expr            `json:"right_key"`
-		RightDir order.Direction `json:"right_dir"`
-		Args     []Assignment    `json:"args"`
+		Kind      string          `json:"kind" unpack:""`
+		Style     string          `json:"style"`
+		LeftKeys  []Expr          `json:"left_keys"`
+		LeftDir   order.Direction `json:"left_dir"`
+		RightKeys []Expr          `json:"right_keys"`
+		RightDir  order.Direction `json:"right_dir"`
+		Cond      Expr            `json:"cond"`
+		Args      []Assignment    `json:"args"`
+		Hash      bool            `json:"hash,omitempty"`
 	}
 	Load struct {
 		Kind    string      `json:"kind" unpack:""`
@@ -164,12 +169,26 @@ type (
 		Kind string `json:"kind" unpack:""`
 		Body Seq    `json:"body"`
 	}
+	Window struct {
+		Kind  string       `json:"kind" unpack:""`
+		Funcs []WindowFunc `json:"funcs"`
+		Keys  []Expr       `json:"keys"`
+		Sort  []SortExpr   `json:"sort"`
+	}
 	Yield struct {
 		Kind  string `json:"kind" unpack:""`
 		Exprs []Expr `json:"exprs"`
 	}
 )
 
+// A WindowFunc is a window function or an aggregate function computed by
+// the Window operator.
+type WindowFunc struct {
+	LHS     Expr `json:"lhs"`
+	Func    *Agg `json:"func"`
+	Running bool `json:"running"`
+}
+
 // Input structure
 
 type (
@@ -210,7 +229,9 @@ type (
 		Path     string         `json:"path"`
 		Format   string         `json:"format"`
 		SortKeys order.SortKeys `json:"sort_keys"`
+		Fields   []field.Path   `json:"fields"`
 		Filter   Expr           `json:"filter"`
+		Pruner   Expr           `json:"pruner"`
 	}
 	HTTPScan struct {
 		Kind     string              `json:"kind" unpack:""`
@@ -316,6 +337,7 @@ func (*Shape) OpNode()     {}
 func (*Explode) OpNode()   {}
 func (*Over) OpNode()      {}
 func (*Vectorize) OpNode() {}
+func (*Window) OpNode()    {}
 func (*Yield) OpNode()     {}
 func (*Merge) OpNode()     {}
 func (*Mirror) OpNode()    {}
@@ -39,6 +39,7 @@ var unpacker = unpack.New(
 	Literal{},
 	Load{},
 	MapCall{},
+	MapFilter{},
 	MapExpr{},
 	Merge{},
 	Mirror{},
@@ -73,6 +74,7 @@ var unpacker = unpack.New(
 	Var{},
 	Vectorize{},
 	VectorValue{},
+	Window{},
 	Yield{},
 )
 
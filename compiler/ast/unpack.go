@@ -44,6 +44,7 @@ var unpacker = unpack.New(
 	astzed.ImpliedValue{},
 	IndexExpr{},
 	Join{},
+	JoinKey{},
 	Load{},
 	Merge{},
 	Output{},
@@ -95,6 +96,8 @@ var unpacker = unpack.New(
 	Uniq{},
 	VectorValue{},
 	Where{},
+	Window{},
+	WindowFunc{},
 	Yield{},
 	Sample{},
 )
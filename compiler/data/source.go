@@ -13,8 +13,10 @@ import (
 	"github.com/brimdata/super/lakeparse"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/runtime/sam/expr"
 	"github.com/brimdata/super/zbuf"
 	"github.com/brimdata/super/zio/anyio"
+	"github.com/brimdata/super/zio/parquetio"
 	"github.com/segmentio/ksuid"
 )
 
@@ -61,11 +63,15 @@ func (s *Source) SortKeys(ctx context.Context, src dag.Op) order.SortKeys {
 	return nil
 }
 
-func (s *Source) Open(ctx context.Context, zctx *zed.Context, path, format string, pushdown zbuf.Filter, demandOut demand.Demand) (zbuf.Puller, error) {
+func (s *Source) Open(ctx context.Context, zctx *zed.Context, path, format string, pushdown zbuf.Filter, pruner expr.Evaluator, demandOut demand.Demand) (zbuf.Puller, error) {
 	if path == "-" {
 		path = "stdio:stdin"
 	}
-	file, err := anyio.Open(ctx, zctx, s.engine, path, demandOut, anyio.ReaderOpts{Format: format})
+	opts := anyio.ReaderOpts{
+		Format:  format,
+		Parquet: parquetio.ReaderOpts{Pruner: pruner},
+	}
+	file, err := anyio.Open(ctx, zctx, s.engine, path, demandOut, opts)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", path, err)
 	}
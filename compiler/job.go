@@ -14,7 +14,6 @@ import (
 	"github.com/brimdata/super/runtime"
 	"github.com/brimdata/super/runtime/sam/op"
 	"github.com/brimdata/super/runtime/vam"
-	vamop "github.com/brimdata/super/runtime/vam/op"
 	"github.com/brimdata/super/runtime/vcache"
 	"github.com/brimdata/super/zbuf"
 	"github.com/brimdata/super/zio"
@@ -165,12 +164,11 @@ func VectorCompile(rctx *runtime.Context, query string, object *vcache.Object) (
 	if err != nil {
 		return nil, err
 	}
-	if len(outputs) == 1 {
-		puller = outputs[0]
-	} else {
-		puller = vamop.NewCombine(rctx, outputs)
+	main, ok := outputs["main"]
+	if !ok {
+		return nil, errors.New("query has no main output")
 	}
-	return vam.NewMaterializer(puller), nil
+	return main, nil
 }
 
 func VectorFilterCompile(rctx *runtime.Context, query string, src *data.Source, head *lakeparse.Commitish) (zbuf.Puller, error) {
@@ -89,6 +89,8 @@ func (b *Builder) compileExpr(e dag.Expr) (expr.Evaluator, error) {
 		return b.compileSetExpr(e)
 	case *dag.MapCall:
 		return b.compileMapCall(e)
+	case *dag.MapFilter:
+		return b.compileMapFilter(e)
 	case *dag.MapExpr:
 		return b.compileMapExpr(e)
 	case *dag.Agg:
@@ -333,6 +335,14 @@ func (b *Builder) compileCall(call dag.Call) (expr.Evaluator, error) {
 		dagPath := &dag.This{Kind: "This", Path: path}
 		args = append([]dag.Expr{dagPath}, args...)
 	}
+	if _, ok := b.udfs[call.Name]; !ok && isMMDBCall(call.Name, args) {
+		dbPath, err := b.compileMMDBPath(call.Name, args[1])
+		if err != nil {
+			return nil, err
+		}
+		fn = function.NewMMDBFunction(b.zctx(), call.Name, dbPath)
+		args = args[:1]
+	}
 	exprs, err := b.compileExprs(args)
 	if err != nil {
 		return nil, fmt.Errorf("%s(): bad argument: %w", call.Name, err)
@@ -340,6 +350,25 @@ func (b *Builder) compileCall(call dag.Call) (expr.Evaluator, error) {
 	return expr.NewCall(fn, exprs), nil
 }
 
+// isMMDBCall returns true if a call to function name with args is a call to
+// geoip or asn with a path argument.
+func isMMDBCall(name string, args []dag.Expr) bool {
+	return (name == "geoip" || name == "asn") && len(args) == 2
+}
+
+// compileMMDBPath evaluates the path argument of geoip or asn, which must be
+// a constant so that a query cannot open files named by its input.
+func (b *Builder) compileMMDBPath(name string, e dag.Expr) (string, error) {
+	val, err := b.evalAtCompileTime(e)
+	if err != nil {
+		return "", err
+	}
+	if val = val.Under(); !val.IsString() || val.IsNull() {
+		return "", fmt.Errorf("%s(): path argument must be a constant string", name)
+	}
+	return val.AsString(), nil
+}
+
 func (b *Builder) compileUDFCall(name string, body dag.Expr) (expr.Function, error) {
 	if fn, ok := b.compiledUDFs[name]; ok {
 		return fn, nil
@@ -368,6 +397,18 @@ func (b *Builder) compileMapCall(a *dag.MapCall) (expr.Evaluator, error) {
 	return expr.NewMapCall(b.zctx(), e, inner), nil
 }
 
+func (b *Builder) compileMapFilter(a *dag.MapFilter) (expr.Evaluator, error) {
+	e, err := b.compileExpr(a.Expr)
+	if err != nil {
+		return nil, err
+	}
+	inner, err := b.compileExpr(a.Inner)
+	if err != nil {
+		return nil, err
+	}
+	return expr.NewMapFilter(b.zctx(), e, inner), nil
+}
+
 func (b *Builder) compileShaper(node dag.Call, tf expr.ShaperTransform) (expr.Evaluator, error) {
 	args := node.Args
 	field, err := b.compileExpr(args[0])
@@ -4,10 +4,12 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/brimdata/super"
 	"github.com/brimdata/super/compiler/ast/dag"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/field"
 	"github.com/brimdata/super/runtime/sam/expr"
+	samagg "github.com/brimdata/super/runtime/sam/expr/agg"
 	"github.com/brimdata/super/runtime/sam/op/groupby"
 	"github.com/brimdata/super/zbuf"
 )
@@ -55,10 +57,11 @@ func (b *Builder) compileAggAssignment(assignment dag.Assignment) (field.Path, *
 
 func (b *Builder) compileAgg(agg *dag.Agg) (*expr.Aggregator, error) {
 	name := agg.Name
+	argExpr, paramExprs := aggArgs(agg)
 	var err error
 	var arg expr.Evaluator
-	if agg.Expr != nil {
-		arg, err = b.compileExpr(agg.Expr)
+	if argExpr != nil {
+		arg, err = b.compileExpr(argExpr)
 		if err != nil {
 			return nil, err
 		}
@@ -70,5 +73,43 @@ func (b *Builder) compileAgg(agg *dag.Agg) (*expr.Aggregator, error) {
 			return nil, err
 		}
 	}
-	return expr.NewAggregator(name, arg, where)
+	params, err := b.compileAggParams(name, paramExprs)
+	if err != nil {
+		return nil, err
+	}
+	return expr.NewAggregator(name, arg, where, b.quantileMemory(), params...)
+}
+
+// aggArgs returns the expression that agg evaluates for each input value
+// and the expressions of its constant parameters.  The two arguments of
+// a function like covar are combined into a record with fields "y" and "x".
+func aggArgs(agg *dag.Agg) (dag.Expr, []dag.Expr) {
+	e, params := agg.Expr, agg.Args
+	if samagg.NumArgs(agg.Name) == 2 && e != nil && len(params) > 0 {
+		e = &dag.RecordExpr{
+			Kind: "RecordExpr",
+			Elems: []dag.RecordElem{
+				&dag.Field{Kind: "Field", Name: "y", Value: e},
+				&dag.Field{Kind: "Field", Name: "x", Value: params[0]},
+			},
+		}
+		params = params[1:]
+	}
+	return e, params
+}
+
+// compileAggParams evaluates the constant parameters of aggregate function op.
+func (b *Builder) compileAggParams(op string, exprs []dag.Expr) ([]zed.Value, error) {
+	var params []zed.Value
+	for _, e := range exprs {
+		val, err := b.evalAtCompileTime(e)
+		if err != nil {
+			return nil, err
+		}
+		if val.IsError() {
+			return nil, fmt.Errorf("%s: parameter must be a constant", op)
+		}
+		params = append(params, val)
+	}
+	return params, nil
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/brimdata/super/pkg/field"
 	"github.com/brimdata/super/runtime"
 	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/expr/agg"
 	"github.com/brimdata/super/runtime/sam/op"
 	"github.com/brimdata/super/runtime/sam/op/combine"
 	"github.com/brimdata/super/runtime/sam/op/explode"
@@ -32,6 +33,7 @@ import (
 	"github.com/brimdata/super/runtime/sam/op/mirror"
 	"github.com/brimdata/super/runtime/sam/op/shape"
 	"github.com/brimdata/super/runtime/sam/op/sort"
+	"github.com/brimdata/super/runtime/sam/op/spill"
 	"github.com/brimdata/super/runtime/sam/op/switcher"
 	"github.com/brimdata/super/runtime/sam/op/tail"
 	"github.com/brimdata/super/runtime/sam/op/top"
@@ -39,6 +41,7 @@ import (
 	"github.com/brimdata/super/runtime/sam/op/uniq"
 	"github.com/brimdata/super/runtime/sam/op/yield"
 	"github.com/brimdata/super/runtime/vam"
+	vamexpr "github.com/brimdata/super/runtime/vam/expr"
 	vop "github.com/brimdata/super/runtime/vam/op"
 	"github.com/brimdata/super/vector"
 	"github.com/brimdata/super/zbuf"
@@ -60,6 +63,13 @@ type Builder struct {
 	udfs         map[string]dag.Expr
 	compiledUDFs map[string]*expr.UDF
 	resetters    expr.Resetters
+	// vamVars holds the variables of the outermost vector over expression
+	// or operator being compiled and nvamVars counts those in scope.
+	vamVars  *vamexpr.Vars
+	nvamVars int
+	// quantileMem is the memory budget shared by the query's quantile
+	// functions.
+	quantileMem *agg.QuantileMemory
 }
 
 func NewBuilder(rctx *runtime.Context, source *data.Source) *Builder {
@@ -90,6 +100,19 @@ func (b *Builder) Build(seq dag.Seq, readers ...zio.Reader) (map[string]zbuf.Pul
 	if _, err := b.compileSeq(seq, nil); err != nil {
 		return nil, err
 	}
+	return b.outputs(), nil
+}
+
+// BuildWithPuller is like Build but compiles seq for the vector runtime with
+// parent as its input.
+func (b *Builder) BuildWithPuller(seq dag.Seq, parent vector.Puller) (map[string]zbuf.Puller, error) {
+	if _, err := b.compileVamSeq(seq, []vector.Puller{parent}); err != nil {
+		return nil, err
+	}
+	return b.outputs(), nil
+}
+
+func (b *Builder) outputs() map[string]zbuf.Puller {
 	channels := make(map[string]zbuf.Puller)
 	for key, pullers := range b.channels {
 		if len(pullers) == 1 {
@@ -98,11 +121,7 @@ func (b *Builder) Build(seq dag.Seq, readers ...zio.Reader) (map[string]zbuf.Pul
 			channels[key] = combine.New(b.rctx, pullers)
 		}
 	}
-	return channels, nil
-}
-
-func (b *Builder) BuildWithPuller(seq dag.Seq, parent vector.Puller) ([]vector.Puller, error) {
-	return b.compileVamSeq(seq, []vector.Puller{parent})
+	return channels
 }
 
 func (b *Builder) BuildVamToSeqFilter(filter dag.Expr, poolID, commitID ksuid.KSUID) (zbuf.Puller, error) {
@@ -131,6 +150,16 @@ func (b *Builder) zctx() *zed.Context {
 	return b.rctx.Zctx
 }
 
+// quantileMemory returns the memory budget of the query's quantile functions.
+func (b *Builder) quantileMemory() *agg.QuantileMemory {
+	if b.quantileMem == nil {
+		b.quantileMem = agg.NewQuantileMemory(agg.QuantileMemMaxValues, func() (agg.Spiller, error) {
+			return spill.NewValueSort()
+		})
+	}
+	return b.quantileMem
+}
+
 func (b *Builder) Meter() zbuf.Meter {
 	return b.progress
 }
@@ -174,6 +203,9 @@ func (b *Builder) compileLeaf(o dag.Op, parent zbuf.Puller) (zbuf.Puller, error)
 			sortExprs = append(sortExprs, expr.NewSortEvaluator(k, s.Order))
 		}
 		return sort.New(b.rctx, parent, sortExprs, v.NullsFirst, v.Reverse, b.resetters), nil
+	case *dag.Window:
+		b.resetResetters()
+		return b.compileWindow(parent, v)
 	case *dag.Head:
 		limit := v.Count
 		if limit == 0 {
@@ -272,7 +304,15 @@ func (b *Builder) compileLeaf(o dag.Op, parent zbuf.Puller) (zbuf.Puller, error)
 		body := strings.NewReader(v.Body)
 		return b.source.OpenHTTP(b.rctx.Context, b.zctx(), v.URL, v.Format, v.Method, v.Headers, body, demand.All())
 	case *dag.FileScan:
-		return b.source.Open(b.rctx.Context, b.zctx(), v.Path, v.Format, b.PushdownOf(v.Filter), demand.All())
+		var pruner expr.Evaluator
+		if v.Pruner != nil {
+			var err error
+			pruner, err = compileExpr(v.Pruner)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return b.source.Open(b.rctx.Context, b.zctx(), v.Path, v.Format, b.PushdownOf(v.Filter), pruner, demand.FromFields(v.Fields))
 	case *dag.DefaultScan:
 		pushdown := b.PushdownOf(v.Filter)
 		if len(b.readers) == 1 {
@@ -341,28 +381,18 @@ func (b *Builder) compileLeaf(o dag.Op, parent zbuf.Puller) (zbuf.Puller, error)
 	case *dag.Load:
 		return load.New(b.rctx, b.source.Lake(), parent, v.Pool, v.Branch, v.Author, v.Message, v.Meta), nil
 	case *dag.Vectorize:
-		// If the first op is SeqScan, then pull it out so we can
-		// give the scanner a zio.Puller parent (i.e., the lister).
-		if scan, ok := v.Body[0].(*dag.SeqScan); ok {
-			puller, err := b.compileVamScan(scan, parent)
-			if err != nil {
-				return nil, err
-			}
-			if len(v.Body) > 1 {
-				outputs, err := b.compileVamSeq(v.Body[1:], []vector.Puller{puller})
-				if err != nil {
-					return nil, err
-				}
-				if len(outputs) == 1 {
-					puller = outputs[0]
-				} else {
-					puller = vop.NewCombine(b.rctx, outputs)
-				}
-			}
-			return vam.NewMaterializer(puller), nil
+		outputs, err := b.compileVamScanSeq(v.Body, parent)
+		if err != nil {
+			return nil, err
+		}
+		switch len(outputs) {
+		case 0:
+			// Every path of the body ends in an output.
+			return nil, nil
+		case 1:
+			return vam.NewMaterializer(outputs[0]), nil
 		}
-		//XXX
-		return nil, errors.New("dag.Vectorize must begin with SeqScan")
+		return vam.NewMaterializer(vop.NewCombine(b.rctx, outputs)), nil
 	case *dag.Output:
 		b.channels[v.Name] = append(b.channels[v.Name], parent)
 		return parent, nil
@@ -624,31 +654,47 @@ func (b *Builder) compile(o dag.Op, parents []zbuf.Puller) ([]zbuf.Puller, error
 			return nil, err
 		}
 		lhs, rhs := splitAssignments(assignments)
-		leftKey, err := b.compileExpr(o.LeftKey)
+		leftKeys, err := b.compileExprs(o.LeftKeys)
 		if err != nil {
 			return nil, err
 		}
-		rightKey, err := b.compileExpr(o.RightKey)
+		rightKeys, err := b.compileExprs(o.RightKeys)
 		if err != nil {
 			return nil, err
 		}
+		var cond *join.Cond
+		if o.Cond != nil {
+			e, err := b.compileExpr(o.Cond)
+			if err != nil {
+				return nil, err
+			}
+			cond = join.NewCond(b.zctx(), e, o.Style == "right")
+		}
 		leftParent, rightParent := parents[0], parents[1]
 		leftDir, rightDir := o.LeftDir, o.RightDir
-		var anti, inner bool
+		var anti, inner, full bool
 		switch o.Style {
 		case "anti":
 			anti = true
+		case "full":
+			full = true
 		case "inner":
 			inner = true
 		case "left":
 		case "right":
-			leftKey, rightKey = rightKey, leftKey
+			leftKeys, rightKeys = rightKeys, leftKeys
 			leftParent, rightParent = rightParent, leftParent
 			leftDir, rightDir = rightDir, leftDir
 		default:
 			return nil, fmt.Errorf("unknown kind of join: '%s'", o.Style)
 		}
-		join, err := join.New(b.rctx, anti, inner, leftParent, rightParent, leftKey, rightKey, leftDir, rightDir, lhs, rhs, b.resetters)
+		if o.Hash {
+			return []zbuf.Puller{join.NewHash(b.rctx, anti, inner, full, leftParent, rightParent, leftKeys, rightKeys, cond, lhs, rhs, b.resetters)}, nil
+		}
+		if len(leftKeys) != 1 || cond != nil {
+			return nil, errors.New("internal error: merge join requires a single key and no condition")
+		}
+		join, err := join.New(b.rctx, anti, inner, full, leftParent, rightParent, leftKeys[0], rightKeys[0], leftDir, rightDir, lhs, rhs, b.resetters)
 		if err != nil {
 			return nil, err
 		}
@@ -4,11 +4,14 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/brimdata/super"
 	"github.com/brimdata/super/compiler/ast/dag"
 	"github.com/brimdata/super/pkg/field"
+	samexpr "github.com/brimdata/super/runtime/sam/expr"
 	vamexpr "github.com/brimdata/super/runtime/vam/expr"
 	vamfunc "github.com/brimdata/super/runtime/vam/expr/function"
 	"github.com/brimdata/super/zson"
+	"golang.org/x/text/unicode/norm"
 )
 
 func (b *Builder) compileVamExpr(e dag.Expr) (vamexpr.Evaluator, error) {
@@ -22,10 +25,13 @@ func (b *Builder) compileVamExpr(e dag.Expr) (vamexpr.Evaluator, error) {
 			return nil, err
 		}
 		return vamexpr.NewLiteral(val), nil
-	//case *dag.Var:
-	//	return vamexpr.NewVar(e.Slot), nil
-	//case *dag.Search:
-	//	return b.compileSearch(e)
+	case *dag.Var:
+		if b.vamVars == nil {
+			return nil, fmt.Errorf("vector variable %q: not supported outside over", e.Name)
+		}
+		return vamexpr.NewVar(b.vamVars, e.Slot), nil
+	case *dag.Search:
+		return b.compileVamSearch(e)
 	case *dag.This:
 		return vamexpr.NewDottedExpr(b.zctx(), field.Path(e.Path)), nil
 	case *dag.Dot:
@@ -36,32 +42,34 @@ func (b *Builder) compileVamExpr(e dag.Expr) (vamexpr.Evaluator, error) {
 		return b.compileVamUnary(*e)
 	case *dag.BinaryExpr:
 		return b.compileVamBinary(e)
-	//case *dag.Conditional:
-	//	return b.compileVamConditional(*e)
+	case *dag.Conditional:
+		return b.compileVamConditional(*e)
 	case *dag.Call:
 		return b.compileVamCall(e)
-	//case *dag.RegexpMatch:
-	//	return b.compileVamRegexpMatch(e)
-	//case *dag.RegexpSearch:
-	//	return b.compileVamRegexpSearch(e)
+	case *dag.RegexpMatch:
+		return b.compileVamRegexpMatch(e)
+	case *dag.RegexpSearch:
+		return b.compileVamRegexpSearch(e)
 	case *dag.RecordExpr:
 		return b.compileVamRecordExpr(e)
-	//case *dag.ArrayExpr:
-	//	return b.compileVamArrayExpr(e)
-	//case *dag.SetExpr:
-	//	return b.compileVamSetExpr(e)
-	//case *dag.MapCall:
-	//	return b.compileVamMapCall(e)
-	//case *dag.MapExpr:
-	//	return b.compileVamMapExpr(e)
+	case *dag.ArrayExpr:
+		return b.compileVamArrayExpr(e)
+	case *dag.SetExpr:
+		return b.compileVamSetExpr(e)
+	case *dag.MapCall:
+		return b.compileVamMapCall(e)
+	case *dag.MapFilter:
+		return b.compileVamMapFilter(e)
+	case *dag.MapExpr:
+		return b.compileVamMapExpr(e)
 	//case *dag.Agg:
 	//	agg, err := b.compileAgg(e)
 	//	if err != nil {
 	//		return nil, err
 	//	}
 	//	return expr.NewAggregatorExpr(agg), nil
-	//case *dag.OverExpr:
-	//	return b.compileOverExpr(e)
+	case *dag.OverExpr:
+		return b.compileVamOverExpr(e)
 	default:
 		return nil, fmt.Errorf("vector expression type %T: not supported", e)
 	}
@@ -166,6 +174,14 @@ func (b *Builder) compileVamCall(call *dag.Call) (vamexpr.Evaluator, error) {
 		dagPath := &dag.This{Kind: "This", Path: path}
 		args = append([]dag.Expr{dagPath}, args...)
 	}
+	if isMMDBCall(call.Name, args) {
+		dbPath, err := b.compileMMDBPath(call.Name, args[1])
+		if err != nil {
+			return nil, err
+		}
+		fn = vamfunc.NewMMDBFunction(b.zctx(), call.Name, dbPath)
+		args = args[:1]
+	}
 	exprs, err := b.compileVamExprs(args)
 	if err != nil {
 		return nil, err
@@ -199,3 +215,138 @@ func (b *Builder) compileVamRecordExpr(e *dag.RecordExpr) (vamexpr.Evaluator, er
 	}
 	return vamexpr.NewRecordExpr(b.zctx(), elems), nil
 }
+
+func (b *Builder) compileVamSearch(search *dag.Search) (vamexpr.Evaluator, error) {
+	val, err := zson.ParseValue(b.zctx(), search.Value)
+	if err != nil {
+		return nil, err
+	}
+	e, err := b.compileVamExpr(search.Expr)
+	if err != nil {
+		return nil, err
+	}
+	if zed.TypeUnder(val.Type()) == zed.TypeString {
+		// Do a grep-style substring search instead of an
+		// exact match on each value.
+		term := norm.NFC.Bytes(val.Bytes())
+		return vamexpr.NewSearchString(string(term), e), nil
+	}
+	return vamexpr.NewSearch(search.Text, val, e)
+}
+
+func (b *Builder) compileVamConditional(node dag.Conditional) (vamexpr.Evaluator, error) {
+	predicate, err := b.compileVamExpr(node.Cond)
+	if err != nil {
+		return nil, err
+	}
+	thenExpr, err := b.compileVamExpr(node.Then)
+	if err != nil {
+		return nil, err
+	}
+	elseExpr, err := b.compileVamExpr(node.Else)
+	if err != nil {
+		return nil, err
+	}
+	return vamexpr.NewConditional(b.zctx(), predicate, thenExpr, elseExpr), nil
+}
+
+func (b *Builder) compileVamRegexpMatch(match *dag.RegexpMatch) (vamexpr.Evaluator, error) {
+	e, err := b.compileVamExpr(match.Expr)
+	if err != nil {
+		return nil, err
+	}
+	re, err := samexpr.CompileRegexp(match.Pattern)
+	if err != nil {
+		return nil, err
+	}
+	return vamexpr.NewRegexpMatch(re, e), nil
+}
+
+func (b *Builder) compileVamRegexpSearch(search *dag.RegexpSearch) (vamexpr.Evaluator, error) {
+	e, err := b.compileVamExpr(search.Expr)
+	if err != nil {
+		return nil, err
+	}
+	re, err := samexpr.CompileRegexp(search.Pattern)
+	if err != nil {
+		return nil, err
+	}
+	return vamexpr.NewSearchRegexp(re, e), nil
+}
+
+func (b *Builder) compileVamArrayExpr(array *dag.ArrayExpr) (vamexpr.Evaluator, error) {
+	elems, err := b.compileVamVectorElems(array.Elems)
+	if err != nil {
+		return nil, err
+	}
+	return vamexpr.NewArrayExpr(b.zctx(), elems), nil
+}
+
+func (b *Builder) compileVamSetExpr(set *dag.SetExpr) (vamexpr.Evaluator, error) {
+	elems, err := b.compileVamVectorElems(set.Elems)
+	if err != nil {
+		return nil, err
+	}
+	return vamexpr.NewSetExpr(b.zctx(), elems), nil
+}
+
+func (b *Builder) compileVamVectorElems(elems []dag.VectorElem) ([]vamexpr.VectorElem, error) {
+	var out []vamexpr.VectorElem
+	for _, elem := range elems {
+		switch elem := elem.(type) {
+		case *dag.Spread:
+			e, err := b.compileVamExpr(elem.Expr)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, vamexpr.VectorElem{Spread: e})
+		case *dag.VectorValue:
+			e, err := b.compileVamExpr(elem.Expr)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, vamexpr.VectorElem{Value: e})
+		}
+	}
+	return out, nil
+}
+
+func (b *Builder) compileVamMapCall(a *dag.MapCall) (vamexpr.Evaluator, error) {
+	e, err := b.compileVamExpr(a.Expr)
+	if err != nil {
+		return nil, err
+	}
+	inner, err := b.compileVamExpr(a.Inner)
+	if err != nil {
+		return nil, err
+	}
+	return vamexpr.NewMapCall(b.zctx(), e, inner), nil
+}
+
+func (b *Builder) compileVamMapFilter(a *dag.MapFilter) (vamexpr.Evaluator, error) {
+	e, err := b.compileVamExpr(a.Expr)
+	if err != nil {
+		return nil, err
+	}
+	inner, err := b.compileVamExpr(a.Inner)
+	if err != nil {
+		return nil, err
+	}
+	return vamexpr.NewMapFilter(b.zctx(), e, inner), nil
+}
+
+func (b *Builder) compileVamMapExpr(m *dag.MapExpr) (vamexpr.Evaluator, error) {
+	var entries []vamexpr.Entry
+	for _, f := range m.Entries {
+		key, err := b.compileVamExpr(f.Key)
+		if err != nil {
+			return nil, err
+		}
+		val, err := b.compileVamExpr(f.Value)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, vamexpr.Entry{Key: key, Val: val})
+	}
+	return vamexpr.NewMapExpr(b.zctx(), entries), nil
+}
@@ -1,12 +1,16 @@
 package kernel
 
 import (
+	"errors"
 	"fmt"
+	"maps"
 
+	"github.com/brimdata/super"
 	"github.com/brimdata/super/compiler/ast/dag"
-	"github.com/brimdata/super/compiler/optimizer"
 	"github.com/brimdata/super/pkg/field"
 	samexpr "github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/op/meta"
+	"github.com/brimdata/super/runtime/vam"
 	vamexpr "github.com/brimdata/super/runtime/vam/expr"
 	vamop "github.com/brimdata/super/runtime/vam/op"
 	"github.com/brimdata/super/vector"
@@ -18,28 +22,36 @@ import (
 func (b *Builder) compileVam(o dag.Op, parents []vector.Puller) ([]vector.Puller, error) {
 	switch o := o.(type) {
 	case *dag.Combine:
-		//return []zbuf.Puller{combine.New(b.rctx, parents)}, nil
+		return []vector.Puller{vamop.NewCombine(b.rctx, parents)}, nil
 	case *dag.Fork:
 		return b.compileVamFork(o, parents)
 	case *dag.Join:
-		// see sam version for ref
+		return b.compileVamJoin(o, parents)
+	case *dag.Output:
+		// The output is materialized here so that its channel can be
+		// pulled alongside the other outputs of the flowgraph.
+		parent := parents[0]
+		if len(parents) > 1 {
+			parent = vamop.NewCombine(b.rctx, parents)
+		}
+		b.channels[o.Name] = append(b.channels[o.Name], vam.NewMaterializer(parent))
+		return nil, nil
 	case *dag.Merge:
-		//e, err := b.compileVamExpr(o.Expr)
-		//if err != nil {
-		//	return nil, err
-		//}
-		//XXX this needs to be native
-		//cmp := vamexpr.NewComparator(true, o.Order == order.Desc, e).WithMissingAsNull()
-		//return []vector.Puller{vamop.NewMerge(b.rctx, parents, cmp.Compare)}, nil
+		e, err := b.compileVamExpr(o.Expr)
+		if err != nil {
+			return nil, err
+		}
+		cmp := vamexpr.NewComparator(o.Order, true).WithMissingAsNull()
+		return []vector.Puller{vamop.NewMerge(b.rctx, parents, e, cmp)}, nil
 	case *dag.Scatter:
-		//return b.compileVecScatter(o, parents)
+		return b.compileVamScatter(o, parents)
 	case *dag.Scope:
-		//return b.compileVecScope(o, parents)
+		return b.compileVamScope(o, parents)
 	case *dag.Switch:
-		//if o.Expr != nil {
-		//	return b.compileVamExprSwitch(o, parents)
-		//}
-		//return b.compileVecSwitch(o, parents)
+		if o.Expr != nil {
+			return b.compileVamExprSwitch(o, parents)
+		}
+		return b.compileVamSwitch(o, parents)
 	default:
 		var parent vector.Puller
 		if len(parents) == 1 {
@@ -53,7 +65,6 @@ func (b *Builder) compileVam(o dag.Op, parents []vector.Puller) ([]vector.Puller
 		}
 		return []vector.Puller{p}, nil
 	}
-	return nil, fmt.Errorf("unsupported dag op in vectorize: %T", o)
 }
 
 func (b *Builder) compileVamScan(scan *dag.SeqScan, parent zbuf.Puller) (vector.Puller, error) {
@@ -62,7 +73,81 @@ func (b *Builder) compileVamScan(scan *dag.SeqScan, parent zbuf.Puller) (vector.
 		return nil, err
 	}
 	//XXX check VectorCache not nil
-	return vamop.NewScanner(b.rctx, b.source.Lake().VectorCache(), parent, pool, scan.Fields, nil, nil), nil
+	var puller vector.Puller = vamop.NewScanner(b.rctx, b.source.Lake().VectorCache(), parent, pool, scan.Fields, nil, nil)
+	if scan.Filter != nil {
+		e, err := b.compileVamExpr(scan.Filter)
+		if err != nil {
+			return nil, err
+		}
+		puller = vamop.NewFilter(b.zctx(), puller, e)
+	}
+	return puller, nil
+}
+
+// compileVamScanSeq compiles seq, which must begin with a SeqScan or with a
+// Scatter whose paths each begin with a SeqScan, so that the scans pull data
+// objects from parent.
+func (b *Builder) compileVamScanSeq(seq dag.Seq, parent zbuf.Puller) ([]vector.Puller, error) {
+	var parents []vector.Puller
+	switch o := seq[0].(type) {
+	case *dag.Scatter:
+		for _, path := range o.Paths {
+			exits, err := b.compileVamScanSeq(path, parent)
+			if err != nil {
+				return nil, err
+			}
+			parents = append(parents, exits...)
+		}
+	case *dag.PoolScan:
+		puller, err := b.compileVamPoolScan(o)
+		if err != nil {
+			return nil, err
+		}
+		parents = []vector.Puller{puller}
+	case *dag.SeqScan:
+		puller, err := b.compileVamScan(o, parent)
+		if err != nil {
+			return nil, err
+		}
+		parents = []vector.Puller{puller}
+	default:
+		return nil, errors.New("dag.Vectorize must begin with PoolScan, SeqScan, or Scatter")
+	}
+	return b.compileVamSeq(seq[1:], parents)
+}
+
+// compileVamPoolScan is the vector analog of compilePoolScan.
+func (b *Builder) compileVamPoolScan(scan *dag.PoolScan) (vector.Puller, error) {
+	pool, err := b.lookupPool(scan.ID)
+	if err != nil {
+		return nil, err
+	}
+	l, err := meta.NewSortedLister(b.rctx.Context, b.mctx, pool, scan.Commit, nil)
+	if err != nil {
+		return nil, err
+	}
+	slicer := meta.NewSlicer(l, b.mctx)
+	return vamop.NewScanner(b.rctx, b.source.Lake().VectorCache(), slicer, pool, nil, nil, nil), nil
+}
+
+// compileVamEntry compiles seq, which begins with a data source.  A lister
+// and slicer at the start of seq run in the sequential runtime and feed data
+// objects to the vector scan that follows them.
+func (b *Builder) compileVamEntry(seq dag.Seq) ([]vector.Puller, error) {
+	var parent zbuf.Puller
+	for len(seq) > 0 {
+		switch seq[0].(type) {
+		case *dag.Lister, *dag.Slicer:
+		default:
+			return b.compileVamScanSeq(seq, parent)
+		}
+		var err error
+		if parent, err = b.compileLeaf(seq[0], parent); err != nil {
+			return nil, err
+		}
+		seq = seq[1:]
+	}
+	return nil, errors.New("internal error: vector data source has no scan")
 }
 
 func (b *Builder) compileVamFork(fork *dag.Fork, parents []vector.Puller) ([]vector.Puller, error) {
@@ -79,8 +164,16 @@ func (b *Builder) compileVamFork(fork *dag.Fork, parents []vector.Puller) ([]vec
 	}
 	var exits []vector.Puller
 	for _, seq := range fork.Paths {
+		if isEntry(seq) {
+			exit, err := b.compileVamEntry(seq)
+			if err != nil {
+				return nil, err
+			}
+			exits = append(exits, exit...)
+			continue
+		}
 		var parent vector.Puller
-		if f != nil && !isEntry(seq) {
+		if f != nil {
 			parent = f.AddExit()
 		}
 		exit, err := b.compileVamSeq(seq, []vector.Puller{parent})
@@ -92,6 +185,130 @@ func (b *Builder) compileVamFork(fork *dag.Fork, parents []vector.Puller) ([]vec
 	return exits, nil
 }
 
+func (b *Builder) compileVamScatter(scatter *dag.Scatter, parents []vector.Puller) ([]vector.Puller, error) {
+	if len(parents) != 1 {
+		return nil, errors.New("internal error: scatter operator requires a single parent")
+	}
+	var ops []vector.Puller
+	for _, seq := range scatter.Paths {
+		exits, err := b.compileVamSeq(seq, parents[:1])
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, exits...)
+	}
+	return ops, nil
+}
+
+func (b *Builder) compileVamScope(scope *dag.Scope, parents []vector.Puller) ([]vector.Puller, error) {
+	// See compileScope.
+	parentUDFs := b.udfs
+	b.udfs = maps.Clone(parentUDFs)
+	defer func() { b.udfs = parentUDFs }()
+	for _, f := range scope.Funcs {
+		b.udfs[f.Name] = f.Expr
+	}
+	return b.compileVamSeq(scope.Body, parents)
+}
+
+func (b *Builder) compileVamExprSwitch(swtch *dag.Switch, parents []vector.Puller) ([]vector.Puller, error) {
+	parent := parents[0]
+	if len(parents) > 1 {
+		parent = vamop.NewCombine(b.rctx, parents)
+	}
+	e, err := b.compileVamExpr(swtch.Expr)
+	if err != nil {
+		return nil, err
+	}
+	s := vamop.NewExprSwitch(b.rctx, parent, e)
+	var exits []vector.Puller
+	for _, c := range swtch.Cases {
+		var val *zed.Value
+		if c.Expr != nil {
+			val2, err := b.evalAtCompileTime(c.Expr)
+			if err != nil {
+				return nil, err
+			}
+			if val2.IsError() {
+				return nil, errors.New("switch case is not a constant expression")
+			}
+			val = &val2
+		}
+		parents, err := b.compileVamSeq(c.Path, []vector.Puller{s.AddCase(val)})
+		if err != nil {
+			return nil, err
+		}
+		exits = append(exits, parents...)
+	}
+	return exits, nil
+}
+
+func (b *Builder) compileVamSwitch(swtch *dag.Switch, parents []vector.Puller) ([]vector.Puller, error) {
+	parent := parents[0]
+	if len(parents) > 1 {
+		parent = vamop.NewCombine(b.rctx, parents)
+	}
+	var exprs []vamexpr.Evaluator
+	for _, c := range swtch.Cases {
+		e, err := b.compileVamExpr(c.Expr)
+		if err != nil {
+			return nil, fmt.Errorf("compiling switch case filter: %w", err)
+		}
+		exprs = append(exprs, e)
+	}
+	s := vamop.NewSwitch(b.rctx, parent)
+	var exits []vector.Puller
+	for i, e := range exprs {
+		o, err := b.compileVamSeq(swtch.Cases[i].Path, []vector.Puller{s.AddCase(e)})
+		if err != nil {
+			return nil, err
+		}
+		exits = append(exits, o...)
+	}
+	return exits, nil
+}
+
+func (b *Builder) compileVamJoin(join *dag.Join, parents []vector.Puller) ([]vector.Puller, error) {
+	if len(parents) != 2 {
+		return nil, ErrJoinParents
+	}
+	leftKeys, err := b.compileVamExprs(join.LeftKeys)
+	if err != nil {
+		return nil, err
+	}
+	rightKeys, err := b.compileVamExprs(join.RightKeys)
+	if err != nil {
+		return nil, err
+	}
+	var cond vamexpr.Evaluator
+	if join.Cond != nil {
+		if cond, err = b.compileVamExpr(join.Cond); err != nil {
+			return nil, err
+		}
+	}
+	cutter, err := b.compileVamAssignmentsToRecordExpression(nil, join.Args)
+	if err != nil {
+		return nil, err
+	}
+	leftParent, rightParent := parents[0], parents[1]
+	var anti, inner, full bool
+	switch join.Style {
+	case "anti":
+		anti = true
+	case "full":
+		full = true
+	case "inner":
+		inner = true
+	case "left":
+	case "right":
+		leftKeys, rightKeys = rightKeys, leftKeys
+		leftParent, rightParent = rightParent, leftParent
+	default:
+		return nil, fmt.Errorf("unknown kind of join: '%s'", join.Style)
+	}
+	return []vector.Puller{vamop.NewJoin(b.rctx, anti, inner, full, leftParent, rightParent, leftKeys, rightKeys, cond, join.Style == "right", cutter)}, nil
+}
+
 func (b *Builder) compileVamLeaf(o dag.Op, parent vector.Puller) (vector.Puller, error) {
 	switch o := o.(type) {
 	case *dag.Cut:
@@ -115,9 +332,6 @@ func (b *Builder) compileVamLeaf(o dag.Op, parent vector.Puller) (vector.Puller,
 		return vamop.NewFilter(b.zctx(), parent, e), nil
 	case *dag.Head:
 		return vamop.NewHead(parent, o.Count), nil
-	case *dag.Output:
-		// XXX Ignore Output op for vectors for now.
-		return parent, nil
 	case *dag.Over:
 		return b.compileVamOver(o, parent)
 	case *dag.Pass:
@@ -150,13 +364,7 @@ func (b *Builder) compileVamLeaf(o dag.Op, parent vector.Puller) (vector.Puller,
 		}
 		return vamop.NewSort(b.rctx, parent, sortExprs, o.NullsFirst, o.Reverse, b.resetters), nil
 	case *dag.Summarize:
-		if name, ok := optimizer.IsCountByString(o); ok {
-			return vamop.NewCountByString(b.zctx(), parent, name), nil
-		} else if name, ok := optimizer.IsSum(o); ok {
-			return vamop.NewSum(b.zctx(), parent, name), nil
-		} else {
-			return nil, fmt.Errorf("internal error: unhandled dag.Summarize: %#v", o)
-		}
+		return b.compileVamSummarize(o, parent)
 	case *dag.Tail:
 		return vamop.NewTail(parent, o.Count), nil
 	case *dag.Yield:
@@ -170,6 +378,64 @@ func (b *Builder) compileVamLeaf(o dag.Op, parent vector.Puller) (vector.Puller,
 	}
 }
 
+func (b *Builder) compileVamSummarize(s *dag.Summarize, parent vector.Puller) (vector.Puller, error) {
+	var keyNames []field.Path
+	var keyExprs []vamexpr.Evaluator
+	for _, k := range s.Keys {
+		lhs, ok := k.LHS.(*dag.This)
+		if !ok {
+			return nil, errors.New("invalid lval in groupby key")
+		}
+		e, err := b.compileVamExpr(k.RHS)
+		if err != nil {
+			return nil, err
+		}
+		keyNames = append(keyNames, lhs.Path)
+		keyExprs = append(keyExprs, e)
+	}
+	var aggNames []field.Path
+	var aggs []*vamexpr.Aggregator
+	for _, a := range s.Aggs {
+		lhs, ok := a.LHS.(*dag.This)
+		if !ok {
+			return nil, fmt.Errorf("internal error: aggregator assignment LHS is not a static path: %#v", a.LHS)
+		}
+		agg, err := b.compileVamAgg(a.RHS)
+		if err != nil {
+			return nil, err
+		}
+		aggNames = append(aggNames, lhs.Path)
+		aggs = append(aggs, agg)
+	}
+	return vamop.NewSummarize(b.rctx, parent, keyNames, keyExprs, aggNames, aggs, s.PartialsIn, s.PartialsOut)
+}
+
+func (b *Builder) compileVamAgg(e dag.Expr) (*vamexpr.Aggregator, error) {
+	agg, ok := e.(*dag.Agg)
+	if !ok {
+		return nil, errors.New("aggregator is not an aggregation expression")
+	}
+	argExpr, paramExprs := aggArgs(agg)
+	var arg, where vamexpr.Evaluator
+	if argExpr != nil {
+		var err error
+		if arg, err = b.compileVamExpr(argExpr); err != nil {
+			return nil, err
+		}
+	}
+	if agg.Where != nil {
+		var err error
+		if where, err = b.compileVamExpr(agg.Where); err != nil {
+			return nil, err
+		}
+	}
+	params, err := b.compileAggParams(agg.Name, paramExprs)
+	if err != nil {
+		return nil, err
+	}
+	return vamexpr.NewAggregator(agg.Name, arg, where, b.quantileMemory(), params...)
+}
+
 func (b *Builder) compileVamAssignmentsToRecordExpression(initial []dag.RecordElem, assignments []dag.Assignment) (vamexpr.Evaluator, error) {
 	elems := initial
 	for _, a := range assignments {
@@ -195,15 +461,11 @@ func newDagRecordExprForPath(path []string, expr dag.Expr) *dag.RecordExpr {
 }
 
 func (b *Builder) compileVamOver(over *dag.Over, parent vector.Puller) (vector.Puller, error) {
-	// withNames, withExprs, err := b.compileDefs(over.Defs)
-	// if err != nil {
-	// 	return nil, err
-	// }
-	exprs, err := b.compileVamExprs(over.Exprs)
+	o, err := b.compileVamOverExprs(parent, over.Defs, over.Exprs)
 	if err != nil {
 		return nil, err
 	}
-	o := vamop.NewOver(b.zctx(), parent, exprs)
+	defer b.exitVamVars(len(over.Defs))
 	if over.Body == nil {
 		return o, nil
 	}
@@ -223,6 +485,64 @@ func (b *Builder) compileVamOver(over *dag.Over, parent vector.Puller) (vector.P
 	return o.NewScopeExit(exit), nil
 }
 
+// compileVamOverExprs returns a vamop.Over for defs and exprs and brings the
+// variables of defs into scope.  The caller must call exitVamVars after
+// compiling the body of the Over.
+func (b *Builder) compileVamOverExprs(parent vector.Puller, defs []dag.Def, exprs []dag.Expr) (*vamop.Over, error) {
+	defExprs := make([]vamexpr.Evaluator, 0, len(defs))
+	for _, def := range defs {
+		e, err := b.compileVamExpr(def.Expr)
+		if err != nil {
+			return nil, err
+		}
+		defExprs = append(defExprs, e)
+	}
+	overExprs, err := b.compileVamExprs(exprs)
+	if err != nil {
+		return nil, err
+	}
+	o := vamop.NewOver(b.zctx(), parent, overExprs)
+	if len(defs) > 0 {
+		if b.vamVars == nil {
+			b.vamVars = &vamexpr.Vars{}
+		}
+		o.Bind(b.vamVars, b.nvamVars, defExprs)
+		b.nvamVars += len(defs)
+	}
+	return o, nil
+}
+
+func (b *Builder) exitVamVars(n int) {
+	b.nvamVars -= n
+	if b.nvamVars == 0 {
+		// Variables are local to the outermost scope that defines them.
+		b.vamVars = nil
+	}
+}
+
+func (b *Builder) compileVamOverExpr(over *dag.OverExpr) (vamexpr.Evaluator, error) {
+	o, err := b.compileVamOverExprs(nil, over.Defs, over.Exprs)
+	if err != nil {
+		return nil, err
+	}
+	defer b.exitVamVars(len(over.Defs))
+	e := vamop.NewOverExpr(b.zctx(), o)
+	exits, err := b.compileVamSeq(over.Body, []vector.Puller{e})
+	if err != nil {
+		return nil, err
+	}
+	var exit vector.Puller
+	if len(exits) == 1 {
+		exit = exits[0]
+	} else {
+		// This can happen when output of over body
+		// is a fork or switch.
+		exit = vamop.NewCombine(b.rctx, exits)
+	}
+	e.SetExit(exit)
+	return e, nil
+}
+
 func (b *Builder) compileVamSeq(seq dag.Seq, parents []vector.Puller) ([]vector.Puller, error) {
 	for _, o := range seq {
 		var err error
@@ -0,0 +1,66 @@
+package kernel
+
+import (
+	"fmt"
+
+	"github.com/brimdata/super/compiler/ast/dag"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/op/window"
+	"github.com/brimdata/super/zbuf"
+)
+
+func (b *Builder) compileWindow(parent zbuf.Puller, w *dag.Window) (*window.Op, error) {
+	keys, err := b.compileExprs(w.Keys)
+	if err != nil {
+		return nil, err
+	}
+	var sortExprs []expr.SortEvaluator
+	for _, s := range w.Sort {
+		k, err := b.compileExpr(s.Key)
+		if err != nil {
+			return nil, err
+		}
+		sortExprs = append(sortExprs, expr.NewSortEvaluator(k, s.Order))
+	}
+	lhs := make([]*expr.Lval, 0, len(w.Funcs))
+	funcs := make([]window.Function, 0, len(w.Funcs))
+	for _, f := range w.Funcs {
+		lval, err := b.compileLval(f.LHS)
+		if err != nil {
+			return nil, err
+		}
+		fn, err := b.compileWindowFunc(f)
+		if err != nil {
+			return nil, err
+		}
+		lhs = append(lhs, lval)
+		funcs = append(funcs, fn)
+	}
+	return window.New(b.rctx, parent, keys, sortExprs, lhs, funcs, b.resetters), nil
+}
+
+func (b *Builder) compileWindowFunc(f dag.WindowFunc) (window.Function, error) {
+	name := f.Func.Name
+	if _, _, ok := window.NumArgs(name); !ok {
+		agg, err := b.compileAgg(f.Func)
+		if err != nil {
+			return nil, err
+		}
+		return window.NewAggFunction(b.zctx(), agg, f.Running), nil
+	}
+	if f.Running {
+		return nil, fmt.Errorf("%s: running applies only to aggregate functions", name)
+	}
+	var e expr.Evaluator
+	if f.Func.Expr != nil {
+		var err error
+		if e, err = b.compileExpr(f.Func.Expr); err != nil {
+			return nil, err
+		}
+	}
+	params, err := b.compileAggParams(name, f.Func.Args)
+	if err != nil {
+		return nil, err
+	}
+	return window.NewFunction(name, e, params...)
+}
@@ -9,8 +9,16 @@ func insertDemand(seq dag.Seq) dag.Seq {
 	demands := InferDemandSeqOut(seq)
 	return walk(seq, true, func(seq dag.Seq) dag.Seq {
 		for _, op := range seq {
-			if s, ok := op.(*dag.SeqScan); ok {
-				s.Fields = demand.Fields(demands[op])
+			switch op := op.(type) {
+			case *dag.SeqScan:
+				op.Fields = demand.Fields(demands[op])
+			case *dag.FileScan:
+				if d, ok := demands[op]; ok {
+					// The pushed-down filter is no longer in seq so add
+					// its demand.
+					d = demand.Union(d, inferDemandExprIn(demand.All(), op.Filter))
+					op.Fields = demand.Fields(d)
+				}
 			}
 		}
 		return seq
@@ -81,10 +89,14 @@ func inferDemandExprIn(demandOut demand.Demand, expr dag.Expr) demand.Demand {
 	switch expr := expr.(type) {
 	case *dag.Agg:
 		// Since we don't know how the expr.Name will transform the inputs, we have to assume demand.All.
-		return demand.Union(
+		d := demand.Union(
 			inferDemandExprIn(demand.All(), expr.Expr),
 			inferDemandExprIn(demand.All(), expr.Where),
 		)
+		for _, arg := range expr.Args {
+			d = demand.Union(d, inferDemandExprIn(demand.All(), arg))
+		}
+		return d
 	case *dag.BinaryExpr:
 		// Since we don't know how the expr.Op will transform the inputs, we have to assume demand.All.
 		demandIn = demand.Union(
@@ -118,3 +118,20 @@ func Fields(d Demand) []field.Path {
 	}
 	return fields
 }
+
+// FromFields is the inverse of Fields.  Since Fields returns nil for both
+// All and None, FromFields returns All for an empty fields.
+func FromFields(fields []field.Path) Demand {
+	if len(fields) == 0 {
+		return All()
+	}
+	d := None()
+	for _, f := range fields {
+		v := All()
+		for i := len(f) - 1; i >= 0; i-- {
+			v = Key(f[i], v)
+		}
+		d = Union(d, v)
+	}
+	return d
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/brimdata/super/compiler/ast/dag"
 	"github.com/brimdata/super/compiler/data"
@@ -255,6 +256,7 @@ func (o *Optimizer) optimizeSourcePaths(seq dag.Seq) (dag.Seq, error) {
 			seq = append(seq, chain...)
 		case *dag.FileScan:
 			op.Filter = filter
+			op.Pruner = newStatsPruner(filter)
 			seq = append(dag.Seq{op}, chain...)
 		case *dag.CommitMetaScan:
 			if op.Tap {
@@ -308,12 +310,17 @@ func (o *Optimizer) propagateSortKeyOp(op dag.Op, parents []order.SortKeys) ([]o
 		if len(parents) != 2 {
 			return nil, errors.New("internal error: join does not have two parents")
 		}
-		if !parents[0].IsNil() && fieldOf(join.LeftKey).Equal(parents[0].Primary().Key) {
+		if !parents[0].IsNil() && fieldOf(join.LeftKeys[0]).Equal(parents[0].Primary().Key) {
 			join.LeftDir = parents[0].Primary().Order.Direction()
 		}
-		if !parents[1].IsNil() && fieldOf(join.RightKey).Equal(parents[1].Primary().Key) {
+		if !parents[1].IsNil() && fieldOf(join.RightKeys[0]).Equal(parents[1].Primary().Key) {
 			join.RightDir = parents[1].Primary().Order.Direction()
 		}
+		// If either input is not sorted by its key, a merge join would
+		// have to sort it so use a hash join instead.  The merge join
+		// also handles only a single key and no condition.
+		join.Hash = join.LeftDir == order.Unknown || join.RightDir == order.Unknown ||
+			len(join.LeftKeys) > 1 || join.Cond != nil
 		// XXX There is definitely a way to propagate the sort key but there's
 		// some complexity here. The propagated sort key should be whatever key
 		// remains between the left and right join keys. If both the left and
@@ -549,7 +556,35 @@ func matchFilter(in []dag.Op) (dag.Expr, []dag.Op) {
 func newRangePruner(pred dag.Expr, sortKey order.SortKey) dag.Expr {
 	min := &dag.This{Kind: "This", Path: field.Path{"min"}}
 	max := &dag.This{Kind: "This", Path: field.Path{"max"}}
-	if e := buildRangePruner(pred, sortKey.Key, min, max); e != nil {
+	leaf := func(this *dag.This, literal *dag.Literal, op string) *dag.BinaryExpr {
+		if !sortKey.Key.Equal(this.Path) {
+			return nil
+		}
+		return rangePrunerPred(op, literal, min, max, compare)
+	}
+	if e := buildRangePruner(pred, leaf); e != nil {
+		return e
+	}
+	return nil
+}
+
+// newStatsPruner is like newRangePruner but for sources with value ranges
+// for many fields, such as the row groups of a Parquet file.  The returned
+// predicate is applied to a record whose fields are those of the source and
+// whose values are records with fields min/max, so "x > 1" becomes a test
+// of this.x.max.  Standard comparisons are used rather than compare() so
+// that a field without a range (i.e., a missing min or max) or with a range
+// of a different type cannot cause pruning.
+func newStatsPruner(pred dag.Expr) dag.Expr {
+	if pred == nil {
+		return nil
+	}
+	leaf := func(this *dag.This, literal *dag.Literal, op string) *dag.BinaryExpr {
+		min := &dag.This{Kind: "This", Path: append(slices.Clone(this.Path), "min")}
+		max := &dag.This{Kind: "This", Path: append(slices.Clone(this.Path), "max")}
+		return rangePrunerPred(op, literal, min, max, dag.NewBinaryExpr)
+	}
+	if e := buildRangePruner(pred, leaf); e != nil {
 		return e
 	}
 	return nil
@@ -564,10 +599,12 @@ func maybeNewRangePruner(pred dag.Expr, sortKeys order.SortKeys) dag.Expr {
 
 // buildRangePruner creates a DAG comparison expression that can evalaute whether
 // a Zed value adhering to the from/to pattern can be excluded from a scan because
-// the expression pred would evaluate to false for all values of fld in the
-// from/to value range.  If a pruning decision cannot be reliably determined then
-// the return value is nil.
-func buildRangePruner(pred dag.Expr, fld field.Path, min, max *dag.This) *dag.BinaryExpr {
+// the expression pred would evaluate to false for all values of a field in the
+// from/to value range.  The leaf function returns the pruning expression for a
+// comparison of a field with a literal or nil if the field's range is unknown.
+// If a pruning decision cannot be reliably determined then the return value
+// is nil.
+func buildRangePruner(pred dag.Expr, leaf func(this *dag.This, literal *dag.Literal, op string) *dag.BinaryExpr) *dag.BinaryExpr {
 	e, ok := pred.(*dag.BinaryExpr)
 	if !ok {
 		// If this isn't a binary predicate composed of comparison operators, we
@@ -582,8 +619,8 @@ func buildRangePruner(pred dag.Expr, fld field.Path, min, max *dag.This) *dag.Bi
 		// For an "and", if we know either side is prunable, then we can prune
 		// because both conditions are required.  So we "or" together the result
 		// when both sub-expressions are valid.
-		lhs := buildRangePruner(e.LHS, fld, min, max)
-		rhs := buildRangePruner(e.RHS, fld, min, max)
+		lhs := buildRangePruner(e.LHS, leaf)
+		rhs := buildRangePruner(e.RHS, leaf)
 		if lhs == nil {
 			return rhs
 		}
@@ -595,44 +632,47 @@ func buildRangePruner(pred dag.Expr, fld field.Path, min, max *dag.This) *dag.Bi
 		// For an "or", if we know both sides are prunable, then we can prune
 		// because either condition is required.  So we "and" together the result
 		// when both sub-expressions are valid.
-		lhs := buildRangePruner(e.LHS, fld, min, max)
-		rhs := buildRangePruner(e.RHS, fld, min, max)
+		lhs := buildRangePruner(e.LHS, leaf)
+		rhs := buildRangePruner(e.RHS, leaf)
 		if lhs == nil || rhs == nil {
 			return nil
 		}
 		return dag.NewBinaryExpr("and", lhs, rhs)
 	case "==", "<", "<=", ">", ">=":
 		this, literal, op := literalComparison(e)
-		if this == nil || !fld.Equal(this.Path) {
+		if this == nil {
 			return nil
 		}
 		// At this point, we know we can definitely run a pruning decision based
 		// on the literal value we found, the comparison op, and the lower/upper bounds.
-		return rangePrunerPred(op, literal, min, max)
+		return leaf(this, literal, op)
 	default:
 		return nil
 	}
 }
 
-func rangePrunerPred(op string, literal *dag.Literal, min, max *dag.This) *dag.BinaryExpr {
+// rangePrunerPred returns the pruning expression for the comparison of a
+// field with a literal, using cmp to compare the literal with the field's
+// lower and upper bounds.
+func rangePrunerPred(op string, literal *dag.Literal, min, max *dag.This, cmp func(string, dag.Expr, dag.Expr) *dag.BinaryExpr) *dag.BinaryExpr {
 	switch op {
 	case "<":
 		// key < CONST
-		return compare("<=", literal, min)
+		return cmp("<=", literal, min)
 	case "<=":
 		// key <= CONST
-		return compare("<", literal, min)
+		return cmp("<", literal, min)
 	case ">":
 		// key > CONST
-		return compare(">=", literal, max)
+		return cmp(">=", literal, max)
 	case ">=":
 		// key >= CONST
-		return compare(">", literal, max)
+		return cmp(">", literal, max)
 	case "==":
 		// key == CONST
 		return dag.NewBinaryExpr("or",
-			compare(">", min, literal),
-			compare("<", max, literal))
+			cmp(">", min, literal),
+			cmp("<", max, literal))
 	}
 	panic("rangePrunerPred unknown op " + op)
 }
@@ -3,38 +3,117 @@ package optimizer
 import (
 	"context"
 
+	"github.com/brimdata/super"
 	"github.com/brimdata/super/compiler/ast/dag"
-	"github.com/brimdata/super/pkg/field"
+	vamfunc "github.com/brimdata/super/runtime/vam/expr/function"
+	"github.com/segmentio/ksuid"
 )
 
+// Vectorize wraps each sequence of operators that the vector runtime can
+// execute in a dag.Vectorize.  Such a sequence begins with a scan of a pool
+// whose data objects all have vectors, or with a scatter of those scans, and
+// extends through the operators following the scan that have vector
+// implementations.
 func (o *Optimizer) Vectorize(seq dag.Seq) (dag.Seq, error) {
+	v := &vectorizer{o, udfsOf(seq)}
 	return walkEntries(seq, func(seq dag.Seq) (dag.Seq, error) {
-		if len(seq) < 2 {
+		// Skip the lister and slicer, which feed data objects to the scan.
+		k := 0
+		for k < len(seq) && isObjectSource(seq[k]) {
+			k++
+		}
+		if k == len(seq) {
 			return seq, nil
 		}
-		if ok, err := o.isScanWithVectors(seq[0]); !ok || err != nil {
+		source, err := v.source(seq[k])
+		if source == nil || err != nil {
 			return seq, err
 		}
-		if _, ok := IsCountByString(seq[1]); ok {
-			return vectorize(seq, 2), nil
+		n := k + 1
+		for n < len(seq) && v.op(seq[n]) {
+			if fork, ok := seq[n].(*dag.Fork); ok {
+				liftVectorEntries(fork)
+			}
+			n++
 		}
-		if _, ok := IsSum(seq[1]); ok {
-			return vectorize(seq, 2), nil
+		body := append(dag.Seq{source}, seq[k+1:n]...)
+		out := append(seq[:k:k], &dag.Vectorize{Kind: "Vectorize", Body: body})
+		return append(out, seq[n:]...), nil
+	})
+}
+
+func isObjectSource(op dag.Op) bool {
+	switch op.(type) {
+	case *dag.Lister, *dag.Slicer:
+		return true
+	}
+	return false
+}
+
+type vectorizer struct {
+	o *Optimizer
+	// udfs holds the names of the user-defined functions in the DAG, which
+	// the vector runtime does not implement.
+	udfs map[string]bool
+}
+
+func udfsOf(seq dag.Seq) map[string]bool {
+	udfs := make(map[string]bool)
+	Walk(seq, func(seq dag.Seq) dag.Seq {
+		for _, op := range seq {
+			if scope, ok := op.(*dag.Scope); ok {
+				for _, f := range scope.Funcs {
+					udfs[f.Name] = true
+				}
+			}
 		}
-		return seq, nil
+		return seq
 	})
+	return udfs
 }
 
-func (o *Optimizer) isScanWithVectors(op dag.Op) (bool, error) {
-	scan, ok := op.(*dag.SeqScan)
-	if !ok {
-		return false, nil
+// source returns the op that begins a vectorized sequence for op, or nil if
+// op cannot begin one.  A scatter can begin a vectorized sequence when each
+// of its paths has already been vectorized in its entirety.
+func (v *vectorizer) source(op dag.Op) (dag.Op, error) {
+	switch op := op.(type) {
+	case *dag.Scatter:
+		var paths []dag.Seq
+		for _, path := range op.Paths {
+			vec, ok := path[0].(*dag.Vectorize)
+			if !ok || len(path) != 1 {
+				return nil, nil
+			}
+			paths = append(paths, vec.Body)
+		}
+		return &dag.Scatter{Kind: "Scatter", Paths: paths}, nil
+	case *dag.PoolScan:
+		ok, err := v.o.hasVectors(op.ID, op.Commit)
+		if !ok || err != nil {
+			return nil, err
+		}
+		return op, nil
+	case *dag.SeqScan:
+		if !v.expr(op.Filter) {
+			return nil, nil
+		}
+		ok, err := v.o.hasVectors(op.Pool, op.Commit)
+		if !ok || err != nil {
+			return nil, err
+		}
+		return op, v.o.projectPoolKey(op)
 	}
-	pool, err := o.lookupPool(scan.Pool)
+	return nil, nil
+}
+
+// hasVectors returns true if every data object of the pool at commit has a
+// vector.
+func (o *Optimizer) hasVectors(poolID, commit ksuid.KSUID) (bool, error) {
+	pool, err := o.lookupPool(poolID)
 	if err != nil {
 		return false, err
 	}
-	snap, err := pool.Snapshot(context.TODO(), scan.Commit)
+	snap, err := pool.Snapshot(context.TODO(), commit)
 	if err != nil {
 		return false, err
 	}
@@ -50,70 +129,250 @@ func (o *Optimizer) isScanWithVectors(op dag.Op) (bool, error) {
 	return true, nil
 }
 
-func vectorize(seq dag.Seq, n int) dag.Seq {
-	return append(dag.Seq{
-		&dag.Vectorize{
-			Kind: "Vectorize",
-			Body: seq[:n],
-		},
-	}, seq[n:]...)
+// projectPoolKey adds the pool key to the fields projected by scan so the
+// vector scanner can merge overlapping objects and a downstream merge can
+// order its inputs.
+func (o *Optimizer) projectPoolKey(scan *dag.SeqScan) error {
+	if len(scan.Fields) == 0 {
+		// All fields are projected.
+		return nil
+	}
+	pool, err := o.lookupPool(scan.Pool)
+	if err != nil {
+		return err
+	}
+	if pool.SortKeys.IsNil() {
+		return nil
+	}
+	if key := pool.SortKeys.Primary().Key; !key.HasPrefixIn(scan.Fields) {
+		scan.Fields = append(scan.Fields, key)
+	}
+	return nil
 }
 
-// IsCountByString returns whether o represents "count() by <top-level field>"
-// along with the field name.
-func IsCountByString(o dag.Op) (string, bool) {
-	s, ok := o.(*dag.Summarize)
-	if ok && len(s.Aggs) == 1 && len(s.Keys) == 1 && isCount(s.Aggs[0]) {
-		return isSingleField(s.Keys[0])
+// vectorEntry returns the operators of path, a path of a fork that begins
+// with a data source, when its data source and the rest of path have been
+// vectorized so that path can be lifted into an enclosing dag.Vectorize.
+func vectorEntry(path dag.Seq) (dag.Seq, bool) {
+	k := 0
+	for k < len(path) && isObjectSource(path[k]) {
+		k++
+	}
+	if k != len(path)-1 {
+		return nil, false
+	}
+	vec, ok := path[k].(*dag.Vectorize)
+	if !ok {
+		return nil, false
 	}
-	return "", false
+	return append(path[:k:k], vec.Body...), true
 }
 
-// IsSum return whether o represents "sum(<top-level field>)" along with the
-// field name.
-func IsSum(o dag.Op) (string, bool) {
-	s, ok := o.(*dag.Summarize)
-	if ok && len(s.Aggs) == 1 && len(s.Keys) == 0 {
-		if path, ok := isSum(s.Aggs[0]); ok && len(path) == 1 {
-			return path[0], true
+func liftVectorEntries(fork *dag.Fork) {
+	for k, path := range fork.Paths {
+		if seq, ok := vectorEntry(path); ok {
+			fork.Paths[k] = seq
 		}
 	}
-	return "", false
 }
 
-func isCount(a dag.Assignment) bool {
-	this, ok := a.LHS.(*dag.This)
-	if !ok || len(this.Path) != 1 || this.Path[0] != "count" {
-		return false
+// op returns true if the vector runtime implements op.  An output is not
+// included in a vectorized sequence unless it ends one of its paths.
+func (v *vectorizer) op(op dag.Op) bool {
+	switch op := op.(type) {
+	case *dag.Combine, *dag.Head, *dag.Pass, *dag.Sort, *dag.Tail:
+		return true
+	case *dag.Cut:
+		return v.assignments(op.Args)
+	case *dag.Drop:
+		for _, e := range op.Args {
+			if _, ok := e.(*dag.This); !ok {
+				return false
+			}
+		}
+		return true
+	case *dag.Filter:
+		return v.expr(op.Expr)
+	case *dag.Fork:
+		for _, path := range op.Paths {
+			if _, ok := vectorEntry(path); !ok && !v.seq(path) {
+				return false
+			}
+		}
+		return true
+	case *dag.Join:
+		// A merge join orders its output by the join keys but the vector
+		// runtime implements only the hash join.
+		return op.Hash && v.exprs(op.LeftKeys) && v.exprs(op.RightKeys) && v.expr(op.Cond) && v.assignments(op.Args)
+	case *dag.Merge:
+		return v.expr(op.Expr)
+	case *dag.Over:
+		return v.defs(op.Defs) && v.exprs(op.Exprs) && v.seq(op.Body)
+	case *dag.Put:
+		return v.assignments(op.Args)
+	case *dag.Rename:
+		return v.assignments(op.Args)
+	case *dag.Scatter:
+		for _, path := range op.Paths {
+			if !v.seq(path) {
+				return false
+			}
+		}
+		return true
+	case *dag.Scope:
+		return v.seq(op.Body)
+	case *dag.Summarize:
+		if !v.assignments(op.Keys) {
+			return false
+		}
+		for _, a := range op.Aggs {
+			agg, ok := a.RHS.(*dag.Agg)
+			if _, isThis := a.LHS.(*dag.This); !ok || !isThis {
+				return false
+			}
+			if !v.expr(agg.Expr) || !v.expr(agg.Where) {
+				return false
+			}
+		}
+		return true
+	case *dag.Switch:
+		// The case expressions of an expression switch are constants.
+		if op.Expr != nil && !v.expr(op.Expr) {
+			return false
+		}
+		for _, c := range op.Cases {
+			if op.Expr == nil && !v.expr(c.Expr) {
+				return false
+			}
+			if !v.seq(c.Path) {
+				return false
+			}
+		}
+		return true
+	case *dag.Yield:
+		return v.exprs(op.Exprs)
 	}
-	agg, ok := a.RHS.(*dag.Agg)
-	return ok && agg.Name == "count" && agg.Expr == nil && agg.Where == nil
+	return false
 }
 
-func isSum(a dag.Assignment) (field.Path, bool) {
-	this, ok := a.LHS.(*dag.This)
-	if !ok || len(this.Path) != 1 || this.Path[0] != "sum" {
-		return nil, false
+// seq returns true if the vector runtime implements every op in seq, which
+// may end in an output.
+func (v *vectorizer) seq(seq dag.Seq) bool {
+	for _, op := range seq {
+		if _, ok := op.(*dag.Output); !ok && !v.op(op) {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *vectorizer) assignments(assignments []dag.Assignment) bool {
+	for _, a := range assignments {
+		if _, ok := a.LHS.(*dag.This); !ok || !v.expr(a.RHS) {
+			return false
+		}
 	}
-	agg, ok := a.RHS.(*dag.Agg)
-	if ok && agg.Name == "sum" && agg.Where == nil {
-		return isThis(agg.Expr)
+	return true
+}
+
+func (v *vectorizer) defs(defs []dag.Def) bool {
+	for _, def := range defs {
+		if !v.expr(def.Expr) {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *vectorizer) exprs(exprs []dag.Expr) bool {
+	for _, e := range exprs {
+		if !v.expr(e) {
+			return false
+		}
 	}
-	return nil, false
+	return true
 }
 
-func isSingleField(a dag.Assignment) (string, bool) {
-	lhs := fieldOf(a.LHS)
-	rhs := fieldOf(a.RHS)
-	if len(lhs) != 1 || len(rhs) != 1 || !lhs.Equal(rhs) {
-		return "", false
+// expr returns true if the vector runtime implements e, which may be nil.
+func (v *vectorizer) expr(e dag.Expr) bool {
+	switch e := e.(type) {
+	case nil, *dag.Literal, *dag.This, *dag.Var:
+		return true
+	case *dag.ArrayExpr:
+		return v.vectorElems(e.Elems)
+	case *dag.BinaryExpr:
+		switch e.Op {
+		case "and", "or", "==", "!=", "<", "<=", ">", ">=", "+", "-", "*", "/", "%":
+			return v.expr(e.LHS) && v.expr(e.RHS)
+		}
+		return false
+	case *dag.Call:
+		if v.udfs[e.Name] {
+			return false
+		}
+		if _, _, err := vamfunc.New(zed.NewContext(), e.Name, len(e.Args)); err != nil {
+			return false
+		}
+		return v.exprs(e.Args)
+	case *dag.Conditional:
+		return v.expr(e.Cond) && v.expr(e.Then) && v.expr(e.Else)
+	case *dag.Dot:
+		return v.expr(e.LHS)
+	case *dag.IndexExpr:
+		return v.expr(e.Expr) && v.expr(e.Index)
+	case *dag.MapCall:
+		return v.expr(e.Expr) && v.expr(e.Inner)
+	case *dag.MapExpr:
+		for _, entry := range e.Entries {
+			if !v.expr(entry.Key) || !v.expr(entry.Value) {
+				return false
+			}
+		}
+		return true
+	case *dag.MapFilter:
+		return v.expr(e.Expr) && v.expr(e.Inner)
+	case *dag.OverExpr:
+		return v.defs(e.Defs) && v.exprs(e.Exprs) && v.seq(e.Body)
+	case *dag.RecordExpr:
+		for _, elem := range e.Elems {
+			switch elem := elem.(type) {
+			case *dag.Field:
+				if !v.expr(elem.Value) {
+					return false
+				}
+			case *dag.Spread:
+				if !v.expr(elem.Expr) {
+					return false
+				}
+			}
+		}
+		return true
+	case *dag.RegexpMatch:
+		return v.expr(e.Expr)
+	case *dag.RegexpSearch:
+		return v.expr(e.Expr)
+	case *dag.Search:
+		return v.expr(e.Expr)
+	case *dag.SetExpr:
+		return v.vectorElems(e.Elems)
+	case *dag.UnaryExpr:
+		return e.Op == "!" && v.expr(e.Operand)
 	}
-	return lhs[0], true
+	return false
 }
 
-func isThis(e dag.Expr) (field.Path, bool) {
-	if this, ok := e.(*dag.This); ok && len(this.Path) >= 1 {
-		return this.Path, true
+func (v *vectorizer) vectorElems(elems []dag.VectorElem) bool {
+	for _, elem := range elems {
+		switch elem := elem.(type) {
+		case *dag.Spread:
+			if !v.expr(elem.Expr) {
+				return false
+			}
+		case *dag.VectorValue:
+			if !v.expr(elem.Expr) {
+				return false
+			}
+		}
 	}
-	return nil, false
+	return true
 }
@@ -24,63 +24,63 @@ var g = &grammar{
 	rules: []*rule{
 		{
 			name: "start",
-			pos:  position{line: 11, col: 1, offset: 150},
+			pos:  position{line: 11, col: 1, offset: 154},
 			expr: &choiceExpr{
-				pos: position{line: 12, col: 5, offset: 160},
+				pos: position{line: 12, col: 5, offset: 164},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 12, col: 5, offset: 160},
+						pos: position{line: 12, col: 5, offset: 164},
 						run: (*parser).callonstart2,
 						expr: &seqExpr{
-							pos: position{line: 12, col: 5, offset: 160},
+							pos: position{line: 12, col: 5, offset: 164},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 12, col: 5, offset: 160},
+									pos:  position{line: 12, col: 5, offset: 164},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 12, col: 8, offset: 163},
+									pos:   position{line: 12, col: 8, offset: 167},
 									label: "scope",
 									expr: &ruleRefExpr{
-										pos:  position{line: 12, col: 14, offset: 169},
+										pos:  position{line: 12, col: 14, offset: 173},
 										name: "Scope",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 12, col: 20, offset: 175},
+									pos:  position{line: 12, col: 20, offset: 179},
 									name: "__",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 12, col: 23, offset: 178},
+									pos:  position{line: 12, col: 23, offset: 182},
 									name: "EOF",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 13, col: 5, offset: 215},
+						pos: position{line: 13, col: 5, offset: 219},
 						run: (*parser).callonstart9,
 						expr: &seqExpr{
-							pos: position{line: 13, col: 5, offset: 215},
+							pos: position{line: 13, col: 5, offset: 219},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 13, col: 5, offset: 215},
+									pos:  position{line: 13, col: 5, offset: 219},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 13, col: 8, offset: 218},
+									pos:   position{line: 13, col: 8, offset: 222},
 									label: "seq",
 									expr: &ruleRefExpr{
-										pos:  position{line: 13, col: 12, offset: 222},
+										pos:  position{line: 13, col: 12, offset: 226},
 										name: "Seq",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 13, col: 16, offset: 226},
+									pos:  position{line: 13, col: 16, offset: 230},
 									name: "__",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 13, col: 19, offset: 229},
+									pos:  position{line: 13, col: 19, offset: 233},
 									name: "EOF",
 								},
 							},
@@ -93,29 +93,29 @@ var g = &grammar{
 		},
 		{
 			name: "Scope",
-			pos:  position{line: 15, col: 1, offset: 254},
+			pos:  position{line: 15, col: 1, offset: 258},
 			expr: &actionExpr{
-				pos: position{line: 16, col: 5, offset: 264},
+				pos: position{line: 16, col: 5, offset: 268},
 				run: (*parser).callonScope1,
 				expr: &seqExpr{
-					pos: position{line: 16, col: 5, offset: 264},
+					pos: position{line: 16, col: 5, offset: 268},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 16, col: 5, offset: 264},
+							pos:   position{line: 16, col: 5, offset: 268},
 							label: "decls",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 16, col: 11, offset: 270},
+								pos: position{line: 16, col: 11, offset: 274},
 								expr: &ruleRefExpr{
-									pos:  position{line: 16, col: 11, offset: 270},
+									pos:  position{line: 16, col: 11, offset: 274},
 									name: "Decl",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 16, col: 17, offset: 276},
+							pos:   position{line: 16, col: 17, offset: 280},
 							label: "body",
 							expr: &ruleRefExpr{
-								pos:  position{line: 16, col: 22, offset: 281},
+								pos:  position{line: 16, col: 22, offset: 285},
 								name: "Seq",
 							},
 						},
@@ -127,28 +127,28 @@ var g = &grammar{
 		},
 		{
 			name: "Seq",
-			pos:  position{line: 24, col: 1, offset: 433},
+			pos:  position{line: 24, col: 1, offset: 437},
 			expr: &actionExpr{
-				pos: position{line: 25, col: 5, offset: 441},
+				pos: position{line: 25, col: 5, offset: 445},
 				run: (*parser).callonSeq1,
 				expr: &seqExpr{
-					pos: position{line: 25, col: 5, offset: 441},
+					pos: position{line: 25, col: 5, offset: 445},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 25, col: 5, offset: 441},
+							pos:   position{line: 25, col: 5, offset: 445},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 25, col: 11, offset: 447},
+								pos:  position{line: 25, col: 11, offset: 451},
 								name: "Operation",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 25, col: 21, offset: 457},
+							pos:   position{line: 25, col: 21, offset: 461},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 25, col: 26, offset: 462},
+								pos: position{line: 25, col: 26, offset: 466},
 								expr: &ruleRefExpr{
-									pos:  position{line: 25, col: 26, offset: 462},
+									pos:  position{line: 25, col: 26, offset: 466},
 									name: "SeqTail",
 								},
 							},
@@ -161,30 +161,30 @@ var g = &grammar{
 		},
 		{
 			name: "SeqTail",
-			pos:  position{line: 29, col: 1, offset: 519},
+			pos:  position{line: 29, col: 1, offset: 523},
 			expr: &actionExpr{
-				pos: position{line: 29, col: 11, offset: 529},
+				pos: position{line: 29, col: 11, offset: 533},
 				run: (*parser).callonSeqTail1,
 				expr: &seqExpr{
-					pos: position{line: 29, col: 11, offset: 529},
+					pos: position{line: 29, col: 11, offset: 533},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 29, col: 11, offset: 529},
+							pos:  position{line: 29, col: 11, offset: 533},
 							name: "__",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 29, col: 14, offset: 532},
+							pos:  position{line: 29, col: 14, offset: 536},
 							name: "Pipe",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 29, col: 19, offset: 537},
+							pos:  position{line: 29, col: 19, offset: 541},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 29, col: 22, offset: 540},
+							pos:   position{line: 29, col: 22, offset: 544},
 							label: "o",
 							expr: &ruleRefExpr{
-								pos:  position{line: 29, col: 24, offset: 542},
+								pos:  position{line: 29, col: 24, offset: 546},
 								name: "Operation",
 							},
 						},
@@ -196,40 +196,40 @@ var g = &grammar{
 		},
 		{
 			name: "Decl",
-			pos:  position{line: 31, col: 1, offset: 571},
+			pos:  position{line: 31, col: 1, offset: 575},
 			expr: &actionExpr{
-				pos: position{line: 32, col: 5, offset: 580},
+				pos: position{line: 32, col: 5, offset: 584},
 				run: (*parser).callonDecl1,
 				expr: &seqExpr{
-					pos: position{line: 32, col: 5, offset: 580},
+					pos: position{line: 32, col: 5, offset: 584},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 32, col: 5, offset: 580},
+							pos:   position{line: 32, col: 5, offset: 584},
 							label: "v",
 							expr: &choiceExpr{
-								pos: position{line: 32, col: 8, offset: 583},
+								pos: position{line: 32, col: 8, offset: 587},
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 32, col: 8, offset: 583},
+										pos:  position{line: 32, col: 8, offset: 587},
 										name: "ConstDecl",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 32, col: 20, offset: 595},
+										pos:  position{line: 32, col: 20, offset: 599},
 										name: "FuncDecl",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 32, col: 31, offset: 606},
+										pos:  position{line: 32, col: 31, offset: 610},
 										name: "OpDecl",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 32, col: 40, offset: 615},
+										pos:  position{line: 32, col: 40, offset: 619},
 										name: "TypeDecl",
 									},
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 32, col: 50, offset: 625},
+							pos:  position{line: 32, col: 50, offset: 629},
 							name: "_",
 						},
 					},
@@ -240,50 +240,50 @@ var g = &grammar{
 		},
 		{
 			name: "ConstDecl",
-			pos:  position{line: 34, col: 1, offset: 646},
+			pos:  position{line: 34, col: 1, offset: 650},
 			expr: &actionExpr{
-				pos: position{line: 35, col: 5, offset: 660},
+				pos: position{line: 35, col: 5, offset: 664},
 				run: (*parser).callonConstDecl1,
 				expr: &seqExpr{
-					pos: position{line: 35, col: 5, offset: 660},
+					pos: position{line: 35, col: 5, offset: 664},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 35, col: 5, offset: 660},
+							pos:        position{line: 35, col: 5, offset: 664},
 							val:        "const",
 							ignoreCase: false,
 							want:       "\"const\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 35, col: 13, offset: 668},
+							pos:  position{line: 35, col: 13, offset: 672},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 35, col: 15, offset: 670},
+							pos:   position{line: 35, col: 15, offset: 674},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 35, col: 20, offset: 675},
+								pos:  position{line: 35, col: 20, offset: 679},
 								name: "Identifier",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 35, col: 31, offset: 686},
+							pos:  position{line: 35, col: 31, offset: 690},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 35, col: 34, offset: 689},
+							pos:        position{line: 35, col: 34, offset: 693},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 35, col: 38, offset: 693},
+							pos:  position{line: 35, col: 38, offset: 697},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 35, col: 41, offset: 696},
+							pos:   position{line: 35, col: 41, offset: 700},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 35, col: 46, offset: 701},
+								pos:  position{line: 35, col: 46, offset: 705},
 								name: "Expr",
 							},
 						},
@@ -295,104 +295,104 @@ var g = &grammar{
 		},
 		{
 			name: "FuncDecl",
-			pos:  position{line: 44, col: 1, offset: 887},
+			pos:  position{line: 44, col: 1, offset: 891},
 			expr: &actionExpr{
-				pos: position{line: 45, col: 5, offset: 900},
+				pos: position{line: 45, col: 5, offset: 904},
 				run: (*parser).callonFuncDecl1,
 				expr: &seqExpr{
-					pos: position{line: 45, col: 5, offset: 900},
+					pos: position{line: 45, col: 5, offset: 904},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 45, col: 5, offset: 900},
+							pos:        position{line: 45, col: 5, offset: 904},
 							val:        "func",
 							ignoreCase: false,
 							want:       "\"func\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 45, col: 12, offset: 907},
+							pos:  position{line: 45, col: 12, offset: 911},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 45, col: 14, offset: 909},
+							pos:   position{line: 45, col: 14, offset: 913},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 45, col: 19, offset: 914},
+								pos:  position{line: 45, col: 19, offset: 918},
 								name: "Identifier",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 45, col: 30, offset: 925},
+							pos:  position{line: 45, col: 30, offset: 929},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 45, col: 33, offset: 928},
+							pos:        position{line: 45, col: 33, offset: 932},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 45, col: 37, offset: 932},
+							pos:  position{line: 45, col: 37, offset: 936},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 45, col: 40, offset: 935},
+							pos:   position{line: 45, col: 40, offset: 939},
 							label: "params",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 45, col: 47, offset: 942},
+								pos: position{line: 45, col: 47, offset: 946},
 								expr: &ruleRefExpr{
-									pos:  position{line: 45, col: 47, offset: 942},
+									pos:  position{line: 45, col: 47, offset: 946},
 									name: "Identifiers",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 45, col: 60, offset: 955},
+							pos:  position{line: 45, col: 60, offset: 959},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 45, col: 63, offset: 958},
+							pos:        position{line: 45, col: 63, offset: 962},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 45, col: 67, offset: 962},
+							pos:  position{line: 45, col: 67, offset: 966},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 45, col: 70, offset: 965},
+							pos:        position{line: 45, col: 70, offset: 969},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 45, col: 74, offset: 969},
+							pos:  position{line: 45, col: 74, offset: 973},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 45, col: 77, offset: 972},
+							pos:        position{line: 45, col: 77, offset: 976},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 45, col: 81, offset: 976},
+							pos:  position{line: 45, col: 81, offset: 980},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 45, col: 84, offset: 979},
+							pos:   position{line: 45, col: 84, offset: 983},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 45, col: 89, offset: 984},
+								pos:  position{line: 45, col: 89, offset: 988},
 								name: "Expr",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 45, col: 94, offset: 989},
+							pos:  position{line: 45, col: 94, offset: 993},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 45, col: 97, offset: 992},
+							pos:        position{line: 45, col: 97, offset: 996},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -405,104 +405,104 @@ var g = &grammar{
 		},
 		{
 			name: "OpDecl",
-			pos:  position{line: 56, col: 1, offset: 1242},
+			pos:  position{line: 56, col: 1, offset: 1246},
 			expr: &actionExpr{
-				pos: position{line: 57, col: 5, offset: 1253},
+				pos: position{line: 57, col: 5, offset: 1257},
 				run: (*parser).callonOpDecl1,
 				expr: &seqExpr{
-					pos: position{line: 57, col: 5, offset: 1253},
+					pos: position{line: 57, col: 5, offset: 1257},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 57, col: 5, offset: 1253},
+							pos:        position{line: 57, col: 5, offset: 1257},
 							val:        "op",
 							ignoreCase: false,
 							want:       "\"op\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 57, col: 10, offset: 1258},
+							pos:  position{line: 57, col: 10, offset: 1262},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 57, col: 12, offset: 1260},
+							pos:   position{line: 57, col: 12, offset: 1264},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 57, col: 17, offset: 1265},
+								pos:  position{line: 57, col: 17, offset: 1269},
 								name: "Identifier",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 57, col: 28, offset: 1276},
+							pos:  position{line: 57, col: 28, offset: 1280},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 57, col: 31, offset: 1279},
+							pos:        position{line: 57, col: 31, offset: 1283},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 57, col: 35, offset: 1283},
+							pos:  position{line: 57, col: 35, offset: 1287},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 57, col: 38, offset: 1286},
+							pos:   position{line: 57, col: 38, offset: 1290},
 							label: "params",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 57, col: 45, offset: 1293},
+								pos: position{line: 57, col: 45, offset: 1297},
 								expr: &ruleRefExpr{
-									pos:  position{line: 57, col: 45, offset: 1293},
+									pos:  position{line: 57, col: 45, offset: 1297},
 									name: "Identifiers",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 57, col: 58, offset: 1306},
+							pos:  position{line: 57, col: 58, offset: 1310},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 57, col: 61, offset: 1309},
+							pos:        position{line: 57, col: 61, offset: 1313},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 57, col: 65, offset: 1313},
+							pos:  position{line: 57, col: 65, offset: 1317},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 57, col: 68, offset: 1316},
+							pos:        position{line: 57, col: 68, offset: 1320},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 57, col: 72, offset: 1320},
+							pos:  position{line: 57, col: 72, offset: 1324},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 57, col: 75, offset: 1323},
+							pos:        position{line: 57, col: 75, offset: 1327},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 57, col: 79, offset: 1327},
+							pos:  position{line: 57, col: 79, offset: 1331},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 57, col: 82, offset: 1330},
+							pos:   position{line: 57, col: 82, offset: 1334},
 							label: "body",
 							expr: &ruleRefExpr{
-								pos:  position{line: 57, col: 87, offset: 1335},
+								pos:  position{line: 57, col: 87, offset: 1339},
 								name: "OpDeclBody",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 57, col: 98, offset: 1346},
+							pos:  position{line: 57, col: 98, offset: 1350},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 57, col: 101, offset: 1349},
+							pos:        position{line: 57, col: 101, offset: 1353},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -515,30 +515,30 @@ var g = &grammar{
 		},
 		{
 			name: "OpDeclBody",
-			pos:  position{line: 68, col: 1, offset: 1601},
+			pos:  position{line: 68, col: 1, offset: 1605},
 			expr: &choiceExpr{
-				pos: position{line: 69, col: 5, offset: 1616},
+				pos: position{line: 69, col: 5, offset: 1620},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 69, col: 5, offset: 1616},
+						pos: position{line: 69, col: 5, offset: 1620},
 						run: (*parser).callonOpDeclBody2,
 						expr: &labeledExpr{
-							pos:   position{line: 69, col: 5, offset: 1616},
+							pos:   position{line: 69, col: 5, offset: 1620},
 							label: "scope",
 							expr: &ruleRefExpr{
-								pos:  position{line: 69, col: 11, offset: 1622},
+								pos:  position{line: 69, col: 11, offset: 1626},
 								name: "Scope",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 70, col: 5, offset: 1661},
+						pos: position{line: 70, col: 5, offset: 1665},
 						run: (*parser).callonOpDeclBody5,
 						expr: &labeledExpr{
-							pos:   position{line: 70, col: 5, offset: 1661},
+							pos:   position{line: 70, col: 5, offset: 1665},
 							label: "seq",
 							expr: &ruleRefExpr{
-								pos:  position{line: 70, col: 9, offset: 1665},
+								pos:  position{line: 70, col: 9, offset: 1669},
 								name: "Seq",
 							},
 						},
@@ -550,50 +550,50 @@ var g = &grammar{
 		},
 		{
 			name: "TypeDecl",
-			pos:  position{line: 72, col: 1, offset: 1690},
+			pos:  position{line: 72, col: 1, offset: 1694},
 			expr: &actionExpr{
-				pos: position{line: 73, col: 5, offset: 1703},
+				pos: position{line: 73, col: 5, offset: 1707},
 				run: (*parser).callonTypeDecl1,
 				expr: &seqExpr{
-					pos: position{line: 73, col: 5, offset: 1703},
+					pos: position{line: 73, col: 5, offset: 1707},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 73, col: 5, offset: 1703},
+							pos:        position{line: 73, col: 5, offset: 1707},
 							val:        "type",
 							ignoreCase: false,
 							want:       "\"type\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 73, col: 12, offset: 1710},
+							pos:  position{line: 73, col: 12, offset: 1714},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 73, col: 14, offset: 1712},
+							pos:   position{line: 73, col: 14, offset: 1716},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 73, col: 19, offset: 1717},
+								pos:  position{line: 73, col: 19, offset: 1721},
 								name: "Identifier",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 73, col: 30, offset: 1728},
+							pos:  position{line: 73, col: 30, offset: 1732},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 73, col: 33, offset: 1731},
+							pos:        position{line: 73, col: 33, offset: 1735},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 73, col: 37, offset: 1735},
+							pos:  position{line: 73, col: 37, offset: 1739},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 73, col: 40, offset: 1738},
+							pos:   position{line: 73, col: 40, offset: 1742},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 73, col: 44, offset: 1742},
+								pos:  position{line: 73, col: 44, offset: 1746},
 								name: "Type",
 							},
 						},
@@ -605,49 +605,49 @@ var g = &grammar{
 		},
 		{
 			name: "Operation",
-			pos:  position{line: 82, col: 1, offset: 1920},
+			pos:  position{line: 82, col: 1, offset: 1924},
 			expr: &choiceExpr{
-				pos: position{line: 83, col: 5, offset: 1934},
+				pos: position{line: 83, col: 5, offset: 1938},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 83, col: 5, offset: 1934},
+						pos: position{line: 83, col: 5, offset: 1938},
 						run: (*parser).callonOperation2,
 						expr: &seqExpr{
-							pos: position{line: 83, col: 5, offset: 1934},
+							pos: position{line: 83, col: 5, offset: 1938},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 83, col: 5, offset: 1934},
+									pos:        position{line: 83, col: 5, offset: 1938},
 									val:        "fork",
 									ignoreCase: false,
 									want:       "\"fork\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 83, col: 12, offset: 1941},
+									pos:  position{line: 83, col: 12, offset: 1945},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 83, col: 15, offset: 1944},
+									pos:        position{line: 83, col: 15, offset: 1948},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 83, col: 19, offset: 1948},
+									pos:   position{line: 83, col: 19, offset: 1952},
 									label: "paths",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 83, col: 25, offset: 1954},
+										pos: position{line: 83, col: 25, offset: 1958},
 										expr: &ruleRefExpr{
-											pos:  position{line: 83, col: 25, offset: 1954},
+											pos:  position{line: 83, col: 25, offset: 1958},
 											name: "Leg",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 83, col: 30, offset: 1959},
+									pos:  position{line: 83, col: 30, offset: 1963},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 83, col: 33, offset: 1962},
+									pos:        position{line: 83, col: 33, offset: 1966},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -656,56 +656,56 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 95, col: 5, offset: 2262},
+						pos: position{line: 95, col: 5, offset: 2266},
 						run: (*parser).callonOperation12,
 						expr: &seqExpr{
-							pos: position{line: 95, col: 5, offset: 2262},
+							pos: position{line: 95, col: 5, offset: 2266},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 95, col: 5, offset: 2262},
+									pos:        position{line: 95, col: 5, offset: 2266},
 									val:        "switch",
 									ignoreCase: false,
 									want:       "\"switch\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 95, col: 14, offset: 2271},
+									pos:  position{line: 95, col: 14, offset: 2275},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 95, col: 16, offset: 2273},
+									pos:   position{line: 95, col: 16, offset: 2277},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 95, col: 21, offset: 2278},
+										pos:  position{line: 95, col: 21, offset: 2282},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 95, col: 26, offset: 2283},
+									pos:  position{line: 95, col: 26, offset: 2287},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 95, col: 28, offset: 2285},
+									pos:        position{line: 95, col: 28, offset: 2289},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 95, col: 32, offset: 2289},
+									pos:   position{line: 95, col: 32, offset: 2293},
 									label: "cases",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 95, col: 38, offset: 2295},
+										pos: position{line: 95, col: 38, offset: 2299},
 										expr: &ruleRefExpr{
-											pos:  position{line: 95, col: 38, offset: 2295},
+											pos:  position{line: 95, col: 38, offset: 2299},
 											name: "SwitchLeg",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 95, col: 49, offset: 2306},
+									pos:  position{line: 95, col: 49, offset: 2310},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 95, col: 52, offset: 2309},
+									pos:        position{line: 95, col: 52, offset: 2313},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -714,44 +714,44 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 104, col: 5, offset: 2537},
+						pos: position{line: 104, col: 5, offset: 2541},
 						run: (*parser).callonOperation25,
 						expr: &seqExpr{
-							pos: position{line: 104, col: 5, offset: 2537},
+							pos: position{line: 104, col: 5, offset: 2541},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 104, col: 5, offset: 2537},
+									pos:        position{line: 104, col: 5, offset: 2541},
 									val:        "switch",
 									ignoreCase: false,
 									want:       "\"switch\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 104, col: 14, offset: 2546},
+									pos:  position{line: 104, col: 14, offset: 2550},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 104, col: 17, offset: 2549},
+									pos:        position{line: 104, col: 17, offset: 2553},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 104, col: 21, offset: 2553},
+									pos:   position{line: 104, col: 21, offset: 2557},
 									label: "cases",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 104, col: 27, offset: 2559},
+										pos: position{line: 104, col: 27, offset: 2563},
 										expr: &ruleRefExpr{
-											pos:  position{line: 104, col: 27, offset: 2559},
+											pos:  position{line: 104, col: 27, offset: 2563},
 											name: "SwitchLeg",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 104, col: 38, offset: 2570},
+									pos:  position{line: 104, col: 38, offset: 2574},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 104, col: 41, offset: 2573},
+									pos:        position{line: 104, col: 41, offset: 2577},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -760,44 +760,44 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 112, col: 5, offset: 2768},
+						pos: position{line: 112, col: 5, offset: 2772},
 						run: (*parser).callonOperation35,
 						expr: &seqExpr{
-							pos: position{line: 112, col: 5, offset: 2768},
+							pos: position{line: 112, col: 5, offset: 2772},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 112, col: 5, offset: 2768},
+									pos:        position{line: 112, col: 5, offset: 2772},
 									val:        "from",
 									ignoreCase: false,
 									want:       "\"from\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 112, col: 12, offset: 2775},
+									pos:  position{line: 112, col: 12, offset: 2779},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 112, col: 15, offset: 2778},
+									pos:        position{line: 112, col: 15, offset: 2782},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 112, col: 19, offset: 2782},
+									pos:   position{line: 112, col: 19, offset: 2786},
 									label: "trunks",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 112, col: 26, offset: 2789},
+										pos: position{line: 112, col: 26, offset: 2793},
 										expr: &ruleRefExpr{
-											pos:  position{line: 112, col: 26, offset: 2789},
+											pos:  position{line: 112, col: 26, offset: 2793},
 											name: "FromLeg",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 112, col: 35, offset: 2798},
+									pos:  position{line: 112, col: 35, offset: 2802},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 112, col: 38, offset: 2801},
+									pos:        position{line: 112, col: 38, offset: 2805},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -806,35 +806,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 120, col: 5, offset: 2995},
+						pos: position{line: 120, col: 5, offset: 2999},
 						run: (*parser).callonOperation45,
 						expr: &seqExpr{
-							pos: position{line: 120, col: 5, offset: 2995},
+							pos: position{line: 120, col: 5, offset: 2999},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 120, col: 5, offset: 2995},
+									pos:        position{line: 120, col: 5, offset: 2999},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 120, col: 9, offset: 2999},
+									pos:  position{line: 120, col: 9, offset: 3003},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 120, col: 12, offset: 3002},
+									pos:   position{line: 120, col: 12, offset: 3006},
 									label: "scope",
 									expr: &ruleRefExpr{
-										pos:  position{line: 120, col: 18, offset: 3008},
+										pos:  position{line: 120, col: 18, offset: 3012},
 										name: "Scope",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 120, col: 24, offset: 3014},
+									pos:  position{line: 120, col: 24, offset: 3018},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 120, col: 27, offset: 3017},
+									pos:        position{line: 120, col: 27, offset: 3021},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -843,31 +843,31 @@ var g = &grammar{
 						},
 					},
 					&labeledExpr{
-						pos:   position{line: 121, col: 5, offset: 3047},
+						pos:   position{line: 121, col: 5, offset: 3051},
 						label: "op",
 						expr: &ruleRefExpr{
-							pos:  position{line: 121, col: 8, offset: 3050},
+							pos:  position{line: 121, col: 8, offset: 3054},
 							name: "Operator",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 122, col: 5, offset: 3063},
+						pos: position{line: 122, col: 5, offset: 3067},
 						run: (*parser).callonOperation55,
 						expr: &seqExpr{
-							pos: position{line: 122, col: 5, offset: 3063},
+							pos: position{line: 122, col: 5, offset: 3067},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 122, col: 5, offset: 3063},
+									pos:   position{line: 122, col: 5, offset: 3067},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 122, col: 7, offset: 3065},
+										pos:  position{line: 122, col: 7, offset: 3069},
 										name: "OpAssignment",
 									},
 								},
 								&andExpr{
-									pos: position{line: 122, col: 20, offset: 3078},
+									pos: position{line: 122, col: 20, offset: 3082},
 									expr: &ruleRefExpr{
-										pos:  position{line: 122, col: 21, offset: 3079},
+										pos:  position{line: 122, col: 21, offset: 3083},
 										name: "EndOfOp",
 									},
 								},
@@ -875,39 +875,39 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 123, col: 5, offset: 3109},
+						pos: position{line: 123, col: 5, offset: 3113},
 						run: (*parser).callonOperation61,
 						expr: &seqExpr{
-							pos: position{line: 123, col: 5, offset: 3109},
+							pos: position{line: 123, col: 5, offset: 3113},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 123, col: 5, offset: 3109},
+									pos: position{line: 123, col: 5, offset: 3113},
 									expr: &seqExpr{
-										pos: position{line: 123, col: 7, offset: 3111},
+										pos: position{line: 123, col: 7, offset: 3115},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 123, col: 7, offset: 3111},
+												pos:  position{line: 123, col: 7, offset: 3115},
 												name: "Function",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 123, col: 16, offset: 3120},
+												pos:  position{line: 123, col: 16, offset: 3124},
 												name: "EndOfOp",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 123, col: 25, offset: 3129},
+									pos:   position{line: 123, col: 25, offset: 3133},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 123, col: 27, offset: 3131},
+										pos:  position{line: 123, col: 27, offset: 3135},
 										name: "Aggregation",
 									},
 								},
 								&andExpr{
-									pos: position{line: 123, col: 39, offset: 3143},
+									pos: position{line: 123, col: 39, offset: 3147},
 									expr: &ruleRefExpr{
-										pos:  position{line: 123, col: 40, offset: 3144},
+										pos:  position{line: 123, col: 40, offset: 3148},
 										name: "EndOfOp",
 									},
 								},
@@ -915,26 +915,26 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 124, col: 5, offset: 3174},
+						pos: position{line: 124, col: 5, offset: 3178},
 						run: (*parser).callonOperation71,
 						expr: &seqExpr{
-							pos: position{line: 124, col: 5, offset: 3174},
+							pos: position{line: 124, col: 5, offset: 3178},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 124, col: 5, offset: 3174},
+									pos:        position{line: 124, col: 5, offset: 3178},
 									val:        "search",
 									ignoreCase: false,
 									want:       "\"search\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 124, col: 14, offset: 3183},
+									pos:  position{line: 124, col: 14, offset: 3187},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 124, col: 16, offset: 3185},
+									pos:   position{line: 124, col: 16, offset: 3189},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 124, col: 21, offset: 3190},
+										pos:  position{line: 124, col: 21, offset: 3194},
 										name: "SearchBoolean",
 									},
 								},
@@ -942,37 +942,37 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 127, col: 5, offset: 3306},
+						pos: position{line: 127, col: 5, offset: 3310},
 						run: (*parser).callonOperation77,
 						expr: &labeledExpr{
-							pos:   position{line: 127, col: 5, offset: 3306},
+							pos:   position{line: 127, col: 5, offset: 3310},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 127, col: 10, offset: 3311},
+								pos:  position{line: 127, col: 10, offset: 3315},
 								name: "SearchBoolean",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 130, col: 5, offset: 3403},
+						pos: position{line: 130, col: 5, offset: 3407},
 						run: (*parser).callonOperation80,
 						expr: &labeledExpr{
-							pos:   position{line: 130, col: 5, offset: 3403},
+							pos:   position{line: 130, col: 5, offset: 3407},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 130, col: 10, offset: 3408},
+								pos:  position{line: 130, col: 10, offset: 3412},
 								name: "Cast",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 133, col: 5, offset: 3489},
+						pos: position{line: 133, col: 5, offset: 3493},
 						run: (*parser).callonOperation83,
 						expr: &labeledExpr{
-							pos:   position{line: 133, col: 5, offset: 3489},
+							pos:   position{line: 133, col: 5, offset: 3493},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 133, col: 10, offset: 3494},
+								pos:  position{line: 133, col: 10, offset: 3498},
 								name: "Expr",
 							},
 						},
@@ -984,39 +984,39 @@ var g = &grammar{
 		},
 		{
 			name: "EndOfOp",
-			pos:  position{line: 137, col: 1, offset: 3574},
+			pos:  position{line: 137, col: 1, offset: 3578},
 			expr: &seqExpr{
-				pos: position{line: 137, col: 11, offset: 3584},
+				pos: position{line: 137, col: 11, offset: 3588},
 				exprs: []any{
 					&ruleRefExpr{
-						pos:  position{line: 137, col: 11, offset: 3584},
+						pos:  position{line: 137, col: 11, offset: 3588},
 						name: "__",
 					},
 					&choiceExpr{
-						pos: position{line: 137, col: 15, offset: 3588},
+						pos: position{line: 137, col: 15, offset: 3592},
 						alternatives: []any{
 							&ruleRefExpr{
-								pos:  position{line: 137, col: 15, offset: 3588},
+								pos:  position{line: 137, col: 15, offset: 3592},
 								name: "Pipe",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 137, col: 22, offset: 3595},
+								pos:  position{line: 137, col: 22, offset: 3599},
 								name: "SearchKeywordGuard",
 							},
 							&litMatcher{
-								pos:        position{line: 137, col: 43, offset: 3616},
+								pos:        position{line: 137, col: 43, offset: 3620},
 								val:        "=>",
 								ignoreCase: false,
 								want:       "\"=>\"",
 							},
 							&litMatcher{
-								pos:        position{line: 137, col: 50, offset: 3623},
+								pos:        position{line: 137, col: 50, offset: 3627},
 								val:        ")",
 								ignoreCase: false,
 								want:       "\")\"",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 137, col: 56, offset: 3629},
+								pos:  position{line: 137, col: 56, offset: 3633},
 								name: "EOF",
 							},
 						},
@@ -1028,29 +1028,29 @@ var g = &grammar{
 		},
 		{
 			name: "Pipe",
-			pos:  position{line: 138, col: 1, offset: 3634},
+			pos:  position{line: 138, col: 1, offset: 3638},
 			expr: &seqExpr{
-				pos: position{line: 138, col: 8, offset: 3641},
+				pos: position{line: 138, col: 8, offset: 3645},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 138, col: 8, offset: 3641},
+						pos:        position{line: 138, col: 8, offset: 3645},
 						val:        "|",
 						ignoreCase: false,
 						want:       "\"|\"",
 					},
 					&notExpr{
-						pos: position{line: 138, col: 12, offset: 3645},
+						pos: position{line: 138, col: 12, offset: 3649},
 						expr: &choiceExpr{
-							pos: position{line: 138, col: 14, offset: 3647},
+							pos: position{line: 138, col: 14, offset: 3651},
 							alternatives: []any{
 								&litMatcher{
-									pos:        position{line: 138, col: 14, offset: 3647},
+									pos:        position{line: 138, col: 14, offset: 3651},
 									val:        "{",
 									ignoreCase: false,
 									want:       "\"{\"",
 								},
 								&litMatcher{
-									pos:        position{line: 138, col: 20, offset: 3653},
+									pos:        position{line: 138, col: 20, offset: 3657},
 									val:        "[",
 									ignoreCase: false,
 									want:       "\"[\"",
@@ -1065,32 +1065,32 @@ var g = &grammar{
 		},
 		{
 			name: "Leg",
-			pos:  position{line: 140, col: 1, offset: 3659},
+			pos:  position{line: 140, col: 1, offset: 3663},
 			expr: &actionExpr{
-				pos: position{line: 140, col: 7, offset: 3665},
+				pos: position{line: 140, col: 7, offset: 3669},
 				run: (*parser).callonLeg1,
 				expr: &seqExpr{
-					pos: position{line: 140, col: 7, offset: 3665},
+					pos: position{line: 140, col: 7, offset: 3669},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 140, col: 7, offset: 3665},
+							pos:  position{line: 140, col: 7, offset: 3669},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 140, col: 10, offset: 3668},
+							pos:        position{line: 140, col: 10, offset: 3672},
 							val:        "=>",
 							ignoreCase: false,
 							want:       "\"=>\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 140, col: 15, offset: 3673},
+							pos:  position{line: 140, col: 15, offset: 3677},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 140, col: 18, offset: 3676},
+							pos:   position{line: 140, col: 18, offset: 3680},
 							label: "seq",
 							expr: &ruleRefExpr{
-								pos:  position{line: 140, col: 22, offset: 3680},
+								pos:  position{line: 140, col: 22, offset: 3684},
 								name: "Seq",
 							},
 						},
@@ -1102,30 +1102,30 @@ var g = &grammar{
 		},
 		{
 			name: "SwitchLeg",
-			pos:  position{line: 142, col: 1, offset: 3705},
+			pos:  position{line: 142, col: 1, offset: 3709},
 			expr: &actionExpr{
-				pos: position{line: 143, col: 5, offset: 3719},
+				pos: position{line: 143, col: 5, offset: 3723},
 				run: (*parser).callonSwitchLeg1,
 				expr: &seqExpr{
-					pos: position{line: 143, col: 5, offset: 3719},
+					pos: position{line: 143, col: 5, offset: 3723},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 143, col: 5, offset: 3719},
+							pos:  position{line: 143, col: 5, offset: 3723},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 143, col: 8, offset: 3722},
+							pos:   position{line: 143, col: 8, offset: 3726},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 143, col: 13, offset: 3727},
+								pos:  position{line: 143, col: 13, offset: 3731},
 								name: "Case",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 143, col: 18, offset: 3732},
+							pos:   position{line: 143, col: 18, offset: 3736},
 							label: "path",
 							expr: &ruleRefExpr{
-								pos:  position{line: 143, col: 23, offset: 3737},
+								pos:  position{line: 143, col: 23, offset: 3741},
 								name: "Leg",
 							},
 						},
@@ -1137,31 +1137,31 @@ var g = &grammar{
 		},
 		{
 			name: "Case",
-			pos:  position{line: 151, col: 1, offset: 3883},
+			pos:  position{line: 151, col: 1, offset: 3887},
 			expr: &choiceExpr{
-				pos: position{line: 152, col: 5, offset: 3892},
+				pos: position{line: 152, col: 5, offset: 3896},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 152, col: 5, offset: 3892},
+						pos: position{line: 152, col: 5, offset: 3896},
 						run: (*parser).callonCase2,
 						expr: &seqExpr{
-							pos: position{line: 152, col: 5, offset: 3892},
+							pos: position{line: 152, col: 5, offset: 3896},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 152, col: 5, offset: 3892},
+									pos:        position{line: 152, col: 5, offset: 3896},
 									val:        "case",
 									ignoreCase: false,
 									want:       "\"case\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 152, col: 12, offset: 3899},
+									pos:  position{line: 152, col: 12, offset: 3903},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 152, col: 14, offset: 3901},
+									pos:   position{line: 152, col: 14, offset: 3905},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 152, col: 19, offset: 3906},
+										pos:  position{line: 152, col: 19, offset: 3910},
 										name: "Expr",
 									},
 								},
@@ -1169,10 +1169,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 153, col: 5, offset: 3936},
+						pos: position{line: 153, col: 5, offset: 3940},
 						run: (*parser).callonCase8,
 						expr: &litMatcher{
-							pos:        position{line: 153, col: 5, offset: 3936},
+							pos:        position{line: 153, col: 5, offset: 3940},
 							val:        "default",
 							ignoreCase: false,
 							want:       "\"default\"",
@@ -1185,49 +1185,49 @@ var g = &grammar{
 		},
 		{
 			name: "FromLeg",
-			pos:  position{line: 155, col: 1, offset: 3967},
+			pos:  position{line: 155, col: 1, offset: 3971},
 			expr: &actionExpr{
-				pos: position{line: 156, col: 5, offset: 3979},
+				pos: position{line: 156, col: 5, offset: 3983},
 				run: (*parser).callonFromLeg1,
 				expr: &seqExpr{
-					pos: position{line: 156, col: 5, offset: 3979},
+					pos: position{line: 156, col: 5, offset: 3983},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 156, col: 5, offset: 3979},
+							pos:  position{line: 156, col: 5, offset: 3983},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 156, col: 8, offset: 3982},
+							pos:   position{line: 156, col: 8, offset: 3986},
 							label: "source",
 							expr: &ruleRefExpr{
-								pos:  position{line: 156, col: 15, offset: 3989},
+								pos:  position{line: 156, col: 15, offset: 3993},
 								name: "FromSource",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 156, col: 26, offset: 4000},
+							pos:   position{line: 156, col: 26, offset: 4004},
 							label: "opt",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 156, col: 30, offset: 4004},
+								pos: position{line: 156, col: 30, offset: 4008},
 								expr: &seqExpr{
-									pos: position{line: 156, col: 31, offset: 4005},
+									pos: position{line: 156, col: 31, offset: 4009},
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 156, col: 31, offset: 4005},
+											pos:  position{line: 156, col: 31, offset: 4009},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 156, col: 34, offset: 4008},
+											pos:        position{line: 156, col: 34, offset: 4012},
 											val:        "=>",
 											ignoreCase: false,
 											want:       "\"=>\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 156, col: 39, offset: 4013},
+											pos:  position{line: 156, col: 39, offset: 4017},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 156, col: 42, offset: 4016},
+											pos:  position{line: 156, col: 42, offset: 4020},
 											name: "Seq",
 										},
 									},
@@ -1242,24 +1242,24 @@ var g = &grammar{
 		},
 		{
 			name: "FromSource",
-			pos:  position{line: 164, col: 1, offset: 4191},
+			pos:  position{line: 164, col: 1, offset: 4195},
 			expr: &choiceExpr{
-				pos: position{line: 165, col: 5, offset: 4206},
+				pos: position{line: 165, col: 5, offset: 4210},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 165, col: 5, offset: 4206},
+						pos:  position{line: 165, col: 5, offset: 4210},
 						name: "File",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 166, col: 5, offset: 4215},
+						pos:  position{line: 166, col: 5, offset: 4219},
 						name: "Get",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 167, col: 5, offset: 4223},
+						pos:  position{line: 167, col: 5, offset: 4227},
 						name: "Pool",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 168, col: 5, offset: 4232},
+						pos:  position{line: 168, col: 5, offset: 4236},
 						name: "PassOp",
 					},
 				},
@@ -1269,63 +1269,63 @@ var g = &grammar{
 		},
 		{
 			name: "ExprGuard",
-			pos:  position{line: 170, col: 1, offset: 4240},
+			pos:  position{line: 170, col: 1, offset: 4244},
 			expr: &seqExpr{
-				pos: position{line: 170, col: 13, offset: 4252},
+				pos: position{line: 170, col: 13, offset: 4256},
 				exprs: []any{
 					&ruleRefExpr{
-						pos:  position{line: 170, col: 13, offset: 4252},
+						pos:  position{line: 170, col: 13, offset: 4256},
 						name: "__",
 					},
 					&choiceExpr{
-						pos: position{line: 170, col: 17, offset: 4256},
+						pos: position{line: 170, col: 17, offset: 4260},
 						alternatives: []any{
 							&seqExpr{
-								pos: position{line: 170, col: 18, offset: 4257},
+								pos: position{line: 170, col: 18, offset: 4261},
 								exprs: []any{
 									&notExpr{
-										pos: position{line: 170, col: 18, offset: 4257},
+										pos: position{line: 170, col: 18, offset: 4261},
 										expr: &litMatcher{
-											pos:        position{line: 170, col: 19, offset: 4258},
+											pos:        position{line: 170, col: 19, offset: 4262},
 											val:        "=>",
 											ignoreCase: false,
 											want:       "\"=>\"",
 										},
 									},
 									&ruleRefExpr{
-										pos:  position{line: 170, col: 24, offset: 4263},
+										pos:  position{line: 170, col: 24, offset: 4267},
 										name: "Comparator",
 									},
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 170, col: 38, offset: 4277},
+								pos:  position{line: 170, col: 38, offset: 4281},
 								name: "AdditiveOperator",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 170, col: 57, offset: 4296},
+								pos:  position{line: 170, col: 57, offset: 4300},
 								name: "MultiplicativeOperator",
 							},
 							&litMatcher{
-								pos:        position{line: 170, col: 82, offset: 4321},
+								pos:        position{line: 170, col: 82, offset: 4325},
 								val:        ":",
 								ignoreCase: false,
 								want:       "\":\"",
 							},
 							&litMatcher{
-								pos:        position{line: 170, col: 88, offset: 4327},
+								pos:        position{line: 170, col: 88, offset: 4331},
 								val:        "(",
 								ignoreCase: false,
 								want:       "\"(\"",
 							},
 							&litMatcher{
-								pos:        position{line: 170, col: 94, offset: 4333},
+								pos:        position{line: 170, col: 94, offset: 4337},
 								val:        "[",
 								ignoreCase: false,
 								want:       "\"[\"",
 							},
 							&litMatcher{
-								pos:        position{line: 170, col: 100, offset: 4339},
+								pos:        position{line: 170, col: 100, offset: 4343},
 								val:        "~",
 								ignoreCase: false,
 								want:       "\"~\"",
@@ -1339,63 +1339,63 @@ var g = &grammar{
 		},
 		{
 			name: "Comparator",
-			pos:  position{line: 172, col: 1, offset: 4345},
+			pos:  position{line: 172, col: 1, offset: 4349},
 			expr: &actionExpr{
-				pos: position{line: 172, col: 14, offset: 4358},
+				pos: position{line: 172, col: 14, offset: 4362},
 				run: (*parser).callonComparator1,
 				expr: &choiceExpr{
-					pos: position{line: 172, col: 15, offset: 4359},
+					pos: position{line: 172, col: 15, offset: 4363},
 					alternatives: []any{
 						&litMatcher{
-							pos:        position{line: 172, col: 15, offset: 4359},
+							pos:        position{line: 172, col: 15, offset: 4363},
 							val:        "==",
 							ignoreCase: false,
 							want:       "\"==\"",
 						},
 						&litMatcher{
-							pos:        position{line: 172, col: 22, offset: 4366},
+							pos:        position{line: 172, col: 22, offset: 4370},
 							val:        "!=",
 							ignoreCase: false,
 							want:       "\"!=\"",
 						},
 						&seqExpr{
-							pos: position{line: 172, col: 30, offset: 4374},
+							pos: position{line: 172, col: 30, offset: 4378},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 172, col: 30, offset: 4374},
+									pos:        position{line: 172, col: 30, offset: 4378},
 									val:        "in",
 									ignoreCase: false,
 									want:       "\"in\"",
 								},
 								&notExpr{
-									pos: position{line: 172, col: 35, offset: 4379},
+									pos: position{line: 172, col: 35, offset: 4383},
 									expr: &ruleRefExpr{
-										pos:  position{line: 172, col: 36, offset: 4380},
+										pos:  position{line: 172, col: 36, offset: 4384},
 										name: "IdentifierRest",
 									},
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 172, col: 54, offset: 4398},
+							pos:        position{line: 172, col: 54, offset: 4402},
 							val:        "<=",
 							ignoreCase: false,
 							want:       "\"<=\"",
 						},
 						&litMatcher{
-							pos:        position{line: 172, col: 61, offset: 4405},
+							pos:        position{line: 172, col: 61, offset: 4409},
 							val:        "<",
 							ignoreCase: false,
 							want:       "\"<\"",
 						},
 						&litMatcher{
-							pos:        position{line: 172, col: 67, offset: 4411},
+							pos:        position{line: 172, col: 67, offset: 4415},
 							val:        ">=",
 							ignoreCase: false,
 							want:       "\">=\"",
 						},
 						&litMatcher{
-							pos:        position{line: 172, col: 74, offset: 4418},
+							pos:        position{line: 172, col: 74, offset: 4422},
 							val:        ">",
 							ignoreCase: false,
 							want:       "\">\"",
@@ -1408,28 +1408,28 @@ var g = &grammar{
 		},
 		{
 			name: "SearchBoolean",
-			pos:  position{line: 174, col: 1, offset: 4455},
+			pos:  position{line: 174, col: 1, offset: 4459},
 			expr: &actionExpr{
-				pos: position{line: 175, col: 5, offset: 4473},
+				pos: position{line: 175, col: 5, offset: 4477},
 				run: (*parser).callonSearchBoolean1,
 				expr: &seqExpr{
-					pos: position{line: 175, col: 5, offset: 4473},
+					pos: position{line: 175, col: 5, offset: 4477},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 175, col: 5, offset: 4473},
+							pos:   position{line: 175, col: 5, offset: 4477},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 175, col: 11, offset: 4479},
+								pos:  position{line: 175, col: 11, offset: 4483},
 								name: "SearchAnd",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 175, col: 21, offset: 4489},
+							pos:   position{line: 175, col: 21, offset: 4493},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 175, col: 26, offset: 4494},
+								pos: position{line: 175, col: 26, offset: 4498},
 								expr: &ruleRefExpr{
-									pos:  position{line: 175, col: 26, offset: 4494},
+									pos:  position{line: 175, col: 26, offset: 4498},
 									name: "SearchOrTerm",
 								},
 							},
@@ -1442,30 +1442,30 @@ var g = &grammar{
 		},
 		{
 			name: "SearchOrTerm",
-			pos:  position{line: 179, col: 1, offset: 4568},
+			pos:  position{line: 179, col: 1, offset: 4572},
 			expr: &actionExpr{
-				pos: position{line: 179, col: 16, offset: 4583},
+				pos: position{line: 179, col: 16, offset: 4587},
 				run: (*parser).callonSearchOrTerm1,
 				expr: &seqExpr{
-					pos: position{line: 179, col: 16, offset: 4583},
+					pos: position{line: 179, col: 16, offset: 4587},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 179, col: 16, offset: 4583},
+							pos:  position{line: 179, col: 16, offset: 4587},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 179, col: 18, offset: 4585},
+							pos:  position{line: 179, col: 18, offset: 4589},
 							name: "OrToken",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 179, col: 26, offset: 4593},
+							pos:  position{line: 179, col: 26, offset: 4597},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 179, col: 28, offset: 4595},
+							pos:   position{line: 179, col: 28, offset: 4599},
 							label: "t",
 							expr: &ruleRefExpr{
-								pos:  position{line: 179, col: 30, offset: 4597},
+								pos:  position{line: 179, col: 30, offset: 4601},
 								name: "SearchAnd",
 							},
 						},
@@ -1477,73 +1477,73 @@ var g = &grammar{
 		},
 		{
 			name: "SearchAnd",
-			pos:  position{line: 181, col: 1, offset: 4639},
+			pos:  position{line: 181, col: 1, offset: 4643},
 			expr: &actionExpr{
-				pos: position{line: 182, col: 5, offset: 4653},
+				pos: position{line: 182, col: 5, offset: 4657},
 				run: (*parser).callonSearchAnd1,
 				expr: &seqExpr{
-					pos: position{line: 182, col: 5, offset: 4653},
+					pos: position{line: 182, col: 5, offset: 4657},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 182, col: 5, offset: 4653},
+							pos:   position{line: 182, col: 5, offset: 4657},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 182, col: 11, offset: 4659},
+								pos:  position{line: 182, col: 11, offset: 4663},
 								name: "SearchFactor",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 183, col: 5, offset: 4676},
+							pos:   position{line: 183, col: 5, offset: 4680},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 183, col: 10, offset: 4681},
+								pos: position{line: 183, col: 10, offset: 4685},
 								expr: &actionExpr{
-									pos: position{line: 183, col: 11, offset: 4682},
+									pos: position{line: 183, col: 11, offset: 4686},
 									run: (*parser).callonSearchAnd7,
 									expr: &seqExpr{
-										pos: position{line: 183, col: 11, offset: 4682},
+										pos: position{line: 183, col: 11, offset: 4686},
 										exprs: []any{
 											&zeroOrOneExpr{
-												pos: position{line: 183, col: 11, offset: 4682},
+												pos: position{line: 183, col: 11, offset: 4686},
 												expr: &seqExpr{
-													pos: position{line: 183, col: 12, offset: 4683},
+													pos: position{line: 183, col: 12, offset: 4687},
 													exprs: []any{
 														&ruleRefExpr{
-															pos:  position{line: 183, col: 12, offset: 4683},
+															pos:  position{line: 183, col: 12, offset: 4687},
 															name: "_",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 183, col: 14, offset: 4685},
+															pos:  position{line: 183, col: 14, offset: 4689},
 															name: "AndToken",
 														},
 													},
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 183, col: 25, offset: 4696},
+												pos:  position{line: 183, col: 25, offset: 4700},
 												name: "_",
 											},
 											&notExpr{
-												pos: position{line: 183, col: 27, offset: 4698},
+												pos: position{line: 183, col: 27, offset: 4702},
 												expr: &choiceExpr{
-													pos: position{line: 183, col: 29, offset: 4700},
+													pos: position{line: 183, col: 29, offset: 4704},
 													alternatives: []any{
 														&ruleRefExpr{
-															pos:  position{line: 183, col: 29, offset: 4700},
+															pos:  position{line: 183, col: 29, offset: 4704},
 															name: "OrToken",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 183, col: 39, offset: 4710},
+															pos:  position{line: 183, col: 39, offset: 4714},
 															name: "SearchKeywordGuard",
 														},
 													},
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 183, col: 59, offset: 4730},
+												pos:   position{line: 183, col: 59, offset: 4734},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 183, col: 64, offset: 4735},
+													pos:  position{line: 183, col: 64, offset: 4739},
 													name: "SearchFactor",
 												},
 											},
@@ -1560,42 +1560,42 @@ var g = &grammar{
 		},
 		{
 			name: "SearchKeywordGuard",
-			pos:  position{line: 187, col: 1, offset: 4845},
+			pos:  position{line: 187, col: 1, offset: 4849},
 			expr: &choiceExpr{
-				pos: position{line: 188, col: 5, offset: 4868},
+				pos: position{line: 188, col: 5, offset: 4872},
 				alternatives: []any{
 					&seqExpr{
-						pos: position{line: 188, col: 5, offset: 4868},
+						pos: position{line: 188, col: 5, offset: 4872},
 						exprs: []any{
 							&ruleRefExpr{
-								pos:  position{line: 188, col: 5, offset: 4868},
+								pos:  position{line: 188, col: 5, offset: 4872},
 								name: "FromSource",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 188, col: 16, offset: 4879},
+								pos:  position{line: 188, col: 16, offset: 4883},
 								name: "__",
 							},
 							&litMatcher{
-								pos:        position{line: 188, col: 19, offset: 4882},
+								pos:        position{line: 188, col: 19, offset: 4886},
 								val:        "=>",
 								ignoreCase: false,
 								want:       "\"=>\"",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 188, col: 24, offset: 4887},
+								pos:  position{line: 188, col: 24, offset: 4891},
 								name: "__",
 							},
 						},
 					},
 					&seqExpr{
-						pos: position{line: 189, col: 5, offset: 4894},
+						pos: position{line: 189, col: 5, offset: 4898},
 						exprs: []any{
 							&ruleRefExpr{
-								pos:  position{line: 189, col: 5, offset: 4894},
+								pos:  position{line: 189, col: 5, offset: 4898},
 								name: "Case",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 189, col: 10, offset: 4899},
+								pos:  position{line: 189, col: 10, offset: 4903},
 								name: "__",
 							},
 						},
@@ -1607,43 +1607,43 @@ var g = &grammar{
 		},
 		{
 			name: "SearchFactor",
-			pos:  position{line: 191, col: 1, offset: 4903},
+			pos:  position{line: 191, col: 1, offset: 4907},
 			expr: &choiceExpr{
-				pos: position{line: 192, col: 5, offset: 4920},
+				pos: position{line: 192, col: 5, offset: 4924},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 192, col: 5, offset: 4920},
+						pos: position{line: 192, col: 5, offset: 4924},
 						run: (*parser).callonSearchFactor2,
 						expr: &seqExpr{
-							pos: position{line: 192, col: 5, offset: 4920},
+							pos: position{line: 192, col: 5, offset: 4924},
 							exprs: []any{
 								&choiceExpr{
-									pos: position{line: 192, col: 6, offset: 4921},
+									pos: position{line: 192, col: 6, offset: 4925},
 									alternatives: []any{
 										&seqExpr{
-											pos: position{line: 192, col: 6, offset: 4921},
+											pos: position{line: 192, col: 6, offset: 4925},
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 192, col: 6, offset: 4921},
+													pos:  position{line: 192, col: 6, offset: 4925},
 													name: "NotToken",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 192, col: 15, offset: 4930},
+													pos:  position{line: 192, col: 15, offset: 4934},
 													name: "_",
 												},
 											},
 										},
 										&seqExpr{
-											pos: position{line: 192, col: 19, offset: 4934},
+											pos: position{line: 192, col: 19, offset: 4938},
 											exprs: []any{
 												&litMatcher{
-													pos:        position{line: 192, col: 19, offset: 4934},
+													pos:        position{line: 192, col: 19, offset: 4938},
 													val:        "!",
 													ignoreCase: false,
 													want:       "\"!\"",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 192, col: 23, offset: 4938},
+													pos:  position{line: 192, col: 23, offset: 4942},
 													name: "__",
 												},
 											},
@@ -1651,10 +1651,10 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 192, col: 27, offset: 4942},
+									pos:   position{line: 192, col: 27, offset: 4946},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 192, col: 29, offset: 4944},
+										pos:  position{line: 192, col: 29, offset: 4948},
 										name: "SearchFactor",
 									},
 								},
@@ -1662,35 +1662,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 199, col: 5, offset: 5092},
+						pos: position{line: 199, col: 5, offset: 5096},
 						run: (*parser).callonSearchFactor13,
 						expr: &seqExpr{
-							pos: position{line: 199, col: 5, offset: 5092},
+							pos: position{line: 199, col: 5, offset: 5096},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 199, col: 5, offset: 5092},
+									pos:        position{line: 199, col: 5, offset: 5096},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 199, col: 9, offset: 5096},
+									pos:  position{line: 199, col: 9, offset: 5100},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 199, col: 12, offset: 5099},
+									pos:   position{line: 199, col: 12, offset: 5103},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 199, col: 17, offset: 5104},
+										pos:  position{line: 199, col: 17, offset: 5108},
 										name: "SearchBoolean",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 199, col: 31, offset: 5118},
+									pos:  position{line: 199, col: 31, offset: 5122},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 199, col: 34, offset: 5121},
+									pos:        position{line: 199, col: 34, offset: 5125},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -1699,7 +1699,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 200, col: 5, offset: 5150},
+						pos:  position{line: 200, col: 5, offset: 5154},
 						name: "SearchExpr",
 					},
 				},
@@ -1709,53 +1709,53 @@ var g = &grammar{
 		},
 		{
 			name: "SearchExpr",
-			pos:  position{line: 202, col: 1, offset: 5162},
+			pos:  position{line: 202, col: 1, offset: 5166},
 			expr: &choiceExpr{
-				pos: position{line: 203, col: 5, offset: 5177},
+				pos: position{line: 203, col: 5, offset: 5181},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 203, col: 5, offset: 5177},
+						pos:  position{line: 203, col: 5, offset: 5181},
 						name: "Regexp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 204, col: 5, offset: 5188},
+						pos:  position{line: 204, col: 5, offset: 5192},
 						name: "Glob",
 					},
 					&actionExpr{
-						pos: position{line: 205, col: 5, offset: 5197},
+						pos: position{line: 205, col: 5, offset: 5201},
 						run: (*parser).callonSearchExpr4,
 						expr: &seqExpr{
-							pos: position{line: 205, col: 5, offset: 5197},
+							pos: position{line: 205, col: 5, offset: 5201},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 205, col: 5, offset: 5197},
+									pos:   position{line: 205, col: 5, offset: 5201},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 205, col: 7, offset: 5199},
+										pos:  position{line: 205, col: 7, offset: 5203},
 										name: "SearchValue",
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 205, col: 20, offset: 5212},
+									pos: position{line: 205, col: 20, offset: 5216},
 									alternatives: []any{
 										&notExpr{
-											pos: position{line: 205, col: 20, offset: 5212},
+											pos: position{line: 205, col: 20, offset: 5216},
 											expr: &ruleRefExpr{
-												pos:  position{line: 205, col: 21, offset: 5213},
+												pos:  position{line: 205, col: 21, offset: 5217},
 												name: "ExprGuard",
 											},
 										},
 										&andExpr{
-											pos: position{line: 205, col: 33, offset: 5225},
+											pos: position{line: 205, col: 33, offset: 5229},
 											expr: &seqExpr{
-												pos: position{line: 205, col: 35, offset: 5227},
+												pos: position{line: 205, col: 35, offset: 5231},
 												exprs: []any{
 													&ruleRefExpr{
-														pos:  position{line: 205, col: 35, offset: 5227},
+														pos:  position{line: 205, col: 35, offset: 5231},
 														name: "_",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 205, col: 37, offset: 5229},
+														pos:  position{line: 205, col: 37, offset: 5233},
 														name: "Glob",
 													},
 												},
@@ -1767,21 +1767,21 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 213, col: 5, offset: 5399},
+						pos: position{line: 213, col: 5, offset: 5403},
 						run: (*parser).callonSearchExpr15,
 						expr: &seqExpr{
-							pos: position{line: 213, col: 5, offset: 5399},
+							pos: position{line: 213, col: 5, offset: 5403},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 213, col: 5, offset: 5399},
+									pos:        position{line: 213, col: 5, offset: 5403},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
 								},
 								&notExpr{
-									pos: position{line: 213, col: 9, offset: 5403},
+									pos: position{line: 213, col: 9, offset: 5407},
 									expr: &ruleRefExpr{
-										pos:  position{line: 213, col: 10, offset: 5404},
+										pos:  position{line: 213, col: 10, offset: 5408},
 										name: "ExprGuard",
 									},
 								},
@@ -1789,7 +1789,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 216, col: 5, offset: 5504},
+						pos:  position{line: 216, col: 5, offset: 5508},
 						name: "SearchPredicate",
 					},
 				},
@@ -1799,45 +1799,45 @@ var g = &grammar{
 		},
 		{
 			name: "SearchPredicate",
-			pos:  position{line: 218, col: 1, offset: 5521},
+			pos:  position{line: 218, col: 1, offset: 5525},
 			expr: &choiceExpr{
-				pos: position{line: 219, col: 5, offset: 5541},
+				pos: position{line: 219, col: 5, offset: 5545},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 219, col: 5, offset: 5541},
+						pos: position{line: 219, col: 5, offset: 5545},
 						run: (*parser).callonSearchPredicate2,
 						expr: &seqExpr{
-							pos: position{line: 219, col: 5, offset: 5541},
+							pos: position{line: 219, col: 5, offset: 5545},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 219, col: 5, offset: 5541},
+									pos:   position{line: 219, col: 5, offset: 5545},
 									label: "lhs",
 									expr: &ruleRefExpr{
-										pos:  position{line: 219, col: 9, offset: 5545},
+										pos:  position{line: 219, col: 9, offset: 5549},
 										name: "AdditiveExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 219, col: 22, offset: 5558},
+									pos:  position{line: 219, col: 22, offset: 5562},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 219, col: 25, offset: 5561},
+									pos:   position{line: 219, col: 25, offset: 5565},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 219, col: 28, offset: 5564},
+										pos:  position{line: 219, col: 28, offset: 5568},
 										name: "Comparator",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 219, col: 39, offset: 5575},
+									pos:  position{line: 219, col: 39, offset: 5579},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 219, col: 42, offset: 5578},
+									pos:   position{line: 219, col: 42, offset: 5582},
 									label: "rhs",
 									expr: &ruleRefExpr{
-										pos:  position{line: 219, col: 46, offset: 5582},
+										pos:  position{line: 219, col: 46, offset: 5586},
 										name: "AdditiveExpr",
 									},
 								},
@@ -1845,13 +1845,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 227, col: 5, offset: 5761},
+						pos: position{line: 227, col: 5, offset: 5765},
 						run: (*parser).callonSearchPredicate12,
 						expr: &labeledExpr{
-							pos:   position{line: 227, col: 5, offset: 5761},
+							pos:   position{line: 227, col: 5, offset: 5765},
 							label: "f",
 							expr: &ruleRefExpr{
-								pos:  position{line: 227, col: 7, offset: 5763},
+								pos:  position{line: 227, col: 7, offset: 5767},
 								name: "Function",
 							},
 						},
@@ -1863,32 +1863,32 @@ var g = &grammar{
 		},
 		{
 			name: "SearchValue",
-			pos:  position{line: 229, col: 1, offset: 5791},
+			pos:  position{line: 229, col: 1, offset: 5795},
 			expr: &choiceExpr{
-				pos: position{line: 230, col: 5, offset: 5807},
+				pos: position{line: 230, col: 5, offset: 5811},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 230, col: 5, offset: 5807},
+						pos:  position{line: 230, col: 5, offset: 5811},
 						name: "Literal",
 					},
 					&actionExpr{
-						pos: position{line: 231, col: 5, offset: 5819},
+						pos: position{line: 231, col: 5, offset: 5823},
 						run: (*parser).callonSearchValue3,
 						expr: &seqExpr{
-							pos: position{line: 231, col: 5, offset: 5819},
+							pos: position{line: 231, col: 5, offset: 5823},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 231, col: 5, offset: 5819},
+									pos: position{line: 231, col: 5, offset: 5823},
 									expr: &ruleRefExpr{
-										pos:  position{line: 231, col: 6, offset: 5820},
+										pos:  position{line: 231, col: 6, offset: 5824},
 										name: "RegexpPattern",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 231, col: 20, offset: 5834},
+									pos:   position{line: 231, col: 20, offset: 5838},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 231, col: 22, offset: 5836},
+										pos:  position{line: 231, col: 22, offset: 5840},
 										name: "KeyWord",
 									},
 								},
@@ -1902,15 +1902,15 @@ var g = &grammar{
 		},
 		{
 			name: "QuotedStringNode",
-			pos:  position{line: 235, col: 1, offset: 5909},
+			pos:  position{line: 235, col: 1, offset: 5913},
 			expr: &actionExpr{
-				pos: position{line: 236, col: 5, offset: 5931},
+				pos: position{line: 236, col: 5, offset: 5935},
 				run: (*parser).callonQuotedStringNode1,
 				expr: &labeledExpr{
-					pos:   position{line: 236, col: 5, offset: 5931},
+					pos:   position{line: 236, col: 5, offset: 5935},
 					label: "s",
 					expr: &ruleRefExpr{
-						pos:  position{line: 236, col: 7, offset: 5933},
+						pos:  position{line: 236, col: 7, offset: 5937},
 						name: "QuotedString",
 					},
 				},
@@ -1920,15 +1920,15 @@ var g = &grammar{
 		},
 		{
 			name: "Glob",
-			pos:  position{line: 240, col: 1, offset: 6053},
+			pos:  position{line: 240, col: 1, offset: 6057},
 			expr: &actionExpr{
-				pos: position{line: 241, col: 5, offset: 6062},
+				pos: position{line: 241, col: 5, offset: 6066},
 				run: (*parser).callonGlob1,
 				expr: &labeledExpr{
-					pos:   position{line: 241, col: 5, offset: 6062},
+					pos:   position{line: 241, col: 5, offset: 6066},
 					label: "pattern",
 					expr: &ruleRefExpr{
-						pos:  position{line: 241, col: 13, offset: 6070},
+						pos:  position{line: 241, col: 13, offset: 6074},
 						name: "GlobPattern",
 					},
 				},
@@ -1938,15 +1938,15 @@ var g = &grammar{
 		},
 		{
 			name: "Regexp",
-			pos:  position{line: 245, col: 1, offset: 6186},
+			pos:  position{line: 245, col: 1, offset: 6190},
 			expr: &actionExpr{
-				pos: position{line: 246, col: 5, offset: 6197},
+				pos: position{line: 246, col: 5, offset: 6201},
 				run: (*parser).callonRegexp1,
 				expr: &labeledExpr{
-					pos:   position{line: 246, col: 5, offset: 6197},
+					pos:   position{line: 246, col: 5, offset: 6201},
 					label: "pattern",
 					expr: &ruleRefExpr{
-						pos:  position{line: 246, col: 13, offset: 6205},
+						pos:  position{line: 246, col: 13, offset: 6209},
 						name: "RegexpPattern",
 					},
 				},
@@ -1956,36 +1956,36 @@ var g = &grammar{
 		},
 		{
 			name: "Aggregation",
-			pos:  position{line: 252, col: 1, offset: 6351},
+			pos:  position{line: 252, col: 1, offset: 6355},
 			expr: &choiceExpr{
-				pos: position{line: 253, col: 5, offset: 6367},
+				pos: position{line: 253, col: 5, offset: 6371},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 253, col: 5, offset: 6367},
+						pos: position{line: 253, col: 5, offset: 6371},
 						run: (*parser).callonAggregation2,
 						expr: &seqExpr{
-							pos: position{line: 253, col: 5, offset: 6367},
+							pos: position{line: 253, col: 5, offset: 6371},
 							exprs: []any{
 								&zeroOrOneExpr{
-									pos: position{line: 253, col: 5, offset: 6367},
+									pos: position{line: 253, col: 5, offset: 6371},
 									expr: &ruleRefExpr{
-										pos:  position{line: 253, col: 5, offset: 6367},
+										pos:  position{line: 253, col: 5, offset: 6371},
 										name: "Summarize",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 253, col: 16, offset: 6378},
+									pos:   position{line: 253, col: 16, offset: 6382},
 									label: "keys",
 									expr: &ruleRefExpr{
-										pos:  position{line: 253, col: 21, offset: 6383},
+										pos:  position{line: 253, col: 21, offset: 6387},
 										name: "GroupByKeys",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 253, col: 33, offset: 6395},
+									pos:   position{line: 253, col: 33, offset: 6399},
 									label: "limit",
 									expr: &ruleRefExpr{
-										pos:  position{line: 253, col: 39, offset: 6401},
+										pos:  position{line: 253, col: 39, offset: 6405},
 										name: "LimitArg",
 									},
 								},
@@ -1993,40 +1993,40 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 261, col: 5, offset: 6596},
+						pos: position{line: 261, col: 5, offset: 6600},
 						run: (*parser).callonAggregation10,
 						expr: &seqExpr{
-							pos: position{line: 261, col: 5, offset: 6596},
+							pos: position{line: 261, col: 5, offset: 6600},
 							exprs: []any{
 								&zeroOrOneExpr{
-									pos: position{line: 261, col: 5, offset: 6596},
+									pos: position{line: 261, col: 5, offset: 6600},
 									expr: &ruleRefExpr{
-										pos:  position{line: 261, col: 5, offset: 6596},
+										pos:  position{line: 261, col: 5, offset: 6600},
 										name: "Summarize",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 261, col: 16, offset: 6607},
+									pos:   position{line: 261, col: 16, offset: 6611},
 									label: "aggs",
 									expr: &ruleRefExpr{
-										pos:  position{line: 261, col: 21, offset: 6612},
+										pos:  position{line: 261, col: 21, offset: 6616},
 										name: "AggAssignments",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 261, col: 36, offset: 6627},
+									pos:   position{line: 261, col: 36, offset: 6631},
 									label: "keys",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 261, col: 41, offset: 6632},
+										pos: position{line: 261, col: 41, offset: 6636},
 										expr: &seqExpr{
-											pos: position{line: 261, col: 42, offset: 6633},
+											pos: position{line: 261, col: 42, offset: 6637},
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 261, col: 42, offset: 6633},
+													pos:  position{line: 261, col: 42, offset: 6637},
 													name: "_",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 261, col: 44, offset: 6635},
+													pos:  position{line: 261, col: 44, offset: 6639},
 													name: "GroupByKeys",
 												},
 											},
@@ -2034,10 +2034,10 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 261, col: 58, offset: 6649},
+									pos:   position{line: 261, col: 58, offset: 6653},
 									label: "limit",
 									expr: &ruleRefExpr{
-										pos:  position{line: 261, col: 64, offset: 6655},
+										pos:  position{line: 261, col: 64, offset: 6659},
 										name: "LimitArg",
 									},
 								},
@@ -2051,18 +2051,18 @@ var g = &grammar{
 		},
 		{
 			name: "Summarize",
-			pos:  position{line: 274, col: 1, offset: 6949},
+			pos:  position{line: 274, col: 1, offset: 6953},
 			expr: &seqExpr{
-				pos: position{line: 274, col: 13, offset: 6961},
+				pos: position{line: 274, col: 13, offset: 6965},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 274, col: 13, offset: 6961},
+						pos:        position{line: 274, col: 13, offset: 6965},
 						val:        "summarize",
 						ignoreCase: false,
 						want:       "\"summarize\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 274, col: 25, offset: 6973},
+						pos:  position{line: 274, col: 25, offset: 6977},
 						name: "_",
 					},
 				},
@@ -2072,26 +2072,26 @@ var g = &grammar{
 		},
 		{
 			name: "GroupByKeys",
-			pos:  position{line: 276, col: 1, offset: 6976},
+			pos:  position{line: 276, col: 1, offset: 6980},
 			expr: &actionExpr{
-				pos: position{line: 277, col: 5, offset: 6992},
+				pos: position{line: 277, col: 5, offset: 6996},
 				run: (*parser).callonGroupByKeys1,
 				expr: &seqExpr{
-					pos: position{line: 277, col: 5, offset: 6992},
+					pos: position{line: 277, col: 5, offset: 6996},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 277, col: 5, offset: 6992},
+							pos:  position{line: 277, col: 5, offset: 6996},
 							name: "ByToken",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 277, col: 13, offset: 7000},
+							pos:  position{line: 277, col: 13, offset: 7004},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 277, col: 15, offset: 7002},
+							pos:   position{line: 277, col: 15, offset: 7006},
 							label: "columns",
 							expr: &ruleRefExpr{
-								pos:  position{line: 277, col: 23, offset: 7010},
+								pos:  position{line: 277, col: 23, offset: 7014},
 								name: "FlexAssignments",
 							},
 						},
@@ -2103,45 +2103,45 @@ var g = &grammar{
 		},
 		{
 			name: "LimitArg",
-			pos:  position{line: 279, col: 1, offset: 7051},
+			pos:  position{line: 279, col: 1, offset: 7055},
 			expr: &choiceExpr{
-				pos: position{line: 280, col: 5, offset: 7064},
+				pos: position{line: 280, col: 5, offset: 7068},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 280, col: 5, offset: 7064},
+						pos: position{line: 280, col: 5, offset: 7068},
 						run: (*parser).callonLimitArg2,
 						expr: &seqExpr{
-							pos: position{line: 280, col: 5, offset: 7064},
+							pos: position{line: 280, col: 5, offset: 7068},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 280, col: 5, offset: 7064},
+									pos:  position{line: 280, col: 5, offset: 7068},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 280, col: 7, offset: 7066},
+									pos:        position{line: 280, col: 7, offset: 7070},
 									val:        "with",
 									ignoreCase: false,
 									want:       "\"with\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 280, col: 14, offset: 7073},
+									pos:  position{line: 280, col: 14, offset: 7077},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 280, col: 16, offset: 7075},
+									pos:        position{line: 280, col: 16, offset: 7079},
 									val:        "-limit",
 									ignoreCase: false,
 									want:       "\"-limit\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 280, col: 25, offset: 7084},
+									pos:  position{line: 280, col: 25, offset: 7088},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 280, col: 27, offset: 7086},
+									pos:   position{line: 280, col: 27, offset: 7090},
 									label: "limit",
 									expr: &ruleRefExpr{
-										pos:  position{line: 280, col: 33, offset: 7092},
+										pos:  position{line: 280, col: 33, offset: 7096},
 										name: "UInt",
 									},
 								},
@@ -2149,10 +2149,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 281, col: 5, offset: 7123},
+						pos: position{line: 281, col: 5, offset: 7127},
 						run: (*parser).callonLimitArg11,
 						expr: &litMatcher{
-							pos:        position{line: 281, col: 5, offset: 7123},
+							pos:        position{line: 281, col: 5, offset: 7127},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -2165,22 +2165,22 @@ var g = &grammar{
 		},
 		{
 			name: "FlexAssignment",
-			pos:  position{line: 286, col: 1, offset: 7383},
+			pos:  position{line: 286, col: 1, offset: 7387},
 			expr: &choiceExpr{
-				pos: position{line: 287, col: 5, offset: 7402},
+				pos: position{line: 287, col: 5, offset: 7406},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 287, col: 5, offset: 7402},
+						pos:  position{line: 287, col: 5, offset: 7406},
 						name: "Assignment",
 					},
 					&actionExpr{
-						pos: position{line: 288, col: 5, offset: 7417},
+						pos: position{line: 288, col: 5, offset: 7421},
 						run: (*parser).callonFlexAssignment3,
 						expr: &labeledExpr{
-							pos:   position{line: 288, col: 5, offset: 7417},
+							pos:   position{line: 288, col: 5, offset: 7421},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 288, col: 10, offset: 7422},
+								pos:  position{line: 288, col: 10, offset: 7426},
 								name: "Expr",
 							},
 						},
@@ -2192,51 +2192,51 @@ var g = &grammar{
 		},
 		{
 			name: "FlexAssignments",
-			pos:  position{line: 290, col: 1, offset: 7498},
+			pos:  position{line: 290, col: 1, offset: 7502},
 			expr: &actionExpr{
-				pos: position{line: 291, col: 5, offset: 7518},
+				pos: position{line: 291, col: 5, offset: 7522},
 				run: (*parser).callonFlexAssignments1,
 				expr: &seqExpr{
-					pos: position{line: 291, col: 5, offset: 7518},
+					pos: position{line: 291, col: 5, offset: 7522},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 291, col: 5, offset: 7518},
+							pos:   position{line: 291, col: 5, offset: 7522},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 291, col: 11, offset: 7524},
+								pos:  position{line: 291, col: 11, offset: 7528},
 								name: "FlexAssignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 291, col: 26, offset: 7539},
+							pos:   position{line: 291, col: 26, offset: 7543},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 291, col: 31, offset: 7544},
+								pos: position{line: 291, col: 31, offset: 7548},
 								expr: &actionExpr{
-									pos: position{line: 291, col: 32, offset: 7545},
+									pos: position{line: 291, col: 32, offset: 7549},
 									run: (*parser).callonFlexAssignments7,
 									expr: &seqExpr{
-										pos: position{line: 291, col: 32, offset: 7545},
+										pos: position{line: 291, col: 32, offset: 7549},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 291, col: 32, offset: 7545},
+												pos:  position{line: 291, col: 32, offset: 7549},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 291, col: 35, offset: 7548},
+												pos:        position{line: 291, col: 35, offset: 7552},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 291, col: 39, offset: 7552},
+												pos:  position{line: 291, col: 39, offset: 7556},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 291, col: 42, offset: 7555},
+												pos:   position{line: 291, col: 42, offset: 7559},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 291, col: 47, offset: 7560},
+													pos:  position{line: 291, col: 47, offset: 7564},
 													name: "FlexAssignment",
 												},
 											},
@@ -2253,43 +2253,43 @@ var g = &grammar{
 		},
 		{
 			name: "AggAssignment",
-			pos:  position{line: 295, col: 1, offset: 7646},
+			pos:  position{line: 295, col: 1, offset: 7650},
 			expr: &choiceExpr{
-				pos: position{line: 296, col: 5, offset: 7664},
+				pos: position{line: 296, col: 5, offset: 7668},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 296, col: 5, offset: 7664},
+						pos: position{line: 296, col: 5, offset: 7668},
 						run: (*parser).callonAggAssignment2,
 						expr: &seqExpr{
-							pos: position{line: 296, col: 5, offset: 7664},
+							pos: position{line: 296, col: 5, offset: 7668},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 296, col: 5, offset: 7664},
+									pos:   position{line: 296, col: 5, offset: 7668},
 									label: "lval",
 									expr: &ruleRefExpr{
-										pos:  position{line: 296, col: 10, offset: 7669},
+										pos:  position{line: 296, col: 10, offset: 7673},
 										name: "Lval",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 296, col: 15, offset: 7674},
+									pos:  position{line: 296, col: 15, offset: 7678},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 296, col: 18, offset: 7677},
+									pos:        position{line: 296, col: 18, offset: 7681},
 									val:        ":=",
 									ignoreCase: false,
 									want:       "\":=\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 296, col: 23, offset: 7682},
+									pos:  position{line: 296, col: 23, offset: 7686},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 296, col: 26, offset: 7685},
+									pos:   position{line: 296, col: 26, offset: 7689},
 									label: "agg",
 									expr: &ruleRefExpr{
-										pos:  position{line: 296, col: 30, offset: 7689},
+										pos:  position{line: 296, col: 30, offset: 7693},
 										name: "Agg",
 									},
 								},
@@ -2297,13 +2297,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 299, col: 5, offset: 7796},
+						pos: position{line: 299, col: 5, offset: 7800},
 						run: (*parser).callonAggAssignment11,
 						expr: &labeledExpr{
-							pos:   position{line: 299, col: 5, offset: 7796},
+							pos:   position{line: 299, col: 5, offset: 7800},
 							label: "agg",
 							expr: &ruleRefExpr{
-								pos:  position{line: 299, col: 9, offset: 7800},
+								pos:  position{line: 299, col: 9, offset: 7804},
 								name: "Agg",
 							},
 						},
@@ -2315,74 +2315,112 @@ var g = &grammar{
 		},
 		{
 			name: "Agg",
-			pos:  position{line: 303, col: 1, offset: 7884},
+			pos:  position{line: 303, col: 1, offset: 7888},
 			expr: &actionExpr{
-				pos: position{line: 304, col: 5, offset: 7892},
+				pos: position{line: 304, col: 5, offset: 7896},
 				run: (*parser).callonAgg1,
 				expr: &seqExpr{
-					pos: position{line: 304, col: 5, offset: 7892},
+					pos: position{line: 304, col: 5, offset: 7896},
 					exprs: []any{
 						&notExpr{
-							pos: position{line: 304, col: 5, offset: 7892},
+							pos: position{line: 304, col: 5, offset: 7896},
 							expr: &ruleRefExpr{
-								pos:  position{line: 304, col: 6, offset: 7893},
+								pos:  position{line: 304, col: 6, offset: 7897},
 								name: "FuncGuard",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 304, col: 16, offset: 7903},
+							pos:   position{line: 304, col: 16, offset: 7907},
 							label: "op",
 							expr: &ruleRefExpr{
-								pos:  position{line: 304, col: 19, offset: 7906},
+								pos:  position{line: 304, col: 19, offset: 7910},
 								name: "AggName",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 304, col: 27, offset: 7914},
+							pos:  position{line: 304, col: 27, offset: 7918},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 304, col: 30, offset: 7917},
+							pos:        position{line: 304, col: 30, offset: 7921},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 304, col: 34, offset: 7921},
+							pos:  position{line: 304, col: 34, offset: 7925},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 304, col: 37, offset: 7924},
+							pos:   position{line: 304, col: 37, offset: 7928},
 							label: "expr",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 304, col: 42, offset: 7929},
+								pos: position{line: 304, col: 42, offset: 7933},
 								expr: &choiceExpr{
-									pos: position{line: 304, col: 43, offset: 7930},
+									pos: position{line: 304, col: 43, offset: 7934},
 									alternatives: []any{
 										&ruleRefExpr{
-											pos:  position{line: 304, col: 43, offset: 7930},
+											pos:  position{line: 304, col: 43, offset: 7934},
 											name: "OverExpr",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 304, col: 54, offset: 7941},
+											pos:  position{line: 304, col: 54, offset: 7945},
 											name: "Expr",
 										},
 									},
 								},
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 304, col: 61, offset: 7952},
+							label: "args",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 304, col: 66, offset: 7957},
+								expr: &actionExpr{
+									pos: position{line: 304, col: 67, offset: 7958},
+									run: (*parser).callonAgg17,
+									expr: &seqExpr{
+										pos: position{line: 304, col: 67, offset: 7958},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 304, col: 67, offset: 7958},
+												name: "__",
+											},
+											&litMatcher{
+												pos:        position{line: 304, col: 70, offset: 7961},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 304, col: 74, offset: 7965},
+												name: "__",
+											},
+											&labeledExpr{
+												pos:   position{line: 304, col: 77, offset: 7968},
+												label: "arg",
+												expr: &ruleRefExpr{
+													pos:  position{line: 304, col: 81, offset: 7972},
+													name: "Expr",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						&ruleRefExpr{
-							pos:  position{line: 304, col: 61, offset: 7948},
+							pos:  position{line: 304, col: 108, offset: 7999},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 304, col: 64, offset: 7951},
+							pos:   position{line: 304, col: 111, offset: 8002},
 							label: "rparen",
 							expr: &actionExpr{
-								pos: position{line: 304, col: 72, offset: 7959},
-								run: (*parser).callonAgg17,
+								pos: position{line: 304, col: 119, offset: 8010},
+								run: (*parser).callonAgg26,
 								expr: &litMatcher{
-									pos:        position{line: 304, col: 72, offset: 7959},
+									pos:        position{line: 304, col: 119, offset: 8010},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -2390,16 +2428,16 @@ var g = &grammar{
 							},
 						},
 						&notExpr{
-							pos: position{line: 304, col: 106, offset: 7993},
+							pos: position{line: 304, col: 153, offset: 8044},
 							expr: &seqExpr{
-								pos: position{line: 304, col: 108, offset: 7995},
+								pos: position{line: 304, col: 155, offset: 8046},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 304, col: 108, offset: 7995},
+										pos:  position{line: 304, col: 155, offset: 8046},
 										name: "__",
 									},
 									&litMatcher{
-										pos:        position{line: 304, col: 111, offset: 7998},
+										pos:        position{line: 304, col: 158, offset: 8049},
 										val:        ".",
 										ignoreCase: false,
 										want:       "\".\"",
@@ -2408,12 +2446,12 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 304, col: 116, offset: 8003},
+							pos:   position{line: 304, col: 163, offset: 8054},
 							label: "where",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 304, col: 122, offset: 8009},
+								pos: position{line: 304, col: 169, offset: 8060},
 								expr: &ruleRefExpr{
-									pos:  position{line: 304, col: 122, offset: 8009},
+									pos:  position{line: 304, col: 169, offset: 8060},
 									name: "WhereClause",
 								},
 							},
@@ -2426,20 +2464,20 @@ var g = &grammar{
 		},
 		{
 			name: "AggName",
-			pos:  position{line: 320, col: 1, offset: 8336},
+			pos:  position{line: 321, col: 1, offset: 8428},
 			expr: &choiceExpr{
-				pos: position{line: 321, col: 5, offset: 8348},
+				pos: position{line: 322, col: 5, offset: 8440},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 321, col: 5, offset: 8348},
+						pos:  position{line: 322, col: 5, offset: 8440},
 						name: "IdentifierName",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 322, col: 5, offset: 8367},
+						pos:  position{line: 323, col: 5, offset: 8459},
 						name: "AndToken",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 323, col: 5, offset: 8380},
+						pos:  position{line: 324, col: 5, offset: 8472},
 						name: "OrToken",
 					},
 				},
@@ -2449,32 +2487,32 @@ var g = &grammar{
 		},
 		{
 			name: "WhereClause",
-			pos:  position{line: 325, col: 1, offset: 8389},
+			pos:  position{line: 326, col: 1, offset: 8481},
 			expr: &actionExpr{
-				pos: position{line: 325, col: 15, offset: 8403},
+				pos: position{line: 326, col: 15, offset: 8495},
 				run: (*parser).callonWhereClause1,
 				expr: &seqExpr{
-					pos: position{line: 325, col: 15, offset: 8403},
+					pos: position{line: 326, col: 15, offset: 8495},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 325, col: 15, offset: 8403},
+							pos:  position{line: 326, col: 15, offset: 8495},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 325, col: 17, offset: 8405},
+							pos:        position{line: 326, col: 17, offset: 8497},
 							val:        "where",
 							ignoreCase: false,
 							want:       "\"where\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 325, col: 25, offset: 8413},
+							pos:  position{line: 326, col: 25, offset: 8505},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 325, col: 27, offset: 8415},
+							pos:   position{line: 326, col: 27, offset: 8507},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 325, col: 32, offset: 8420},
+								pos:  position{line: 326, col: 32, offset: 8512},
 								name: "LogicalOrExpr",
 							},
 						},
@@ -2486,45 +2524,45 @@ var g = &grammar{
 		},
 		{
 			name: "AggAssignments",
-			pos:  position{line: 327, col: 1, offset: 8456},
+			pos:  position{line: 328, col: 1, offset: 8548},
 			expr: &actionExpr{
-				pos: position{line: 328, col: 5, offset: 8475},
+				pos: position{line: 329, col: 5, offset: 8567},
 				run: (*parser).callonAggAssignments1,
 				expr: &seqExpr{
-					pos: position{line: 328, col: 5, offset: 8475},
+					pos: position{line: 329, col: 5, offset: 8567},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 328, col: 5, offset: 8475},
+							pos:   position{line: 329, col: 5, offset: 8567},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 328, col: 11, offset: 8481},
+								pos:  position{line: 329, col: 11, offset: 8573},
 								name: "AggAssignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 328, col: 25, offset: 8495},
+							pos:   position{line: 329, col: 25, offset: 8587},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 328, col: 30, offset: 8500},
+								pos: position{line: 329, col: 30, offset: 8592},
 								expr: &seqExpr{
-									pos: position{line: 328, col: 31, offset: 8501},
+									pos: position{line: 329, col: 31, offset: 8593},
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 328, col: 31, offset: 8501},
+											pos:  position{line: 329, col: 31, offset: 8593},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 328, col: 34, offset: 8504},
+											pos:        position{line: 329, col: 34, offset: 8596},
 											val:        ",",
 											ignoreCase: false,
 											want:       "\",\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 328, col: 38, offset: 8508},
+											pos:  position{line: 329, col: 38, offset: 8600},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 328, col: 41, offset: 8511},
+											pos:  position{line: 329, col: 41, offset: 8603},
 											name: "AggAssignment",
 										},
 									},
@@ -2539,104 +2577,108 @@ var g = &grammar{
 		},
 		{
 			name: "Operator",
-			pos:  position{line: 338, col: 1, offset: 8708},
+			pos:  position{line: 339, col: 1, offset: 8800},
 			expr: &choiceExpr{
-				pos: position{line: 339, col: 5, offset: 8721},
+				pos: position{line: 340, col: 5, offset: 8813},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 339, col: 5, offset: 8721},
+						pos:  position{line: 340, col: 5, offset: 8813},
 						name: "AssertOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 340, col: 5, offset: 8734},
+						pos:  position{line: 341, col: 5, offset: 8826},
 						name: "SortOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 341, col: 5, offset: 8745},
+						pos:  position{line: 342, col: 5, offset: 8837},
 						name: "TopOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 342, col: 5, offset: 8755},
+						pos:  position{line: 343, col: 5, offset: 8847},
 						name: "CutOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 343, col: 5, offset: 8765},
+						pos:  position{line: 344, col: 5, offset: 8857},
 						name: "DropOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 344, col: 5, offset: 8776},
+						pos:  position{line: 345, col: 5, offset: 8868},
 						name: "HeadOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 345, col: 5, offset: 8787},
+						pos:  position{line: 346, col: 5, offset: 8879},
 						name: "TailOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 346, col: 5, offset: 8798},
+						pos:  position{line: 347, col: 5, offset: 8890},
 						name: "WhereOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 347, col: 5, offset: 8810},
+						pos:  position{line: 348, col: 5, offset: 8902},
 						name: "UniqOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 348, col: 5, offset: 8821},
+						pos:  position{line: 349, col: 5, offset: 8913},
 						name: "PutOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 349, col: 5, offset: 8831},
+						pos:  position{line: 350, col: 5, offset: 8923},
 						name: "RenameOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 350, col: 5, offset: 8844},
+						pos:  position{line: 351, col: 5, offset: 8936},
 						name: "FuseOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 351, col: 5, offset: 8855},
+						pos:  position{line: 352, col: 5, offset: 8947},
 						name: "ShapeOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 352, col: 5, offset: 8867},
+						pos:  position{line: 353, col: 5, offset: 8959},
 						name: "JoinOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 353, col: 5, offset: 8878},
+						pos:  position{line: 354, col: 5, offset: 8970},
 						name: "SampleOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 354, col: 5, offset: 8891},
+						pos:  position{line: 355, col: 5, offset: 8983},
 						name: "FromOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 355, col: 5, offset: 8902},
+						pos:  position{line: 356, col: 5, offset: 8994},
 						name: "PassOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 356, col: 5, offset: 8913},
+						pos:  position{line: 357, col: 5, offset: 9005},
 						name: "ExplodeOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 357, col: 5, offset: 8927},
+						pos:  position{line: 358, col: 5, offset: 9019},
 						name: "MergeOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 358, col: 5, offset: 8939},
+						pos:  position{line: 359, col: 5, offset: 9031},
 						name: "OverOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 359, col: 5, offset: 8950},
+						pos:  position{line: 360, col: 5, offset: 9042},
+						name: "WindowOp",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 361, col: 5, offset: 9055},
 						name: "YieldOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 360, col: 5, offset: 8962},
+						pos:  position{line: 362, col: 5, offset: 9067},
 						name: "LoadOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 361, col: 5, offset: 8973},
+						pos:  position{line: 363, col: 5, offset: 9078},
 						name: "OutputOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 362, col: 5, offset: 8986},
+						pos:  position{line: 364, col: 5, offset: 9091},
 						name: "DebugOp",
 					},
 				},
@@ -2646,34 +2688,34 @@ var g = &grammar{
 		},
 		{
 			name: "AssertOp",
-			pos:  position{line: 364, col: 1, offset: 8995},
+			pos:  position{line: 366, col: 1, offset: 9100},
 			expr: &actionExpr{
-				pos: position{line: 365, col: 5, offset: 9008},
+				pos: position{line: 367, col: 5, offset: 9113},
 				run: (*parser).callonAssertOp1,
 				expr: &seqExpr{
-					pos: position{line: 365, col: 5, offset: 9008},
+					pos: position{line: 367, col: 5, offset: 9113},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 365, col: 5, offset: 9008},
+							pos:        position{line: 367, col: 5, offset: 9113},
 							val:        "assert",
 							ignoreCase: false,
 							want:       "\"assert\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 365, col: 14, offset: 9017},
+							pos:  position{line: 367, col: 14, offset: 9122},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 365, col: 16, offset: 9019},
+							pos:   position{line: 367, col: 16, offset: 9124},
 							label: "expr",
 							expr: &actionExpr{
-								pos: position{line: 365, col: 22, offset: 9025},
+								pos: position{line: 367, col: 22, offset: 9130},
 								run: (*parser).callonAssertOp6,
 								expr: &labeledExpr{
-									pos:   position{line: 365, col: 22, offset: 9025},
+									pos:   position{line: 367, col: 22, offset: 9130},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 365, col: 24, offset: 9027},
+										pos:  position{line: 367, col: 24, offset: 9132},
 										name: "Expr",
 									},
 								},
@@ -2687,54 +2729,54 @@ var g = &grammar{
 		},
 		{
 			name: "SortOp",
-			pos:  position{line: 374, col: 1, offset: 9270},
+			pos:  position{line: 376, col: 1, offset: 9375},
 			expr: &actionExpr{
-				pos: position{line: 375, col: 5, offset: 9281},
+				pos: position{line: 377, col: 5, offset: 9386},
 				run: (*parser).callonSortOp1,
 				expr: &seqExpr{
-					pos: position{line: 375, col: 5, offset: 9281},
+					pos: position{line: 377, col: 5, offset: 9386},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 375, col: 5, offset: 9281},
+							pos:        position{line: 377, col: 5, offset: 9386},
 							val:        "sort",
 							ignoreCase: false,
 							want:       "\"sort\"",
 						},
 						&andExpr{
-							pos: position{line: 375, col: 12, offset: 9288},
+							pos: position{line: 377, col: 12, offset: 9393},
 							expr: &ruleRefExpr{
-								pos:  position{line: 375, col: 13, offset: 9289},
+								pos:  position{line: 377, col: 13, offset: 9394},
 								name: "EOKW",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 375, col: 18, offset: 9294},
+							pos:   position{line: 377, col: 18, offset: 9399},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 375, col: 23, offset: 9299},
+								pos:  position{line: 377, col: 23, offset: 9404},
 								name: "SortArgs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 375, col: 32, offset: 9308},
+							pos:   position{line: 377, col: 32, offset: 9413},
 							label: "exprs",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 375, col: 38, offset: 9314},
+								pos: position{line: 377, col: 38, offset: 9419},
 								expr: &actionExpr{
-									pos: position{line: 375, col: 39, offset: 9315},
+									pos: position{line: 377, col: 39, offset: 9420},
 									run: (*parser).callonSortOp10,
 									expr: &seqExpr{
-										pos: position{line: 375, col: 39, offset: 9315},
+										pos: position{line: 377, col: 39, offset: 9420},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 375, col: 39, offset: 9315},
+												pos:  position{line: 377, col: 39, offset: 9420},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 375, col: 42, offset: 9318},
+												pos:   position{line: 377, col: 42, offset: 9423},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 375, col: 44, offset: 9320},
+													pos:  position{line: 377, col: 44, offset: 9425},
 													name: "SortExprs",
 												},
 											},
@@ -2751,30 +2793,30 @@ var g = &grammar{
 		},
 		{
 			name: "SortArgs",
-			pos:  position{line: 391, col: 1, offset: 9702},
+			pos:  position{line: 393, col: 1, offset: 9807},
 			expr: &actionExpr{
-				pos: position{line: 391, col: 12, offset: 9713},
+				pos: position{line: 393, col: 12, offset: 9818},
 				run: (*parser).callonSortArgs1,
 				expr: &labeledExpr{
-					pos:   position{line: 391, col: 12, offset: 9713},
+					pos:   position{line: 393, col: 12, offset: 9818},
 					label: "args",
 					expr: &zeroOrMoreExpr{
-						pos: position{line: 391, col: 17, offset: 9718},
+						pos: position{line: 393, col: 17, offset: 9823},
 						expr: &actionExpr{
-							pos: position{line: 391, col: 18, offset: 9719},
+							pos: position{line: 393, col: 18, offset: 9824},
 							run: (*parser).callonSortArgs4,
 							expr: &seqExpr{
-								pos: position{line: 391, col: 18, offset: 9719},
+								pos: position{line: 393, col: 18, offset: 9824},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 391, col: 18, offset: 9719},
+										pos:  position{line: 393, col: 18, offset: 9824},
 										name: "_",
 									},
 									&labeledExpr{
-										pos:   position{line: 391, col: 20, offset: 9721},
+										pos:   position{line: 393, col: 20, offset: 9826},
 										label: "a",
 										expr: &ruleRefExpr{
-											pos:  position{line: 391, col: 22, offset: 9723},
+											pos:  position{line: 393, col: 22, offset: 9828},
 											name: "SortArg",
 										},
 									},
@@ -2789,53 +2831,53 @@ var g = &grammar{
 		},
 		{
 			name: "SortArg",
-			pos:  position{line: 393, col: 1, offset: 9780},
+			pos:  position{line: 395, col: 1, offset: 9885},
 			expr: &choiceExpr{
-				pos: position{line: 394, col: 5, offset: 9792},
+				pos: position{line: 396, col: 5, offset: 9897},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 394, col: 5, offset: 9792},
+						pos: position{line: 396, col: 5, offset: 9897},
 						run: (*parser).callonSortArg2,
 						expr: &litMatcher{
-							pos:        position{line: 394, col: 5, offset: 9792},
+							pos:        position{line: 396, col: 5, offset: 9897},
 							val:        "-r",
 							ignoreCase: false,
 							want:       "\"-r\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 395, col: 5, offset: 9859},
+						pos: position{line: 397, col: 5, offset: 9964},
 						run: (*parser).callonSortArg4,
 						expr: &seqExpr{
-							pos: position{line: 395, col: 5, offset: 9859},
+							pos: position{line: 397, col: 5, offset: 9964},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 395, col: 5, offset: 9859},
+									pos:        position{line: 397, col: 5, offset: 9964},
 									val:        "-nulls",
 									ignoreCase: false,
 									want:       "\"-nulls\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 395, col: 14, offset: 9868},
+									pos:  position{line: 397, col: 14, offset: 9973},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 395, col: 16, offset: 9870},
+									pos:   position{line: 397, col: 16, offset: 9975},
 									label: "where",
 									expr: &actionExpr{
-										pos: position{line: 395, col: 23, offset: 9877},
+										pos: position{line: 397, col: 23, offset: 9982},
 										run: (*parser).callonSortArg9,
 										expr: &choiceExpr{
-											pos: position{line: 395, col: 24, offset: 9878},
+											pos: position{line: 397, col: 24, offset: 9983},
 											alternatives: []any{
 												&litMatcher{
-													pos:        position{line: 395, col: 24, offset: 9878},
+													pos:        position{line: 397, col: 24, offset: 9983},
 													val:        "first",
 													ignoreCase: false,
 													want:       "\"first\"",
 												},
 												&litMatcher{
-													pos:        position{line: 395, col: 34, offset: 9888},
+													pos:        position{line: 397, col: 34, offset: 9993},
 													val:        "last",
 													ignoreCase: false,
 													want:       "\"last\"",
@@ -2854,46 +2896,46 @@ var g = &grammar{
 		},
 		{
 			name: "TopOp",
-			pos:  position{line: 399, col: 1, offset: 10007},
+			pos:  position{line: 401, col: 1, offset: 10112},
 			expr: &actionExpr{
-				pos: position{line: 400, col: 5, offset: 10017},
+				pos: position{line: 402, col: 5, offset: 10122},
 				run: (*parser).callonTopOp1,
 				expr: &seqExpr{
-					pos: position{line: 400, col: 5, offset: 10017},
+					pos: position{line: 402, col: 5, offset: 10122},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 400, col: 5, offset: 10017},
+							pos:        position{line: 402, col: 5, offset: 10122},
 							val:        "top",
 							ignoreCase: false,
 							want:       "\"top\"",
 						},
 						&andExpr{
-							pos: position{line: 400, col: 11, offset: 10023},
+							pos: position{line: 402, col: 11, offset: 10128},
 							expr: &ruleRefExpr{
-								pos:  position{line: 400, col: 12, offset: 10024},
+								pos:  position{line: 402, col: 12, offset: 10129},
 								name: "EOKW",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 400, col: 17, offset: 10029},
+							pos:   position{line: 402, col: 17, offset: 10134},
 							label: "limit",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 400, col: 23, offset: 10035},
+								pos: position{line: 402, col: 23, offset: 10140},
 								expr: &actionExpr{
-									pos: position{line: 400, col: 24, offset: 10036},
+									pos: position{line: 402, col: 24, offset: 10141},
 									run: (*parser).callonTopOp8,
 									expr: &seqExpr{
-										pos: position{line: 400, col: 24, offset: 10036},
+										pos: position{line: 402, col: 24, offset: 10141},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 400, col: 24, offset: 10036},
+												pos:  position{line: 402, col: 24, offset: 10141},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 400, col: 26, offset: 10038},
+												pos:   position{line: 402, col: 26, offset: 10143},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 400, col: 28, offset: 10040},
+													pos:  position{line: 402, col: 28, offset: 10145},
 													name: "Expr",
 												},
 											},
@@ -2903,19 +2945,19 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 400, col: 53, offset: 10065},
+							pos:   position{line: 402, col: 53, offset: 10170},
 							label: "flush",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 400, col: 59, offset: 10071},
+								pos: position{line: 402, col: 59, offset: 10176},
 								expr: &seqExpr{
-									pos: position{line: 400, col: 60, offset: 10072},
+									pos: position{line: 402, col: 60, offset: 10177},
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 400, col: 60, offset: 10072},
+											pos:  position{line: 402, col: 60, offset: 10177},
 											name: "_",
 										},
 										&litMatcher{
-											pos:        position{line: 400, col: 62, offset: 10074},
+											pos:        position{line: 402, col: 62, offset: 10179},
 											val:        "-flush",
 											ignoreCase: false,
 											want:       "\"-flush\"",
@@ -2925,25 +2967,25 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 400, col: 73, offset: 10085},
+							pos:   position{line: 402, col: 73, offset: 10190},
 							label: "fields",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 400, col: 80, offset: 10092},
+								pos: position{line: 402, col: 80, offset: 10197},
 								expr: &actionExpr{
-									pos: position{line: 400, col: 81, offset: 10093},
+									pos: position{line: 402, col: 81, offset: 10198},
 									run: (*parser).callonTopOp20,
 									expr: &seqExpr{
-										pos: position{line: 400, col: 81, offset: 10093},
+										pos: position{line: 402, col: 81, offset: 10198},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 400, col: 81, offset: 10093},
+												pos:  position{line: 402, col: 81, offset: 10198},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 400, col: 83, offset: 10095},
+												pos:   position{line: 402, col: 83, offset: 10200},
 												label: "f",
 												expr: &ruleRefExpr{
-													pos:  position{line: 400, col: 85, offset: 10097},
+													pos:  position{line: 402, col: 85, offset: 10202},
 													name: "FieldExprs",
 												},
 											},
@@ -2960,28 +3002,28 @@ var g = &grammar{
 		},
 		{
 			name: "CutOp",
-			pos:  position{line: 417, col: 1, offset: 10444},
+			pos:  position{line: 419, col: 1, offset: 10549},
 			expr: &actionExpr{
-				pos: position{line: 418, col: 5, offset: 10454},
+				pos: position{line: 420, col: 5, offset: 10559},
 				run: (*parser).callonCutOp1,
 				expr: &seqExpr{
-					pos: position{line: 418, col: 5, offset: 10454},
+					pos: position{line: 420, col: 5, offset: 10559},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 418, col: 5, offset: 10454},
+							pos:        position{line: 420, col: 5, offset: 10559},
 							val:        "cut",
 							ignoreCase: false,
 							want:       "\"cut\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 418, col: 11, offset: 10460},
+							pos:  position{line: 420, col: 11, offset: 10565},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 418, col: 13, offset: 10462},
+							pos:   position{line: 420, col: 13, offset: 10567},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 418, col: 18, offset: 10467},
+								pos:  position{line: 420, col: 18, offset: 10572},
 								name: "FlexAssignments",
 							},
 						},
@@ -2993,28 +3035,28 @@ var g = &grammar{
 		},
 		{
 			name: "DropOp",
-			pos:  position{line: 426, col: 1, offset: 10628},
+			pos:  position{line: 428, col: 1, offset: 10733},
 			expr: &actionExpr{
-				pos: position{line: 427, col: 5, offset: 10639},
+				pos: position{line: 429, col: 5, offset: 10744},
 				run: (*parser).callonDropOp1,
 				expr: &seqExpr{
-					pos: position{line: 427, col: 5, offset: 10639},
+					pos: position{line: 429, col: 5, offset: 10744},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 427, col: 5, offset: 10639},
+							pos:        position{line: 429, col: 5, offset: 10744},
 							val:        "drop",
 							ignoreCase: false,
 							want:       "\"drop\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 427, col: 12, offset: 10646},
+							pos:  position{line: 429, col: 12, offset: 10751},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 427, col: 14, offset: 10648},
+							pos:   position{line: 429, col: 14, offset: 10753},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 427, col: 19, offset: 10653},
+								pos:  position{line: 429, col: 19, offset: 10758},
 								name: "FieldExprs",
 							},
 						},
@@ -3026,38 +3068,38 @@ var g = &grammar{
 		},
 		{
 			name: "HeadOp",
-			pos:  position{line: 435, col: 1, offset: 10811},
+			pos:  position{line: 437, col: 1, offset: 10916},
 			expr: &choiceExpr{
-				pos: position{line: 436, col: 5, offset: 10822},
+				pos: position{line: 438, col: 5, offset: 10927},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 436, col: 5, offset: 10822},
+						pos: position{line: 438, col: 5, offset: 10927},
 						run: (*parser).callonHeadOp2,
 						expr: &seqExpr{
-							pos: position{line: 436, col: 5, offset: 10822},
+							pos: position{line: 438, col: 5, offset: 10927},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 436, col: 5, offset: 10822},
+									pos:        position{line: 438, col: 5, offset: 10927},
 									val:        "head",
 									ignoreCase: false,
 									want:       "\"head\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 436, col: 12, offset: 10829},
+									pos:  position{line: 438, col: 12, offset: 10934},
 									name: "_",
 								},
 								&notExpr{
-									pos: position{line: 436, col: 14, offset: 10831},
+									pos: position{line: 438, col: 14, offset: 10936},
 									expr: &ruleRefExpr{
-										pos:  position{line: 436, col: 15, offset: 10832},
+										pos:  position{line: 438, col: 15, offset: 10937},
 										name: "EndOfOp",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 436, col: 23, offset: 10840},
+									pos:   position{line: 438, col: 23, offset: 10945},
 									label: "count",
 									expr: &ruleRefExpr{
-										pos:  position{line: 436, col: 29, offset: 10846},
+										pos:  position{line: 438, col: 29, offset: 10951},
 										name: "Expr",
 									},
 								},
@@ -3065,28 +3107,28 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 443, col: 5, offset: 10989},
+						pos: position{line: 445, col: 5, offset: 11094},
 						run: (*parser).callonHeadOp10,
 						expr: &seqExpr{
-							pos: position{line: 443, col: 5, offset: 10989},
+							pos: position{line: 445, col: 5, offset: 11094},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 443, col: 5, offset: 10989},
+									pos:        position{line: 445, col: 5, offset: 11094},
 									val:        "head",
 									ignoreCase: false,
 									want:       "\"head\"",
 								},
 								&notExpr{
-									pos: position{line: 443, col: 12, offset: 10996},
+									pos: position{line: 445, col: 12, offset: 11101},
 									expr: &seqExpr{
-										pos: position{line: 443, col: 14, offset: 10998},
+										pos: position{line: 445, col: 14, offset: 11103},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 443, col: 14, offset: 10998},
+												pos:  position{line: 445, col: 14, offset: 11103},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 443, col: 17, offset: 11001},
+												pos:        position{line: 445, col: 17, offset: 11106},
 												val:        "(",
 												ignoreCase: false,
 												want:       "\"(\"",
@@ -3095,9 +3137,9 @@ var g = &grammar{
 									},
 								},
 								&andExpr{
-									pos: position{line: 443, col: 22, offset: 11006},
+									pos: position{line: 445, col: 22, offset: 11111},
 									expr: &ruleRefExpr{
-										pos:  position{line: 443, col: 23, offset: 11007},
+										pos:  position{line: 445, col: 23, offset: 11112},
 										name: "EOKW",
 									},
 								},
@@ -3111,38 +3153,38 @@ var g = &grammar{
 		},
 		{
 			name: "TailOp",
-			pos:  position{line: 450, col: 1, offset: 11114},
+			pos:  position{line: 452, col: 1, offset: 11219},
 			expr: &choiceExpr{
-				pos: position{line: 451, col: 5, offset: 11125},
+				pos: position{line: 453, col: 5, offset: 11230},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 451, col: 5, offset: 11125},
+						pos: position{line: 453, col: 5, offset: 11230},
 						run: (*parser).callonTailOp2,
 						expr: &seqExpr{
-							pos: position{line: 451, col: 5, offset: 11125},
+							pos: position{line: 453, col: 5, offset: 11230},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 451, col: 5, offset: 11125},
+									pos:        position{line: 453, col: 5, offset: 11230},
 									val:        "tail",
 									ignoreCase: false,
 									want:       "\"tail\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 451, col: 12, offset: 11132},
+									pos:  position{line: 453, col: 12, offset: 11237},
 									name: "_",
 								},
 								&notExpr{
-									pos: position{line: 451, col: 14, offset: 11134},
+									pos: position{line: 453, col: 14, offset: 11239},
 									expr: &ruleRefExpr{
-										pos:  position{line: 451, col: 15, offset: 11135},
+										pos:  position{line: 453, col: 15, offset: 11240},
 										name: "EndOfOp",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 451, col: 23, offset: 11143},
+									pos:   position{line: 453, col: 23, offset: 11248},
 									label: "count",
 									expr: &ruleRefExpr{
-										pos:  position{line: 451, col: 29, offset: 11149},
+										pos:  position{line: 453, col: 29, offset: 11254},
 										name: "Expr",
 									},
 								},
@@ -3150,28 +3192,28 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 458, col: 5, offset: 11292},
+						pos: position{line: 460, col: 5, offset: 11397},
 						run: (*parser).callonTailOp10,
 						expr: &seqExpr{
-							pos: position{line: 458, col: 5, offset: 11292},
+							pos: position{line: 460, col: 5, offset: 11397},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 458, col: 5, offset: 11292},
+									pos:        position{line: 460, col: 5, offset: 11397},
 									val:        "tail",
 									ignoreCase: false,
 									want:       "\"tail\"",
 								},
 								&notExpr{
-									pos: position{line: 458, col: 12, offset: 11299},
+									pos: position{line: 460, col: 12, offset: 11404},
 									expr: &seqExpr{
-										pos: position{line: 458, col: 14, offset: 11301},
+										pos: position{line: 460, col: 14, offset: 11406},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 458, col: 14, offset: 11301},
+												pos:  position{line: 460, col: 14, offset: 11406},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 458, col: 17, offset: 11304},
+												pos:        position{line: 460, col: 17, offset: 11409},
 												val:        "(",
 												ignoreCase: false,
 												want:       "\"(\"",
@@ -3180,9 +3222,9 @@ var g = &grammar{
 									},
 								},
 								&andExpr{
-									pos: position{line: 458, col: 22, offset: 11309},
+									pos: position{line: 460, col: 22, offset: 11414},
 									expr: &ruleRefExpr{
-										pos:  position{line: 458, col: 23, offset: 11310},
+										pos:  position{line: 460, col: 23, offset: 11415},
 										name: "EOKW",
 									},
 								},
@@ -3196,28 +3238,28 @@ var g = &grammar{
 		},
 		{
 			name: "WhereOp",
-			pos:  position{line: 465, col: 1, offset: 11417},
+			pos:  position{line: 467, col: 1, offset: 11522},
 			expr: &actionExpr{
-				pos: position{line: 466, col: 5, offset: 11429},
+				pos: position{line: 468, col: 5, offset: 11534},
 				run: (*parser).callonWhereOp1,
 				expr: &seqExpr{
-					pos: position{line: 466, col: 5, offset: 11429},
+					pos: position{line: 468, col: 5, offset: 11534},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 466, col: 5, offset: 11429},
+							pos:        position{line: 468, col: 5, offset: 11534},
 							val:        "where",
 							ignoreCase: false,
 							want:       "\"where\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 466, col: 13, offset: 11437},
+							pos:  position{line: 468, col: 13, offset: 11542},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 466, col: 15, offset: 11439},
+							pos:   position{line: 468, col: 15, offset: 11544},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 466, col: 20, offset: 11444},
+								pos:  position{line: 468, col: 20, offset: 11549},
 								name: "Expr",
 							},
 						},
@@ -3229,28 +3271,28 @@ var g = &grammar{
 		},
 		{
 			name: "UniqOp",
-			pos:  position{line: 474, col: 1, offset: 11584},
+			pos:  position{line: 476, col: 1, offset: 11689},
 			expr: &choiceExpr{
-				pos: position{line: 475, col: 5, offset: 11595},
+				pos: position{line: 477, col: 5, offset: 11700},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 475, col: 5, offset: 11595},
+						pos: position{line: 477, col: 5, offset: 11700},
 						run: (*parser).callonUniqOp2,
 						expr: &seqExpr{
-							pos: position{line: 475, col: 5, offset: 11595},
+							pos: position{line: 477, col: 5, offset: 11700},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 475, col: 5, offset: 11595},
+									pos:        position{line: 477, col: 5, offset: 11700},
 									val:        "uniq",
 									ignoreCase: false,
 									want:       "\"uniq\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 475, col: 12, offset: 11602},
+									pos:  position{line: 477, col: 12, offset: 11707},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 475, col: 14, offset: 11604},
+									pos:        position{line: 477, col: 14, offset: 11709},
 									val:        "-c",
 									ignoreCase: false,
 									want:       "\"-c\"",
@@ -3259,28 +3301,28 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 478, col: 5, offset: 11702},
+						pos: position{line: 480, col: 5, offset: 11807},
 						run: (*parser).callonUniqOp7,
 						expr: &seqExpr{
-							pos: position{line: 478, col: 5, offset: 11702},
+							pos: position{line: 480, col: 5, offset: 11807},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 478, col: 5, offset: 11702},
+									pos:        position{line: 480, col: 5, offset: 11807},
 									val:        "uniq",
 									ignoreCase: false,
 									want:       "\"uniq\"",
 								},
 								&notExpr{
-									pos: position{line: 478, col: 12, offset: 11709},
+									pos: position{line: 480, col: 12, offset: 11814},
 									expr: &seqExpr{
-										pos: position{line: 478, col: 14, offset: 11711},
+										pos: position{line: 480, col: 14, offset: 11816},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 478, col: 14, offset: 11711},
+												pos:  position{line: 480, col: 14, offset: 11816},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 478, col: 17, offset: 11714},
+												pos:        position{line: 480, col: 17, offset: 11819},
 												val:        "(",
 												ignoreCase: false,
 												want:       "\"(\"",
@@ -3289,9 +3331,9 @@ var g = &grammar{
 									},
 								},
 								&andExpr{
-									pos: position{line: 478, col: 22, offset: 11719},
+									pos: position{line: 480, col: 22, offset: 11824},
 									expr: &ruleRefExpr{
-										pos:  position{line: 478, col: 23, offset: 11720},
+										pos:  position{line: 480, col: 23, offset: 11825},
 										name: "EOKW",
 									},
 								},
@@ -3305,28 +3347,28 @@ var g = &grammar{
 		},
 		{
 			name: "PutOp",
-			pos:  position{line: 482, col: 1, offset: 11802},
+			pos:  position{line: 484, col: 1, offset: 11907},
 			expr: &actionExpr{
-				pos: position{line: 483, col: 5, offset: 11812},
+				pos: position{line: 485, col: 5, offset: 11917},
 				run: (*parser).callonPutOp1,
 				expr: &seqExpr{
-					pos: position{line: 483, col: 5, offset: 11812},
+					pos: position{line: 485, col: 5, offset: 11917},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 483, col: 5, offset: 11812},
+							pos:        position{line: 485, col: 5, offset: 11917},
 							val:        "put",
 							ignoreCase: false,
 							want:       "\"put\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 483, col: 11, offset: 11818},
+							pos:  position{line: 485, col: 11, offset: 11923},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 483, col: 13, offset: 11820},
+							pos:   position{line: 485, col: 13, offset: 11925},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 483, col: 18, offset: 11825},
+								pos:  position{line: 485, col: 18, offset: 11930},
 								name: "Assignments",
 							},
 						},
@@ -3338,61 +3380,61 @@ var g = &grammar{
 		},
 		{
 			name: "RenameOp",
-			pos:  position{line: 491, col: 1, offset: 11988},
+			pos:  position{line: 493, col: 1, offset: 12093},
 			expr: &actionExpr{
-				pos: position{line: 492, col: 5, offset: 12001},
+				pos: position{line: 494, col: 5, offset: 12106},
 				run: (*parser).callonRenameOp1,
 				expr: &seqExpr{
-					pos: position{line: 492, col: 5, offset: 12001},
+					pos: position{line: 494, col: 5, offset: 12106},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 492, col: 5, offset: 12001},
+							pos:        position{line: 494, col: 5, offset: 12106},
 							val:        "rename",
 							ignoreCase: false,
 							want:       "\"rename\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 492, col: 14, offset: 12010},
+							pos:  position{line: 494, col: 14, offset: 12115},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 492, col: 16, offset: 12012},
+							pos:   position{line: 494, col: 16, offset: 12117},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 492, col: 22, offset: 12018},
+								pos:  position{line: 494, col: 22, offset: 12123},
 								name: "Assignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 492, col: 33, offset: 12029},
+							pos:   position{line: 494, col: 33, offset: 12134},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 492, col: 38, offset: 12034},
+								pos: position{line: 494, col: 38, offset: 12139},
 								expr: &actionExpr{
-									pos: position{line: 492, col: 39, offset: 12035},
+									pos: position{line: 494, col: 39, offset: 12140},
 									run: (*parser).callonRenameOp9,
 									expr: &seqExpr{
-										pos: position{line: 492, col: 39, offset: 12035},
+										pos: position{line: 494, col: 39, offset: 12140},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 492, col: 39, offset: 12035},
+												pos:  position{line: 494, col: 39, offset: 12140},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 492, col: 42, offset: 12038},
+												pos:        position{line: 494, col: 42, offset: 12143},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 492, col: 46, offset: 12042},
+												pos:  position{line: 494, col: 46, offset: 12147},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 492, col: 49, offset: 12045},
+												pos:   position{line: 494, col: 49, offset: 12150},
 												label: "cl",
 												expr: &ruleRefExpr{
-													pos:  position{line: 492, col: 52, offset: 12048},
+													pos:  position{line: 494, col: 52, offset: 12153},
 													name: "Assignment",
 												},
 											},
@@ -3409,30 +3451,30 @@ var g = &grammar{
 		},
 		{
 			name: "FuseOp",
-			pos:  position{line: 505, col: 1, offset: 12524},
+			pos:  position{line: 507, col: 1, offset: 12631},
 			expr: &actionExpr{
-				pos: position{line: 506, col: 5, offset: 12535},
+				pos: position{line: 508, col: 5, offset: 12642},
 				run: (*parser).callonFuseOp1,
 				expr: &seqExpr{
-					pos: position{line: 506, col: 5, offset: 12535},
+					pos: position{line: 508, col: 5, offset: 12642},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 506, col: 5, offset: 12535},
+							pos:        position{line: 508, col: 5, offset: 12642},
 							val:        "fuse",
 							ignoreCase: false,
 							want:       "\"fuse\"",
 						},
 						&notExpr{
-							pos: position{line: 506, col: 12, offset: 12542},
+							pos: position{line: 508, col: 12, offset: 12649},
 							expr: &seqExpr{
-								pos: position{line: 506, col: 14, offset: 12544},
+								pos: position{line: 508, col: 14, offset: 12651},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 506, col: 14, offset: 12544},
+										pos:  position{line: 508, col: 14, offset: 12651},
 										name: "__",
 									},
 									&litMatcher{
-										pos:        position{line: 506, col: 17, offset: 12547},
+										pos:        position{line: 508, col: 17, offset: 12654},
 										val:        "(",
 										ignoreCase: false,
 										want:       "\"(\"",
@@ -3441,9 +3483,9 @@ var g = &grammar{
 							},
 						},
 						&andExpr{
-							pos: position{line: 506, col: 22, offset: 12552},
+							pos: position{line: 508, col: 22, offset: 12659},
 							expr: &ruleRefExpr{
-								pos:  position{line: 506, col: 23, offset: 12553},
+								pos:  position{line: 508, col: 23, offset: 12660},
 								name: "EOKW",
 							},
 						},
@@ -3455,30 +3497,30 @@ var g = &grammar{
 		},
 		{
 			name: "ShapeOp",
-			pos:  position{line: 510, col: 1, offset: 12635},
+			pos:  position{line: 512, col: 1, offset: 12742},
 			expr: &actionExpr{
-				pos: position{line: 511, col: 5, offset: 12647},
+				pos: position{line: 513, col: 5, offset: 12754},
 				run: (*parser).callonShapeOp1,
 				expr: &seqExpr{
-					pos: position{line: 511, col: 5, offset: 12647},
+					pos: position{line: 513, col: 5, offset: 12754},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 511, col: 5, offset: 12647},
+							pos:        position{line: 513, col: 5, offset: 12754},
 							val:        "shape",
 							ignoreCase: false,
 							want:       "\"shape\"",
 						},
 						&notExpr{
-							pos: position{line: 511, col: 13, offset: 12655},
+							pos: position{line: 513, col: 13, offset: 12762},
 							expr: &seqExpr{
-								pos: position{line: 511, col: 15, offset: 12657},
+								pos: position{line: 513, col: 15, offset: 12764},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 511, col: 15, offset: 12657},
+										pos:  position{line: 513, col: 15, offset: 12764},
 										name: "__",
 									},
 									&litMatcher{
-										pos:        position{line: 511, col: 18, offset: 12660},
+										pos:        position{line: 513, col: 18, offset: 12767},
 										val:        "(",
 										ignoreCase: false,
 										want:       "\"(\"",
@@ -3487,9 +3529,9 @@ var g = &grammar{
 							},
 						},
 						&andExpr{
-							pos: position{line: 511, col: 23, offset: 12665},
+							pos: position{line: 513, col: 23, offset: 12772},
 							expr: &ruleRefExpr{
-								pos:  position{line: 511, col: 24, offset: 12666},
+								pos:  position{line: 513, col: 24, offset: 12773},
 								name: "EOKW",
 							},
 						},
@@ -3501,97 +3543,105 @@ var g = &grammar{
 		},
 		{
 			name: "JoinOp",
-			pos:  position{line: 515, col: 1, offset: 12750},
+			pos:  position{line: 517, col: 1, offset: 12857},
 			expr: &actionExpr{
-				pos: position{line: 516, col: 5, offset: 12761},
+				pos: position{line: 518, col: 5, offset: 12868},
 				run: (*parser).callonJoinOp1,
 				expr: &seqExpr{
-					pos: position{line: 516, col: 5, offset: 12761},
+					pos: position{line: 518, col: 5, offset: 12868},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 516, col: 5, offset: 12761},
+							pos:   position{line: 518, col: 5, offset: 12868},
 							label: "style",
 							expr: &ruleRefExpr{
-								pos:  position{line: 516, col: 11, offset: 12767},
+								pos:  position{line: 518, col: 11, offset: 12874},
 								name: "JoinStyle",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 516, col: 21, offset: 12777},
+							pos:        position{line: 518, col: 21, offset: 12884},
 							val:        "join",
 							ignoreCase: false,
 							want:       "\"join\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 516, col: 28, offset: 12784},
+							pos:   position{line: 518, col: 28, offset: 12891},
 							label: "rightInput",
 							expr: &ruleRefExpr{
-								pos:  position{line: 516, col: 39, offset: 12795},
+								pos:  position{line: 518, col: 39, offset: 12902},
 								name: "JoinRightInput",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 516, col: 54, offset: 12810},
+							pos:        position{line: 518, col: 54, offset: 12917},
 							val:        "on",
 							ignoreCase: false,
 							want:       "\"on\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 516, col: 59, offset: 12815},
+							pos:  position{line: 518, col: 59, offset: 12922},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 516, col: 61, offset: 12817},
-							label: "key",
+							pos:   position{line: 518, col: 61, offset: 12924},
+							label: "keys",
 							expr: &ruleRefExpr{
-								pos:  position{line: 516, col: 65, offset: 12821},
-								name: "JoinKey",
+								pos:  position{line: 518, col: 66, offset: 12929},
+								name: "JoinKeys",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 516, col: 73, offset: 12829},
-							label: "optKey",
+							pos:   position{line: 518, col: 75, offset: 12938},
+							label: "cond",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 516, col: 80, offset: 12836},
-								expr: &seqExpr{
-									pos: position{line: 516, col: 81, offset: 12837},
-									exprs: []any{
-										&ruleRefExpr{
-											pos:  position{line: 516, col: 81, offset: 12837},
-											name: "__",
-										},
-										&litMatcher{
-											pos:        position{line: 516, col: 84, offset: 12840},
-											val:        "=",
-											ignoreCase: false,
-											want:       "\"=\"",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 516, col: 88, offset: 12844},
-											name: "__",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 516, col: 91, offset: 12847},
-											name: "JoinKey",
+								pos: position{line: 518, col: 80, offset: 12943},
+								expr: &actionExpr{
+									pos: position{line: 518, col: 81, offset: 12944},
+									run: (*parser).callonJoinOp14,
+									expr: &seqExpr{
+										pos: position{line: 518, col: 81, offset: 12944},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 518, col: 81, offset: 12944},
+												name: "_",
+											},
+											&litMatcher{
+												pos:        position{line: 518, col: 83, offset: 12946},
+												val:        "where",
+												ignoreCase: false,
+												want:       "\"where\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 518, col: 91, offset: 12954},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 518, col: 93, offset: 12956},
+												label: "e",
+												expr: &ruleRefExpr{
+													pos:  position{line: 518, col: 95, offset: 12958},
+													name: "Expr",
+												},
+											},
 										},
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 516, col: 101, offset: 12857},
+							pos:   position{line: 518, col: 120, offset: 12983},
 							label: "optArgs",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 516, col: 109, offset: 12865},
+								pos: position{line: 518, col: 128, offset: 12991},
 								expr: &seqExpr{
-									pos: position{line: 516, col: 110, offset: 12866},
+									pos: position{line: 518, col: 129, offset: 12992},
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 516, col: 110, offset: 12866},
+											pos:  position{line: 518, col: 129, offset: 12992},
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 516, col: 112, offset: 12868},
+											pos:  position{line: 518, col: 131, offset: 12994},
 											name: "FlexAssignments",
 										},
 									},
@@ -3606,91 +3656,110 @@ var g = &grammar{
 		},
 		{
 			name: "JoinStyle",
-			pos:  position{line: 535, col: 1, offset: 13331},
+			pos:  position{line: 537, col: 1, offset: 13447},
 			expr: &choiceExpr{
-				pos: position{line: 536, col: 5, offset: 13345},
+				pos: position{line: 538, col: 5, offset: 13461},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 536, col: 5, offset: 13345},
+						pos: position{line: 538, col: 5, offset: 13461},
 						run: (*parser).callonJoinStyle2,
 						expr: &seqExpr{
-							pos: position{line: 536, col: 5, offset: 13345},
+							pos: position{line: 538, col: 5, offset: 13461},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 536, col: 5, offset: 13345},
+									pos:        position{line: 538, col: 5, offset: 13461},
 									val:        "anti",
 									ignoreCase: false,
 									want:       "\"anti\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 536, col: 12, offset: 13352},
+									pos:  position{line: 538, col: 12, offset: 13468},
 									name: "_",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 537, col: 5, offset: 13382},
+						pos: position{line: 539, col: 5, offset: 13498},
 						run: (*parser).callonJoinStyle6,
 						expr: &seqExpr{
-							pos: position{line: 537, col: 5, offset: 13382},
+							pos: position{line: 539, col: 5, offset: 13498},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 539, col: 5, offset: 13498},
+									val:        "full",
+									ignoreCase: false,
+									want:       "\"full\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 539, col: 12, offset: 13505},
+									name: "_",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 540, col: 5, offset: 13535},
+						run: (*parser).callonJoinStyle10,
+						expr: &seqExpr{
+							pos: position{line: 540, col: 5, offset: 13535},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 537, col: 5, offset: 13382},
+									pos:        position{line: 540, col: 5, offset: 13535},
 									val:        "inner",
 									ignoreCase: false,
 									want:       "\"inner\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 537, col: 13, offset: 13390},
+									pos:  position{line: 540, col: 13, offset: 13543},
 									name: "_",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 538, col: 5, offset: 13420},
-						run: (*parser).callonJoinStyle10,
+						pos: position{line: 541, col: 5, offset: 13573},
+						run: (*parser).callonJoinStyle14,
 						expr: &seqExpr{
-							pos: position{line: 538, col: 5, offset: 13420},
+							pos: position{line: 541, col: 5, offset: 13573},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 538, col: 5, offset: 13420},
+									pos:        position{line: 541, col: 5, offset: 13573},
 									val:        "left",
 									ignoreCase: false,
 									want:       "\"left\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 538, col: 13, offset: 13428},
+									pos:  position{line: 541, col: 13, offset: 13581},
 									name: "_",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 539, col: 5, offset: 13457},
-						run: (*parser).callonJoinStyle14,
+						pos: position{line: 542, col: 5, offset: 13610},
+						run: (*parser).callonJoinStyle18,
 						expr: &seqExpr{
-							pos: position{line: 539, col: 5, offset: 13457},
+							pos: position{line: 542, col: 5, offset: 13610},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 539, col: 5, offset: 13457},
+									pos:        position{line: 542, col: 5, offset: 13610},
 									val:        "right",
 									ignoreCase: false,
 									want:       "\"right\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 539, col: 13, offset: 13465},
+									pos:  position{line: 542, col: 13, offset: 13618},
 									name: "_",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 540, col: 5, offset: 13495},
-						run: (*parser).callonJoinStyle18,
+						pos: position{line: 543, col: 5, offset: 13648},
+						run: (*parser).callonJoinStyle22,
 						expr: &litMatcher{
-							pos:        position{line: 540, col: 5, offset: 13495},
+							pos:        position{line: 543, col: 5, offset: 13648},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -3703,60 +3772,60 @@ var g = &grammar{
 		},
 		{
 			name: "JoinRightInput",
-			pos:  position{line: 542, col: 1, offset: 13530},
+			pos:  position{line: 545, col: 1, offset: 13683},
 			expr: &choiceExpr{
-				pos: position{line: 543, col: 5, offset: 13549},
+				pos: position{line: 546, col: 5, offset: 13702},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 543, col: 5, offset: 13549},
+						pos: position{line: 546, col: 5, offset: 13702},
 						run: (*parser).callonJoinRightInput2,
 						expr: &seqExpr{
-							pos: position{line: 543, col: 5, offset: 13549},
+							pos: position{line: 546, col: 5, offset: 13702},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 543, col: 5, offset: 13549},
+									pos:  position{line: 546, col: 5, offset: 13702},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 543, col: 8, offset: 13552},
+									pos:        position{line: 546, col: 8, offset: 13705},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 543, col: 12, offset: 13556},
+									pos:  position{line: 546, col: 12, offset: 13709},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 543, col: 15, offset: 13559},
+									pos:   position{line: 546, col: 15, offset: 13712},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 543, col: 17, offset: 13561},
+										pos:  position{line: 546, col: 17, offset: 13714},
 										name: "Seq",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 543, col: 21, offset: 13565},
+									pos:  position{line: 546, col: 21, offset: 13718},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 543, col: 24, offset: 13568},
+									pos:        position{line: 546, col: 24, offset: 13721},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 543, col: 28, offset: 13572},
+									pos:  position{line: 546, col: 28, offset: 13725},
 									name: "__",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 544, col: 5, offset: 13597},
+						pos: position{line: 547, col: 5, offset: 13750},
 						run: (*parser).callonJoinRightInput12,
 						expr: &ruleRefExpr{
-							pos:  position{line: 544, col: 5, offset: 13597},
+							pos:  position{line: 547, col: 5, offset: 13750},
 							name: "_",
 						},
 					},
@@ -3765,38 +3834,160 @@ var g = &grammar{
 			leader:        false,
 			leftRecursive: false,
 		},
+		{
+			name: "JoinKeys",
+			pos:  position{line: 549, col: 1, offset: 13773},
+			expr: &actionExpr{
+				pos: position{line: 550, col: 5, offset: 13786},
+				run: (*parser).callonJoinKeys1,
+				expr: &seqExpr{
+					pos: position{line: 550, col: 5, offset: 13786},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 550, col: 5, offset: 13786},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 550, col: 11, offset: 13792},
+								name: "JoinKeyPair",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 550, col: 23, offset: 13804},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 550, col: 28, offset: 13809},
+								expr: &actionExpr{
+									pos: position{line: 550, col: 29, offset: 13810},
+									run: (*parser).callonJoinKeys7,
+									expr: &seqExpr{
+										pos: position{line: 550, col: 29, offset: 13810},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 550, col: 29, offset: 13810},
+												name: "__",
+											},
+											&litMatcher{
+												pos:        position{line: 550, col: 32, offset: 13813},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 550, col: 36, offset: 13817},
+												name: "__",
+											},
+											&labeledExpr{
+												pos:   position{line: 550, col: 39, offset: 13820},
+												label: "k",
+												expr: &ruleRefExpr{
+													pos:  position{line: 550, col: 41, offset: 13822},
+													name: "JoinKeyPair",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "JoinKeyPair",
+			pos:  position{line: 554, col: 1, offset: 13902},
+			expr: &actionExpr{
+				pos: position{line: 555, col: 5, offset: 13918},
+				run: (*parser).callonJoinKeyPair1,
+				expr: &seqExpr{
+					pos: position{line: 555, col: 5, offset: 13918},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 555, col: 5, offset: 13918},
+							label: "left",
+							expr: &ruleRefExpr{
+								pos:  position{line: 555, col: 10, offset: 13923},
+								name: "JoinKey",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 555, col: 18, offset: 13931},
+							label: "right",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 555, col: 24, offset: 13937},
+								expr: &actionExpr{
+									pos: position{line: 555, col: 25, offset: 13938},
+									run: (*parser).callonJoinKeyPair7,
+									expr: &seqExpr{
+										pos: position{line: 555, col: 25, offset: 13938},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 555, col: 25, offset: 13938},
+												name: "__",
+											},
+											&litMatcher{
+												pos:        position{line: 555, col: 28, offset: 13941},
+												val:        "=",
+												ignoreCase: false,
+												want:       "\"=\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 555, col: 32, offset: 13945},
+												name: "__",
+											},
+											&labeledExpr{
+												pos:   position{line: 555, col: 35, offset: 13948},
+												label: "k",
+												expr: &ruleRefExpr{
+													pos:  position{line: 555, col: 37, offset: 13950},
+													name: "JoinKey",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
 		{
 			name: "JoinKey",
-			pos:  position{line: 546, col: 1, offset: 13620},
+			pos:  position{line: 563, col: 1, offset: 14137},
 			expr: &choiceExpr{
-				pos: position{line: 547, col: 5, offset: 13632},
+				pos: position{line: 564, col: 5, offset: 14149},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 547, col: 5, offset: 13632},
+						pos:  position{line: 564, col: 5, offset: 14149},
 						name: "Lval",
 					},
 					&actionExpr{
-						pos: position{line: 548, col: 5, offset: 13641},
+						pos: position{line: 565, col: 5, offset: 14158},
 						run: (*parser).callonJoinKey3,
 						expr: &seqExpr{
-							pos: position{line: 548, col: 5, offset: 13641},
+							pos: position{line: 565, col: 5, offset: 14158},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 548, col: 5, offset: 13641},
+									pos:        position{line: 565, col: 5, offset: 14158},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 548, col: 9, offset: 13645},
+									pos:   position{line: 565, col: 9, offset: 14162},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 548, col: 14, offset: 13650},
+										pos:  position{line: 565, col: 14, offset: 14167},
 										name: "Expr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 548, col: 19, offset: 13655},
+									pos:        position{line: 565, col: 19, offset: 14172},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -3811,46 +4002,46 @@ var g = &grammar{
 		},
 		{
 			name: "SampleOp",
-			pos:  position{line: 550, col: 1, offset: 13681},
+			pos:  position{line: 567, col: 1, offset: 14198},
 			expr: &actionExpr{
-				pos: position{line: 551, col: 5, offset: 13694},
+				pos: position{line: 568, col: 5, offset: 14211},
 				run: (*parser).callonSampleOp1,
 				expr: &seqExpr{
-					pos: position{line: 551, col: 5, offset: 13694},
+					pos: position{line: 568, col: 5, offset: 14211},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 551, col: 5, offset: 13694},
+							pos:        position{line: 568, col: 5, offset: 14211},
 							val:        "sample",
 							ignoreCase: false,
 							want:       "\"sample\"",
 						},
 						&andExpr{
-							pos: position{line: 551, col: 14, offset: 13703},
+							pos: position{line: 568, col: 14, offset: 14220},
 							expr: &ruleRefExpr{
-								pos:  position{line: 551, col: 15, offset: 13704},
+								pos:  position{line: 568, col: 15, offset: 14221},
 								name: "EOKW",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 551, col: 20, offset: 13709},
+							pos:   position{line: 568, col: 20, offset: 14226},
 							label: "expr",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 551, col: 25, offset: 13714},
+								pos: position{line: 568, col: 25, offset: 14231},
 								expr: &actionExpr{
-									pos: position{line: 551, col: 26, offset: 13715},
+									pos: position{line: 568, col: 26, offset: 14232},
 									run: (*parser).callonSampleOp8,
 									expr: &seqExpr{
-										pos: position{line: 551, col: 26, offset: 13715},
+										pos: position{line: 568, col: 26, offset: 14232},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 551, col: 26, offset: 13715},
+												pos:  position{line: 568, col: 26, offset: 14232},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 551, col: 28, offset: 13717},
+												pos:   position{line: 568, col: 28, offset: 14234},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 551, col: 30, offset: 13719},
+													pos:  position{line: 568, col: 30, offset: 14236},
 													name: "Lval",
 												},
 											},
@@ -3867,15 +4058,15 @@ var g = &grammar{
 		},
 		{
 			name: "OpAssignment",
-			pos:  position{line: 564, col: 1, offset: 14170},
+			pos:  position{line: 581, col: 1, offset: 14687},
 			expr: &actionExpr{
-				pos: position{line: 565, col: 5, offset: 14187},
+				pos: position{line: 582, col: 5, offset: 14704},
 				run: (*parser).callonOpAssignment1,
 				expr: &labeledExpr{
-					pos:   position{line: 565, col: 5, offset: 14187},
+					pos:   position{line: 582, col: 5, offset: 14704},
 					label: "a",
 					expr: &ruleRefExpr{
-						pos:  position{line: 565, col: 7, offset: 14189},
+						pos:  position{line: 582, col: 7, offset: 14706},
 						name: "Assignments",
 					},
 				},
@@ -3885,71 +4076,71 @@ var g = &grammar{
 		},
 		{
 			name: "LoadOp",
-			pos:  position{line: 572, col: 1, offset: 14338},
+			pos:  position{line: 589, col: 1, offset: 14855},
 			expr: &actionExpr{
-				pos: position{line: 573, col: 5, offset: 14349},
+				pos: position{line: 590, col: 5, offset: 14866},
 				run: (*parser).callonLoadOp1,
 				expr: &seqExpr{
-					pos: position{line: 573, col: 5, offset: 14349},
+					pos: position{line: 590, col: 5, offset: 14866},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 573, col: 5, offset: 14349},
+							pos:        position{line: 590, col: 5, offset: 14866},
 							val:        "load",
 							ignoreCase: false,
 							want:       "\"load\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 573, col: 12, offset: 14356},
+							pos:  position{line: 590, col: 12, offset: 14873},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 573, col: 14, offset: 14358},
+							pos:   position{line: 590, col: 14, offset: 14875},
 							label: "pool",
 							expr: &ruleRefExpr{
-								pos:  position{line: 573, col: 19, offset: 14363},
+								pos:  position{line: 590, col: 19, offset: 14880},
 								name: "PoolNameString",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 573, col: 34, offset: 14378},
+							pos:   position{line: 590, col: 34, offset: 14895},
 							label: "branch",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 573, col: 41, offset: 14385},
+								pos: position{line: 590, col: 41, offset: 14902},
 								expr: &ruleRefExpr{
-									pos:  position{line: 573, col: 41, offset: 14385},
+									pos:  position{line: 590, col: 41, offset: 14902},
 									name: "PoolBranch",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 573, col: 53, offset: 14397},
+							pos:   position{line: 590, col: 53, offset: 14914},
 							label: "author",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 573, col: 60, offset: 14404},
+								pos: position{line: 590, col: 60, offset: 14921},
 								expr: &ruleRefExpr{
-									pos:  position{line: 573, col: 60, offset: 14404},
+									pos:  position{line: 590, col: 60, offset: 14921},
 									name: "AuthorArg",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 573, col: 71, offset: 14415},
+							pos:   position{line: 590, col: 71, offset: 14932},
 							label: "message",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 573, col: 79, offset: 14423},
+								pos: position{line: 590, col: 79, offset: 14940},
 								expr: &ruleRefExpr{
-									pos:  position{line: 573, col: 79, offset: 14423},
+									pos:  position{line: 590, col: 79, offset: 14940},
 									name: "MessageArg",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 573, col: 91, offset: 14435},
+							pos:   position{line: 590, col: 91, offset: 14952},
 							label: "meta",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 573, col: 96, offset: 14440},
+								pos: position{line: 590, col: 96, offset: 14957},
 								expr: &ruleRefExpr{
-									pos:  position{line: 573, col: 96, offset: 14440},
+									pos:  position{line: 590, col: 96, offset: 14957},
 									name: "MetaArg",
 								},
 							},
@@ -3962,32 +4153,32 @@ var g = &grammar{
 		},
 		{
 			name: "AuthorArg",
-			pos:  position{line: 586, col: 1, offset: 14787},
+			pos:  position{line: 603, col: 1, offset: 15304},
 			expr: &actionExpr{
-				pos: position{line: 587, col: 5, offset: 14801},
+				pos: position{line: 604, col: 5, offset: 15318},
 				run: (*parser).callonAuthorArg1,
 				expr: &seqExpr{
-					pos: position{line: 587, col: 5, offset: 14801},
+					pos: position{line: 604, col: 5, offset: 15318},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 587, col: 5, offset: 14801},
+							pos:  position{line: 604, col: 5, offset: 15318},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 587, col: 7, offset: 14803},
+							pos:        position{line: 604, col: 7, offset: 15320},
 							val:        "author",
 							ignoreCase: false,
 							want:       "\"author\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 587, col: 16, offset: 14812},
+							pos:  position{line: 604, col: 16, offset: 15329},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 587, col: 18, offset: 14814},
+							pos:   position{line: 604, col: 18, offset: 15331},
 							label: "val",
 							expr: &ruleRefExpr{
-								pos:  position{line: 587, col: 22, offset: 14818},
+								pos:  position{line: 604, col: 22, offset: 15335},
 								name: "QuotedString",
 							},
 						},
@@ -3999,32 +4190,32 @@ var g = &grammar{
 		},
 		{
 			name: "MessageArg",
-			pos:  position{line: 589, col: 1, offset: 14852},
+			pos:  position{line: 606, col: 1, offset: 15369},
 			expr: &actionExpr{
-				pos: position{line: 590, col: 5, offset: 14867},
+				pos: position{line: 607, col: 5, offset: 15384},
 				run: (*parser).callonMessageArg1,
 				expr: &seqExpr{
-					pos: position{line: 590, col: 5, offset: 14867},
+					pos: position{line: 607, col: 5, offset: 15384},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 590, col: 5, offset: 14867},
+							pos:  position{line: 607, col: 5, offset: 15384},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 590, col: 7, offset: 14869},
+							pos:        position{line: 607, col: 7, offset: 15386},
 							val:        "message",
 							ignoreCase: false,
 							want:       "\"message\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 590, col: 17, offset: 14879},
+							pos:  position{line: 607, col: 17, offset: 15396},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 590, col: 19, offset: 14881},
+							pos:   position{line: 607, col: 19, offset: 15398},
 							label: "val",
 							expr: &ruleRefExpr{
-								pos:  position{line: 590, col: 23, offset: 14885},
+								pos:  position{line: 607, col: 23, offset: 15402},
 								name: "QuotedString",
 							},
 						},
@@ -4036,32 +4227,32 @@ var g = &grammar{
 		},
 		{
 			name: "MetaArg",
-			pos:  position{line: 592, col: 1, offset: 14919},
+			pos:  position{line: 609, col: 1, offset: 15436},
 			expr: &actionExpr{
-				pos: position{line: 593, col: 5, offset: 14931},
+				pos: position{line: 610, col: 5, offset: 15448},
 				run: (*parser).callonMetaArg1,
 				expr: &seqExpr{
-					pos: position{line: 593, col: 5, offset: 14931},
+					pos: position{line: 610, col: 5, offset: 15448},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 593, col: 5, offset: 14931},
+							pos:  position{line: 610, col: 5, offset: 15448},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 593, col: 7, offset: 14933},
+							pos:        position{line: 610, col: 7, offset: 15450},
 							val:        "meta",
 							ignoreCase: false,
 							want:       "\"meta\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 593, col: 14, offset: 14940},
+							pos:  position{line: 610, col: 14, offset: 15457},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 593, col: 16, offset: 14942},
+							pos:   position{line: 610, col: 16, offset: 15459},
 							label: "val",
 							expr: &ruleRefExpr{
-								pos:  position{line: 593, col: 20, offset: 14946},
+								pos:  position{line: 610, col: 20, offset: 15463},
 								name: "QuotedString",
 							},
 						},
@@ -4073,31 +4264,31 @@ var g = &grammar{
 		},
 		{
 			name: "PoolBranch",
-			pos:  position{line: 595, col: 1, offset: 14980},
+			pos:  position{line: 612, col: 1, offset: 15497},
 			expr: &actionExpr{
-				pos: position{line: 596, col: 5, offset: 14995},
+				pos: position{line: 613, col: 5, offset: 15512},
 				run: (*parser).callonPoolBranch1,
 				expr: &seqExpr{
-					pos: position{line: 596, col: 5, offset: 14995},
+					pos: position{line: 613, col: 5, offset: 15512},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 596, col: 5, offset: 14995},
+							pos:        position{line: 613, col: 5, offset: 15512},
 							val:        "@",
 							ignoreCase: false,
 							want:       "\"@\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 596, col: 9, offset: 14999},
+							pos:   position{line: 613, col: 9, offset: 15516},
 							label: "branch",
 							expr: &choiceExpr{
-								pos: position{line: 596, col: 17, offset: 15007},
+								pos: position{line: 613, col: 17, offset: 15524},
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 596, col: 17, offset: 15007},
+										pos:  position{line: 613, col: 17, offset: 15524},
 										name: "PoolIdentifier",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 596, col: 34, offset: 15024},
+										pos:  position{line: 613, col: 34, offset: 15541},
 										name: "QuotedString",
 									},
 								},
@@ -4111,28 +4302,28 @@ var g = &grammar{
 		},
 		{
 			name: "OutputOp",
-			pos:  position{line: 598, col: 1, offset: 15062},
+			pos:  position{line: 615, col: 1, offset: 15579},
 			expr: &actionExpr{
-				pos: position{line: 599, col: 5, offset: 15075},
+				pos: position{line: 616, col: 5, offset: 15592},
 				run: (*parser).callonOutputOp1,
 				expr: &seqExpr{
-					pos: position{line: 599, col: 5, offset: 15075},
+					pos: position{line: 616, col: 5, offset: 15592},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 599, col: 5, offset: 15075},
+							pos:        position{line: 616, col: 5, offset: 15592},
 							val:        "output",
 							ignoreCase: false,
 							want:       "\"output\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 599, col: 14, offset: 15084},
+							pos:  position{line: 616, col: 14, offset: 15601},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 599, col: 16, offset: 15086},
+							pos:   position{line: 616, col: 16, offset: 15603},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 599, col: 21, offset: 15091},
+								pos:  position{line: 616, col: 21, offset: 15608},
 								name: "Identifier",
 							},
 						},
@@ -4144,46 +4335,46 @@ var g = &grammar{
 		},
 		{
 			name: "DebugOp",
-			pos:  position{line: 607, col: 1, offset: 15238},
+			pos:  position{line: 624, col: 1, offset: 15755},
 			expr: &actionExpr{
-				pos: position{line: 608, col: 5, offset: 15250},
+				pos: position{line: 625, col: 5, offset: 15767},
 				run: (*parser).callonDebugOp1,
 				expr: &seqExpr{
-					pos: position{line: 608, col: 5, offset: 15250},
+					pos: position{line: 625, col: 5, offset: 15767},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 608, col: 5, offset: 15250},
+							pos:        position{line: 625, col: 5, offset: 15767},
 							val:        "debug",
 							ignoreCase: false,
 							want:       "\"debug\"",
 						},
 						&andExpr{
-							pos: position{line: 608, col: 13, offset: 15258},
+							pos: position{line: 625, col: 13, offset: 15775},
 							expr: &ruleRefExpr{
-								pos:  position{line: 608, col: 14, offset: 15259},
+								pos:  position{line: 625, col: 14, offset: 15776},
 								name: "EOKW",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 608, col: 19, offset: 15264},
+							pos:   position{line: 625, col: 19, offset: 15781},
 							label: "expr",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 608, col: 24, offset: 15269},
+								pos: position{line: 625, col: 24, offset: 15786},
 								expr: &actionExpr{
-									pos: position{line: 608, col: 25, offset: 15270},
+									pos: position{line: 625, col: 25, offset: 15787},
 									run: (*parser).callonDebugOp8,
 									expr: &seqExpr{
-										pos: position{line: 608, col: 25, offset: 15270},
+										pos: position{line: 625, col: 25, offset: 15787},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 608, col: 25, offset: 15270},
+												pos:  position{line: 625, col: 25, offset: 15787},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 608, col: 27, offset: 15272},
+												pos:   position{line: 625, col: 27, offset: 15789},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 608, col: 29, offset: 15274},
+													pos:  position{line: 625, col: 29, offset: 15791},
 													name: "Expr",
 												},
 											},
@@ -4200,20 +4391,20 @@ var g = &grammar{
 		},
 		{
 			name: "FromOp",
-			pos:  position{line: 619, col: 1, offset: 15480},
+			pos:  position{line: 636, col: 1, offset: 15997},
 			expr: &choiceExpr{
-				pos: position{line: 620, col: 5, offset: 15491},
+				pos: position{line: 637, col: 5, offset: 16008},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 620, col: 5, offset: 15491},
+						pos:  position{line: 637, col: 5, offset: 16008},
 						name: "File",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 621, col: 5, offset: 15500},
+						pos:  position{line: 638, col: 5, offset: 16017},
 						name: "Get",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 622, col: 5, offset: 15508},
+						pos:  position{line: 639, col: 5, offset: 16025},
 						name: "From",
 					},
 				},
@@ -4223,49 +4414,49 @@ var g = &grammar{
 		},
 		{
 			name: "File",
-			pos:  position{line: 624, col: 1, offset: 15514},
+			pos:  position{line: 641, col: 1, offset: 16031},
 			expr: &actionExpr{
-				pos: position{line: 625, col: 5, offset: 15523},
+				pos: position{line: 642, col: 5, offset: 16040},
 				run: (*parser).callonFile1,
 				expr: &seqExpr{
-					pos: position{line: 625, col: 5, offset: 15523},
+					pos: position{line: 642, col: 5, offset: 16040},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 625, col: 5, offset: 15523},
+							pos:        position{line: 642, col: 5, offset: 16040},
 							val:        "file",
 							ignoreCase: false,
 							want:       "\"file\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 625, col: 12, offset: 15530},
+							pos:  position{line: 642, col: 12, offset: 16047},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 625, col: 14, offset: 15532},
+							pos:   position{line: 642, col: 14, offset: 16049},
 							label: "path",
 							expr: &ruleRefExpr{
-								pos:  position{line: 625, col: 19, offset: 15537},
+								pos:  position{line: 642, col: 19, offset: 16054},
 								name: "Path",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 625, col: 24, offset: 15542},
+							pos:   position{line: 642, col: 24, offset: 16059},
 							label: "format",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 625, col: 31, offset: 15549},
+								pos: position{line: 642, col: 31, offset: 16066},
 								expr: &ruleRefExpr{
-									pos:  position{line: 625, col: 31, offset: 15549},
+									pos:  position{line: 642, col: 31, offset: 16066},
 									name: "FormatArg",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 625, col: 42, offset: 15560},
+							pos:   position{line: 642, col: 42, offset: 16077},
 							label: "sortKeys",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 625, col: 51, offset: 15569},
+								pos: position{line: 642, col: 51, offset: 16086},
 								expr: &ruleRefExpr{
-									pos:  position{line: 625, col: 51, offset: 15569},
+									pos:  position{line: 642, col: 51, offset: 16086},
 									name: "OrderArg",
 								},
 							},
@@ -4278,28 +4469,28 @@ var g = &grammar{
 		},
 		{
 			name: "From",
-			pos:  position{line: 636, col: 1, offset: 15848},
+			pos:  position{line: 653, col: 1, offset: 16365},
 			expr: &actionExpr{
-				pos: position{line: 637, col: 5, offset: 15857},
+				pos: position{line: 654, col: 5, offset: 16374},
 				run: (*parser).callonFrom1,
 				expr: &seqExpr{
-					pos: position{line: 637, col: 5, offset: 15857},
+					pos: position{line: 654, col: 5, offset: 16374},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 637, col: 5, offset: 15857},
+							pos:        position{line: 654, col: 5, offset: 16374},
 							val:        "from",
 							ignoreCase: false,
 							want:       "\"from\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 637, col: 12, offset: 15864},
+							pos:  position{line: 654, col: 12, offset: 16381},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 637, col: 14, offset: 15866},
+							pos:   position{line: 654, col: 14, offset: 16383},
 							label: "spec",
 							expr: &ruleRefExpr{
-								pos:  position{line: 637, col: 19, offset: 15871},
+								pos:  position{line: 654, col: 19, offset: 16388},
 								name: "PoolSpec",
 							},
 						},
@@ -4311,28 +4502,28 @@ var g = &grammar{
 		},
 		{
 			name: "Pool",
-			pos:  position{line: 646, col: 1, offset: 16059},
+			pos:  position{line: 663, col: 1, offset: 16576},
 			expr: &actionExpr{
-				pos: position{line: 647, col: 5, offset: 16068},
+				pos: position{line: 664, col: 5, offset: 16585},
 				run: (*parser).callonPool1,
 				expr: &seqExpr{
-					pos: position{line: 647, col: 5, offset: 16068},
+					pos: position{line: 664, col: 5, offset: 16585},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 647, col: 5, offset: 16068},
+							pos:        position{line: 664, col: 5, offset: 16585},
 							val:        "pool",
 							ignoreCase: false,
 							want:       "\"pool\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 647, col: 12, offset: 16075},
+							pos:  position{line: 664, col: 12, offset: 16592},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 647, col: 14, offset: 16077},
+							pos:   position{line: 664, col: 14, offset: 16594},
 							label: "spec",
 							expr: &ruleRefExpr{
-								pos:  position{line: 647, col: 19, offset: 16082},
+								pos:  position{line: 664, col: 19, offset: 16599},
 								name: "PoolSpec",
 							},
 						},
@@ -4344,82 +4535,82 @@ var g = &grammar{
 		},
 		{
 			name: "Get",
-			pos:  position{line: 656, col: 1, offset: 16270},
+			pos:  position{line: 673, col: 1, offset: 16787},
 			expr: &actionExpr{
-				pos: position{line: 657, col: 5, offset: 16278},
+				pos: position{line: 674, col: 5, offset: 16795},
 				run: (*parser).callonGet1,
 				expr: &seqExpr{
-					pos: position{line: 657, col: 5, offset: 16278},
+					pos: position{line: 674, col: 5, offset: 16795},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 657, col: 5, offset: 16278},
+							pos:        position{line: 674, col: 5, offset: 16795},
 							val:        "get",
 							ignoreCase: false,
 							want:       "\"get\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 657, col: 11, offset: 16284},
+							pos:  position{line: 674, col: 11, offset: 16801},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 657, col: 13, offset: 16286},
+							pos:   position{line: 674, col: 13, offset: 16803},
 							label: "url",
 							expr: &ruleRefExpr{
-								pos:  position{line: 657, col: 17, offset: 16290},
+								pos:  position{line: 674, col: 17, offset: 16807},
 								name: "Path",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 657, col: 22, offset: 16295},
+							pos:   position{line: 674, col: 22, offset: 16812},
 							label: "format",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 657, col: 29, offset: 16302},
+								pos: position{line: 674, col: 29, offset: 16819},
 								expr: &ruleRefExpr{
-									pos:  position{line: 657, col: 29, offset: 16302},
+									pos:  position{line: 674, col: 29, offset: 16819},
 									name: "FormatArg",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 657, col: 40, offset: 16313},
+							pos:   position{line: 674, col: 40, offset: 16830},
 							label: "sortKeys",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 657, col: 49, offset: 16322},
+								pos: position{line: 674, col: 49, offset: 16839},
 								expr: &ruleRefExpr{
-									pos:  position{line: 657, col: 49, offset: 16322},
+									pos:  position{line: 674, col: 49, offset: 16839},
 									name: "OrderArg",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 657, col: 59, offset: 16332},
+							pos:   position{line: 674, col: 59, offset: 16849},
 							label: "method",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 657, col: 66, offset: 16339},
+								pos: position{line: 674, col: 66, offset: 16856},
 								expr: &ruleRefExpr{
-									pos:  position{line: 657, col: 66, offset: 16339},
+									pos:  position{line: 674, col: 66, offset: 16856},
 									name: "MethodArg",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 657, col: 77, offset: 16350},
+							pos:   position{line: 674, col: 77, offset: 16867},
 							label: "headers",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 657, col: 85, offset: 16358},
+								pos: position{line: 674, col: 85, offset: 16875},
 								expr: &ruleRefExpr{
-									pos:  position{line: 657, col: 85, offset: 16358},
+									pos:  position{line: 674, col: 85, offset: 16875},
 									name: "HeadersArg",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 657, col: 97, offset: 16370},
+							pos:   position{line: 674, col: 97, offset: 16887},
 							label: "body",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 657, col: 102, offset: 16375},
+								pos: position{line: 674, col: 102, offset: 16892},
 								expr: &ruleRefExpr{
-									pos:  position{line: 657, col: 102, offset: 16375},
+									pos:  position{line: 674, col: 102, offset: 16892},
 									name: "BodyArg",
 								},
 							},
@@ -4432,39 +4623,39 @@ var g = &grammar{
 		},
 		{
 			name: "MethodArg",
-			pos:  position{line: 674, col: 1, offset: 16820},
+			pos:  position{line: 691, col: 1, offset: 17337},
 			expr: &actionExpr{
-				pos: position{line: 674, col: 13, offset: 16832},
+				pos: position{line: 691, col: 13, offset: 17349},
 				run: (*parser).callonMethodArg1,
 				expr: &seqExpr{
-					pos: position{line: 674, col: 13, offset: 16832},
+					pos: position{line: 691, col: 13, offset: 17349},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 674, col: 13, offset: 16832},
+							pos:  position{line: 691, col: 13, offset: 17349},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 674, col: 15, offset: 16834},
+							pos:        position{line: 691, col: 15, offset: 17351},
 							val:        "method",
 							ignoreCase: false,
 							want:       "\"method\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 674, col: 24, offset: 16843},
+							pos:  position{line: 691, col: 24, offset: 17360},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 674, col: 26, offset: 16845},
+							pos:   position{line: 691, col: 26, offset: 17362},
 							label: "v",
 							expr: &choiceExpr{
-								pos: position{line: 674, col: 29, offset: 16848},
+								pos: position{line: 691, col: 29, offset: 17365},
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 674, col: 29, offset: 16848},
+										pos:  position{line: 691, col: 29, offset: 17365},
 										name: "IdentifierName",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 674, col: 46, offset: 16865},
+										pos:  position{line: 691, col: 46, offset: 17382},
 										name: "QuotedString",
 									},
 								},
@@ -4478,32 +4669,32 @@ var g = &grammar{
 		},
 		{
 			name: "HeadersArg",
-			pos:  position{line: 676, col: 1, offset: 16898},
+			pos:  position{line: 693, col: 1, offset: 17415},
 			expr: &actionExpr{
-				pos: position{line: 676, col: 14, offset: 16911},
+				pos: position{line: 693, col: 14, offset: 17428},
 				run: (*parser).callonHeadersArg1,
 				expr: &seqExpr{
-					pos: position{line: 676, col: 14, offset: 16911},
+					pos: position{line: 693, col: 14, offset: 17428},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 676, col: 14, offset: 16911},
+							pos:  position{line: 693, col: 14, offset: 17428},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 676, col: 16, offset: 16913},
+							pos:        position{line: 693, col: 16, offset: 17430},
 							val:        "headers",
 							ignoreCase: false,
 							want:       "\"headers\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 676, col: 26, offset: 16923},
+							pos:  position{line: 693, col: 26, offset: 17440},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 676, col: 28, offset: 16925},
+							pos:   position{line: 693, col: 28, offset: 17442},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 676, col: 30, offset: 16927},
+								pos:  position{line: 693, col: 30, offset: 17444},
 								name: "Record",
 							},
 						},
@@ -4515,39 +4706,39 @@ var g = &grammar{
 		},
 		{
 			name: "BodyArg",
-			pos:  position{line: 678, col: 1, offset: 16953},
+			pos:  position{line: 695, col: 1, offset: 17470},
 			expr: &actionExpr{
-				pos: position{line: 678, col: 11, offset: 16963},
+				pos: position{line: 695, col: 11, offset: 17480},
 				run: (*parser).callonBodyArg1,
 				expr: &seqExpr{
-					pos: position{line: 678, col: 11, offset: 16963},
+					pos: position{line: 695, col: 11, offset: 17480},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 678, col: 11, offset: 16963},
+							pos:  position{line: 695, col: 11, offset: 17480},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 678, col: 13, offset: 16965},
+							pos:        position{line: 695, col: 13, offset: 17482},
 							val:        "body",
 							ignoreCase: false,
 							want:       "\"body\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 678, col: 20, offset: 16972},
+							pos:  position{line: 695, col: 20, offset: 17489},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 678, col: 22, offset: 16974},
+							pos:   position{line: 695, col: 22, offset: 17491},
 							label: "v",
 							expr: &choiceExpr{
-								pos: position{line: 678, col: 25, offset: 16977},
+								pos: position{line: 695, col: 25, offset: 17494},
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 678, col: 25, offset: 16977},
+										pos:  position{line: 695, col: 25, offset: 17494},
 										name: "IdentifierName",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 678, col: 42, offset: 16994},
+										pos:  position{line: 695, col: 42, offset: 17511},
 										name: "QuotedString",
 									},
 								},
@@ -4561,21 +4752,21 @@ var g = &grammar{
 		},
 		{
 			name: "Path",
-			pos:  position{line: 680, col: 1, offset: 17027},
+			pos:  position{line: 697, col: 1, offset: 17544},
 			expr: &choiceExpr{
-				pos: position{line: 681, col: 5, offset: 17036},
+				pos: position{line: 698, col: 5, offset: 17553},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 681, col: 5, offset: 17036},
+						pos:  position{line: 698, col: 5, offset: 17553},
 						name: "QuotedStringNode",
 					},
 					&actionExpr{
-						pos: position{line: 682, col: 5, offset: 17057},
+						pos: position{line: 699, col: 5, offset: 17574},
 						run: (*parser).callonPath3,
 						expr: &oneOrMoreExpr{
-							pos: position{line: 682, col: 5, offset: 17057},
+							pos: position{line: 699, col: 5, offset: 17574},
 							expr: &charClassMatcher{
-								pos:        position{line: 682, col: 5, offset: 17057},
+								pos:        position{line: 699, col: 5, offset: 17574},
 								val:        "[0-9a-zA-Z!@$%^&*_=<>,./?:[\\]{}~+-]",
 								chars:      []rune{'!', '@', '$', '%', '^', '&', '*', '_', '=', '<', '>', ',', '.', '/', '?', ':', '[', ']', '{', '}', '~', '+', '-'},
 								ranges:     []rune{'0', '9', 'a', 'z', 'A', 'Z'},
@@ -4591,32 +4782,32 @@ var g = &grammar{
 		},
 		{
 			name: "PoolAt",
-			pos:  position{line: 687, col: 1, offset: 17227},
+			pos:  position{line: 704, col: 1, offset: 17744},
 			expr: &actionExpr{
-				pos: position{line: 688, col: 5, offset: 17238},
+				pos: position{line: 705, col: 5, offset: 17755},
 				run: (*parser).callonPoolAt1,
 				expr: &seqExpr{
-					pos: position{line: 688, col: 5, offset: 17238},
+					pos: position{line: 705, col: 5, offset: 17755},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 688, col: 5, offset: 17238},
+							pos:  position{line: 705, col: 5, offset: 17755},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 688, col: 7, offset: 17240},
+							pos:        position{line: 705, col: 7, offset: 17757},
 							val:        "at",
 							ignoreCase: false,
 							want:       "\"at\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 688, col: 12, offset: 17245},
+							pos:  position{line: 705, col: 12, offset: 17762},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 688, col: 14, offset: 17247},
+							pos:   position{line: 705, col: 14, offset: 17764},
 							label: "id",
 							expr: &ruleRefExpr{
-								pos:  position{line: 688, col: 17, offset: 17250},
+								pos:  position{line: 705, col: 17, offset: 17767},
 								name: "KSUID",
 							},
 						},
@@ -4628,14 +4819,14 @@ var g = &grammar{
 		},
 		{
 			name: "KSUID",
-			pos:  position{line: 691, col: 1, offset: 17316},
+			pos:  position{line: 708, col: 1, offset: 17833},
 			expr: &actionExpr{
-				pos: position{line: 691, col: 9, offset: 17324},
+				pos: position{line: 708, col: 9, offset: 17841},
 				run: (*parser).callonKSUID1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 691, col: 9, offset: 17324},
+					pos: position{line: 708, col: 9, offset: 17841},
 					expr: &charClassMatcher{
-						pos:        position{line: 691, col: 10, offset: 17325},
+						pos:        position{line: 708, col: 10, offset: 17842},
 						val:        "[0-9a-zA-Z]",
 						ranges:     []rune{'0', '9', 'a', 'z', 'A', 'Z'},
 						ignoreCase: false,
@@ -4648,51 +4839,51 @@ var g = &grammar{
 		},
 		{
 			name: "PoolSpec",
-			pos:  position{line: 693, col: 1, offset: 17371},
+			pos:  position{line: 710, col: 1, offset: 17888},
 			expr: &choiceExpr{
-				pos: position{line: 694, col: 5, offset: 17384},
+				pos: position{line: 711, col: 5, offset: 17901},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 694, col: 5, offset: 17384},
+						pos: position{line: 711, col: 5, offset: 17901},
 						run: (*parser).callonPoolSpec2,
 						expr: &seqExpr{
-							pos: position{line: 694, col: 5, offset: 17384},
+							pos: position{line: 711, col: 5, offset: 17901},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 694, col: 5, offset: 17384},
+									pos:   position{line: 711, col: 5, offset: 17901},
 									label: "pool",
 									expr: &ruleRefExpr{
-										pos:  position{line: 694, col: 10, offset: 17389},
+										pos:  position{line: 711, col: 10, offset: 17906},
 										name: "PoolName",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 694, col: 19, offset: 17398},
+									pos:   position{line: 711, col: 19, offset: 17915},
 									label: "commit",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 694, col: 26, offset: 17405},
+										pos: position{line: 711, col: 26, offset: 17922},
 										expr: &ruleRefExpr{
-											pos:  position{line: 694, col: 26, offset: 17405},
+											pos:  position{line: 711, col: 26, offset: 17922},
 											name: "PoolCommit",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 694, col: 38, offset: 17417},
+									pos:   position{line: 711, col: 38, offset: 17934},
 									label: "meta",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 694, col: 43, offset: 17422},
+										pos: position{line: 711, col: 43, offset: 17939},
 										expr: &ruleRefExpr{
-											pos:  position{line: 694, col: 43, offset: 17422},
+											pos:  position{line: 711, col: 43, offset: 17939},
 											name: "PoolMeta",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 694, col: 53, offset: 17432},
+									pos:   position{line: 711, col: 53, offset: 17949},
 									label: "tap",
 									expr: &ruleRefExpr{
-										pos:  position{line: 694, col: 57, offset: 17436},
+										pos:  position{line: 711, col: 57, offset: 17953},
 										name: "TapArg",
 									},
 								},
@@ -4700,13 +4891,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 702, col: 5, offset: 17630},
+						pos: position{line: 719, col: 5, offset: 18147},
 						run: (*parser).callonPoolSpec14,
 						expr: &labeledExpr{
-							pos:   position{line: 702, col: 5, offset: 17630},
+							pos:   position{line: 719, col: 5, offset: 18147},
 							label: "meta",
 							expr: &ruleRefExpr{
-								pos:  position{line: 702, col: 10, offset: 17635},
+								pos:  position{line: 719, col: 10, offset: 18152},
 								name: "PoolMeta",
 							},
 						},
@@ -4718,24 +4909,24 @@ var g = &grammar{
 		},
 		{
 			name: "PoolCommit",
-			pos:  position{line: 706, col: 1, offset: 17705},
+			pos:  position{line: 723, col: 1, offset: 18222},
 			expr: &actionExpr{
-				pos: position{line: 707, col: 5, offset: 17720},
+				pos: position{line: 724, col: 5, offset: 18237},
 				run: (*parser).callonPoolCommit1,
 				expr: &seqExpr{
-					pos: position{line: 707, col: 5, offset: 17720},
+					pos: position{line: 724, col: 5, offset: 18237},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 707, col: 5, offset: 17720},
+							pos:        position{line: 724, col: 5, offset: 18237},
 							val:        "@",
 							ignoreCase: false,
 							want:       "\"@\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 707, col: 9, offset: 17724},
+							pos:   position{line: 724, col: 9, offset: 18241},
 							label: "commit",
 							expr: &ruleRefExpr{
-								pos:  position{line: 707, col: 16, offset: 17731},
+								pos:  position{line: 724, col: 16, offset: 18248},
 								name: "PoolNameString",
 							},
 						},
@@ -4747,24 +4938,24 @@ var g = &grammar{
 		},
 		{
 			name: "PoolMeta",
-			pos:  position{line: 709, col: 1, offset: 17770},
+			pos:  position{line: 726, col: 1, offset: 18287},
 			expr: &actionExpr{
-				pos: position{line: 710, col: 5, offset: 17783},
+				pos: position{line: 727, col: 5, offset: 18300},
 				run: (*parser).callonPoolMeta1,
 				expr: &seqExpr{
-					pos: position{line: 710, col: 5, offset: 17783},
+					pos: position{line: 727, col: 5, offset: 18300},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 710, col: 5, offset: 17783},
+							pos:        position{line: 727, col: 5, offset: 18300},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 710, col: 9, offset: 17787},
+							pos:   position{line: 727, col: 9, offset: 18304},
 							label: "meta",
 							expr: &ruleRefExpr{
-								pos:  position{line: 710, col: 14, offset: 17792},
+								pos:  position{line: 727, col: 14, offset: 18309},
 								name: "PoolIdentifier",
 							},
 						},
@@ -4776,34 +4967,34 @@ var g = &grammar{
 		},
 		{
 			name: "PoolName",
-			pos:  position{line: 712, col: 1, offset: 17829},
+			pos:  position{line: 729, col: 1, offset: 18346},
 			expr: &choiceExpr{
-				pos: position{line: 713, col: 5, offset: 17842},
+				pos: position{line: 730, col: 5, offset: 18359},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 713, col: 5, offset: 17842},
+						pos:  position{line: 730, col: 5, offset: 18359},
 						name: "Regexp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 714, col: 5, offset: 17853},
+						pos:  position{line: 731, col: 5, offset: 18370},
 						name: "Glob",
 					},
 					&actionExpr{
-						pos: position{line: 715, col: 5, offset: 17862},
+						pos: position{line: 732, col: 5, offset: 18379},
 						run: (*parser).callonPoolName4,
 						expr: &seqExpr{
-							pos: position{line: 715, col: 5, offset: 17862},
+							pos: position{line: 732, col: 5, offset: 18379},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 715, col: 5, offset: 17862},
+									pos:        position{line: 732, col: 5, offset: 18379},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
 								},
 								&notExpr{
-									pos: position{line: 715, col: 9, offset: 17866},
+									pos: position{line: 732, col: 9, offset: 18383},
 									expr: &ruleRefExpr{
-										pos:  position{line: 715, col: 10, offset: 17867},
+										pos:  position{line: 732, col: 10, offset: 18384},
 										name: "ExprGuard",
 									},
 								},
@@ -4811,17 +5002,17 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 716, col: 5, offset: 17961},
+						pos:  position{line: 733, col: 5, offset: 18478},
 						name: "QuotedStringNode",
 					},
 					&actionExpr{
-						pos: position{line: 717, col: 5, offset: 17982},
+						pos: position{line: 734, col: 5, offset: 18499},
 						run: (*parser).callonPoolName10,
 						expr: &labeledExpr{
-							pos:   position{line: 717, col: 5, offset: 17982},
+							pos:   position{line: 734, col: 5, offset: 18499},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 717, col: 10, offset: 17987},
+								pos:  position{line: 734, col: 10, offset: 18504},
 								name: "PoolNameString",
 							},
 						},
@@ -4833,20 +5024,20 @@ var g = &grammar{
 		},
 		{
 			name: "PoolNameString",
-			pos:  position{line: 719, col: 1, offset: 18091},
+			pos:  position{line: 736, col: 1, offset: 18608},
 			expr: &choiceExpr{
-				pos: position{line: 720, col: 5, offset: 18110},
+				pos: position{line: 737, col: 5, offset: 18627},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 720, col: 5, offset: 18110},
+						pos:  position{line: 737, col: 5, offset: 18627},
 						name: "PoolIdentifier",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 721, col: 5, offset: 18129},
+						pos:  position{line: 738, col: 5, offset: 18646},
 						name: "KSUID",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 722, col: 5, offset: 18139},
+						pos:  position{line: 739, col: 5, offset: 18656},
 						name: "QuotedString",
 					},
 				},
@@ -4856,22 +5047,22 @@ var g = &grammar{
 		},
 		{
 			name: "PoolIdentifier",
-			pos:  position{line: 724, col: 1, offset: 18153},
+			pos:  position{line: 741, col: 1, offset: 18670},
 			expr: &actionExpr{
-				pos: position{line: 725, col: 5, offset: 18172},
+				pos: position{line: 742, col: 5, offset: 18689},
 				run: (*parser).callonPoolIdentifier1,
 				expr: &seqExpr{
-					pos: position{line: 725, col: 5, offset: 18172},
+					pos: position{line: 742, col: 5, offset: 18689},
 					exprs: []any{
 						&choiceExpr{
-							pos: position{line: 725, col: 6, offset: 18173},
+							pos: position{line: 742, col: 6, offset: 18690},
 							alternatives: []any{
 								&ruleRefExpr{
-									pos:  position{line: 725, col: 6, offset: 18173},
+									pos:  position{line: 742, col: 6, offset: 18690},
 									name: "IdentifierStart",
 								},
 								&litMatcher{
-									pos:        position{line: 725, col: 24, offset: 18191},
+									pos:        position{line: 742, col: 24, offset: 18708},
 									val:        ".",
 									ignoreCase: false,
 									want:       "\".\"",
@@ -4879,16 +5070,16 @@ var g = &grammar{
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 725, col: 29, offset: 18196},
+							pos: position{line: 742, col: 29, offset: 18713},
 							expr: &choiceExpr{
-								pos: position{line: 725, col: 30, offset: 18197},
+								pos: position{line: 742, col: 30, offset: 18714},
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 725, col: 30, offset: 18197},
+										pos:  position{line: 742, col: 30, offset: 18714},
 										name: "IdentifierRest",
 									},
 									&litMatcher{
-										pos:        position{line: 725, col: 47, offset: 18214},
+										pos:        position{line: 742, col: 47, offset: 18731},
 										val:        ".",
 										ignoreCase: false,
 										want:       "\".\"",
@@ -4904,32 +5095,32 @@ var g = &grammar{
 		},
 		{
 			name: "OrderArg",
-			pos:  position{line: 727, col: 1, offset: 18252},
+			pos:  position{line: 744, col: 1, offset: 18769},
 			expr: &actionExpr{
-				pos: position{line: 728, col: 5, offset: 18265},
+				pos: position{line: 745, col: 5, offset: 18782},
 				run: (*parser).callonOrderArg1,
 				expr: &seqExpr{
-					pos: position{line: 728, col: 5, offset: 18265},
+					pos: position{line: 745, col: 5, offset: 18782},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 728, col: 5, offset: 18265},
+							pos:  position{line: 745, col: 5, offset: 18782},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 728, col: 7, offset: 18267},
+							pos:        position{line: 745, col: 7, offset: 18784},
 							val:        "order",
 							ignoreCase: false,
 							want:       "\"order\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 728, col: 15, offset: 18275},
+							pos:  position{line: 745, col: 15, offset: 18792},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 728, col: 17, offset: 18277},
+							pos:   position{line: 745, col: 17, offset: 18794},
 							label: "exprs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 728, col: 23, offset: 18283},
+								pos:  position{line: 745, col: 23, offset: 18800},
 								name: "SortExprs",
 							},
 						},
@@ -4941,51 +5132,51 @@ var g = &grammar{
 		},
 		{
 			name: "SortExprs",
-			pos:  position{line: 732, col: 1, offset: 18326},
+			pos:  position{line: 749, col: 1, offset: 18843},
 			expr: &actionExpr{
-				pos: position{line: 733, col: 5, offset: 18340},
+				pos: position{line: 750, col: 5, offset: 18857},
 				run: (*parser).callonSortExprs1,
 				expr: &seqExpr{
-					pos: position{line: 733, col: 5, offset: 18340},
+					pos: position{line: 750, col: 5, offset: 18857},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 733, col: 5, offset: 18340},
+							pos:   position{line: 750, col: 5, offset: 18857},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 733, col: 11, offset: 18346},
+								pos:  position{line: 750, col: 11, offset: 18863},
 								name: "SortExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 733, col: 20, offset: 18355},
+							pos:   position{line: 750, col: 20, offset: 18872},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 733, col: 25, offset: 18360},
+								pos: position{line: 750, col: 25, offset: 18877},
 								expr: &actionExpr{
-									pos: position{line: 733, col: 26, offset: 18361},
+									pos: position{line: 750, col: 26, offset: 18878},
 									run: (*parser).callonSortExprs7,
 									expr: &seqExpr{
-										pos: position{line: 733, col: 26, offset: 18361},
+										pos: position{line: 750, col: 26, offset: 18878},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 733, col: 26, offset: 18361},
+												pos:  position{line: 750, col: 26, offset: 18878},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 733, col: 29, offset: 18364},
+												pos:        position{line: 750, col: 29, offset: 18881},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 733, col: 33, offset: 18368},
+												pos:  position{line: 750, col: 33, offset: 18885},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 733, col: 36, offset: 18371},
+												pos:   position{line: 750, col: 36, offset: 18888},
 												label: "s",
 												expr: &ruleRefExpr{
-													pos:  position{line: 733, col: 38, offset: 18373},
+													pos:  position{line: 750, col: 38, offset: 18890},
 													name: "SortExpr",
 												},
 											},
@@ -5002,41 +5193,41 @@ var g = &grammar{
 		},
 		{
 			name: "SortExpr",
-			pos:  position{line: 737, col: 1, offset: 18450},
+			pos:  position{line: 754, col: 1, offset: 18967},
 			expr: &actionExpr{
-				pos: position{line: 738, col: 5, offset: 18463},
+				pos: position{line: 755, col: 5, offset: 18980},
 				run: (*parser).callonSortExpr1,
 				expr: &seqExpr{
-					pos: position{line: 738, col: 5, offset: 18463},
+					pos: position{line: 755, col: 5, offset: 18980},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 738, col: 5, offset: 18463},
+							pos:   position{line: 755, col: 5, offset: 18980},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 738, col: 7, offset: 18465},
+								pos:  position{line: 755, col: 7, offset: 18982},
 								name: "Expr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 738, col: 12, offset: 18470},
+							pos:   position{line: 755, col: 12, offset: 18987},
 							label: "order",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 738, col: 18, offset: 18476},
+								pos: position{line: 755, col: 18, offset: 18993},
 								expr: &actionExpr{
-									pos: position{line: 738, col: 19, offset: 18477},
+									pos: position{line: 755, col: 19, offset: 18994},
 									run: (*parser).callonSortExpr7,
 									expr: &seqExpr{
-										pos: position{line: 738, col: 19, offset: 18477},
+										pos: position{line: 755, col: 19, offset: 18994},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 738, col: 19, offset: 18477},
+												pos:  position{line: 755, col: 19, offset: 18994},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 738, col: 21, offset: 18479},
+												pos:   position{line: 755, col: 21, offset: 18996},
 												label: "o",
 												expr: &ruleRefExpr{
-													pos:  position{line: 738, col: 23, offset: 18481},
+													pos:  position{line: 755, col: 23, offset: 18998},
 													name: "OrderSpec",
 												},
 											},
@@ -5053,21 +5244,21 @@ var g = &grammar{
 		},
 		{
 			name: "OrderSpec",
-			pos:  position{line: 746, col: 1, offset: 18674},
+			pos:  position{line: 763, col: 1, offset: 19191},
 			expr: &actionExpr{
-				pos: position{line: 747, col: 5, offset: 18688},
+				pos: position{line: 764, col: 5, offset: 19205},
 				run: (*parser).callonOrderSpec1,
 				expr: &choiceExpr{
-					pos: position{line: 747, col: 6, offset: 18689},
+					pos: position{line: 764, col: 6, offset: 19206},
 					alternatives: []any{
 						&litMatcher{
-							pos:        position{line: 747, col: 6, offset: 18689},
+							pos:        position{line: 764, col: 6, offset: 19206},
 							val:        "asc",
 							ignoreCase: false,
 							want:       "\"asc\"",
 						},
 						&litMatcher{
-							pos:        position{line: 747, col: 14, offset: 18697},
+							pos:        position{line: 764, col: 14, offset: 19214},
 							val:        "desc",
 							ignoreCase: false,
 							want:       "\"desc\"",
@@ -5080,22 +5271,22 @@ var g = &grammar{
 		},
 		{
 			name: "TapArg",
-			pos:  position{line: 751, col: 1, offset: 18797},
+			pos:  position{line: 768, col: 1, offset: 19314},
 			expr: &choiceExpr{
-				pos: position{line: 752, col: 5, offset: 18808},
+				pos: position{line: 769, col: 5, offset: 19325},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 752, col: 5, offset: 18808},
+						pos: position{line: 769, col: 5, offset: 19325},
 						run: (*parser).callonTapArg2,
 						expr: &seqExpr{
-							pos: position{line: 752, col: 5, offset: 18808},
+							pos: position{line: 769, col: 5, offset: 19325},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 752, col: 5, offset: 18808},
+									pos:  position{line: 769, col: 5, offset: 19325},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 752, col: 7, offset: 18810},
+									pos:        position{line: 769, col: 7, offset: 19327},
 									val:        "tap",
 									ignoreCase: false,
 									want:       "\"tap\"",
@@ -5104,10 +5295,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 753, col: 5, offset: 18841},
+						pos: position{line: 770, col: 5, offset: 19358},
 						run: (*parser).callonTapArg6,
 						expr: &litMatcher{
-							pos:        position{line: 753, col: 5, offset: 18841},
+							pos:        position{line: 770, col: 5, offset: 19358},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -5120,32 +5311,32 @@ var g = &grammar{
 		},
 		{
 			name: "FormatArg",
-			pos:  position{line: 755, col: 1, offset: 18867},
+			pos:  position{line: 772, col: 1, offset: 19384},
 			expr: &actionExpr{
-				pos: position{line: 756, col: 5, offset: 18881},
+				pos: position{line: 773, col: 5, offset: 19398},
 				run: (*parser).callonFormatArg1,
 				expr: &seqExpr{
-					pos: position{line: 756, col: 5, offset: 18881},
+					pos: position{line: 773, col: 5, offset: 19398},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 756, col: 5, offset: 18881},
+							pos:  position{line: 773, col: 5, offset: 19398},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 756, col: 7, offset: 18883},
+							pos:        position{line: 773, col: 7, offset: 19400},
 							val:        "format",
 							ignoreCase: false,
 							want:       "\"format\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 756, col: 16, offset: 18892},
+							pos:  position{line: 773, col: 16, offset: 19409},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 756, col: 18, offset: 18894},
+							pos:   position{line: 773, col: 18, offset: 19411},
 							label: "val",
 							expr: &ruleRefExpr{
-								pos:  position{line: 756, col: 22, offset: 18898},
+								pos:  position{line: 773, col: 22, offset: 19415},
 								name: "IdentifierName",
 							},
 						},
@@ -5157,30 +5348,30 @@ var g = &grammar{
 		},
 		{
 			name: "PassOp",
-			pos:  position{line: 758, col: 1, offset: 18934},
+			pos:  position{line: 775, col: 1, offset: 19451},
 			expr: &actionExpr{
-				pos: position{line: 759, col: 5, offset: 18945},
+				pos: position{line: 776, col: 5, offset: 19462},
 				run: (*parser).callonPassOp1,
 				expr: &seqExpr{
-					pos: position{line: 759, col: 5, offset: 18945},
+					pos: position{line: 776, col: 5, offset: 19462},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 759, col: 5, offset: 18945},
+							pos:        position{line: 776, col: 5, offset: 19462},
 							val:        "pass",
 							ignoreCase: false,
 							want:       "\"pass\"",
 						},
 						&notExpr{
-							pos: position{line: 759, col: 12, offset: 18952},
+							pos: position{line: 776, col: 12, offset: 19469},
 							expr: &seqExpr{
-								pos: position{line: 759, col: 14, offset: 18954},
+								pos: position{line: 776, col: 14, offset: 19471},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 759, col: 14, offset: 18954},
+										pos:  position{line: 776, col: 14, offset: 19471},
 										name: "__",
 									},
 									&litMatcher{
-										pos:        position{line: 759, col: 17, offset: 18957},
+										pos:        position{line: 776, col: 17, offset: 19474},
 										val:        "(",
 										ignoreCase: false,
 										want:       "\"(\"",
@@ -5189,9 +5380,9 @@ var g = &grammar{
 							},
 						},
 						&andExpr{
-							pos: position{line: 759, col: 22, offset: 18962},
+							pos: position{line: 776, col: 22, offset: 19479},
 							expr: &ruleRefExpr{
-								pos:  position{line: 759, col: 23, offset: 18963},
+								pos:  position{line: 776, col: 23, offset: 19480},
 								name: "EOKW",
 							},
 						},
@@ -5203,46 +5394,46 @@ var g = &grammar{
 		},
 		{
 			name: "ExplodeOp",
-			pos:  position{line: 765, col: 1, offset: 19167},
+			pos:  position{line: 782, col: 1, offset: 19684},
 			expr: &actionExpr{
-				pos: position{line: 766, col: 5, offset: 19181},
+				pos: position{line: 783, col: 5, offset: 19698},
 				run: (*parser).callonExplodeOp1,
 				expr: &seqExpr{
-					pos: position{line: 766, col: 5, offset: 19181},
+					pos: position{line: 783, col: 5, offset: 19698},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 766, col: 5, offset: 19181},
+							pos:        position{line: 783, col: 5, offset: 19698},
 							val:        "explode",
 							ignoreCase: false,
 							want:       "\"explode\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 766, col: 15, offset: 19191},
+							pos:  position{line: 783, col: 15, offset: 19708},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 766, col: 17, offset: 19193},
+							pos:   position{line: 783, col: 17, offset: 19710},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 766, col: 22, offset: 19198},
+								pos:  position{line: 783, col: 22, offset: 19715},
 								name: "Exprs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 766, col: 28, offset: 19204},
+							pos:   position{line: 783, col: 28, offset: 19721},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 766, col: 32, offset: 19208},
+								pos:  position{line: 783, col: 32, offset: 19725},
 								name: "TypeArg",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 766, col: 40, offset: 19216},
+							pos:   position{line: 783, col: 40, offset: 19733},
 							label: "as",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 766, col: 43, offset: 19219},
+								pos: position{line: 783, col: 43, offset: 19736},
 								expr: &ruleRefExpr{
-									pos:  position{line: 766, col: 43, offset: 19219},
+									pos:  position{line: 783, col: 43, offset: 19736},
 									name: "AsArg",
 								},
 							},
@@ -5255,28 +5446,28 @@ var g = &grammar{
 		},
 		{
 			name: "MergeOp",
-			pos:  position{line: 779, col: 1, offset: 19477},
+			pos:  position{line: 796, col: 1, offset: 19994},
 			expr: &actionExpr{
-				pos: position{line: 780, col: 5, offset: 19489},
+				pos: position{line: 797, col: 5, offset: 20006},
 				run: (*parser).callonMergeOp1,
 				expr: &seqExpr{
-					pos: position{line: 780, col: 5, offset: 19489},
+					pos: position{line: 797, col: 5, offset: 20006},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 780, col: 5, offset: 19489},
+							pos:        position{line: 797, col: 5, offset: 20006},
 							val:        "merge",
 							ignoreCase: false,
 							want:       "\"merge\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 780, col: 13, offset: 19497},
+							pos:  position{line: 797, col: 13, offset: 20014},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 780, col: 15, offset: 19499},
+							pos:   position{line: 797, col: 15, offset: 20016},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 780, col: 20, offset: 19504},
+								pos:  position{line: 797, col: 20, offset: 20021},
 								name: "Expr",
 							},
 						},
@@ -5288,49 +5479,49 @@ var g = &grammar{
 		},
 		{
 			name: "OverOp",
-			pos:  position{line: 788, col: 1, offset: 19644},
+			pos:  position{line: 805, col: 1, offset: 20161},
 			expr: &actionExpr{
-				pos: position{line: 789, col: 5, offset: 19655},
+				pos: position{line: 806, col: 5, offset: 20172},
 				run: (*parser).callonOverOp1,
 				expr: &seqExpr{
-					pos: position{line: 789, col: 5, offset: 19655},
+					pos: position{line: 806, col: 5, offset: 20172},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 789, col: 5, offset: 19655},
+							pos:        position{line: 806, col: 5, offset: 20172},
 							val:        "over",
 							ignoreCase: false,
 							want:       "\"over\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 789, col: 12, offset: 19662},
+							pos:  position{line: 806, col: 12, offset: 20179},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 789, col: 14, offset: 19664},
+							pos:   position{line: 806, col: 14, offset: 20181},
 							label: "exprs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 789, col: 20, offset: 19670},
+								pos:  position{line: 806, col: 20, offset: 20187},
 								name: "Exprs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 789, col: 26, offset: 19676},
+							pos:   position{line: 806, col: 26, offset: 20193},
 							label: "locals",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 789, col: 33, offset: 19683},
+								pos: position{line: 806, col: 33, offset: 20200},
 								expr: &ruleRefExpr{
-									pos:  position{line: 789, col: 33, offset: 19683},
+									pos:  position{line: 806, col: 33, offset: 20200},
 									name: "Locals",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 789, col: 41, offset: 19691},
+							pos:   position{line: 806, col: 41, offset: 20208},
 							label: "body",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 789, col: 46, offset: 19696},
+								pos: position{line: 806, col: 46, offset: 20213},
 								expr: &ruleRefExpr{
-									pos:  position{line: 789, col: 46, offset: 19696},
+									pos:  position{line: 806, col: 46, offset: 20213},
 									name: "Lateral",
 								},
 							},
@@ -5341,56 +5532,347 @@ var g = &grammar{
 			leader:        false,
 			leftRecursive: false,
 		},
+		{
+			name: "WindowOp",
+			pos:  position{line: 821, col: 1, offset: 20562},
+			expr: &actionExpr{
+				pos: position{line: 822, col: 5, offset: 20575},
+				run: (*parser).callonWindowOp1,
+				expr: &seqExpr{
+					pos: position{line: 822, col: 5, offset: 20575},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 822, col: 5, offset: 20575},
+							val:        "window",
+							ignoreCase: false,
+							want:       "\"window\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 822, col: 14, offset: 20584},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 822, col: 16, offset: 20586},
+							label: "funcs",
+							expr: &ruleRefExpr{
+								pos:  position{line: 822, col: 22, offset: 20592},
+								name: "WindowFuncs",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 822, col: 34, offset: 20604},
+							label: "keys",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 822, col: 39, offset: 20609},
+								expr: &actionExpr{
+									pos: position{line: 822, col: 40, offset: 20610},
+									run: (*parser).callonWindowOp9,
+									expr: &seqExpr{
+										pos: position{line: 822, col: 40, offset: 20610},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 822, col: 40, offset: 20610},
+												name: "_",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 822, col: 42, offset: 20612},
+												name: "ByToken",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 822, col: 50, offset: 20620},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 822, col: 52, offset: 20622},
+												label: "k",
+												expr: &ruleRefExpr{
+													pos:  position{line: 822, col: 54, offset: 20624},
+													name: "Exprs",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 822, col: 80, offset: 20650},
+							label: "sort",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 822, col: 85, offset: 20655},
+								expr: &actionExpr{
+									pos: position{line: 822, col: 86, offset: 20656},
+									run: (*parser).callonWindowOp18,
+									expr: &seqExpr{
+										pos: position{line: 822, col: 86, offset: 20656},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 822, col: 86, offset: 20656},
+												name: "_",
+											},
+											&litMatcher{
+												pos:        position{line: 822, col: 88, offset: 20658},
+												val:        "sort",
+												ignoreCase: false,
+												want:       "\"sort\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 822, col: 95, offset: 20665},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 822, col: 97, offset: 20667},
+												label: "s",
+												expr: &ruleRefExpr{
+													pos:  position{line: 822, col: 99, offset: 20669},
+													name: "SortExprs",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "WindowFuncs",
+			pos:  position{line: 832, col: 1, offset: 20934},
+			expr: &actionExpr{
+				pos: position{line: 833, col: 5, offset: 20950},
+				run: (*parser).callonWindowFuncs1,
+				expr: &seqExpr{
+					pos: position{line: 833, col: 5, offset: 20950},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 833, col: 5, offset: 20950},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 833, col: 11, offset: 20956},
+								name: "WindowFunc",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 833, col: 22, offset: 20967},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 833, col: 27, offset: 20972},
+								expr: &actionExpr{
+									pos: position{line: 833, col: 28, offset: 20973},
+									run: (*parser).callonWindowFuncs7,
+									expr: &seqExpr{
+										pos: position{line: 833, col: 28, offset: 20973},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 833, col: 28, offset: 20973},
+												name: "__",
+											},
+											&litMatcher{
+												pos:        position{line: 833, col: 31, offset: 20976},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 833, col: 35, offset: 20980},
+												name: "__",
+											},
+											&labeledExpr{
+												pos:   position{line: 833, col: 38, offset: 20983},
+												label: "f",
+												expr: &ruleRefExpr{
+													pos:  position{line: 833, col: 40, offset: 20985},
+													name: "WindowFunc",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "WindowFunc",
+			pos:  position{line: 837, col: 1, offset: 21064},
+			expr: &choiceExpr{
+				pos: position{line: 838, col: 5, offset: 21079},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 838, col: 5, offset: 21079},
+						run: (*parser).callonWindowFunc2,
+						expr: &seqExpr{
+							pos: position{line: 838, col: 5, offset: 21079},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 838, col: 5, offset: 21079},
+									label: "lval",
+									expr: &ruleRefExpr{
+										pos:  position{line: 838, col: 10, offset: 21084},
+										name: "Lval",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 838, col: 15, offset: 21089},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 838, col: 18, offset: 21092},
+									val:        ":=",
+									ignoreCase: false,
+									want:       "\":=\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 838, col: 23, offset: 21097},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 838, col: 26, offset: 21100},
+									label: "running",
+									expr: &ruleRefExpr{
+										pos:  position{line: 838, col: 34, offset: 21108},
+										name: "Running",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 838, col: 42, offset: 21116},
+									label: "agg",
+									expr: &ruleRefExpr{
+										pos:  position{line: 838, col: 46, offset: 21120},
+										name: "Agg",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 846, col: 5, offset: 21299},
+						run: (*parser).callonWindowFunc13,
+						expr: &seqExpr{
+							pos: position{line: 846, col: 5, offset: 21299},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 846, col: 5, offset: 21299},
+									label: "running",
+									expr: &ruleRefExpr{
+										pos:  position{line: 846, col: 13, offset: 21307},
+										name: "Running",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 846, col: 21, offset: 21315},
+									label: "agg",
+									expr: &ruleRefExpr{
+										pos:  position{line: 846, col: 25, offset: 21319},
+										name: "Agg",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "Running",
+			pos:  position{line: 854, col: 1, offset: 21465},
+			expr: &choiceExpr{
+				pos: position{line: 855, col: 5, offset: 21477},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 855, col: 5, offset: 21477},
+						run: (*parser).callonRunning2,
+						expr: &seqExpr{
+							pos: position{line: 855, col: 5, offset: 21477},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 855, col: 5, offset: 21477},
+									val:        "running",
+									ignoreCase: false,
+									want:       "\"running\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 855, col: 15, offset: 21487},
+									name: "_",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 856, col: 5, offset: 21514},
+						run: (*parser).callonRunning6,
+						expr: &litMatcher{
+							pos:        position{line: 856, col: 5, offset: 21514},
+							val:        "",
+							ignoreCase: false,
+							want:       "\"\"",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
 		{
 			name: "Lateral",
-			pos:  position{line: 804, col: 1, offset: 20045},
+			pos:  position{line: 858, col: 1, offset: 21540},
 			expr: &choiceExpr{
-				pos: position{line: 805, col: 5, offset: 20057},
+				pos: position{line: 859, col: 5, offset: 21552},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 805, col: 5, offset: 20057},
+						pos: position{line: 859, col: 5, offset: 21552},
 						run: (*parser).callonLateral2,
 						expr: &seqExpr{
-							pos: position{line: 805, col: 5, offset: 20057},
+							pos: position{line: 859, col: 5, offset: 21552},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 805, col: 5, offset: 20057},
+									pos:  position{line: 859, col: 5, offset: 21552},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 805, col: 8, offset: 20060},
+									pos:        position{line: 859, col: 8, offset: 21555},
 									val:        "=>",
 									ignoreCase: false,
 									want:       "\"=>\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 805, col: 13, offset: 20065},
+									pos:  position{line: 859, col: 13, offset: 21560},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 805, col: 16, offset: 20068},
+									pos:        position{line: 859, col: 16, offset: 21563},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 805, col: 20, offset: 20072},
+									pos:  position{line: 859, col: 20, offset: 21567},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 805, col: 23, offset: 20075},
+									pos:   position{line: 859, col: 23, offset: 21570},
 									label: "scope",
 									expr: &ruleRefExpr{
-										pos:  position{line: 805, col: 29, offset: 20081},
+										pos:  position{line: 859, col: 29, offset: 21576},
 										name: "Scope",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 805, col: 35, offset: 20087},
+									pos:  position{line: 859, col: 35, offset: 21582},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 805, col: 38, offset: 20090},
+									pos:        position{line: 859, col: 38, offset: 21585},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -5399,49 +5881,49 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 808, col: 5, offset: 20174},
+						pos: position{line: 862, col: 5, offset: 21669},
 						run: (*parser).callonLateral13,
 						expr: &seqExpr{
-							pos: position{line: 808, col: 5, offset: 20174},
+							pos: position{line: 862, col: 5, offset: 21669},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 808, col: 5, offset: 20174},
+									pos:  position{line: 862, col: 5, offset: 21669},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 808, col: 8, offset: 20177},
+									pos:        position{line: 862, col: 8, offset: 21672},
 									val:        "=>",
 									ignoreCase: false,
 									want:       "\"=>\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 808, col: 13, offset: 20182},
+									pos:  position{line: 862, col: 13, offset: 21677},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 808, col: 16, offset: 20185},
+									pos:        position{line: 862, col: 16, offset: 21680},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 808, col: 20, offset: 20189},
+									pos:  position{line: 862, col: 20, offset: 21684},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 808, col: 23, offset: 20192},
+									pos:   position{line: 862, col: 23, offset: 21687},
 									label: "seq",
 									expr: &ruleRefExpr{
-										pos:  position{line: 808, col: 27, offset: 20196},
+										pos:  position{line: 862, col: 27, offset: 21691},
 										name: "Seq",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 808, col: 31, offset: 20200},
+									pos:  position{line: 862, col: 31, offset: 21695},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 808, col: 34, offset: 20203},
+									pos:        position{line: 862, col: 34, offset: 21698},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -5456,65 +5938,65 @@ var g = &grammar{
 		},
 		{
 			name: "Locals",
-			pos:  position{line: 812, col: 1, offset: 20262},
+			pos:  position{line: 866, col: 1, offset: 21757},
 			expr: &actionExpr{
-				pos: position{line: 813, col: 5, offset: 20273},
+				pos: position{line: 867, col: 5, offset: 21768},
 				run: (*parser).callonLocals1,
 				expr: &seqExpr{
-					pos: position{line: 813, col: 5, offset: 20273},
+					pos: position{line: 867, col: 5, offset: 21768},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 813, col: 5, offset: 20273},
+							pos:  position{line: 867, col: 5, offset: 21768},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 813, col: 7, offset: 20275},
+							pos:        position{line: 867, col: 7, offset: 21770},
 							val:        "with",
 							ignoreCase: false,
 							want:       "\"with\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 813, col: 14, offset: 20282},
+							pos:  position{line: 867, col: 14, offset: 21777},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 813, col: 16, offset: 20284},
+							pos:   position{line: 867, col: 16, offset: 21779},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 813, col: 22, offset: 20290},
+								pos:  position{line: 867, col: 22, offset: 21785},
 								name: "LocalsAssignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 813, col: 39, offset: 20307},
+							pos:   position{line: 867, col: 39, offset: 21802},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 813, col: 44, offset: 20312},
+								pos: position{line: 867, col: 44, offset: 21807},
 								expr: &actionExpr{
-									pos: position{line: 813, col: 45, offset: 20313},
+									pos: position{line: 867, col: 45, offset: 21808},
 									run: (*parser).callonLocals10,
 									expr: &seqExpr{
-										pos: position{line: 813, col: 45, offset: 20313},
+										pos: position{line: 867, col: 45, offset: 21808},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 813, col: 45, offset: 20313},
+												pos:  position{line: 867, col: 45, offset: 21808},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 813, col: 48, offset: 20316},
+												pos:        position{line: 867, col: 48, offset: 21811},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 813, col: 52, offset: 20320},
+												pos:  position{line: 867, col: 52, offset: 21815},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 813, col: 55, offset: 20323},
+												pos:   position{line: 867, col: 55, offset: 21818},
 												label: "a",
 												expr: &ruleRefExpr{
-													pos:  position{line: 813, col: 57, offset: 20325},
+													pos:  position{line: 867, col: 57, offset: 21820},
 													name: "LocalsAssignment",
 												},
 											},
@@ -5531,45 +6013,45 @@ var g = &grammar{
 		},
 		{
 			name: "LocalsAssignment",
-			pos:  position{line: 817, col: 1, offset: 20410},
+			pos:  position{line: 871, col: 1, offset: 21905},
 			expr: &actionExpr{
-				pos: position{line: 818, col: 5, offset: 20431},
+				pos: position{line: 872, col: 5, offset: 21926},
 				run: (*parser).callonLocalsAssignment1,
 				expr: &seqExpr{
-					pos: position{line: 818, col: 5, offset: 20431},
+					pos: position{line: 872, col: 5, offset: 21926},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 818, col: 5, offset: 20431},
+							pos:   position{line: 872, col: 5, offset: 21926},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 818, col: 10, offset: 20436},
+								pos:  position{line: 872, col: 10, offset: 21931},
 								name: "Identifier",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 818, col: 21, offset: 20447},
+							pos:   position{line: 872, col: 21, offset: 21942},
 							label: "opt",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 818, col: 25, offset: 20451},
+								pos: position{line: 872, col: 25, offset: 21946},
 								expr: &seqExpr{
-									pos: position{line: 818, col: 26, offset: 20452},
+									pos: position{line: 872, col: 26, offset: 21947},
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 818, col: 26, offset: 20452},
+											pos:  position{line: 872, col: 26, offset: 21947},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 818, col: 29, offset: 20455},
+											pos:        position{line: 872, col: 29, offset: 21950},
 											val:        "=",
 											ignoreCase: false,
 											want:       "\"=\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 818, col: 33, offset: 20459},
+											pos:  position{line: 872, col: 33, offset: 21954},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 818, col: 36, offset: 20462},
+											pos:  position{line: 872, col: 36, offset: 21957},
 											name: "Expr",
 										},
 									},
@@ -5584,28 +6066,28 @@ var g = &grammar{
 		},
 		{
 			name: "YieldOp",
-			pos:  position{line: 829, col: 1, offset: 20665},
+			pos:  position{line: 883, col: 1, offset: 22160},
 			expr: &actionExpr{
-				pos: position{line: 830, col: 5, offset: 20677},
+				pos: position{line: 884, col: 5, offset: 22172},
 				run: (*parser).callonYieldOp1,
 				expr: &seqExpr{
-					pos: position{line: 830, col: 5, offset: 20677},
+					pos: position{line: 884, col: 5, offset: 22172},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 830, col: 5, offset: 20677},
+							pos:        position{line: 884, col: 5, offset: 22172},
 							val:        "yield",
 							ignoreCase: false,
 							want:       "\"yield\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 830, col: 13, offset: 20685},
+							pos:  position{line: 884, col: 13, offset: 22180},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 830, col: 15, offset: 20687},
+							pos:   position{line: 884, col: 15, offset: 22182},
 							label: "exprs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 830, col: 21, offset: 20693},
+								pos:  position{line: 884, col: 21, offset: 22188},
 								name: "Exprs",
 							},
 						},
@@ -5617,32 +6099,32 @@ var g = &grammar{
 		},
 		{
 			name: "TypeArg",
-			pos:  position{line: 838, col: 1, offset: 20850},
+			pos:  position{line: 892, col: 1, offset: 22345},
 			expr: &actionExpr{
-				pos: position{line: 839, col: 5, offset: 20862},
+				pos: position{line: 893, col: 5, offset: 22357},
 				run: (*parser).callonTypeArg1,
 				expr: &seqExpr{
-					pos: position{line: 839, col: 5, offset: 20862},
+					pos: position{line: 893, col: 5, offset: 22357},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 839, col: 5, offset: 20862},
+							pos:  position{line: 893, col: 5, offset: 22357},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 839, col: 7, offset: 20864},
+							pos:        position{line: 893, col: 7, offset: 22359},
 							val:        "by",
 							ignoreCase: false,
 							want:       "\"by\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 839, col: 12, offset: 20869},
+							pos:  position{line: 893, col: 12, offset: 22364},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 839, col: 14, offset: 20871},
+							pos:   position{line: 893, col: 14, offset: 22366},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 839, col: 18, offset: 20875},
+								pos:  position{line: 893, col: 18, offset: 22370},
 								name: "Type",
 							},
 						},
@@ -5654,32 +6136,32 @@ var g = &grammar{
 		},
 		{
 			name: "AsArg",
-			pos:  position{line: 841, col: 1, offset: 20901},
+			pos:  position{line: 895, col: 1, offset: 22396},
 			expr: &actionExpr{
-				pos: position{line: 842, col: 5, offset: 20911},
+				pos: position{line: 896, col: 5, offset: 22406},
 				run: (*parser).callonAsArg1,
 				expr: &seqExpr{
-					pos: position{line: 842, col: 5, offset: 20911},
+					pos: position{line: 896, col: 5, offset: 22406},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 842, col: 5, offset: 20911},
+							pos:  position{line: 896, col: 5, offset: 22406},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 842, col: 7, offset: 20913},
+							pos:        position{line: 896, col: 7, offset: 22408},
 							val:        "as",
 							ignoreCase: false,
 							want:       "\"as\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 842, col: 12, offset: 20918},
+							pos:  position{line: 896, col: 12, offset: 22413},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 842, col: 14, offset: 20920},
+							pos:   position{line: 896, col: 14, offset: 22415},
 							label: "lhs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 842, col: 18, offset: 20924},
+								pos:  position{line: 896, col: 18, offset: 22419},
 								name: "Lval",
 							},
 						},
@@ -5691,9 +6173,9 @@ var g = &grammar{
 		},
 		{
 			name: "Lval",
-			pos:  position{line: 846, col: 1, offset: 20975},
+			pos:  position{line: 900, col: 1, offset: 22470},
 			expr: &ruleRefExpr{
-				pos:  position{line: 846, col: 8, offset: 20982},
+				pos:  position{line: 900, col: 8, offset: 22477},
 				name: "DerefExpr",
 			},
 			leader:        false,
@@ -5701,51 +6183,51 @@ var g = &grammar{
 		},
 		{
 			name: "Lvals",
-			pos:  position{line: 848, col: 1, offset: 20993},
+			pos:  position{line: 902, col: 1, offset: 22488},
 			expr: &actionExpr{
-				pos: position{line: 849, col: 5, offset: 21003},
+				pos: position{line: 903, col: 5, offset: 22498},
 				run: (*parser).callonLvals1,
 				expr: &seqExpr{
-					pos: position{line: 849, col: 5, offset: 21003},
+					pos: position{line: 903, col: 5, offset: 22498},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 849, col: 5, offset: 21003},
+							pos:   position{line: 903, col: 5, offset: 22498},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 849, col: 11, offset: 21009},
+								pos:  position{line: 903, col: 11, offset: 22504},
 								name: "Lval",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 849, col: 16, offset: 21014},
+							pos:   position{line: 903, col: 16, offset: 22509},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 849, col: 21, offset: 21019},
+								pos: position{line: 903, col: 21, offset: 22514},
 								expr: &actionExpr{
-									pos: position{line: 849, col: 22, offset: 21020},
+									pos: position{line: 903, col: 22, offset: 22515},
 									run: (*parser).callonLvals7,
 									expr: &seqExpr{
-										pos: position{line: 849, col: 22, offset: 21020},
+										pos: position{line: 903, col: 22, offset: 22515},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 849, col: 22, offset: 21020},
+												pos:  position{line: 903, col: 22, offset: 22515},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 849, col: 25, offset: 21023},
+												pos:        position{line: 903, col: 25, offset: 22518},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 849, col: 29, offset: 21027},
+												pos:  position{line: 903, col: 29, offset: 22522},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 849, col: 32, offset: 21030},
+												pos:   position{line: 903, col: 32, offset: 22525},
 												label: "lval",
 												expr: &ruleRefExpr{
-													pos:  position{line: 849, col: 37, offset: 21035},
+													pos:  position{line: 903, col: 37, offset: 22530},
 													name: "Lval",
 												},
 											},
@@ -5762,9 +6244,9 @@ var g = &grammar{
 		},
 		{
 			name: "FieldExpr",
-			pos:  position{line: 853, col: 1, offset: 21111},
+			pos:  position{line: 907, col: 1, offset: 22606},
 			expr: &ruleRefExpr{
-				pos:  position{line: 853, col: 13, offset: 21123},
+				pos:  position{line: 907, col: 13, offset: 22618},
 				name: "Lval",
 			},
 			leader:        false,
@@ -5772,51 +6254,51 @@ var g = &grammar{
 		},
 		{
 			name: "FieldExprs",
-			pos:  position{line: 855, col: 1, offset: 21129},
+			pos:  position{line: 909, col: 1, offset: 22624},
 			expr: &actionExpr{
-				pos: position{line: 856, col: 5, offset: 21144},
+				pos: position{line: 910, col: 5, offset: 22639},
 				run: (*parser).callonFieldExprs1,
 				expr: &seqExpr{
-					pos: position{line: 856, col: 5, offset: 21144},
+					pos: position{line: 910, col: 5, offset: 22639},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 856, col: 5, offset: 21144},
+							pos:   position{line: 910, col: 5, offset: 22639},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 856, col: 11, offset: 21150},
+								pos:  position{line: 910, col: 11, offset: 22645},
 								name: "FieldExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 856, col: 21, offset: 21160},
+							pos:   position{line: 910, col: 21, offset: 22655},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 856, col: 26, offset: 21165},
+								pos: position{line: 910, col: 26, offset: 22660},
 								expr: &actionExpr{
-									pos: position{line: 856, col: 27, offset: 21166},
+									pos: position{line: 910, col: 27, offset: 22661},
 									run: (*parser).callonFieldExprs7,
 									expr: &seqExpr{
-										pos: position{line: 856, col: 27, offset: 21166},
+										pos: position{line: 910, col: 27, offset: 22661},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 856, col: 27, offset: 21166},
+												pos:  position{line: 910, col: 27, offset: 22661},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 856, col: 30, offset: 21169},
+												pos:        position{line: 910, col: 30, offset: 22664},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 856, col: 34, offset: 21173},
+												pos:  position{line: 910, col: 34, offset: 22668},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 856, col: 37, offset: 21176},
+												pos:   position{line: 910, col: 37, offset: 22671},
 												label: "f",
 												expr: &ruleRefExpr{
-													pos:  position{line: 856, col: 39, offset: 21178},
+													pos:  position{line: 910, col: 39, offset: 22673},
 													name: "FieldExpr",
 												},
 											},
@@ -5833,51 +6315,51 @@ var g = &grammar{
 		},
 		{
 			name: "Assignments",
-			pos:  position{line: 860, col: 1, offset: 21255},
+			pos:  position{line: 914, col: 1, offset: 22750},
 			expr: &actionExpr{
-				pos: position{line: 861, col: 5, offset: 21271},
+				pos: position{line: 915, col: 5, offset: 22766},
 				run: (*parser).callonAssignments1,
 				expr: &seqExpr{
-					pos: position{line: 861, col: 5, offset: 21271},
+					pos: position{line: 915, col: 5, offset: 22766},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 861, col: 5, offset: 21271},
+							pos:   position{line: 915, col: 5, offset: 22766},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 861, col: 11, offset: 21277},
+								pos:  position{line: 915, col: 11, offset: 22772},
 								name: "Assignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 861, col: 22, offset: 21288},
+							pos:   position{line: 915, col: 22, offset: 22783},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 861, col: 27, offset: 21293},
+								pos: position{line: 915, col: 27, offset: 22788},
 								expr: &actionExpr{
-									pos: position{line: 861, col: 28, offset: 21294},
+									pos: position{line: 915, col: 28, offset: 22789},
 									run: (*parser).callonAssignments7,
 									expr: &seqExpr{
-										pos: position{line: 861, col: 28, offset: 21294},
+										pos: position{line: 915, col: 28, offset: 22789},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 861, col: 28, offset: 21294},
+												pos:  position{line: 915, col: 28, offset: 22789},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 861, col: 31, offset: 21297},
+												pos:        position{line: 915, col: 31, offset: 22792},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 861, col: 35, offset: 21301},
+												pos:  position{line: 915, col: 35, offset: 22796},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 861, col: 38, offset: 21304},
+												pos:   position{line: 915, col: 38, offset: 22799},
 												label: "a",
 												expr: &ruleRefExpr{
-													pos:  position{line: 861, col: 40, offset: 21306},
+													pos:  position{line: 915, col: 40, offset: 22801},
 													name: "Assignment",
 												},
 											},
@@ -5894,40 +6376,40 @@ var g = &grammar{
 		},
 		{
 			name: "Assignment",
-			pos:  position{line: 865, col: 1, offset: 21381},
+			pos:  position{line: 919, col: 1, offset: 22876},
 			expr: &actionExpr{
-				pos: position{line: 866, col: 5, offset: 21396},
+				pos: position{line: 920, col: 5, offset: 22891},
 				run: (*parser).callonAssignment1,
 				expr: &seqExpr{
-					pos: position{line: 866, col: 5, offset: 21396},
+					pos: position{line: 920, col: 5, offset: 22891},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 866, col: 5, offset: 21396},
+							pos:   position{line: 920, col: 5, offset: 22891},
 							label: "lhs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 866, col: 9, offset: 21400},
+								pos:  position{line: 920, col: 9, offset: 22895},
 								name: "Lval",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 866, col: 14, offset: 21405},
+							pos:  position{line: 920, col: 14, offset: 22900},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 866, col: 17, offset: 21408},
+							pos:        position{line: 920, col: 17, offset: 22903},
 							val:        ":=",
 							ignoreCase: false,
 							want:       "\":=\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 866, col: 22, offset: 21413},
+							pos:  position{line: 920, col: 22, offset: 22908},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 866, col: 25, offset: 21416},
+							pos:   position{line: 920, col: 25, offset: 22911},
 							label: "rhs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 866, col: 29, offset: 21420},
+								pos:  position{line: 920, col: 29, offset: 22915},
 								name: "Expr",
 							},
 						},
@@ -5939,9 +6421,9 @@ var g = &grammar{
 		},
 		{
 			name: "Expr",
-			pos:  position{line: 874, col: 1, offset: 21568},
+			pos:  position{line: 928, col: 1, offset: 23063},
 			expr: &ruleRefExpr{
-				pos:  position{line: 874, col: 8, offset: 21575},
+				pos:  position{line: 928, col: 8, offset: 23070},
 				name: "ConditionalExpr",
 			},
 			leader:        false,
@@ -5949,63 +6431,63 @@ var g = &grammar{
 		},
 		{
 			name: "ConditionalExpr",
-			pos:  position{line: 876, col: 1, offset: 21592},
+			pos:  position{line: 930, col: 1, offset: 23087},
 			expr: &actionExpr{
-				pos: position{line: 877, col: 5, offset: 21612},
+				pos: position{line: 931, col: 5, offset: 23107},
 				run: (*parser).callonConditionalExpr1,
 				expr: &seqExpr{
-					pos: position{line: 877, col: 5, offset: 21612},
+					pos: position{line: 931, col: 5, offset: 23107},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 877, col: 5, offset: 21612},
+							pos:   position{line: 931, col: 5, offset: 23107},
 							label: "cond",
 							expr: &ruleRefExpr{
-								pos:  position{line: 877, col: 10, offset: 21617},
+								pos:  position{line: 931, col: 10, offset: 23112},
 								name: "LogicalOrExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 877, col: 24, offset: 21631},
+							pos:   position{line: 931, col: 24, offset: 23126},
 							label: "opt",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 877, col: 28, offset: 21635},
+								pos: position{line: 931, col: 28, offset: 23130},
 								expr: &seqExpr{
-									pos: position{line: 877, col: 29, offset: 21636},
+									pos: position{line: 931, col: 29, offset: 23131},
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 877, col: 29, offset: 21636},
+											pos:  position{line: 931, col: 29, offset: 23131},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 877, col: 32, offset: 21639},
+											pos:        position{line: 931, col: 32, offset: 23134},
 											val:        "?",
 											ignoreCase: false,
 											want:       "\"?\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 877, col: 36, offset: 21643},
+											pos:  position{line: 931, col: 36, offset: 23138},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 877, col: 39, offset: 21646},
+											pos:  position{line: 931, col: 39, offset: 23141},
 											name: "Expr",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 877, col: 44, offset: 21651},
+											pos:  position{line: 931, col: 44, offset: 23146},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 877, col: 47, offset: 21654},
+											pos:        position{line: 931, col: 47, offset: 23149},
 											val:        ":",
 											ignoreCase: false,
 											want:       "\":\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 877, col: 51, offset: 21658},
+											pos:  position{line: 931, col: 51, offset: 23153},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 877, col: 54, offset: 21661},
+											pos:  position{line: 931, col: 54, offset: 23156},
 											name: "Expr",
 										},
 									},
@@ -6020,53 +6502,53 @@ var g = &grammar{
 		},
 		{
 			name: "LogicalOrExpr",
-			pos:  position{line: 890, col: 1, offset: 21957},
+			pos:  position{line: 944, col: 1, offset: 23452},
 			expr: &actionExpr{
-				pos: position{line: 891, col: 5, offset: 21975},
+				pos: position{line: 945, col: 5, offset: 23470},
 				run: (*parser).callonLogicalOrExpr1,
 				expr: &seqExpr{
-					pos: position{line: 891, col: 5, offset: 21975},
+					pos: position{line: 945, col: 5, offset: 23470},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 891, col: 5, offset: 21975},
+							pos:   position{line: 945, col: 5, offset: 23470},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 891, col: 11, offset: 21981},
+								pos:  position{line: 945, col: 11, offset: 23476},
 								name: "LogicalAndExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 892, col: 5, offset: 22000},
+							pos:   position{line: 946, col: 5, offset: 23495},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 892, col: 10, offset: 22005},
+								pos: position{line: 946, col: 10, offset: 23500},
 								expr: &actionExpr{
-									pos: position{line: 892, col: 11, offset: 22006},
+									pos: position{line: 946, col: 11, offset: 23501},
 									run: (*parser).callonLogicalOrExpr7,
 									expr: &seqExpr{
-										pos: position{line: 892, col: 11, offset: 22006},
+										pos: position{line: 946, col: 11, offset: 23501},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 892, col: 11, offset: 22006},
+												pos:  position{line: 946, col: 11, offset: 23501},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 892, col: 14, offset: 22009},
+												pos:   position{line: 946, col: 14, offset: 23504},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 892, col: 17, offset: 22012},
+													pos:  position{line: 946, col: 17, offset: 23507},
 													name: "OrToken",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 892, col: 25, offset: 22020},
+												pos:  position{line: 946, col: 25, offset: 23515},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 892, col: 28, offset: 22023},
+												pos:   position{line: 946, col: 28, offset: 23518},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 892, col: 33, offset: 22028},
+													pos:  position{line: 946, col: 33, offset: 23523},
 													name: "LogicalAndExpr",
 												},
 											},
@@ -6083,53 +6565,53 @@ var g = &grammar{
 		},
 		{
 			name: "LogicalAndExpr",
-			pos:  position{line: 896, col: 1, offset: 22139},
+			pos:  position{line: 950, col: 1, offset: 23634},
 			expr: &actionExpr{
-				pos: position{line: 897, col: 5, offset: 22158},
+				pos: position{line: 951, col: 5, offset: 23653},
 				run: (*parser).callonLogicalAndExpr1,
 				expr: &seqExpr{
-					pos: position{line: 897, col: 5, offset: 22158},
+					pos: position{line: 951, col: 5, offset: 23653},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 897, col: 5, offset: 22158},
+							pos:   position{line: 951, col: 5, offset: 23653},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 897, col: 11, offset: 22164},
+								pos:  position{line: 951, col: 11, offset: 23659},
 								name: "ComparisonExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 898, col: 5, offset: 22183},
+							pos:   position{line: 952, col: 5, offset: 23678},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 898, col: 10, offset: 22188},
+								pos: position{line: 952, col: 10, offset: 23683},
 								expr: &actionExpr{
-									pos: position{line: 898, col: 11, offset: 22189},
+									pos: position{line: 952, col: 11, offset: 23684},
 									run: (*parser).callonLogicalAndExpr7,
 									expr: &seqExpr{
-										pos: position{line: 898, col: 11, offset: 22189},
+										pos: position{line: 952, col: 11, offset: 23684},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 898, col: 11, offset: 22189},
+												pos:  position{line: 952, col: 11, offset: 23684},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 898, col: 14, offset: 22192},
+												pos:   position{line: 952, col: 14, offset: 23687},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 898, col: 17, offset: 22195},
+													pos:  position{line: 952, col: 17, offset: 23690},
 													name: "AndToken",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 898, col: 26, offset: 22204},
+												pos:  position{line: 952, col: 26, offset: 23699},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 898, col: 29, offset: 22207},
+												pos:   position{line: 952, col: 29, offset: 23702},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 898, col: 34, offset: 22212},
+													pos:  position{line: 952, col: 34, offset: 23707},
 													name: "ComparisonExpr",
 												},
 											},
@@ -6146,73 +6628,73 @@ var g = &grammar{
 		},
 		{
 			name: "ComparisonExpr",
-			pos:  position{line: 902, col: 1, offset: 22323},
+			pos:  position{line: 956, col: 1, offset: 23818},
 			expr: &actionExpr{
-				pos: position{line: 903, col: 5, offset: 22342},
+				pos: position{line: 957, col: 5, offset: 23837},
 				run: (*parser).callonComparisonExpr1,
 				expr: &seqExpr{
-					pos: position{line: 903, col: 5, offset: 22342},
+					pos: position{line: 957, col: 5, offset: 23837},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 903, col: 5, offset: 22342},
+							pos:   position{line: 957, col: 5, offset: 23837},
 							label: "lhs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 903, col: 9, offset: 22346},
+								pos:  position{line: 957, col: 9, offset: 23841},
 								name: "AdditiveExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 903, col: 22, offset: 22359},
+							pos:   position{line: 957, col: 22, offset: 23854},
 							label: "opAndRHS",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 903, col: 31, offset: 22368},
+								pos: position{line: 957, col: 31, offset: 23863},
 								expr: &choiceExpr{
-									pos: position{line: 903, col: 32, offset: 22369},
+									pos: position{line: 957, col: 32, offset: 23864},
 									alternatives: []any{
 										&seqExpr{
-											pos: position{line: 903, col: 32, offset: 22369},
+											pos: position{line: 957, col: 32, offset: 23864},
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 903, col: 32, offset: 22369},
+													pos:  position{line: 957, col: 32, offset: 23864},
 													name: "__",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 903, col: 35, offset: 22372},
+													pos:  position{line: 957, col: 35, offset: 23867},
 													name: "Comparator",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 903, col: 46, offset: 22383},
+													pos:  position{line: 957, col: 46, offset: 23878},
 													name: "__",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 903, col: 49, offset: 22386},
+													pos:  position{line: 957, col: 49, offset: 23881},
 													name: "AdditiveExpr",
 												},
 											},
 										},
 										&seqExpr{
-											pos: position{line: 903, col: 64, offset: 22401},
+											pos: position{line: 957, col: 64, offset: 23896},
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 903, col: 64, offset: 22401},
+													pos:  position{line: 957, col: 64, offset: 23896},
 													name: "__",
 												},
 												&actionExpr{
-													pos: position{line: 903, col: 68, offset: 22405},
+													pos: position{line: 957, col: 68, offset: 23900},
 													run: (*parser).callonComparisonExpr15,
 													expr: &litMatcher{
-														pos:        position{line: 903, col: 68, offset: 22405},
+														pos:        position{line: 957, col: 68, offset: 23900},
 														val:        "~",
 														ignoreCase: false,
 														want:       "\"~\"",
 													},
 												},
 												&ruleRefExpr{
-													pos:  position{line: 903, col: 104, offset: 22441},
+													pos:  position{line: 957, col: 104, offset: 23936},
 													name: "__",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 903, col: 107, offset: 22444},
+													pos:  position{line: 957, col: 107, offset: 23939},
 													name: "Regexp",
 												},
 											},
@@ -6229,53 +6711,53 @@ var g = &grammar{
 		},
 		{
 			name: "AdditiveExpr",
-			pos:  position{line: 915, col: 1, offset: 22708},
+			pos:  position{line: 969, col: 1, offset: 24203},
 			expr: &actionExpr{
-				pos: position{line: 916, col: 5, offset: 22725},
+				pos: position{line: 970, col: 5, offset: 24220},
 				run: (*parser).callonAdditiveExpr1,
 				expr: &seqExpr{
-					pos: position{line: 916, col: 5, offset: 22725},
+					pos: position{line: 970, col: 5, offset: 24220},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 916, col: 5, offset: 22725},
+							pos:   position{line: 970, col: 5, offset: 24220},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 916, col: 11, offset: 22731},
+								pos:  position{line: 970, col: 11, offset: 24226},
 								name: "MultiplicativeExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 917, col: 5, offset: 22754},
+							pos:   position{line: 971, col: 5, offset: 24249},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 917, col: 10, offset: 22759},
+								pos: position{line: 971, col: 10, offset: 24254},
 								expr: &actionExpr{
-									pos: position{line: 917, col: 11, offset: 22760},
+									pos: position{line: 971, col: 11, offset: 24255},
 									run: (*parser).callonAdditiveExpr7,
 									expr: &seqExpr{
-										pos: position{line: 917, col: 11, offset: 22760},
+										pos: position{line: 971, col: 11, offset: 24255},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 917, col: 11, offset: 22760},
+												pos:  position{line: 971, col: 11, offset: 24255},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 917, col: 14, offset: 22763},
+												pos:   position{line: 971, col: 14, offset: 24258},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 917, col: 17, offset: 22766},
+													pos:  position{line: 971, col: 17, offset: 24261},
 													name: "AdditiveOperator",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 917, col: 34, offset: 22783},
+												pos:  position{line: 971, col: 34, offset: 24278},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 917, col: 37, offset: 22786},
+												pos:   position{line: 971, col: 37, offset: 24281},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 917, col: 42, offset: 22791},
+													pos:  position{line: 971, col: 42, offset: 24286},
 													name: "MultiplicativeExpr",
 												},
 											},
@@ -6292,21 +6774,21 @@ var g = &grammar{
 		},
 		{
 			name: "AdditiveOperator",
-			pos:  position{line: 921, col: 1, offset: 22906},
+			pos:  position{line: 975, col: 1, offset: 24401},
 			expr: &actionExpr{
-				pos: position{line: 921, col: 20, offset: 22925},
+				pos: position{line: 975, col: 20, offset: 24420},
 				run: (*parser).callonAdditiveOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 921, col: 21, offset: 22926},
+					pos: position{line: 975, col: 21, offset: 24421},
 					alternatives: []any{
 						&litMatcher{
-							pos:        position{line: 921, col: 21, offset: 22926},
+							pos:        position{line: 975, col: 21, offset: 24421},
 							val:        "+",
 							ignoreCase: false,
 							want:       "\"+\"",
 						},
 						&litMatcher{
-							pos:        position{line: 921, col: 27, offset: 22932},
+							pos:        position{line: 975, col: 27, offset: 24427},
 							val:        "-",
 							ignoreCase: false,
 							want:       "\"-\"",
@@ -6319,53 +6801,53 @@ var g = &grammar{
 		},
 		{
 			name: "MultiplicativeExpr",
-			pos:  position{line: 923, col: 1, offset: 22969},
+			pos:  position{line: 977, col: 1, offset: 24464},
 			expr: &actionExpr{
-				pos: position{line: 924, col: 5, offset: 22992},
+				pos: position{line: 978, col: 5, offset: 24487},
 				run: (*parser).callonMultiplicativeExpr1,
 				expr: &seqExpr{
-					pos: position{line: 924, col: 5, offset: 22992},
+					pos: position{line: 978, col: 5, offset: 24487},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 924, col: 5, offset: 22992},
+							pos:   position{line: 978, col: 5, offset: 24487},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 924, col: 11, offset: 22998},
+								pos:  position{line: 978, col: 11, offset: 24493},
 								name: "NotExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 925, col: 5, offset: 23010},
+							pos:   position{line: 979, col: 5, offset: 24505},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 925, col: 10, offset: 23015},
+								pos: position{line: 979, col: 10, offset: 24510},
 								expr: &actionExpr{
-									pos: position{line: 925, col: 11, offset: 23016},
+									pos: position{line: 979, col: 11, offset: 24511},
 									run: (*parser).callonMultiplicativeExpr7,
 									expr: &seqExpr{
-										pos: position{line: 925, col: 11, offset: 23016},
+										pos: position{line: 979, col: 11, offset: 24511},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 925, col: 11, offset: 23016},
+												pos:  position{line: 979, col: 11, offset: 24511},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 925, col: 14, offset: 23019},
+												pos:   position{line: 979, col: 14, offset: 24514},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 925, col: 17, offset: 23022},
+													pos:  position{line: 979, col: 17, offset: 24517},
 													name: "MultiplicativeOperator",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 925, col: 40, offset: 23045},
+												pos:  position{line: 979, col: 40, offset: 24540},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 925, col: 43, offset: 23048},
+												pos:   position{line: 979, col: 43, offset: 24543},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 925, col: 48, offset: 23053},
+													pos:  position{line: 979, col: 48, offset: 24548},
 													name: "NotExpr",
 												},
 											},
@@ -6382,27 +6864,27 @@ var g = &grammar{
 		},
 		{
 			name: "MultiplicativeOperator",
-			pos:  position{line: 929, col: 1, offset: 23157},
+			pos:  position{line: 983, col: 1, offset: 24652},
 			expr: &actionExpr{
-				pos: position{line: 929, col: 26, offset: 23182},
+				pos: position{line: 983, col: 26, offset: 24677},
 				run: (*parser).callonMultiplicativeOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 929, col: 27, offset: 23183},
+					pos: position{line: 983, col: 27, offset: 24678},
 					alternatives: []any{
 						&litMatcher{
-							pos:        position{line: 929, col: 27, offset: 23183},
+							pos:        position{line: 983, col: 27, offset: 24678},
 							val:        "*",
 							ignoreCase: false,
 							want:       "\"*\"",
 						},
 						&litMatcher{
-							pos:        position{line: 929, col: 33, offset: 23189},
+							pos:        position{line: 983, col: 33, offset: 24684},
 							val:        "/",
 							ignoreCase: false,
 							want:       "\"/\"",
 						},
 						&litMatcher{
-							pos:        position{line: 929, col: 39, offset: 23195},
+							pos:        position{line: 983, col: 39, offset: 24690},
 							val:        "%",
 							ignoreCase: false,
 							want:       "\"%\"",
@@ -6415,43 +6897,43 @@ var g = &grammar{
 		},
 		{
 			name: "NotExpr",
-			pos:  position{line: 931, col: 1, offset: 23232},
+			pos:  position{line: 985, col: 1, offset: 24727},
 			expr: &choiceExpr{
-				pos: position{line: 932, col: 5, offset: 23244},
+				pos: position{line: 986, col: 5, offset: 24739},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 932, col: 5, offset: 23244},
+						pos: position{line: 986, col: 5, offset: 24739},
 						run: (*parser).callonNotExpr2,
 						expr: &seqExpr{
-							pos: position{line: 932, col: 5, offset: 23244},
+							pos: position{line: 986, col: 5, offset: 24739},
 							exprs: []any{
 								&choiceExpr{
-									pos: position{line: 932, col: 6, offset: 23245},
+									pos: position{line: 986, col: 6, offset: 24740},
 									alternatives: []any{
 										&seqExpr{
-											pos: position{line: 932, col: 6, offset: 23245},
+											pos: position{line: 986, col: 6, offset: 24740},
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 932, col: 6, offset: 23245},
+													pos:  position{line: 986, col: 6, offset: 24740},
 													name: "NotToken",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 932, col: 15, offset: 23254},
+													pos:  position{line: 986, col: 15, offset: 24749},
 													name: "_",
 												},
 											},
 										},
 										&seqExpr{
-											pos: position{line: 932, col: 19, offset: 23258},
+											pos: position{line: 986, col: 19, offset: 24753},
 											exprs: []any{
 												&litMatcher{
-													pos:        position{line: 932, col: 19, offset: 23258},
+													pos:        position{line: 986, col: 19, offset: 24753},
 													val:        "!",
 													ignoreCase: false,
 													want:       "\"!\"",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 932, col: 23, offset: 23262},
+													pos:  position{line: 986, col: 23, offset: 24757},
 													name: "__",
 												},
 											},
@@ -6459,10 +6941,10 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 932, col: 27, offset: 23266},
+									pos:   position{line: 986, col: 27, offset: 24761},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 932, col: 29, offset: 23268},
+										pos:  position{line: 986, col: 29, offset: 24763},
 										name: "NotExpr",
 									},
 								},
@@ -6470,7 +6952,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 940, col: 5, offset: 23442},
+						pos:  position{line: 994, col: 5, offset: 24937},
 						name: "NegationExpr",
 					},
 				},
@@ -6480,38 +6962,38 @@ var g = &grammar{
 		},
 		{
 			name: "NegationExpr",
-			pos:  position{line: 942, col: 1, offset: 23456},
+			pos:  position{line: 996, col: 1, offset: 24951},
 			expr: &choiceExpr{
-				pos: position{line: 943, col: 5, offset: 23473},
+				pos: position{line: 997, col: 5, offset: 24968},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 943, col: 5, offset: 23473},
+						pos: position{line: 997, col: 5, offset: 24968},
 						run: (*parser).callonNegationExpr2,
 						expr: &seqExpr{
-							pos: position{line: 943, col: 5, offset: 23473},
+							pos: position{line: 997, col: 5, offset: 24968},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 943, col: 5, offset: 23473},
+									pos: position{line: 997, col: 5, offset: 24968},
 									expr: &ruleRefExpr{
-										pos:  position{line: 943, col: 6, offset: 23474},
+										pos:  position{line: 997, col: 6, offset: 24969},
 										name: "Literal",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 943, col: 14, offset: 23482},
+									pos:        position{line: 997, col: 14, offset: 24977},
 									val:        "-",
 									ignoreCase: false,
 									want:       "\"-\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 943, col: 18, offset: 23486},
+									pos:  position{line: 997, col: 18, offset: 24981},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 943, col: 21, offset: 23489},
+									pos:   position{line: 997, col: 21, offset: 24984},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 943, col: 23, offset: 23491},
+										pos:  position{line: 997, col: 23, offset: 24986},
 										name: "DerefExpr",
 									},
 								},
@@ -6519,7 +7001,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 951, col: 5, offset: 23667},
+						pos:  position{line: 1005, col: 5, offset: 25162},
 						name: "DerefExpr",
 					},
 				},
@@ -6529,65 +7011,65 @@ var g = &grammar{
 		},
 		{
 			name: "DerefExpr",
-			pos:  position{line: 953, col: 1, offset: 23678},
+			pos:  position{line: 1007, col: 1, offset: 25173},
 			expr: &choiceExpr{
-				pos: position{line: 954, col: 5, offset: 23692},
+				pos: position{line: 1008, col: 5, offset: 25187},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 954, col: 5, offset: 23692},
+						pos: position{line: 1008, col: 5, offset: 25187},
 						run: (*parser).callonDerefExpr2,
 						expr: &seqExpr{
-							pos: position{line: 954, col: 5, offset: 23692},
+							pos: position{line: 1008, col: 5, offset: 25187},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 954, col: 5, offset: 23692},
+									pos:   position{line: 1008, col: 5, offset: 25187},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 954, col: 10, offset: 23697},
+										pos:  position{line: 1008, col: 10, offset: 25192},
 										name: "DerefExpr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 954, col: 20, offset: 23707},
+									pos:        position{line: 1008, col: 20, offset: 25202},
 									val:        "[",
 									ignoreCase: false,
 									want:       "\"[\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 954, col: 24, offset: 23711},
+									pos:   position{line: 1008, col: 24, offset: 25206},
 									label: "from",
 									expr: &ruleRefExpr{
-										pos:  position{line: 954, col: 29, offset: 23716},
+										pos:  position{line: 1008, col: 29, offset: 25211},
 										name: "AdditiveExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 954, col: 42, offset: 23729},
+									pos:  position{line: 1008, col: 42, offset: 25224},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 954, col: 45, offset: 23732},
+									pos:        position{line: 1008, col: 45, offset: 25227},
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 954, col: 49, offset: 23736},
+									pos:  position{line: 1008, col: 49, offset: 25231},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 954, col: 52, offset: 23739},
+									pos:   position{line: 1008, col: 52, offset: 25234},
 									label: "to",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 954, col: 55, offset: 23742},
+										pos: position{line: 1008, col: 55, offset: 25237},
 										expr: &ruleRefExpr{
-											pos:  position{line: 954, col: 55, offset: 23742},
+											pos:  position{line: 1008, col: 55, offset: 25237},
 											name: "AdditiveExpr",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 954, col: 69, offset: 23756},
+									pos:        position{line: 1008, col: 69, offset: 25251},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -6596,49 +7078,49 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 966, col: 5, offset: 24007},
+						pos: position{line: 1020, col: 5, offset: 25502},
 						run: (*parser).callonDerefExpr16,
 						expr: &seqExpr{
-							pos: position{line: 966, col: 5, offset: 24007},
+							pos: position{line: 1020, col: 5, offset: 25502},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 966, col: 5, offset: 24007},
+									pos:   position{line: 1020, col: 5, offset: 25502},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 966, col: 10, offset: 24012},
+										pos:  position{line: 1020, col: 10, offset: 25507},
 										name: "DerefExpr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 966, col: 20, offset: 24022},
+									pos:        position{line: 1020, col: 20, offset: 25517},
 									val:        "[",
 									ignoreCase: false,
 									want:       "\"[\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 966, col: 24, offset: 24026},
+									pos:  position{line: 1020, col: 24, offset: 25521},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 966, col: 27, offset: 24029},
+									pos:        position{line: 1020, col: 27, offset: 25524},
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 966, col: 31, offset: 24033},
+									pos:  position{line: 1020, col: 31, offset: 25528},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 966, col: 34, offset: 24036},
+									pos:   position{line: 1020, col: 34, offset: 25531},
 									label: "to",
 									expr: &ruleRefExpr{
-										pos:  position{line: 966, col: 37, offset: 24039},
+										pos:  position{line: 1020, col: 37, offset: 25534},
 										name: "AdditiveExpr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 966, col: 50, offset: 24052},
+									pos:        position{line: 1020, col: 50, offset: 25547},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -6647,35 +7129,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 974, col: 5, offset: 24228},
+						pos: position{line: 1028, col: 5, offset: 25723},
 						run: (*parser).callonDerefExpr27,
 						expr: &seqExpr{
-							pos: position{line: 974, col: 5, offset: 24228},
+							pos: position{line: 1028, col: 5, offset: 25723},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 974, col: 5, offset: 24228},
+									pos:   position{line: 1028, col: 5, offset: 25723},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 974, col: 10, offset: 24233},
+										pos:  position{line: 1028, col: 10, offset: 25728},
 										name: "DerefExpr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 974, col: 20, offset: 24243},
+									pos:        position{line: 1028, col: 20, offset: 25738},
 									val:        "[",
 									ignoreCase: false,
 									want:       "\"[\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 974, col: 24, offset: 24247},
+									pos:   position{line: 1028, col: 24, offset: 25742},
 									label: "index",
 									expr: &ruleRefExpr{
-										pos:  position{line: 974, col: 30, offset: 24253},
+										pos:  position{line: 1028, col: 30, offset: 25748},
 										name: "Expr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 974, col: 35, offset: 24258},
+									pos:        position{line: 1028, col: 35, offset: 25753},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -6684,30 +7166,30 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 982, col: 5, offset: 24440},
+						pos: position{line: 1036, col: 5, offset: 25935},
 						run: (*parser).callonDerefExpr35,
 						expr: &seqExpr{
-							pos: position{line: 982, col: 5, offset: 24440},
+							pos: position{line: 1036, col: 5, offset: 25935},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 982, col: 5, offset: 24440},
+									pos:   position{line: 1036, col: 5, offset: 25935},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 982, col: 10, offset: 24445},
+										pos:  position{line: 1036, col: 10, offset: 25940},
 										name: "DerefExpr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 982, col: 20, offset: 24455},
+									pos:        position{line: 1036, col: 20, offset: 25950},
 									val:        ".",
 									ignoreCase: false,
 									want:       "\".\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 982, col: 24, offset: 24459},
+									pos:   position{line: 1036, col: 24, offset: 25954},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 982, col: 27, offset: 24462},
+										pos:  position{line: 1036, col: 27, offset: 25957},
 										name: "Identifier",
 									},
 								},
@@ -6715,25 +7197,25 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 990, col: 5, offset: 24631},
+						pos: position{line: 1044, col: 5, offset: 26126},
 						run: (*parser).callonDerefExpr42,
 						expr: &labeledExpr{
-							pos:   position{line: 990, col: 5, offset: 24631},
+							pos:   position{line: 1044, col: 5, offset: 26126},
 							label: "fn",
 							expr: &ruleRefExpr{
-								pos:  position{line: 990, col: 8, offset: 24634},
+								pos:  position{line: 1044, col: 8, offset: 26129},
 								name: "FuncExpr",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 993, col: 5, offset: 24676},
+						pos: position{line: 1047, col: 5, offset: 26171},
 						run: (*parser).callonDerefExpr45,
 						expr: &labeledExpr{
-							pos:   position{line: 993, col: 5, offset: 24676},
+							pos:   position{line: 1047, col: 5, offset: 26171},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 993, col: 10, offset: 24681},
+								pos:  position{line: 1047, col: 10, offset: 26176},
 								name: "Primary",
 							},
 						},
@@ -6745,30 +7227,30 @@ var g = &grammar{
 		},
 		{
 			name: "FuncExpr",
-			pos:  position{line: 998, col: 1, offset: 24722},
+			pos:  position{line: 1052, col: 1, offset: 26217},
 			expr: &choiceExpr{
-				pos: position{line: 999, col: 5, offset: 24735},
+				pos: position{line: 1053, col: 5, offset: 26230},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 999, col: 5, offset: 24735},
+						pos: position{line: 1053, col: 5, offset: 26230},
 						run: (*parser).callonFuncExpr2,
 						expr: &labeledExpr{
-							pos:   position{line: 999, col: 5, offset: 24735},
+							pos:   position{line: 1053, col: 5, offset: 26230},
 							label: "cast",
 							expr: &ruleRefExpr{
-								pos:  position{line: 999, col: 10, offset: 24740},
+								pos:  position{line: 1053, col: 10, offset: 26235},
 								name: "Cast",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1002, col: 5, offset: 24780},
+						pos: position{line: 1056, col: 5, offset: 26275},
 						run: (*parser).callonFuncExpr5,
 						expr: &labeledExpr{
-							pos:   position{line: 1002, col: 5, offset: 24780},
+							pos:   position{line: 1056, col: 5, offset: 26275},
 							label: "fn",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1002, col: 8, offset: 24783},
+								pos:  position{line: 1056, col: 8, offset: 26278},
 								name: "Function",
 							},
 						},
@@ -6780,20 +7262,20 @@ var g = &grammar{
 		},
 		{
 			name: "FuncGuard",
-			pos:  position{line: 1006, col: 1, offset: 24822},
+			pos:  position{line: 1060, col: 1, offset: 26317},
 			expr: &seqExpr{
-				pos: position{line: 1006, col: 13, offset: 24834},
+				pos: position{line: 1060, col: 13, offset: 26329},
 				exprs: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1006, col: 13, offset: 24834},
+						pos:  position{line: 1060, col: 13, offset: 26329},
 						name: "NotFuncs",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1006, col: 22, offset: 24843},
+						pos:  position{line: 1060, col: 22, offset: 26338},
 						name: "__",
 					},
 					&litMatcher{
-						pos:        position{line: 1006, col: 25, offset: 24846},
+						pos:        position{line: 1060, col: 25, offset: 26341},
 						val:        "(",
 						ignoreCase: false,
 						want:       "\"(\"",
@@ -6805,18 +7287,18 @@ var g = &grammar{
 		},
 		{
 			name: "NotFuncs",
-			pos:  position{line: 1008, col: 1, offset: 24851},
+			pos:  position{line: 1062, col: 1, offset: 26346},
 			expr: &choiceExpr{
-				pos: position{line: 1009, col: 5, offset: 24864},
+				pos: position{line: 1063, col: 5, offset: 26359},
 				alternatives: []any{
 					&litMatcher{
-						pos:        position{line: 1009, col: 5, offset: 24864},
+						pos:        position{line: 1063, col: 5, offset: 26359},
 						val:        "not",
 						ignoreCase: false,
 						want:       "\"not\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1010, col: 5, offset: 24874},
+						pos:        position{line: 1064, col: 5, offset: 26369},
 						val:        "select",
 						ignoreCase: false,
 						want:       "\"select\"",
@@ -6828,58 +7310,58 @@ var g = &grammar{
 		},
 		{
 			name: "Cast",
-			pos:  position{line: 1012, col: 1, offset: 24884},
+			pos:  position{line: 1066, col: 1, offset: 26379},
 			expr: &actionExpr{
-				pos: position{line: 1013, col: 5, offset: 24893},
+				pos: position{line: 1067, col: 5, offset: 26388},
 				run: (*parser).callonCast1,
 				expr: &seqExpr{
-					pos: position{line: 1013, col: 5, offset: 24893},
+					pos: position{line: 1067, col: 5, offset: 26388},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1013, col: 5, offset: 24893},
+							pos:   position{line: 1067, col: 5, offset: 26388},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1013, col: 9, offset: 24897},
+								pos:  position{line: 1067, col: 9, offset: 26392},
 								name: "TypeLiteral",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1013, col: 21, offset: 24909},
+							pos:  position{line: 1067, col: 21, offset: 26404},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1013, col: 24, offset: 24912},
+							pos:        position{line: 1067, col: 24, offset: 26407},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1013, col: 28, offset: 24916},
+							pos:  position{line: 1067, col: 28, offset: 26411},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1013, col: 31, offset: 24919},
+							pos:   position{line: 1067, col: 31, offset: 26414},
 							label: "expr",
 							expr: &choiceExpr{
-								pos: position{line: 1013, col: 37, offset: 24925},
+								pos: position{line: 1067, col: 37, offset: 26420},
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 1013, col: 37, offset: 24925},
+										pos:  position{line: 1067, col: 37, offset: 26420},
 										name: "OverExpr",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1013, col: 48, offset: 24936},
+										pos:  position{line: 1067, col: 48, offset: 26431},
 										name: "Expr",
 									},
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1013, col: 54, offset: 24942},
+							pos:  position{line: 1067, col: 54, offset: 26437},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1013, col: 57, offset: 24945},
+							pos:        position{line: 1067, col: 57, offset: 26440},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6892,87 +7374,87 @@ var g = &grammar{
 		},
 		{
 			name: "Function",
-			pos:  position{line: 1017, col: 1, offset: 25070},
+			pos:  position{line: 1071, col: 1, offset: 26565},
 			expr: &choiceExpr{
-				pos: position{line: 1018, col: 5, offset: 25083},
+				pos: position{line: 1072, col: 5, offset: 26578},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1018, col: 5, offset: 25083},
+						pos:  position{line: 1072, col: 5, offset: 26578},
 						name: "Grep",
 					},
 					&actionExpr{
-						pos: position{line: 1020, col: 5, offset: 25170},
+						pos: position{line: 1074, col: 5, offset: 26665},
 						run: (*parser).callonFunction3,
 						expr: &seqExpr{
-							pos: position{line: 1020, col: 5, offset: 25170},
+							pos: position{line: 1074, col: 5, offset: 26665},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1020, col: 5, offset: 25170},
+									pos:        position{line: 1074, col: 5, offset: 26665},
 									val:        "regexp",
 									ignoreCase: false,
 									want:       "\"regexp\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1020, col: 14, offset: 25179},
+									pos:  position{line: 1074, col: 14, offset: 26674},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1020, col: 17, offset: 25182},
+									pos:        position{line: 1074, col: 17, offset: 26677},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1020, col: 21, offset: 25186},
+									pos:  position{line: 1074, col: 21, offset: 26681},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1020, col: 24, offset: 25189},
+									pos:   position{line: 1074, col: 24, offset: 26684},
 									label: "arg0",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1020, col: 29, offset: 25194},
+										pos:  position{line: 1074, col: 29, offset: 26689},
 										name: "RegexpPrimitive",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1020, col: 45, offset: 25210},
+									pos:  position{line: 1074, col: 45, offset: 26705},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1020, col: 48, offset: 25213},
+									pos:        position{line: 1074, col: 48, offset: 26708},
 									val:        ",",
 									ignoreCase: false,
 									want:       "\",\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1020, col: 52, offset: 25217},
+									pos:  position{line: 1074, col: 52, offset: 26712},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1020, col: 55, offset: 25220},
+									pos:   position{line: 1074, col: 55, offset: 26715},
 									label: "arg1",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1020, col: 60, offset: 25225},
+										pos:  position{line: 1074, col: 60, offset: 26720},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1020, col: 65, offset: 25230},
+									pos:  position{line: 1074, col: 65, offset: 26725},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1020, col: 68, offset: 25233},
+									pos:        position{line: 1074, col: 68, offset: 26728},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1020, col: 72, offset: 25237},
+									pos:   position{line: 1074, col: 72, offset: 26732},
 									label: "where",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1020, col: 78, offset: 25243},
+										pos: position{line: 1074, col: 78, offset: 26738},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1020, col: 78, offset: 25243},
+											pos:  position{line: 1074, col: 78, offset: 26738},
 											name: "WhereClause",
 										},
 									},
@@ -6981,100 +7463,100 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1024, col: 5, offset: 25398},
+						pos: position{line: 1078, col: 5, offset: 26893},
 						run: (*parser).callonFunction21,
 						expr: &seqExpr{
-							pos: position{line: 1024, col: 5, offset: 25398},
+							pos: position{line: 1078, col: 5, offset: 26893},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1024, col: 5, offset: 25398},
+									pos:        position{line: 1078, col: 5, offset: 26893},
 									val:        "regexp_replace",
 									ignoreCase: false,
 									want:       "\"regexp_replace\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1024, col: 22, offset: 25415},
+									pos:  position{line: 1078, col: 22, offset: 26910},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1024, col: 25, offset: 25418},
+									pos:        position{line: 1078, col: 25, offset: 26913},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1024, col: 29, offset: 25422},
+									pos:  position{line: 1078, col: 29, offset: 26917},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1024, col: 32, offset: 25425},
+									pos:   position{line: 1078, col: 32, offset: 26920},
 									label: "arg0",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1024, col: 37, offset: 25430},
+										pos:  position{line: 1078, col: 37, offset: 26925},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1024, col: 42, offset: 25435},
+									pos:  position{line: 1078, col: 42, offset: 26930},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1024, col: 45, offset: 25438},
+									pos:        position{line: 1078, col: 45, offset: 26933},
 									val:        ",",
 									ignoreCase: false,
 									want:       "\",\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1024, col: 49, offset: 25442},
+									pos:  position{line: 1078, col: 49, offset: 26937},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1024, col: 52, offset: 25445},
+									pos:   position{line: 1078, col: 52, offset: 26940},
 									label: "arg1",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1024, col: 57, offset: 25450},
+										pos:  position{line: 1078, col: 57, offset: 26945},
 										name: "RegexpPrimitive",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1024, col: 73, offset: 25466},
+									pos:  position{line: 1078, col: 73, offset: 26961},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1024, col: 76, offset: 25469},
+									pos:        position{line: 1078, col: 76, offset: 26964},
 									val:        ",",
 									ignoreCase: false,
 									want:       "\",\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1024, col: 80, offset: 25473},
+									pos:  position{line: 1078, col: 80, offset: 26968},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1024, col: 83, offset: 25476},
+									pos:   position{line: 1078, col: 83, offset: 26971},
 									label: "arg2",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1024, col: 88, offset: 25481},
+										pos:  position{line: 1078, col: 88, offset: 26976},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1024, col: 93, offset: 25486},
+									pos:  position{line: 1078, col: 93, offset: 26981},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1024, col: 96, offset: 25489},
+									pos:        position{line: 1078, col: 96, offset: 26984},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1024, col: 100, offset: 25493},
+									pos:   position{line: 1078, col: 100, offset: 26988},
 									label: "where",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1024, col: 106, offset: 25499},
+										pos: position{line: 1078, col: 106, offset: 26994},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1024, col: 106, offset: 25499},
+											pos:  position{line: 1078, col: 106, offset: 26994},
 											name: "WhereClause",
 										},
 									},
@@ -7083,65 +7565,65 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1028, col: 5, offset: 25668},
+						pos: position{line: 1082, col: 5, offset: 27163},
 						run: (*parser).callonFunction44,
 						expr: &seqExpr{
-							pos: position{line: 1028, col: 5, offset: 25668},
+							pos: position{line: 1082, col: 5, offset: 27163},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1028, col: 5, offset: 25668},
+									pos: position{line: 1082, col: 5, offset: 27163},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1028, col: 6, offset: 25669},
+										pos:  position{line: 1082, col: 6, offset: 27164},
 										name: "FuncGuard",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1028, col: 16, offset: 25679},
+									pos:   position{line: 1082, col: 16, offset: 27174},
 									label: "fn",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1028, col: 19, offset: 25682},
+										pos:  position{line: 1082, col: 19, offset: 27177},
 										name: "Identifier",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1028, col: 30, offset: 25693},
+									pos:  position{line: 1082, col: 30, offset: 27188},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1028, col: 33, offset: 25696},
+									pos:        position{line: 1082, col: 33, offset: 27191},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1028, col: 37, offset: 25700},
+									pos:  position{line: 1082, col: 37, offset: 27195},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1028, col: 40, offset: 25703},
+									pos:   position{line: 1082, col: 40, offset: 27198},
 									label: "args",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1028, col: 45, offset: 25708},
+										pos:  position{line: 1082, col: 45, offset: 27203},
 										name: "FunctionArgs",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1028, col: 58, offset: 25721},
+									pos:  position{line: 1082, col: 58, offset: 27216},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1028, col: 61, offset: 25724},
+									pos:        position{line: 1082, col: 61, offset: 27219},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1028, col: 65, offset: 25728},
+									pos:   position{line: 1082, col: 65, offset: 27223},
 									label: "where",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1028, col: 71, offset: 25734},
+										pos: position{line: 1082, col: 71, offset: 27229},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1028, col: 71, offset: 25734},
+											pos:  position{line: 1082, col: 71, offset: 27229},
 											name: "WhereClause",
 										},
 									},
@@ -7156,15 +7638,15 @@ var g = &grammar{
 		},
 		{
 			name: "RegexpPrimitive",
-			pos:  position{line: 1032, col: 1, offset: 25802},
+			pos:  position{line: 1086, col: 1, offset: 27297},
 			expr: &actionExpr{
-				pos: position{line: 1033, col: 5, offset: 25822},
+				pos: position{line: 1087, col: 5, offset: 27317},
 				run: (*parser).callonRegexpPrimitive1,
 				expr: &labeledExpr{
-					pos:   position{line: 1033, col: 5, offset: 25822},
+					pos:   position{line: 1087, col: 5, offset: 27317},
 					label: "pat",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1033, col: 9, offset: 25826},
+						pos:  position{line: 1087, col: 9, offset: 27321},
 						name: "RegexpPattern",
 					},
 				},
@@ -7174,24 +7656,24 @@ var g = &grammar{
 		},
 		{
 			name: "FunctionArgs",
-			pos:  position{line: 1035, col: 1, offset: 25897},
+			pos:  position{line: 1089, col: 1, offset: 27392},
 			expr: &choiceExpr{
-				pos: position{line: 1036, col: 5, offset: 25914},
+				pos: position{line: 1090, col: 5, offset: 27409},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1036, col: 5, offset: 25914},
+						pos: position{line: 1090, col: 5, offset: 27409},
 						run: (*parser).callonFunctionArgs2,
 						expr: &labeledExpr{
-							pos:   position{line: 1036, col: 5, offset: 25914},
+							pos:   position{line: 1090, col: 5, offset: 27409},
 							label: "o",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1036, col: 7, offset: 25916},
+								pos:  position{line: 1090, col: 7, offset: 27411},
 								name: "OverExpr",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1037, col: 5, offset: 25954},
+						pos:  position{line: 1091, col: 5, offset: 27449},
 						name: "OptionalExprs",
 					},
 				},
@@ -7201,98 +7683,98 @@ var g = &grammar{
 		},
 		{
 			name: "Grep",
-			pos:  position{line: 1039, col: 1, offset: 25969},
+			pos:  position{line: 1093, col: 1, offset: 27464},
 			expr: &actionExpr{
-				pos: position{line: 1040, col: 5, offset: 25978},
+				pos: position{line: 1094, col: 5, offset: 27473},
 				run: (*parser).callonGrep1,
 				expr: &seqExpr{
-					pos: position{line: 1040, col: 5, offset: 25978},
+					pos: position{line: 1094, col: 5, offset: 27473},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1040, col: 5, offset: 25978},
+							pos:        position{line: 1094, col: 5, offset: 27473},
 							val:        "grep",
 							ignoreCase: false,
 							want:       "\"grep\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1040, col: 12, offset: 25985},
+							pos:  position{line: 1094, col: 12, offset: 27480},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1040, col: 15, offset: 25988},
+							pos:        position{line: 1094, col: 15, offset: 27483},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1040, col: 19, offset: 25992},
+							pos:  position{line: 1094, col: 19, offset: 27487},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1040, col: 22, offset: 25995},
+							pos:   position{line: 1094, col: 22, offset: 27490},
 							label: "pattern",
 							expr: &choiceExpr{
-								pos: position{line: 1040, col: 31, offset: 26004},
+								pos: position{line: 1094, col: 31, offset: 27499},
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 1040, col: 31, offset: 26004},
+										pos:  position{line: 1094, col: 31, offset: 27499},
 										name: "Regexp",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1040, col: 40, offset: 26013},
+										pos:  position{line: 1094, col: 40, offset: 27508},
 										name: "Glob",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1040, col: 47, offset: 26020},
+										pos:  position{line: 1094, col: 47, offset: 27515},
 										name: "Expr",
 									},
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1040, col: 53, offset: 26026},
+							pos:  position{line: 1094, col: 53, offset: 27521},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1040, col: 56, offset: 26029},
+							pos:   position{line: 1094, col: 56, offset: 27524},
 							label: "opt",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1040, col: 60, offset: 26033},
+								pos: position{line: 1094, col: 60, offset: 27528},
 								expr: &actionExpr{
-									pos: position{line: 1040, col: 61, offset: 26034},
+									pos: position{line: 1094, col: 61, offset: 27529},
 									run: (*parser).callonGrep15,
 									expr: &seqExpr{
-										pos: position{line: 1040, col: 61, offset: 26034},
+										pos: position{line: 1094, col: 61, offset: 27529},
 										exprs: []any{
 											&litMatcher{
-												pos:        position{line: 1040, col: 61, offset: 26034},
+												pos:        position{line: 1094, col: 61, offset: 27529},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1040, col: 65, offset: 26038},
+												pos:  position{line: 1094, col: 65, offset: 27533},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1040, col: 68, offset: 26041},
+												pos:   position{line: 1094, col: 68, offset: 27536},
 												label: "e",
 												expr: &choiceExpr{
-													pos: position{line: 1040, col: 71, offset: 26044},
+													pos: position{line: 1094, col: 71, offset: 27539},
 													alternatives: []any{
 														&ruleRefExpr{
-															pos:  position{line: 1040, col: 71, offset: 26044},
+															pos:  position{line: 1094, col: 71, offset: 27539},
 															name: "OverExpr",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1040, col: 82, offset: 26055},
+															pos:  position{line: 1094, col: 82, offset: 27550},
 															name: "Expr",
 														},
 													},
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1040, col: 88, offset: 26061},
+												pos:  position{line: 1094, col: 88, offset: 27556},
 												name: "__",
 											},
 										},
@@ -7301,7 +7783,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1040, col: 111, offset: 26084},
+							pos:        position{line: 1094, col: 111, offset: 27579},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -7314,19 +7796,19 @@ var g = &grammar{
 		},
 		{
 			name: "OptionalExprs",
-			pos:  position{line: 1053, col: 1, offset: 26345},
+			pos:  position{line: 1107, col: 1, offset: 27840},
 			expr: &choiceExpr{
-				pos: position{line: 1054, col: 5, offset: 26363},
+				pos: position{line: 1108, col: 5, offset: 27858},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1054, col: 5, offset: 26363},
+						pos:  position{line: 1108, col: 5, offset: 27858},
 						name: "Exprs",
 					},
 					&actionExpr{
-						pos: position{line: 1055, col: 5, offset: 26373},
+						pos: position{line: 1109, col: 5, offset: 27868},
 						run: (*parser).callonOptionalExprs3,
 						expr: &ruleRefExpr{
-							pos:  position{line: 1055, col: 5, offset: 26373},
+							pos:  position{line: 1109, col: 5, offset: 27868},
 							name: "__",
 						},
 					},
@@ -7337,51 +7819,51 @@ var g = &grammar{
 		},
 		{
 			name: "Exprs",
-			pos:  position{line: 1057, col: 1, offset: 26401},
+			pos:  position{line: 1111, col: 1, offset: 27896},
 			expr: &actionExpr{
-				pos: position{line: 1058, col: 5, offset: 26411},
+				pos: position{line: 1112, col: 5, offset: 27906},
 				run: (*parser).callonExprs1,
 				expr: &seqExpr{
-					pos: position{line: 1058, col: 5, offset: 26411},
+					pos: position{line: 1112, col: 5, offset: 27906},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1058, col: 5, offset: 26411},
+							pos:   position{line: 1112, col: 5, offset: 27906},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1058, col: 11, offset: 26417},
+								pos:  position{line: 1112, col: 11, offset: 27912},
 								name: "Expr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1058, col: 16, offset: 26422},
+							pos:   position{line: 1112, col: 16, offset: 27917},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1058, col: 21, offset: 26427},
+								pos: position{line: 1112, col: 21, offset: 27922},
 								expr: &actionExpr{
-									pos: position{line: 1058, col: 22, offset: 26428},
+									pos: position{line: 1112, col: 22, offset: 27923},
 									run: (*parser).callonExprs7,
 									expr: &seqExpr{
-										pos: position{line: 1058, col: 22, offset: 26428},
+										pos: position{line: 1112, col: 22, offset: 27923},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1058, col: 22, offset: 26428},
+												pos:  position{line: 1112, col: 22, offset: 27923},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 1058, col: 25, offset: 26431},
+												pos:        position{line: 1112, col: 25, offset: 27926},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1058, col: 29, offset: 26435},
+												pos:  position{line: 1112, col: 29, offset: 27930},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1058, col: 32, offset: 26438},
+												pos:   position{line: 1112, col: 32, offset: 27933},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1058, col: 34, offset: 26440},
+													pos:  position{line: 1112, col: 34, offset: 27935},
 													name: "Expr",
 												},
 											},
@@ -7398,64 +7880,64 @@ var g = &grammar{
 		},
 		{
 			name: "Primary",
-			pos:  position{line: 1062, col: 1, offset: 26513},
+			pos:  position{line: 1116, col: 1, offset: 28008},
 			expr: &choiceExpr{
-				pos: position{line: 1063, col: 5, offset: 26525},
+				pos: position{line: 1117, col: 5, offset: 28020},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1063, col: 5, offset: 26525},
+						pos:  position{line: 1117, col: 5, offset: 28020},
 						name: "Record",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1064, col: 5, offset: 26536},
+						pos:  position{line: 1118, col: 5, offset: 28031},
 						name: "Array",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1065, col: 5, offset: 26546},
+						pos:  position{line: 1119, col: 5, offset: 28041},
 						name: "Set",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1066, col: 5, offset: 26554},
+						pos:  position{line: 1120, col: 5, offset: 28049},
 						name: "Map",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1067, col: 5, offset: 26562},
+						pos:  position{line: 1121, col: 5, offset: 28057},
 						name: "Literal",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1068, col: 5, offset: 26574},
+						pos:  position{line: 1122, col: 5, offset: 28069},
 						name: "Identifier",
 					},
 					&actionExpr{
-						pos: position{line: 1069, col: 5, offset: 26589},
+						pos: position{line: 1123, col: 5, offset: 28084},
 						run: (*parser).callonPrimary8,
 						expr: &seqExpr{
-							pos: position{line: 1069, col: 5, offset: 26589},
+							pos: position{line: 1123, col: 5, offset: 28084},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1069, col: 5, offset: 26589},
+									pos:        position{line: 1123, col: 5, offset: 28084},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1069, col: 9, offset: 26593},
+									pos:  position{line: 1123, col: 9, offset: 28088},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1069, col: 12, offset: 26596},
+									pos:   position{line: 1123, col: 12, offset: 28091},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1069, col: 17, offset: 26601},
+										pos:  position{line: 1123, col: 17, offset: 28096},
 										name: "OverExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1069, col: 26, offset: 26610},
+									pos:  position{line: 1123, col: 26, offset: 28105},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1069, col: 29, offset: 26613},
+									pos:        position{line: 1123, col: 29, offset: 28108},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -7464,35 +7946,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1070, col: 5, offset: 26642},
+						pos: position{line: 1124, col: 5, offset: 28137},
 						run: (*parser).callonPrimary16,
 						expr: &seqExpr{
-							pos: position{line: 1070, col: 5, offset: 26642},
+							pos: position{line: 1124, col: 5, offset: 28137},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1070, col: 5, offset: 26642},
+									pos:        position{line: 1124, col: 5, offset: 28137},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1070, col: 9, offset: 26646},
+									pos:  position{line: 1124, col: 9, offset: 28141},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1070, col: 12, offset: 26649},
+									pos:   position{line: 1124, col: 12, offset: 28144},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1070, col: 17, offset: 26654},
+										pos:  position{line: 1124, col: 17, offset: 28149},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1070, col: 22, offset: 26659},
+									pos:  position{line: 1124, col: 22, offset: 28154},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1070, col: 25, offset: 26662},
+									pos:        position{line: 1124, col: 25, offset: 28157},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -7507,61 +7989,61 @@ var g = &grammar{
 		},
 		{
 			name: "OverExpr",
-			pos:  position{line: 1072, col: 1, offset: 26688},
+			pos:  position{line: 1126, col: 1, offset: 28183},
 			expr: &actionExpr{
-				pos: position{line: 1073, col: 5, offset: 26701},
+				pos: position{line: 1127, col: 5, offset: 28196},
 				run: (*parser).callonOverExpr1,
 				expr: &seqExpr{
-					pos: position{line: 1073, col: 5, offset: 26701},
+					pos: position{line: 1127, col: 5, offset: 28196},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1073, col: 5, offset: 26701},
+							pos:        position{line: 1127, col: 5, offset: 28196},
 							val:        "over",
 							ignoreCase: false,
 							want:       "\"over\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1073, col: 12, offset: 26708},
+							pos:  position{line: 1127, col: 12, offset: 28203},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 1073, col: 14, offset: 26710},
+							pos:   position{line: 1127, col: 14, offset: 28205},
 							label: "exprs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1073, col: 20, offset: 26716},
+								pos:  position{line: 1127, col: 20, offset: 28211},
 								name: "Exprs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1073, col: 26, offset: 26722},
+							pos:   position{line: 1127, col: 26, offset: 28217},
 							label: "locals",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1073, col: 33, offset: 26729},
+								pos: position{line: 1127, col: 33, offset: 28224},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1073, col: 33, offset: 26729},
+									pos:  position{line: 1127, col: 33, offset: 28224},
 									name: "Locals",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1073, col: 41, offset: 26737},
+							pos:  position{line: 1127, col: 41, offset: 28232},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1073, col: 44, offset: 26740},
+							pos:        position{line: 1127, col: 44, offset: 28235},
 							val:        "|",
 							ignoreCase: false,
 							want:       "\"|\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1073, col: 48, offset: 26744},
+							pos:  position{line: 1127, col: 48, offset: 28239},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1073, col: 51, offset: 26747},
+							pos:   position{line: 1127, col: 51, offset: 28242},
 							label: "body",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1073, col: 56, offset: 26752},
+								pos:  position{line: 1127, col: 56, offset: 28247},
 								name: "Seq",
 							},
 						},
@@ -7573,37 +8055,37 @@ var g = &grammar{
 		},
 		{
 			name: "Record",
-			pos:  position{line: 1083, col: 1, offset: 26996},
+			pos:  position{line: 1137, col: 1, offset: 28491},
 			expr: &actionExpr{
-				pos: position{line: 1084, col: 5, offset: 27007},
+				pos: position{line: 1138, col: 5, offset: 28502},
 				run: (*parser).callonRecord1,
 				expr: &seqExpr{
-					pos: position{line: 1084, col: 5, offset: 27007},
+					pos: position{line: 1138, col: 5, offset: 28502},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1084, col: 5, offset: 27007},
+							pos:        position{line: 1138, col: 5, offset: 28502},
 							val:        "{",
 							ignoreCase: false,
 							want:       "\"{\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1084, col: 9, offset: 27011},
+							pos:  position{line: 1138, col: 9, offset: 28506},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1084, col: 12, offset: 27014},
+							pos:   position{line: 1138, col: 12, offset: 28509},
 							label: "elems",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1084, col: 18, offset: 27020},
+								pos:  position{line: 1138, col: 18, offset: 28515},
 								name: "RecordElems",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1084, col: 30, offset: 27032},
+							pos:  position{line: 1138, col: 30, offset: 28527},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1084, col: 33, offset: 27035},
+							pos:        position{line: 1138, col: 33, offset: 28530},
 							val:        "}",
 							ignoreCase: false,
 							want:       "\"}\"",
@@ -7616,31 +8098,31 @@ var g = &grammar{
 		},
 		{
 			name: "RecordElems",
-			pos:  position{line: 1093, col: 1, offset: 27237},
+			pos:  position{line: 1147, col: 1, offset: 28732},
 			expr: &choiceExpr{
-				pos: position{line: 1094, col: 5, offset: 27253},
+				pos: position{line: 1148, col: 5, offset: 28748},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1094, col: 5, offset: 27253},
+						pos: position{line: 1148, col: 5, offset: 28748},
 						run: (*parser).callonRecordElems2,
 						expr: &seqExpr{
-							pos: position{line: 1094, col: 5, offset: 27253},
+							pos: position{line: 1148, col: 5, offset: 28748},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1094, col: 5, offset: 27253},
+									pos:   position{line: 1148, col: 5, offset: 28748},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1094, col: 11, offset: 27259},
+										pos:  position{line: 1148, col: 11, offset: 28754},
 										name: "RecordElem",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1094, col: 22, offset: 27270},
+									pos:   position{line: 1148, col: 22, offset: 28765},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1094, col: 27, offset: 27275},
+										pos: position{line: 1148, col: 27, offset: 28770},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1094, col: 27, offset: 27275},
+											pos:  position{line: 1148, col: 27, offset: 28770},
 											name: "RecordElemTail",
 										},
 									},
@@ -7649,10 +8131,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1097, col: 5, offset: 27338},
+						pos: position{line: 1151, col: 5, offset: 28833},
 						run: (*parser).callonRecordElems9,
 						expr: &ruleRefExpr{
-							pos:  position{line: 1097, col: 5, offset: 27338},
+							pos:  position{line: 1151, col: 5, offset: 28833},
 							name: "__",
 						},
 					},
@@ -7663,32 +8145,32 @@ var g = &grammar{
 		},
 		{
 			name: "RecordElemTail",
-			pos:  position{line: 1099, col: 1, offset: 27362},
+			pos:  position{line: 1153, col: 1, offset: 28857},
 			expr: &actionExpr{
-				pos: position{line: 1099, col: 18, offset: 27379},
+				pos: position{line: 1153, col: 18, offset: 28874},
 				run: (*parser).callonRecordElemTail1,
 				expr: &seqExpr{
-					pos: position{line: 1099, col: 18, offset: 27379},
+					pos: position{line: 1153, col: 18, offset: 28874},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1099, col: 18, offset: 27379},
+							pos:  position{line: 1153, col: 18, offset: 28874},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1099, col: 21, offset: 27382},
+							pos:        position{line: 1153, col: 21, offset: 28877},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1099, col: 25, offset: 27386},
+							pos:  position{line: 1153, col: 25, offset: 28881},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1099, col: 28, offset: 27389},
+							pos:   position{line: 1153, col: 28, offset: 28884},
 							label: "elem",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1099, col: 33, offset: 27394},
+								pos:  position{line: 1153, col: 33, offset: 28889},
 								name: "RecordElem",
 							},
 						},
@@ -7700,20 +8182,20 @@ var g = &grammar{
 		},
 		{
 			name: "RecordElem",
-			pos:  position{line: 1101, col: 1, offset: 27427},
+			pos:  position{line: 1155, col: 1, offset: 28922},
 			expr: &choiceExpr{
-				pos: position{line: 1102, col: 5, offset: 27442},
+				pos: position{line: 1156, col: 5, offset: 28937},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1102, col: 5, offset: 27442},
+						pos:  position{line: 1156, col: 5, offset: 28937},
 						name: "Spread",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1103, col: 5, offset: 27453},
+						pos:  position{line: 1157, col: 5, offset: 28948},
 						name: "Field",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1104, col: 5, offset: 27463},
+						pos:  position{line: 1158, col: 5, offset: 28958},
 						name: "Identifier",
 					},
 				},
@@ -7723,28 +8205,28 @@ var g = &grammar{
 		},
 		{
 			name: "Spread",
-			pos:  position{line: 1106, col: 1, offset: 27475},
+			pos:  position{line: 1160, col: 1, offset: 28970},
 			expr: &actionExpr{
-				pos: position{line: 1107, col: 5, offset: 27486},
+				pos: position{line: 1161, col: 5, offset: 28981},
 				run: (*parser).callonSpread1,
 				expr: &seqExpr{
-					pos: position{line: 1107, col: 5, offset: 27486},
+					pos: position{line: 1161, col: 5, offset: 28981},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1107, col: 5, offset: 27486},
+							pos:        position{line: 1161, col: 5, offset: 28981},
 							val:        "...",
 							ignoreCase: false,
 							want:       "\"...\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1107, col: 11, offset: 27492},
+							pos:  position{line: 1161, col: 11, offset: 28987},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1107, col: 14, offset: 27495},
+							pos:   position{line: 1161, col: 14, offset: 28990},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1107, col: 19, offset: 27500},
+								pos:  position{line: 1161, col: 19, offset: 28995},
 								name: "Expr",
 							},
 						},
@@ -7756,40 +8238,40 @@ var g = &grammar{
 		},
 		{
 			name: "Field",
-			pos:  position{line: 1111, col: 1, offset: 27607},
+			pos:  position{line: 1165, col: 1, offset: 29102},
 			expr: &actionExpr{
-				pos: position{line: 1112, col: 5, offset: 27617},
+				pos: position{line: 1166, col: 5, offset: 29112},
 				run: (*parser).callonField1,
 				expr: &seqExpr{
-					pos: position{line: 1112, col: 5, offset: 27617},
+					pos: position{line: 1166, col: 5, offset: 29112},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1112, col: 5, offset: 27617},
+							pos:   position{line: 1166, col: 5, offset: 29112},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1112, col: 10, offset: 27622},
+								pos:  position{line: 1166, col: 10, offset: 29117},
 								name: "FieldName",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1112, col: 20, offset: 27632},
+							pos:  position{line: 1166, col: 20, offset: 29127},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1112, col: 23, offset: 27635},
+							pos:        position{line: 1166, col: 23, offset: 29130},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1112, col: 27, offset: 27639},
+							pos:  position{line: 1166, col: 27, offset: 29134},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1112, col: 30, offset: 27642},
+							pos:   position{line: 1166, col: 30, offset: 29137},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1112, col: 36, offset: 27648},
+								pos:  position{line: 1166, col: 36, offset: 29143},
 								name: "Expr",
 							},
 						},
@@ -7801,37 +8283,37 @@ var g = &grammar{
 		},
 		{
 			name: "Array",
-			pos:  position{line: 1121, col: 1, offset: 27816},
+			pos:  position{line: 1175, col: 1, offset: 29311},
 			expr: &actionExpr{
-				pos: position{line: 1122, col: 5, offset: 27826},
+				pos: position{line: 1176, col: 5, offset: 29321},
 				run: (*parser).callonArray1,
 				expr: &seqExpr{
-					pos: position{line: 1122, col: 5, offset: 27826},
+					pos: position{line: 1176, col: 5, offset: 29321},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1122, col: 5, offset: 27826},
+							pos:        position{line: 1176, col: 5, offset: 29321},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1122, col: 9, offset: 27830},
+							pos:  position{line: 1176, col: 9, offset: 29325},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1122, col: 12, offset: 27833},
+							pos:   position{line: 1176, col: 12, offset: 29328},
 							label: "elems",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1122, col: 18, offset: 27839},
+								pos:  position{line: 1176, col: 18, offset: 29334},
 								name: "VectorElems",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1122, col: 30, offset: 27851},
+							pos:  position{line: 1176, col: 30, offset: 29346},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1122, col: 33, offset: 27854},
+							pos:        position{line: 1176, col: 33, offset: 29349},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
@@ -7844,37 +8326,37 @@ var g = &grammar{
 		},
 		{
 			name: "Set",
-			pos:  position{line: 1131, col: 1, offset: 28054},
+			pos:  position{line: 1185, col: 1, offset: 29549},
 			expr: &actionExpr{
-				pos: position{line: 1132, col: 5, offset: 28062},
+				pos: position{line: 1186, col: 5, offset: 29557},
 				run: (*parser).callonSet1,
 				expr: &seqExpr{
-					pos: position{line: 1132, col: 5, offset: 28062},
+					pos: position{line: 1186, col: 5, offset: 29557},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1132, col: 5, offset: 28062},
+							pos:        position{line: 1186, col: 5, offset: 29557},
 							val:        "|[",
 							ignoreCase: false,
 							want:       "\"|[\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1132, col: 10, offset: 28067},
+							pos:  position{line: 1186, col: 10, offset: 29562},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1132, col: 13, offset: 28070},
+							pos:   position{line: 1186, col: 13, offset: 29565},
 							label: "elems",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1132, col: 19, offset: 28076},
+								pos:  position{line: 1186, col: 19, offset: 29571},
 								name: "VectorElems",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1132, col: 31, offset: 28088},
+							pos:  position{line: 1186, col: 31, offset: 29583},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1132, col: 34, offset: 28091},
+							pos:        position{line: 1186, col: 34, offset: 29586},
 							val:        "]|",
 							ignoreCase: false,
 							want:       "\"]|\"",
@@ -7887,54 +8369,54 @@ var g = &grammar{
 		},
 		{
 			name: "VectorElems",
-			pos:  position{line: 1141, col: 1, offset: 28286},
+			pos:  position{line: 1195, col: 1, offset: 29781},
 			expr: &choiceExpr{
-				pos: position{line: 1142, col: 5, offset: 28302},
+				pos: position{line: 1196, col: 5, offset: 29797},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1142, col: 5, offset: 28302},
+						pos: position{line: 1196, col: 5, offset: 29797},
 						run: (*parser).callonVectorElems2,
 						expr: &seqExpr{
-							pos: position{line: 1142, col: 5, offset: 28302},
+							pos: position{line: 1196, col: 5, offset: 29797},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1142, col: 5, offset: 28302},
+									pos:   position{line: 1196, col: 5, offset: 29797},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1142, col: 11, offset: 28308},
+										pos:  position{line: 1196, col: 11, offset: 29803},
 										name: "VectorElem",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1142, col: 22, offset: 28319},
+									pos:   position{line: 1196, col: 22, offset: 29814},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1142, col: 27, offset: 28324},
+										pos: position{line: 1196, col: 27, offset: 29819},
 										expr: &actionExpr{
-											pos: position{line: 1142, col: 28, offset: 28325},
+											pos: position{line: 1196, col: 28, offset: 29820},
 											run: (*parser).callonVectorElems8,
 											expr: &seqExpr{
-												pos: position{line: 1142, col: 28, offset: 28325},
+												pos: position{line: 1196, col: 28, offset: 29820},
 												exprs: []any{
 													&ruleRefExpr{
-														pos:  position{line: 1142, col: 28, offset: 28325},
+														pos:  position{line: 1196, col: 28, offset: 29820},
 														name: "__",
 													},
 													&litMatcher{
-														pos:        position{line: 1142, col: 31, offset: 28328},
+														pos:        position{line: 1196, col: 31, offset: 29823},
 														val:        ",",
 														ignoreCase: false,
 														want:       "\",\"",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 1142, col: 35, offset: 28332},
+														pos:  position{line: 1196, col: 35, offset: 29827},
 														name: "__",
 													},
 													&labeledExpr{
-														pos:   position{line: 1142, col: 38, offset: 28335},
+														pos:   position{line: 1196, col: 38, offset: 29830},
 														label: "e",
 														expr: &ruleRefExpr{
-															pos:  position{line: 1142, col: 40, offset: 28337},
+															pos:  position{line: 1196, col: 40, offset: 29832},
 															name: "VectorElem",
 														},
 													},
@@ -7947,10 +8429,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1145, col: 5, offset: 28419},
+						pos: position{line: 1199, col: 5, offset: 29914},
 						run: (*parser).callonVectorElems15,
 						expr: &ruleRefExpr{
-							pos:  position{line: 1145, col: 5, offset: 28419},
+							pos:  position{line: 1199, col: 5, offset: 29914},
 							name: "__",
 						},
 					},
@@ -7961,22 +8443,22 @@ var g = &grammar{
 		},
 		{
 			name: "VectorElem",
-			pos:  position{line: 1147, col: 1, offset: 28443},
+			pos:  position{line: 1201, col: 1, offset: 29938},
 			expr: &choiceExpr{
-				pos: position{line: 1148, col: 5, offset: 28458},
+				pos: position{line: 1202, col: 5, offset: 29953},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1148, col: 5, offset: 28458},
+						pos:  position{line: 1202, col: 5, offset: 29953},
 						name: "Spread",
 					},
 					&actionExpr{
-						pos: position{line: 1149, col: 5, offset: 28469},
+						pos: position{line: 1203, col: 5, offset: 29964},
 						run: (*parser).callonVectorElem3,
 						expr: &labeledExpr{
-							pos:   position{line: 1149, col: 5, offset: 28469},
+							pos:   position{line: 1203, col: 5, offset: 29964},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1149, col: 7, offset: 28471},
+								pos:  position{line: 1203, col: 7, offset: 29966},
 								name: "Expr",
 							},
 						},
@@ -7988,37 +8470,37 @@ var g = &grammar{
 		},
 		{
 			name: "Map",
-			pos:  position{line: 1151, col: 1, offset: 28551},
+			pos:  position{line: 1205, col: 1, offset: 30046},
 			expr: &actionExpr{
-				pos: position{line: 1152, col: 5, offset: 28559},
+				pos: position{line: 1206, col: 5, offset: 30054},
 				run: (*parser).callonMap1,
 				expr: &seqExpr{
-					pos: position{line: 1152, col: 5, offset: 28559},
+					pos: position{line: 1206, col: 5, offset: 30054},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1152, col: 5, offset: 28559},
+							pos:        position{line: 1206, col: 5, offset: 30054},
 							val:        "|{",
 							ignoreCase: false,
 							want:       "\"|{\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1152, col: 10, offset: 28564},
+							pos:  position{line: 1206, col: 10, offset: 30059},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1152, col: 13, offset: 28567},
+							pos:   position{line: 1206, col: 13, offset: 30062},
 							label: "exprs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1152, col: 19, offset: 28573},
+								pos:  position{line: 1206, col: 19, offset: 30068},
 								name: "Entries",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1152, col: 27, offset: 28581},
+							pos:  position{line: 1206, col: 27, offset: 30076},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1152, col: 30, offset: 28584},
+							pos:        position{line: 1206, col: 30, offset: 30079},
 							val:        "}|",
 							ignoreCase: false,
 							want:       "\"}|\"",
@@ -8031,31 +8513,31 @@ var g = &grammar{
 		},
 		{
 			name: "Entries",
-			pos:  position{line: 1161, col: 1, offset: 28780},
+			pos:  position{line: 1215, col: 1, offset: 30275},
 			expr: &choiceExpr{
-				pos: position{line: 1162, col: 5, offset: 28792},
+				pos: position{line: 1216, col: 5, offset: 30287},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1162, col: 5, offset: 28792},
+						pos: position{line: 1216, col: 5, offset: 30287},
 						run: (*parser).callonEntries2,
 						expr: &seqExpr{
-							pos: position{line: 1162, col: 5, offset: 28792},
+							pos: position{line: 1216, col: 5, offset: 30287},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1162, col: 5, offset: 28792},
+									pos:   position{line: 1216, col: 5, offset: 30287},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1162, col: 11, offset: 28798},
+										pos:  position{line: 1216, col: 11, offset: 30293},
 										name: "Entry",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1162, col: 17, offset: 28804},
+									pos:   position{line: 1216, col: 17, offset: 30299},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1162, col: 22, offset: 28809},
+										pos: position{line: 1216, col: 22, offset: 30304},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1162, col: 22, offset: 28809},
+											pos:  position{line: 1216, col: 22, offset: 30304},
 											name: "EntryTail",
 										},
 									},
@@ -8064,10 +8546,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1165, col: 5, offset: 28867},
+						pos: position{line: 1219, col: 5, offset: 30362},
 						run: (*parser).callonEntries9,
 						expr: &ruleRefExpr{
-							pos:  position{line: 1165, col: 5, offset: 28867},
+							pos:  position{line: 1219, col: 5, offset: 30362},
 							name: "__",
 						},
 					},
@@ -8078,32 +8560,32 @@ var g = &grammar{
 		},
 		{
 			name: "EntryTail",
-			pos:  position{line: 1168, col: 1, offset: 28892},
+			pos:  position{line: 1222, col: 1, offset: 30387},
 			expr: &actionExpr{
-				pos: position{line: 1168, col: 13, offset: 28904},
+				pos: position{line: 1222, col: 13, offset: 30399},
 				run: (*parser).callonEntryTail1,
 				expr: &seqExpr{
-					pos: position{line: 1168, col: 13, offset: 28904},
+					pos: position{line: 1222, col: 13, offset: 30399},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1168, col: 13, offset: 28904},
+							pos:  position{line: 1222, col: 13, offset: 30399},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1168, col: 16, offset: 28907},
+							pos:        position{line: 1222, col: 16, offset: 30402},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1168, col: 20, offset: 28911},
+							pos:  position{line: 1222, col: 20, offset: 30406},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1168, col: 23, offset: 28914},
+							pos:   position{line: 1222, col: 23, offset: 30409},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1168, col: 25, offset: 28916},
+								pos:  position{line: 1222, col: 25, offset: 30411},
 								name: "Entry",
 							},
 						},
@@ -8115,40 +8597,40 @@ var g = &grammar{
 		},
 		{
 			name: "Entry",
-			pos:  position{line: 1170, col: 1, offset: 28941},
+			pos:  position{line: 1224, col: 1, offset: 30436},
 			expr: &actionExpr{
-				pos: position{line: 1171, col: 5, offset: 28951},
+				pos: position{line: 1225, col: 5, offset: 30446},
 				run: (*parser).callonEntry1,
 				expr: &seqExpr{
-					pos: position{line: 1171, col: 5, offset: 28951},
+					pos: position{line: 1225, col: 5, offset: 30446},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1171, col: 5, offset: 28951},
+							pos:   position{line: 1225, col: 5, offset: 30446},
 							label: "key",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1171, col: 9, offset: 28955},
+								pos:  position{line: 1225, col: 9, offset: 30450},
 								name: "Expr",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1171, col: 14, offset: 28960},
+							pos:  position{line: 1225, col: 14, offset: 30455},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1171, col: 17, offset: 28963},
+							pos:        position{line: 1225, col: 17, offset: 30458},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1171, col: 21, offset: 28967},
+							pos:  position{line: 1225, col: 21, offset: 30462},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1171, col: 24, offset: 28970},
+							pos:   position{line: 1225, col: 24, offset: 30465},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1171, col: 30, offset: 28976},
+								pos:  position{line: 1225, col: 30, offset: 30471},
 								name: "Expr",
 							},
 						},
@@ -8160,56 +8642,56 @@ var g = &grammar{
 		},
 		{
 			name: "Literal",
-			pos:  position{line: 1177, col: 1, offset: 29090},
+			pos:  position{line: 1231, col: 1, offset: 30585},
 			expr: &choiceExpr{
-				pos: position{line: 1178, col: 5, offset: 29102},
+				pos: position{line: 1232, col: 5, offset: 30597},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1178, col: 5, offset: 29102},
+						pos:  position{line: 1232, col: 5, offset: 30597},
 						name: "TypeLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1179, col: 5, offset: 29118},
+						pos:  position{line: 1233, col: 5, offset: 30613},
 						name: "StringLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1180, col: 5, offset: 29136},
+						pos:  position{line: 1234, col: 5, offset: 30631},
 						name: "FString",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1181, col: 5, offset: 29148},
+						pos:  position{line: 1235, col: 5, offset: 30643},
 						name: "SubnetLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1182, col: 5, offset: 29166},
+						pos:  position{line: 1236, col: 5, offset: 30661},
 						name: "AddressLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1183, col: 5, offset: 29185},
+						pos:  position{line: 1237, col: 5, offset: 30680},
 						name: "BytesLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1184, col: 5, offset: 29202},
+						pos:  position{line: 1238, col: 5, offset: 30697},
 						name: "Duration",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1185, col: 5, offset: 29215},
+						pos:  position{line: 1239, col: 5, offset: 30710},
 						name: "Time",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1186, col: 5, offset: 29224},
+						pos:  position{line: 1240, col: 5, offset: 30719},
 						name: "FloatLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1187, col: 5, offset: 29241},
+						pos:  position{line: 1241, col: 5, offset: 30736},
 						name: "IntegerLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1188, col: 5, offset: 29260},
+						pos:  position{line: 1242, col: 5, offset: 30755},
 						name: "BooleanLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1189, col: 5, offset: 29279},
+						pos:  position{line: 1243, col: 5, offset: 30774},
 						name: "NullLiteral",
 					},
 				},
@@ -8219,28 +8701,28 @@ var g = &grammar{
 		},
 		{
 			name: "SubnetLiteral",
-			pos:  position{line: 1191, col: 1, offset: 29292},
+			pos:  position{line: 1245, col: 1, offset: 30787},
 			expr: &choiceExpr{
-				pos: position{line: 1192, col: 5, offset: 29310},
+				pos: position{line: 1246, col: 5, offset: 30805},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1192, col: 5, offset: 29310},
+						pos: position{line: 1246, col: 5, offset: 30805},
 						run: (*parser).callonSubnetLiteral2,
 						expr: &seqExpr{
-							pos: position{line: 1192, col: 5, offset: 29310},
+							pos: position{line: 1246, col: 5, offset: 30805},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1192, col: 5, offset: 29310},
+									pos:   position{line: 1246, col: 5, offset: 30805},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1192, col: 7, offset: 29312},
+										pos:  position{line: 1246, col: 7, offset: 30807},
 										name: "IP6Net",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1192, col: 14, offset: 29319},
+									pos: position{line: 1246, col: 14, offset: 30814},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1192, col: 15, offset: 29320},
+										pos:  position{line: 1246, col: 15, offset: 30815},
 										name: "IdentifierRest",
 									},
 								},
@@ -8248,13 +8730,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1195, col: 5, offset: 29400},
+						pos: position{line: 1249, col: 5, offset: 30895},
 						run: (*parser).callonSubnetLiteral8,
 						expr: &labeledExpr{
-							pos:   position{line: 1195, col: 5, offset: 29400},
+							pos:   position{line: 1249, col: 5, offset: 30895},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1195, col: 7, offset: 29402},
+								pos:  position{line: 1249, col: 7, offset: 30897},
 								name: "IP4Net",
 							},
 						},
@@ -8266,28 +8748,28 @@ var g = &grammar{
 		},
 		{
 			name: "AddressLiteral",
-			pos:  position{line: 1199, col: 1, offset: 29471},
+			pos:  position{line: 1253, col: 1, offset: 30966},
 			expr: &choiceExpr{
-				pos: position{line: 1200, col: 5, offset: 29490},
+				pos: position{line: 1254, col: 5, offset: 30985},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1200, col: 5, offset: 29490},
+						pos: position{line: 1254, col: 5, offset: 30985},
 						run: (*parser).callonAddressLiteral2,
 						expr: &seqExpr{
-							pos: position{line: 1200, col: 5, offset: 29490},
+							pos: position{line: 1254, col: 5, offset: 30985},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1200, col: 5, offset: 29490},
+									pos:   position{line: 1254, col: 5, offset: 30985},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1200, col: 7, offset: 29492},
+										pos:  position{line: 1254, col: 7, offset: 30987},
 										name: "IP6",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1200, col: 11, offset: 29496},
+									pos: position{line: 1254, col: 11, offset: 30991},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1200, col: 12, offset: 29497},
+										pos:  position{line: 1254, col: 12, offset: 30992},
 										name: "IdentifierRest",
 									},
 								},
@@ -8295,13 +8777,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1203, col: 5, offset: 29576},
+						pos: position{line: 1257, col: 5, offset: 31071},
 						run: (*parser).callonAddressLiteral8,
 						expr: &labeledExpr{
-							pos:   position{line: 1203, col: 5, offset: 29576},
+							pos:   position{line: 1257, col: 5, offset: 31071},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1203, col: 7, offset: 29578},
+								pos:  position{line: 1257, col: 7, offset: 31073},
 								name: "IP",
 							},
 						},
@@ -8313,15 +8795,15 @@ var g = &grammar{
 		},
 		{
 			name: "FloatLiteral",
-			pos:  position{line: 1207, col: 1, offset: 29642},
+			pos:  position{line: 1261, col: 1, offset: 31137},
 			expr: &actionExpr{
-				pos: position{line: 1208, col: 5, offset: 29659},
+				pos: position{line: 1262, col: 5, offset: 31154},
 				run: (*parser).callonFloatLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 1208, col: 5, offset: 29659},
+					pos:   position{line: 1262, col: 5, offset: 31154},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1208, col: 7, offset: 29661},
+						pos:  position{line: 1262, col: 7, offset: 31156},
 						name: "FloatString",
 					},
 				},
@@ -8331,15 +8813,15 @@ var g = &grammar{
 		},
 		{
 			name: "IntegerLiteral",
-			pos:  position{line: 1212, col: 1, offset: 29739},
+			pos:  position{line: 1266, col: 1, offset: 31234},
 			expr: &actionExpr{
-				pos: position{line: 1213, col: 5, offset: 29758},
+				pos: position{line: 1267, col: 5, offset: 31253},
 				run: (*parser).callonIntegerLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 1213, col: 5, offset: 29758},
+					pos:   position{line: 1267, col: 5, offset: 31253},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1213, col: 7, offset: 29760},
+						pos:  position{line: 1267, col: 7, offset: 31255},
 						name: "IntString",
 					},
 				},
@@ -8349,23 +8831,23 @@ var g = &grammar{
 		},
 		{
 			name: "BooleanLiteral",
-			pos:  position{line: 1217, col: 1, offset: 29834},
+			pos:  position{line: 1271, col: 1, offset: 31329},
 			expr: &choiceExpr{
-				pos: position{line: 1218, col: 5, offset: 29853},
+				pos: position{line: 1272, col: 5, offset: 31348},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1218, col: 5, offset: 29853},
+						pos: position{line: 1272, col: 5, offset: 31348},
 						run: (*parser).callonBooleanLiteral2,
 						expr: &ruleRefExpr{
-							pos:  position{line: 1218, col: 5, offset: 29853},
+							pos:  position{line: 1272, col: 5, offset: 31348},
 							name: "TrueToken",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1219, col: 5, offset: 29916},
+						pos: position{line: 1273, col: 5, offset: 31411},
 						run: (*parser).callonBooleanLiteral4,
 						expr: &ruleRefExpr{
-							pos:  position{line: 1219, col: 5, offset: 29916},
+							pos:  position{line: 1273, col: 5, offset: 31411},
 							name: "FalseToken",
 						},
 					},
@@ -8376,12 +8858,12 @@ var g = &grammar{
 		},
 		{
 			name: "NullLiteral",
-			pos:  position{line: 1221, col: 1, offset: 29977},
+			pos:  position{line: 1275, col: 1, offset: 31472},
 			expr: &actionExpr{
-				pos: position{line: 1222, col: 5, offset: 29993},
+				pos: position{line: 1276, col: 5, offset: 31488},
 				run: (*parser).callonNullLiteral1,
 				expr: &ruleRefExpr{
-					pos:  position{line: 1222, col: 5, offset: 29993},
+					pos:  position{line: 1276, col: 5, offset: 31488},
 					name: "NullToken",
 				},
 			},
@@ -8390,23 +8872,23 @@ var g = &grammar{
 		},
 		{
 			name: "BytesLiteral",
-			pos:  position{line: 1224, col: 1, offset: 30048},
+			pos:  position{line: 1278, col: 1, offset: 31543},
 			expr: &actionExpr{
-				pos: position{line: 1225, col: 5, offset: 30065},
+				pos: position{line: 1279, col: 5, offset: 31560},
 				run: (*parser).callonBytesLiteral1,
 				expr: &seqExpr{
-					pos: position{line: 1225, col: 5, offset: 30065},
+					pos: position{line: 1279, col: 5, offset: 31560},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1225, col: 5, offset: 30065},
+							pos:        position{line: 1279, col: 5, offset: 31560},
 							val:        "0x",
 							ignoreCase: false,
 							want:       "\"0x\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1225, col: 10, offset: 30070},
+							pos: position{line: 1279, col: 10, offset: 31565},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1225, col: 10, offset: 30070},
+								pos:  position{line: 1279, col: 10, offset: 31565},
 								name: "HexDigit",
 							},
 						},
@@ -8418,29 +8900,29 @@ var g = &grammar{
 		},
 		{
 			name: "TypeLiteral",
-			pos:  position{line: 1229, col: 1, offset: 30144},
+			pos:  position{line: 1283, col: 1, offset: 31639},
 			expr: &actionExpr{
-				pos: position{line: 1230, col: 5, offset: 30160},
+				pos: position{line: 1284, col: 5, offset: 31655},
 				run: (*parser).callonTypeLiteral1,
 				expr: &seqExpr{
-					pos: position{line: 1230, col: 5, offset: 30160},
+					pos: position{line: 1284, col: 5, offset: 31655},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1230, col: 5, offset: 30160},
+							pos:        position{line: 1284, col: 5, offset: 31655},
 							val:        "<",
 							ignoreCase: false,
 							want:       "\"<\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1230, col: 9, offset: 30164},
+							pos:   position{line: 1284, col: 9, offset: 31659},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1230, col: 13, offset: 30168},
+								pos:  position{line: 1284, col: 13, offset: 31663},
 								name: "Type",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1230, col: 18, offset: 30173},
+							pos:        position{line: 1284, col: 18, offset: 31668},
 							val:        ">",
 							ignoreCase: false,
 							want:       "\">\"",
@@ -8453,16 +8935,16 @@ var g = &grammar{
 		},
 		{
 			name: "Type",
-			pos:  position{line: 1239, col: 1, offset: 30354},
+			pos:  position{line: 1293, col: 1, offset: 31849},
 			expr: &choiceExpr{
-				pos: position{line: 1240, col: 5, offset: 30363},
+				pos: position{line: 1294, col: 5, offset: 31858},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1240, col: 5, offset: 30363},
+						pos:  position{line: 1294, col: 5, offset: 31858},
 						name: "AmbiguousType",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1241, col: 5, offset: 30381},
+						pos:  position{line: 1295, col: 5, offset: 31876},
 						name: "ComplexType",
 					},
 				},
@@ -8472,28 +8954,28 @@ var g = &grammar{
 		},
 		{
 			name: "AmbiguousType",
-			pos:  position{line: 1243, col: 1, offset: 30394},
+			pos:  position{line: 1297, col: 1, offset: 31889},
 			expr: &choiceExpr{
-				pos: position{line: 1244, col: 5, offset: 30412},
+				pos: position{line: 1298, col: 5, offset: 31907},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1244, col: 5, offset: 30412},
+						pos: position{line: 1298, col: 5, offset: 31907},
 						run: (*parser).callonAmbiguousType2,
 						expr: &seqExpr{
-							pos: position{line: 1244, col: 5, offset: 30412},
+							pos: position{line: 1298, col: 5, offset: 31907},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1244, col: 5, offset: 30412},
+									pos:   position{line: 1298, col: 5, offset: 31907},
 									label: "name",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1244, col: 10, offset: 30417},
+										pos:  position{line: 1298, col: 10, offset: 31912},
 										name: "PrimitiveType",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1244, col: 24, offset: 30431},
+									pos: position{line: 1298, col: 24, offset: 31926},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1244, col: 25, offset: 30432},
+										pos:  position{line: 1298, col: 25, offset: 31927},
 										name: "IdentifierRest",
 									},
 								},
@@ -8501,45 +8983,45 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1245, col: 5, offset: 30472},
+						pos: position{line: 1299, col: 5, offset: 31967},
 						run: (*parser).callonAmbiguousType8,
 						expr: &seqExpr{
-							pos: position{line: 1245, col: 5, offset: 30472},
+							pos: position{line: 1299, col: 5, offset: 31967},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1245, col: 5, offset: 30472},
+									pos:        position{line: 1299, col: 5, offset: 31967},
 									val:        "error",
 									ignoreCase: false,
 									want:       "\"error\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1245, col: 13, offset: 30480},
+									pos:  position{line: 1299, col: 13, offset: 31975},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1245, col: 16, offset: 30483},
+									pos:        position{line: 1299, col: 16, offset: 31978},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1245, col: 20, offset: 30487},
+									pos:  position{line: 1299, col: 20, offset: 31982},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1245, col: 23, offset: 30490},
+									pos:   position{line: 1299, col: 23, offset: 31985},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1245, col: 25, offset: 30492},
+										pos:  position{line: 1299, col: 25, offset: 31987},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1245, col: 30, offset: 30497},
+									pos:  position{line: 1299, col: 30, offset: 31992},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1245, col: 33, offset: 30500},
+									pos:        position{line: 1299, col: 33, offset: 31995},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -8548,52 +9030,52 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1253, col: 5, offset: 30694},
+						pos: position{line: 1307, col: 5, offset: 32189},
 						run: (*parser).callonAmbiguousType18,
 						expr: &seqExpr{
-							pos: position{line: 1253, col: 5, offset: 30694},
+							pos: position{line: 1307, col: 5, offset: 32189},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1253, col: 5, offset: 30694},
+									pos:   position{line: 1307, col: 5, offset: 32189},
 									label: "name",
 									expr: &choiceExpr{
-										pos: position{line: 1253, col: 11, offset: 30700},
+										pos: position{line: 1307, col: 11, offset: 32195},
 										alternatives: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1253, col: 11, offset: 30700},
+												pos:  position{line: 1307, col: 11, offset: 32195},
 												name: "IdentifierName",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1253, col: 28, offset: 30717},
+												pos:  position{line: 1307, col: 28, offset: 32212},
 												name: "QuotedString",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1253, col: 42, offset: 30731},
+									pos:   position{line: 1307, col: 42, offset: 32226},
 									label: "opt",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1253, col: 46, offset: 30735},
+										pos: position{line: 1307, col: 46, offset: 32230},
 										expr: &seqExpr{
-											pos: position{line: 1253, col: 47, offset: 30736},
+											pos: position{line: 1307, col: 47, offset: 32231},
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 1253, col: 47, offset: 30736},
+													pos:  position{line: 1307, col: 47, offset: 32231},
 													name: "__",
 												},
 												&litMatcher{
-													pos:        position{line: 1253, col: 50, offset: 30739},
+													pos:        position{line: 1307, col: 50, offset: 32234},
 													val:        "=",
 													ignoreCase: false,
 													want:       "\"=\"",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1253, col: 54, offset: 30743},
+													pos:  position{line: 1307, col: 54, offset: 32238},
 													name: "__",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1253, col: 57, offset: 30746},
+													pos:  position{line: 1307, col: 57, offset: 32241},
 													name: "Type",
 												},
 											},
@@ -8604,31 +9086,31 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1264, col: 5, offset: 31084},
+						pos: position{line: 1318, col: 5, offset: 32579},
 						run: (*parser).callonAmbiguousType31,
 						expr: &seqExpr{
-							pos: position{line: 1264, col: 5, offset: 31084},
+							pos: position{line: 1318, col: 5, offset: 32579},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1264, col: 5, offset: 31084},
+									pos:        position{line: 1318, col: 5, offset: 32579},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1264, col: 9, offset: 31088},
+									pos:  position{line: 1318, col: 9, offset: 32583},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1264, col: 12, offset: 31091},
+									pos:   position{line: 1318, col: 12, offset: 32586},
 									label: "types",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1264, col: 18, offset: 31097},
+										pos:  position{line: 1318, col: 18, offset: 32592},
 										name: "TypeList",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1264, col: 27, offset: 31106},
+									pos:        position{line: 1318, col: 27, offset: 32601},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -8643,28 +9125,28 @@ var g = &grammar{
 		},
 		{
 			name: "TypeList",
-			pos:  position{line: 1273, col: 1, offset: 31298},
+			pos:  position{line: 1327, col: 1, offset: 32793},
 			expr: &actionExpr{
-				pos: position{line: 1274, col: 5, offset: 31311},
+				pos: position{line: 1328, col: 5, offset: 32806},
 				run: (*parser).callonTypeList1,
 				expr: &seqExpr{
-					pos: position{line: 1274, col: 5, offset: 31311},
+					pos: position{line: 1328, col: 5, offset: 32806},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1274, col: 5, offset: 31311},
+							pos:   position{line: 1328, col: 5, offset: 32806},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1274, col: 11, offset: 31317},
+								pos:  position{line: 1328, col: 11, offset: 32812},
 								name: "Type",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1274, col: 16, offset: 31322},
+							pos:   position{line: 1328, col: 16, offset: 32817},
 							label: "rest",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1274, col: 21, offset: 31327},
+								pos: position{line: 1328, col: 21, offset: 32822},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1274, col: 21, offset: 31327},
+									pos:  position{line: 1328, col: 21, offset: 32822},
 									name: "TypeListTail",
 								},
 							},
@@ -8677,32 +9159,32 @@ var g = &grammar{
 		},
 		{
 			name: "TypeListTail",
-			pos:  position{line: 1278, col: 1, offset: 31385},
+			pos:  position{line: 1332, col: 1, offset: 32880},
 			expr: &actionExpr{
-				pos: position{line: 1278, col: 16, offset: 31400},
+				pos: position{line: 1332, col: 16, offset: 32895},
 				run: (*parser).callonTypeListTail1,
 				expr: &seqExpr{
-					pos: position{line: 1278, col: 16, offset: 31400},
+					pos: position{line: 1332, col: 16, offset: 32895},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1278, col: 16, offset: 31400},
+							pos:  position{line: 1332, col: 16, offset: 32895},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1278, col: 19, offset: 31403},
+							pos:        position{line: 1332, col: 19, offset: 32898},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1278, col: 23, offset: 31407},
+							pos:  position{line: 1332, col: 23, offset: 32902},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1278, col: 26, offset: 31410},
+							pos:   position{line: 1332, col: 26, offset: 32905},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1278, col: 30, offset: 31414},
+								pos:  position{line: 1332, col: 30, offset: 32909},
 								name: "Type",
 							},
 						},
@@ -8714,40 +9196,40 @@ var g = &grammar{
 		},
 		{
 			name: "ComplexType",
-			pos:  position{line: 1280, col: 1, offset: 31440},
+			pos:  position{line: 1334, col: 1, offset: 32935},
 			expr: &choiceExpr{
-				pos: position{line: 1281, col: 5, offset: 31456},
+				pos: position{line: 1335, col: 5, offset: 32951},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1281, col: 5, offset: 31456},
+						pos: position{line: 1335, col: 5, offset: 32951},
 						run: (*parser).callonComplexType2,
 						expr: &seqExpr{
-							pos: position{line: 1281, col: 5, offset: 31456},
+							pos: position{line: 1335, col: 5, offset: 32951},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1281, col: 5, offset: 31456},
+									pos:        position{line: 1335, col: 5, offset: 32951},
 									val:        "{",
 									ignoreCase: false,
 									want:       "\"{\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1281, col: 9, offset: 31460},
+									pos:  position{line: 1335, col: 9, offset: 32955},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1281, col: 12, offset: 31463},
+									pos:   position{line: 1335, col: 12, offset: 32958},
 									label: "fields",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1281, col: 19, offset: 31470},
+										pos:  position{line: 1335, col: 19, offset: 32965},
 										name: "TypeFieldList",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1281, col: 33, offset: 31484},
+									pos:  position{line: 1335, col: 33, offset: 32979},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1281, col: 36, offset: 31487},
+									pos:        position{line: 1335, col: 36, offset: 32982},
 									val:        "}",
 									ignoreCase: false,
 									want:       "\"}\"",
@@ -8756,35 +9238,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1289, col: 5, offset: 31699},
+						pos: position{line: 1343, col: 5, offset: 33194},
 						run: (*parser).callonComplexType10,
 						expr: &seqExpr{
-							pos: position{line: 1289, col: 5, offset: 31699},
+							pos: position{line: 1343, col: 5, offset: 33194},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1289, col: 5, offset: 31699},
+									pos:        position{line: 1343, col: 5, offset: 33194},
 									val:        "[",
 									ignoreCase: false,
 									want:       "\"[\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1289, col: 9, offset: 31703},
+									pos:  position{line: 1343, col: 9, offset: 33198},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1289, col: 12, offset: 31706},
+									pos:   position{line: 1343, col: 12, offset: 33201},
 									label: "typ",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1289, col: 16, offset: 31710},
+										pos:  position{line: 1343, col: 16, offset: 33205},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1289, col: 21, offset: 31715},
+									pos:  position{line: 1343, col: 21, offset: 33210},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1289, col: 24, offset: 31718},
+									pos:        position{line: 1343, col: 24, offset: 33213},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -8793,35 +9275,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1297, col: 5, offset: 31910},
+						pos: position{line: 1351, col: 5, offset: 33405},
 						run: (*parser).callonComplexType18,
 						expr: &seqExpr{
-							pos: position{line: 1297, col: 5, offset: 31910},
+							pos: position{line: 1351, col: 5, offset: 33405},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1297, col: 5, offset: 31910},
+									pos:        position{line: 1351, col: 5, offset: 33405},
 									val:        "|[",
 									ignoreCase: false,
 									want:       "\"|[\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1297, col: 10, offset: 31915},
+									pos:  position{line: 1351, col: 10, offset: 33410},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1297, col: 13, offset: 31918},
+									pos:   position{line: 1351, col: 13, offset: 33413},
 									label: "typ",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1297, col: 17, offset: 31922},
+										pos:  position{line: 1351, col: 17, offset: 33417},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1297, col: 22, offset: 31927},
+									pos:  position{line: 1351, col: 22, offset: 33422},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1297, col: 25, offset: 31930},
+									pos:        position{line: 1351, col: 25, offset: 33425},
 									val:        "]|",
 									ignoreCase: false,
 									want:       "\"]|\"",
@@ -8830,57 +9312,57 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1305, col: 5, offset: 32117},
+						pos: position{line: 1359, col: 5, offset: 33612},
 						run: (*parser).callonComplexType26,
 						expr: &seqExpr{
-							pos: position{line: 1305, col: 5, offset: 32117},
+							pos: position{line: 1359, col: 5, offset: 33612},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1305, col: 5, offset: 32117},
+									pos:        position{line: 1359, col: 5, offset: 33612},
 									val:        "|{",
 									ignoreCase: false,
 									want:       "\"|{\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1305, col: 10, offset: 32122},
+									pos:  position{line: 1359, col: 10, offset: 33617},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1305, col: 13, offset: 32125},
+									pos:   position{line: 1359, col: 13, offset: 33620},
 									label: "keyType",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1305, col: 21, offset: 32133},
+										pos:  position{line: 1359, col: 21, offset: 33628},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1305, col: 26, offset: 32138},
+									pos:  position{line: 1359, col: 26, offset: 33633},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1305, col: 29, offset: 32141},
+									pos:        position{line: 1359, col: 29, offset: 33636},
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1305, col: 33, offset: 32145},
+									pos:  position{line: 1359, col: 33, offset: 33640},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1305, col: 36, offset: 32148},
+									pos:   position{line: 1359, col: 36, offset: 33643},
 									label: "valType",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1305, col: 44, offset: 32156},
+										pos:  position{line: 1359, col: 44, offset: 33651},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1305, col: 49, offset: 32161},
+									pos:  position{line: 1359, col: 49, offset: 33656},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1305, col: 52, offset: 32164},
+									pos:        position{line: 1359, col: 52, offset: 33659},
 									val:        "}|",
 									ignoreCase: false,
 									want:       "\"}|\"",
@@ -8895,35 +9377,35 @@ var g = &grammar{
 		},
 		{
 			name: "StringLiteral",
-			pos:  position{line: 1315, col: 1, offset: 32387},
+			pos:  position{line: 1369, col: 1, offset: 33882},
 			expr: &choiceExpr{
-				pos: position{line: 1316, col: 5, offset: 32405},
+				pos: position{line: 1370, col: 5, offset: 33900},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1316, col: 5, offset: 32405},
+						pos: position{line: 1370, col: 5, offset: 33900},
 						run: (*parser).callonStringLiteral2,
 						expr: &seqExpr{
-							pos: position{line: 1316, col: 5, offset: 32405},
+							pos: position{line: 1370, col: 5, offset: 33900},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1316, col: 5, offset: 32405},
+									pos:        position{line: 1370, col: 5, offset: 33900},
 									val:        "\"",
 									ignoreCase: false,
 									want:       "\"\\\"\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1316, col: 9, offset: 32409},
+									pos:   position{line: 1370, col: 9, offset: 33904},
 									label: "v",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1316, col: 11, offset: 32411},
+										pos: position{line: 1370, col: 11, offset: 33906},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1316, col: 11, offset: 32411},
+											pos:  position{line: 1370, col: 11, offset: 33906},
 											name: "DoubleQuotedChar",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1316, col: 29, offset: 32429},
+									pos:        position{line: 1370, col: 29, offset: 33924},
 									val:        "\"",
 									ignoreCase: false,
 									want:       "\"\\\"\"",
@@ -8932,30 +9414,30 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1317, col: 5, offset: 32493},
+						pos: position{line: 1371, col: 5, offset: 33988},
 						run: (*parser).callonStringLiteral9,
 						expr: &seqExpr{
-							pos: position{line: 1317, col: 5, offset: 32493},
+							pos: position{line: 1371, col: 5, offset: 33988},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1317, col: 5, offset: 32493},
+									pos:        position{line: 1371, col: 5, offset: 33988},
 									val:        "'",
 									ignoreCase: false,
 									want:       "\"'\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1317, col: 9, offset: 32497},
+									pos:   position{line: 1371, col: 9, offset: 33992},
 									label: "v",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1317, col: 11, offset: 32499},
+										pos: position{line: 1371, col: 11, offset: 33994},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1317, col: 11, offset: 32499},
+											pos:  position{line: 1371, col: 11, offset: 33994},
 											name: "SingleQuotedChar",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1317, col: 29, offset: 32517},
+									pos:        position{line: 1371, col: 29, offset: 34012},
 									val:        "'",
 									ignoreCase: false,
 									want:       "\"'\"",
@@ -8970,35 +9452,35 @@ var g = &grammar{
 		},
 		{
 			name: "FString",
-			pos:  position{line: 1319, col: 1, offset: 32578},
+			pos:  position{line: 1373, col: 1, offset: 34073},
 			expr: &choiceExpr{
-				pos: position{line: 1320, col: 5, offset: 32590},
+				pos: position{line: 1374, col: 5, offset: 34085},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1320, col: 5, offset: 32590},
+						pos: position{line: 1374, col: 5, offset: 34085},
 						run: (*parser).callonFString2,
 						expr: &seqExpr{
-							pos: position{line: 1320, col: 5, offset: 32590},
+							pos: position{line: 1374, col: 5, offset: 34085},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1320, col: 5, offset: 32590},
+									pos:        position{line: 1374, col: 5, offset: 34085},
 									val:        "f\"",
 									ignoreCase: false,
 									want:       "\"f\\\"\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1320, col: 11, offset: 32596},
+									pos:   position{line: 1374, col: 11, offset: 34091},
 									label: "v",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1320, col: 13, offset: 32598},
+										pos: position{line: 1374, col: 13, offset: 34093},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1320, col: 13, offset: 32598},
+											pos:  position{line: 1374, col: 13, offset: 34093},
 											name: "FStringDoubleQuotedElem",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1320, col: 38, offset: 32623},
+									pos:        position{line: 1374, col: 38, offset: 34118},
 									val:        "\"",
 									ignoreCase: false,
 									want:       "\"\\\"\"",
@@ -9007,30 +9489,30 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1327, col: 5, offset: 32780},
+						pos: position{line: 1381, col: 5, offset: 34275},
 						run: (*parser).callonFString9,
 						expr: &seqExpr{
-							pos: position{line: 1327, col: 5, offset: 32780},
+							pos: position{line: 1381, col: 5, offset: 34275},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1327, col: 5, offset: 32780},
+									pos:        position{line: 1381, col: 5, offset: 34275},
 									val:        "f'",
 									ignoreCase: false,
 									want:       "\"f'\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1327, col: 10, offset: 32785},
+									pos:   position{line: 1381, col: 10, offset: 34280},
 									label: "v",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1327, col: 12, offset: 32787},
+										pos: position{line: 1381, col: 12, offset: 34282},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1327, col: 12, offset: 32787},
+											pos:  position{line: 1381, col: 12, offset: 34282},
 											name: "FStringSingleQuotedElem",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1327, col: 37, offset: 32812},
+									pos:        position{line: 1381, col: 37, offset: 34307},
 									val:        "'",
 									ignoreCase: false,
 									want:       "\"'\"",
@@ -9045,24 +9527,24 @@ var g = &grammar{
 		},
 		{
 			name: "FStringDoubleQuotedElem",
-			pos:  position{line: 1335, col: 1, offset: 32966},
+			pos:  position{line: 1389, col: 1, offset: 34461},
 			expr: &choiceExpr{
-				pos: position{line: 1336, col: 5, offset: 32994},
+				pos: position{line: 1390, col: 5, offset: 34489},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1336, col: 5, offset: 32994},
+						pos:  position{line: 1390, col: 5, offset: 34489},
 						name: "FStringExpr",
 					},
 					&actionExpr{
-						pos: position{line: 1337, col: 5, offset: 33010},
+						pos: position{line: 1391, col: 5, offset: 34505},
 						run: (*parser).callonFStringDoubleQuotedElem3,
 						expr: &labeledExpr{
-							pos:   position{line: 1337, col: 5, offset: 33010},
+							pos:   position{line: 1391, col: 5, offset: 34505},
 							label: "v",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1337, col: 7, offset: 33012},
+								pos: position{line: 1391, col: 7, offset: 34507},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1337, col: 7, offset: 33012},
+									pos:  position{line: 1391, col: 7, offset: 34507},
 									name: "FStringDoubleQuotedChar",
 								},
 							},
@@ -9075,27 +9557,27 @@ var g = &grammar{
 		},
 		{
 			name: "FStringDoubleQuotedChar",
-			pos:  position{line: 1341, col: 1, offset: 33145},
+			pos:  position{line: 1395, col: 1, offset: 34640},
 			expr: &choiceExpr{
-				pos: position{line: 1342, col: 5, offset: 33173},
+				pos: position{line: 1396, col: 5, offset: 34668},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1342, col: 5, offset: 33173},
+						pos: position{line: 1396, col: 5, offset: 34668},
 						run: (*parser).callonFStringDoubleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 1342, col: 5, offset: 33173},
+							pos: position{line: 1396, col: 5, offset: 34668},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1342, col: 5, offset: 33173},
+									pos:        position{line: 1396, col: 5, offset: 34668},
 									val:        "\\",
 									ignoreCase: false,
 									want:       "\"\\\\\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1342, col: 10, offset: 33178},
+									pos:   position{line: 1396, col: 10, offset: 34673},
 									label: "v",
 									expr: &litMatcher{
-										pos:        position{line: 1342, col: 12, offset: 33180},
+										pos:        position{line: 1396, col: 12, offset: 34675},
 										val:        "{",
 										ignoreCase: false,
 										want:       "\"{\"",
@@ -9105,25 +9587,25 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1343, col: 5, offset: 33206},
+						pos: position{line: 1397, col: 5, offset: 34701},
 						run: (*parser).callonFStringDoubleQuotedChar7,
 						expr: &seqExpr{
-							pos: position{line: 1343, col: 5, offset: 33206},
+							pos: position{line: 1397, col: 5, offset: 34701},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1343, col: 5, offset: 33206},
+									pos: position{line: 1397, col: 5, offset: 34701},
 									expr: &litMatcher{
-										pos:        position{line: 1343, col: 7, offset: 33208},
+										pos:        position{line: 1397, col: 7, offset: 34703},
 										val:        "{",
 										ignoreCase: false,
 										want:       "\"{\"",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1343, col: 12, offset: 33213},
+									pos:   position{line: 1397, col: 12, offset: 34708},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1343, col: 14, offset: 33215},
+										pos:  position{line: 1397, col: 14, offset: 34710},
 										name: "DoubleQuotedChar",
 									},
 								},
@@ -9137,24 +9619,24 @@ var g = &grammar{
 		},
 		{
 			name: "FStringSingleQuotedElem",
-			pos:  position{line: 1345, col: 1, offset: 33251},
+			pos:  position{line: 1399, col: 1, offset: 34746},
 			expr: &choiceExpr{
-				pos: position{line: 1346, col: 5, offset: 33279},
+				pos: position{line: 1400, col: 5, offset: 34774},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1346, col: 5, offset: 33279},
+						pos:  position{line: 1400, col: 5, offset: 34774},
 						name: "FStringExpr",
 					},
 					&actionExpr{
-						pos: position{line: 1347, col: 5, offset: 33295},
+						pos: position{line: 1401, col: 5, offset: 34790},
 						run: (*parser).callonFStringSingleQuotedElem3,
 						expr: &labeledExpr{
-							pos:   position{line: 1347, col: 5, offset: 33295},
+							pos:   position{line: 1401, col: 5, offset: 34790},
 							label: "v",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1347, col: 7, offset: 33297},
+								pos: position{line: 1401, col: 7, offset: 34792},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1347, col: 7, offset: 33297},
+									pos:  position{line: 1401, col: 7, offset: 34792},
 									name: "FStringSingleQuotedChar",
 								},
 							},
@@ -9167,27 +9649,27 @@ var g = &grammar{
 		},
 		{
 			name: "FStringSingleQuotedChar",
-			pos:  position{line: 1351, col: 1, offset: 33430},
+			pos:  position{line: 1405, col: 1, offset: 34925},
 			expr: &choiceExpr{
-				pos: position{line: 1352, col: 5, offset: 33458},
+				pos: position{line: 1406, col: 5, offset: 34953},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1352, col: 5, offset: 33458},
+						pos: position{line: 1406, col: 5, offset: 34953},
 						run: (*parser).callonFStringSingleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 1352, col: 5, offset: 33458},
+							pos: position{line: 1406, col: 5, offset: 34953},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1352, col: 5, offset: 33458},
+									pos:        position{line: 1406, col: 5, offset: 34953},
 									val:        "\\",
 									ignoreCase: false,
 									want:       "\"\\\\\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1352, col: 10, offset: 33463},
+									pos:   position{line: 1406, col: 10, offset: 34958},
 									label: "v",
 									expr: &litMatcher{
-										pos:        position{line: 1352, col: 12, offset: 33465},
+										pos:        position{line: 1406, col: 12, offset: 34960},
 										val:        "{",
 										ignoreCase: false,
 										want:       "\"{\"",
@@ -9197,25 +9679,25 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1353, col: 5, offset: 33491},
+						pos: position{line: 1407, col: 5, offset: 34986},
 						run: (*parser).callonFStringSingleQuotedChar7,
 						expr: &seqExpr{
-							pos: position{line: 1353, col: 5, offset: 33491},
+							pos: position{line: 1407, col: 5, offset: 34986},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1353, col: 5, offset: 33491},
+									pos: position{line: 1407, col: 5, offset: 34986},
 									expr: &litMatcher{
-										pos:        position{line: 1353, col: 7, offset: 33493},
+										pos:        position{line: 1407, col: 7, offset: 34988},
 										val:        "{",
 										ignoreCase: false,
 										want:       "\"{\"",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1353, col: 12, offset: 33498},
+									pos:   position{line: 1407, col: 12, offset: 34993},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1353, col: 14, offset: 33500},
+										pos:  position{line: 1407, col: 14, offset: 34995},
 										name: "SingleQuotedChar",
 									},
 								},
@@ -9229,37 +9711,37 @@ var g = &grammar{
 		},
 		{
 			name: "FStringExpr",
-			pos:  position{line: 1355, col: 1, offset: 33536},
+			pos:  position{line: 1409, col: 1, offset: 35031},
 			expr: &actionExpr{
-				pos: position{line: 1356, col: 5, offset: 33552},
+				pos: position{line: 1410, col: 5, offset: 35047},
 				run: (*parser).callonFStringExpr1,
 				expr: &seqExpr{
-					pos: position{line: 1356, col: 5, offset: 33552},
+					pos: position{line: 1410, col: 5, offset: 35047},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1356, col: 5, offset: 33552},
+							pos:        position{line: 1410, col: 5, offset: 35047},
 							val:        "{",
 							ignoreCase: false,
 							want:       "\"{\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1356, col: 9, offset: 33556},
+							pos:  position{line: 1410, col: 9, offset: 35051},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1356, col: 12, offset: 33559},
+							pos:   position{line: 1410, col: 12, offset: 35054},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1356, col: 14, offset: 33561},
+								pos:  position{line: 1410, col: 14, offset: 35056},
 								name: "Expr",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1356, col: 19, offset: 33566},
+							pos:  position{line: 1410, col: 19, offset: 35061},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1356, col: 22, offset: 33569},
+							pos:        position{line: 1410, col: 22, offset: 35064},
 							val:        "}",
 							ignoreCase: false,
 							want:       "\"}\"",
@@ -9272,129 +9754,129 @@ var g = &grammar{
 		},
 		{
 			name: "PrimitiveType",
-			pos:  position{line: 1365, col: 1, offset: 33747},
+			pos:  position{line: 1419, col: 1, offset: 35242},
 			expr: &actionExpr{
-				pos: position{line: 1366, col: 5, offset: 33765},
+				pos: position{line: 1420, col: 5, offset: 35260},
 				run: (*parser).callonPrimitiveType1,
 				expr: &choiceExpr{
-					pos: position{line: 1366, col: 9, offset: 33769},
+					pos: position{line: 1420, col: 9, offset: 35264},
 					alternatives: []any{
 						&litMatcher{
-							pos:        position{line: 1366, col: 9, offset: 33769},
+							pos:        position{line: 1420, col: 9, offset: 35264},
 							val:        "uint8",
 							ignoreCase: false,
 							want:       "\"uint8\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1366, col: 19, offset: 33779},
+							pos:        position{line: 1420, col: 19, offset: 35274},
 							val:        "uint16",
 							ignoreCase: false,
 							want:       "\"uint16\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1366, col: 30, offset: 33790},
+							pos:        position{line: 1420, col: 30, offset: 35285},
 							val:        "uint32",
 							ignoreCase: false,
 							want:       "\"uint32\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1366, col: 41, offset: 33801},
+							pos:        position{line: 1420, col: 41, offset: 35296},
 							val:        "uint64",
 							ignoreCase: false,
 							want:       "\"uint64\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1367, col: 9, offset: 33818},
+							pos:        position{line: 1421, col: 9, offset: 35313},
 							val:        "int8",
 							ignoreCase: false,
 							want:       "\"int8\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1367, col: 18, offset: 33827},
+							pos:        position{line: 1421, col: 18, offset: 35322},
 							val:        "int16",
 							ignoreCase: false,
 							want:       "\"int16\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1367, col: 28, offset: 33837},
+							pos:        position{line: 1421, col: 28, offset: 35332},
 							val:        "int32",
 							ignoreCase: false,
 							want:       "\"int32\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1367, col: 38, offset: 33847},
+							pos:        position{line: 1421, col: 38, offset: 35342},
 							val:        "int64",
 							ignoreCase: false,
 							want:       "\"int64\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1368, col: 9, offset: 33863},
+							pos:        position{line: 1422, col: 9, offset: 35358},
 							val:        "float16",
 							ignoreCase: false,
 							want:       "\"float16\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1368, col: 21, offset: 33875},
+							pos:        position{line: 1422, col: 21, offset: 35370},
 							val:        "float32",
 							ignoreCase: false,
 							want:       "\"float32\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1368, col: 33, offset: 33887},
+							pos:        position{line: 1422, col: 33, offset: 35382},
 							val:        "float64",
 							ignoreCase: false,
 							want:       "\"float64\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1369, col: 9, offset: 33905},
+							pos:        position{line: 1423, col: 9, offset: 35400},
 							val:        "bool",
 							ignoreCase: false,
 							want:       "\"bool\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1369, col: 18, offset: 33914},
+							pos:        position{line: 1423, col: 18, offset: 35409},
 							val:        "string",
 							ignoreCase: false,
 							want:       "\"string\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1370, col: 9, offset: 33931},
+							pos:        position{line: 1424, col: 9, offset: 35426},
 							val:        "duration",
 							ignoreCase: false,
 							want:       "\"duration\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1370, col: 22, offset: 33944},
+							pos:        position{line: 1424, col: 22, offset: 35439},
 							val:        "time",
 							ignoreCase: false,
 							want:       "\"time\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1371, col: 9, offset: 33959},
+							pos:        position{line: 1425, col: 9, offset: 35454},
 							val:        "bytes",
 							ignoreCase: false,
 							want:       "\"bytes\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1372, col: 9, offset: 33975},
+							pos:        position{line: 1426, col: 9, offset: 35470},
 							val:        "ip",
 							ignoreCase: false,
 							want:       "\"ip\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1372, col: 16, offset: 33982},
+							pos:        position{line: 1426, col: 16, offset: 35477},
 							val:        "net",
 							ignoreCase: false,
 							want:       "\"net\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1373, col: 9, offset: 33996},
+							pos:        position{line: 1427, col: 9, offset: 35491},
 							val:        "type",
 							ignoreCase: false,
 							want:       "\"type\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1373, col: 18, offset: 34005},
+							pos:        position{line: 1427, col: 18, offset: 35500},
 							val:        "null",
 							ignoreCase: false,
 							want:       "\"null\"",
@@ -9407,31 +9889,31 @@ var g = &grammar{
 		},
 		{
 			name: "TypeFieldList",
-			pos:  position{line: 1381, col: 1, offset: 34203},
+			pos:  position{line: 1435, col: 1, offset: 35698},
 			expr: &choiceExpr{
-				pos: position{line: 1382, col: 5, offset: 34221},
+				pos: position{line: 1436, col: 5, offset: 35716},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1382, col: 5, offset: 34221},
+						pos: position{line: 1436, col: 5, offset: 35716},
 						run: (*parser).callonTypeFieldList2,
 						expr: &seqExpr{
-							pos: position{line: 1382, col: 5, offset: 34221},
+							pos: position{line: 1436, col: 5, offset: 35716},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1382, col: 5, offset: 34221},
+									pos:   position{line: 1436, col: 5, offset: 35716},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1382, col: 11, offset: 34227},
+										pos:  position{line: 1436, col: 11, offset: 35722},
 										name: "TypeField",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1382, col: 21, offset: 34237},
+									pos:   position{line: 1436, col: 21, offset: 35732},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1382, col: 26, offset: 34242},
+										pos: position{line: 1436, col: 26, offset: 35737},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1382, col: 26, offset: 34242},
+											pos:  position{line: 1436, col: 26, offset: 35737},
 											name: "TypeFieldListTail",
 										},
 									},
@@ -9440,10 +9922,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1385, col: 5, offset: 34308},
+						pos: position{line: 1439, col: 5, offset: 35803},
 						run: (*parser).callonTypeFieldList9,
 						expr: &litMatcher{
-							pos:        position{line: 1385, col: 5, offset: 34308},
+							pos:        position{line: 1439, col: 5, offset: 35803},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -9456,32 +9938,32 @@ var g = &grammar{
 		},
 		{
 			name: "TypeFieldListTail",
-			pos:  position{line: 1387, col: 1, offset: 34332},
+			pos:  position{line: 1441, col: 1, offset: 35827},
 			expr: &actionExpr{
-				pos: position{line: 1387, col: 21, offset: 34352},
+				pos: position{line: 1441, col: 21, offset: 35847},
 				run: (*parser).callonTypeFieldListTail1,
 				expr: &seqExpr{
-					pos: position{line: 1387, col: 21, offset: 34352},
+					pos: position{line: 1441, col: 21, offset: 35847},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1387, col: 21, offset: 34352},
+							pos:  position{line: 1441, col: 21, offset: 35847},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1387, col: 24, offset: 34355},
+							pos:        position{line: 1441, col: 24, offset: 35850},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1387, col: 28, offset: 34359},
+							pos:  position{line: 1441, col: 28, offset: 35854},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1387, col: 31, offset: 34362},
+							pos:   position{line: 1441, col: 31, offset: 35857},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1387, col: 35, offset: 34366},
+								pos:  position{line: 1441, col: 35, offset: 35861},
 								name: "TypeField",
 							},
 						},
@@ -9493,40 +9975,40 @@ var g = &grammar{
 		},
 		{
 			name: "TypeField",
-			pos:  position{line: 1389, col: 1, offset: 34397},
+			pos:  position{line: 1443, col: 1, offset: 35892},
 			expr: &actionExpr{
-				pos: position{line: 1390, col: 5, offset: 34411},
+				pos: position{line: 1444, col: 5, offset: 35906},
 				run: (*parser).callonTypeField1,
 				expr: &seqExpr{
-					pos: position{line: 1390, col: 5, offset: 34411},
+					pos: position{line: 1444, col: 5, offset: 35906},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1390, col: 5, offset: 34411},
+							pos:   position{line: 1444, col: 5, offset: 35906},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1390, col: 10, offset: 34416},
+								pos:  position{line: 1444, col: 10, offset: 35911},
 								name: "FieldName",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1390, col: 20, offset: 34426},
+							pos:  position{line: 1444, col: 20, offset: 35921},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1390, col: 23, offset: 34429},
+							pos:        position{line: 1444, col: 23, offset: 35924},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1390, col: 27, offset: 34433},
+							pos:  position{line: 1444, col: 27, offset: 35928},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1390, col: 30, offset: 34436},
+							pos:   position{line: 1444, col: 30, offset: 35931},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1390, col: 34, offset: 34440},
+								pos:  position{line: 1444, col: 34, offset: 35935},
 								name: "Type",
 							},
 						},
@@ -9538,16 +10020,16 @@ var g = &grammar{
 		},
 		{
 			name: "FieldName",
-			pos:  position{line: 1397, col: 1, offset: 34564},
+			pos:  position{line: 1451, col: 1, offset: 36059},
 			expr: &choiceExpr{
-				pos: position{line: 1398, col: 5, offset: 34578},
+				pos: position{line: 1452, col: 5, offset: 36073},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1398, col: 5, offset: 34578},
+						pos:  position{line: 1452, col: 5, offset: 36073},
 						name: "IdentifierName",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1399, col: 5, offset: 34597},
+						pos:  position{line: 1453, col: 5, offset: 36092},
 						name: "QuotedString",
 					},
 				},
@@ -9557,24 +10039,24 @@ var g = &grammar{
 		},
 		{
 			name: "AndToken",
-			pos:  position{line: 1401, col: 1, offset: 34611},
+			pos:  position{line: 1455, col: 1, offset: 36106},
 			expr: &actionExpr{
-				pos: position{line: 1401, col: 12, offset: 34622},
+				pos: position{line: 1455, col: 12, offset: 36117},
 				run: (*parser).callonAndToken1,
 				expr: &seqExpr{
-					pos: position{line: 1401, col: 12, offset: 34622},
+					pos: position{line: 1455, col: 12, offset: 36117},
 					exprs: []any{
 						&choiceExpr{
-							pos: position{line: 1401, col: 13, offset: 34623},
+							pos: position{line: 1455, col: 13, offset: 36118},
 							alternatives: []any{
 								&litMatcher{
-									pos:        position{line: 1401, col: 13, offset: 34623},
+									pos:        position{line: 1455, col: 13, offset: 36118},
 									val:        "and",
 									ignoreCase: false,
 									want:       "\"and\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1401, col: 21, offset: 34631},
+									pos:        position{line: 1455, col: 21, offset: 36126},
 									val:        "AND",
 									ignoreCase: false,
 									want:       "\"AND\"",
@@ -9582,9 +10064,9 @@ var g = &grammar{
 							},
 						},
 						&notExpr{
-							pos: position{line: 1401, col: 28, offset: 34638},
+							pos: position{line: 1455, col: 28, offset: 36133},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1401, col: 29, offset: 34639},
+								pos:  position{line: 1455, col: 29, offset: 36134},
 								name: "IdentifierRest",
 							},
 						},
@@ -9596,20 +10078,20 @@ var g = &grammar{
 		},
 		{
 			name: "ByToken",
-			pos:  position{line: 1402, col: 1, offset: 34676},
+			pos:  position{line: 1456, col: 1, offset: 36171},
 			expr: &seqExpr{
-				pos: position{line: 1402, col: 11, offset: 34686},
+				pos: position{line: 1456, col: 11, offset: 36181},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 1402, col: 11, offset: 34686},
+						pos:        position{line: 1456, col: 11, offset: 36181},
 						val:        "by",
 						ignoreCase: false,
 						want:       "\"by\"",
 					},
 					&notExpr{
-						pos: position{line: 1402, col: 16, offset: 34691},
+						pos: position{line: 1456, col: 16, offset: 36186},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1402, col: 17, offset: 34692},
+							pos:  position{line: 1456, col: 17, offset: 36187},
 							name: "IdentifierRest",
 						},
 					},
@@ -9620,20 +10102,20 @@ var g = &grammar{
 		},
 		{
 			name: "FalseToken",
-			pos:  position{line: 1403, col: 1, offset: 34707},
+			pos:  position{line: 1457, col: 1, offset: 36202},
 			expr: &seqExpr{
-				pos: position{line: 1403, col: 14, offset: 34720},
+				pos: position{line: 1457, col: 14, offset: 36215},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 1403, col: 14, offset: 34720},
+						pos:        position{line: 1457, col: 14, offset: 36215},
 						val:        "false",
 						ignoreCase: false,
 						want:       "\"false\"",
 					},
 					&notExpr{
-						pos: position{line: 1403, col: 22, offset: 34728},
+						pos: position{line: 1457, col: 22, offset: 36223},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1403, col: 23, offset: 34729},
+							pos:  position{line: 1457, col: 23, offset: 36224},
 							name: "IdentifierRest",
 						},
 					},
@@ -9644,20 +10126,20 @@ var g = &grammar{
 		},
 		{
 			name: "InToken",
-			pos:  position{line: 1404, col: 1, offset: 34744},
+			pos:  position{line: 1458, col: 1, offset: 36239},
 			expr: &seqExpr{
-				pos: position{line: 1404, col: 11, offset: 34754},
+				pos: position{line: 1458, col: 11, offset: 36249},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 1404, col: 11, offset: 34754},
+						pos:        position{line: 1458, col: 11, offset: 36249},
 						val:        "in",
 						ignoreCase: false,
 						want:       "\"in\"",
 					},
 					&notExpr{
-						pos: position{line: 1404, col: 16, offset: 34759},
+						pos: position{line: 1458, col: 16, offset: 36254},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1404, col: 17, offset: 34760},
+							pos:  position{line: 1458, col: 17, offset: 36255},
 							name: "IdentifierRest",
 						},
 					},
@@ -9668,24 +10150,24 @@ var g = &grammar{
 		},
 		{
 			name: "NotToken",
-			pos:  position{line: 1405, col: 1, offset: 34775},
+			pos:  position{line: 1459, col: 1, offset: 36270},
 			expr: &actionExpr{
-				pos: position{line: 1405, col: 12, offset: 34786},
+				pos: position{line: 1459, col: 12, offset: 36281},
 				run: (*parser).callonNotToken1,
 				expr: &seqExpr{
-					pos: position{line: 1405, col: 12, offset: 34786},
+					pos: position{line: 1459, col: 12, offset: 36281},
 					exprs: []any{
 						&choiceExpr{
-							pos: position{line: 1405, col: 13, offset: 34787},
+							pos: position{line: 1459, col: 13, offset: 36282},
 							alternatives: []any{
 								&litMatcher{
-									pos:        position{line: 1405, col: 13, offset: 34787},
+									pos:        position{line: 1459, col: 13, offset: 36282},
 									val:        "not",
 									ignoreCase: false,
 									want:       "\"not\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1405, col: 21, offset: 34795},
+									pos:        position{line: 1459, col: 21, offset: 36290},
 									val:        "NOT",
 									ignoreCase: false,
 									want:       "\"NOT\"",
@@ -9693,9 +10175,9 @@ var g = &grammar{
 							},
 						},
 						&notExpr{
-							pos: position{line: 1405, col: 28, offset: 34802},
+							pos: position{line: 1459, col: 28, offset: 36297},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1405, col: 29, offset: 34803},
+								pos:  position{line: 1459, col: 29, offset: 36298},
 								name: "IdentifierRest",
 							},
 						},
@@ -9707,20 +10189,20 @@ var g = &grammar{
 		},
 		{
 			name: "NullToken",
-			pos:  position{line: 1406, col: 1, offset: 34840},
+			pos:  position{line: 1460, col: 1, offset: 36335},
 			expr: &seqExpr{
-				pos: position{line: 1406, col: 13, offset: 34852},
+				pos: position{line: 1460, col: 13, offset: 36347},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 1406, col: 13, offset: 34852},
+						pos:        position{line: 1460, col: 13, offset: 36347},
 						val:        "null",
 						ignoreCase: false,
 						want:       "\"null\"",
 					},
 					&notExpr{
-						pos: position{line: 1406, col: 20, offset: 34859},
+						pos: position{line: 1460, col: 20, offset: 36354},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1406, col: 21, offset: 34860},
+							pos:  position{line: 1460, col: 21, offset: 36355},
 							name: "IdentifierRest",
 						},
 					},
@@ -9731,24 +10213,24 @@ var g = &grammar{
 		},
 		{
 			name: "OrToken",
-			pos:  position{line: 1407, col: 1, offset: 34875},
+			pos:  position{line: 1461, col: 1, offset: 36370},
 			expr: &actionExpr{
-				pos: position{line: 1407, col: 11, offset: 34885},
+				pos: position{line: 1461, col: 11, offset: 36380},
 				run: (*parser).callonOrToken1,
 				expr: &seqExpr{
-					pos: position{line: 1407, col: 11, offset: 34885},
+					pos: position{line: 1461, col: 11, offset: 36380},
 					exprs: []any{
 						&choiceExpr{
-							pos: position{line: 1407, col: 12, offset: 34886},
+							pos: position{line: 1461, col: 12, offset: 36381},
 							alternatives: []any{
 								&litMatcher{
-									pos:        position{line: 1407, col: 12, offset: 34886},
+									pos:        position{line: 1461, col: 12, offset: 36381},
 									val:        "or",
 									ignoreCase: false,
 									want:       "\"or\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1407, col: 19, offset: 34893},
+									pos:        position{line: 1461, col: 19, offset: 36388},
 									val:        "OR",
 									ignoreCase: false,
 									want:       "\"OR\"",
@@ -9756,9 +10238,9 @@ var g = &grammar{
 							},
 						},
 						&notExpr{
-							pos: position{line: 1407, col: 25, offset: 34899},
+							pos: position{line: 1461, col: 25, offset: 36394},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1407, col: 26, offset: 34900},
+								pos:  position{line: 1461, col: 26, offset: 36395},
 								name: "IdentifierRest",
 							},
 						},
@@ -9770,20 +10252,20 @@ var g = &grammar{
 		},
 		{
 			name: "TrueToken",
-			pos:  position{line: 1408, col: 1, offset: 34936},
+			pos:  position{line: 1462, col: 1, offset: 36431},
 			expr: &seqExpr{
-				pos: position{line: 1408, col: 13, offset: 34948},
+				pos: position{line: 1462, col: 13, offset: 36443},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 1408, col: 13, offset: 34948},
+						pos:        position{line: 1462, col: 13, offset: 36443},
 						val:        "true",
 						ignoreCase: false,
 						want:       "\"true\"",
 					},
 					&notExpr{
-						pos: position{line: 1408, col: 20, offset: 34955},
+						pos: position{line: 1462, col: 20, offset: 36450},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1408, col: 21, offset: 34956},
+							pos:  position{line: 1462, col: 21, offset: 36451},
 							name: "IdentifierRest",
 						},
 					},
@@ -9794,15 +10276,15 @@ var g = &grammar{
 		},
 		{
 			name: "Identifier",
-			pos:  position{line: 1410, col: 1, offset: 34972},
+			pos:  position{line: 1464, col: 1, offset: 36467},
 			expr: &actionExpr{
-				pos: position{line: 1411, col: 5, offset: 34987},
+				pos: position{line: 1465, col: 5, offset: 36482},
 				run: (*parser).callonIdentifier1,
 				expr: &labeledExpr{
-					pos:   position{line: 1411, col: 5, offset: 34987},
+					pos:   position{line: 1465, col: 5, offset: 36482},
 					label: "id",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1411, col: 8, offset: 34990},
+						pos:  position{line: 1465, col: 8, offset: 36485},
 						name: "IdentifierName",
 					},
 				},
@@ -9812,51 +10294,51 @@ var g = &grammar{
 		},
 		{
 			name: "Identifiers",
-			pos:  position{line: 1419, col: 1, offset: 35133},
+			pos:  position{line: 1473, col: 1, offset: 36628},
 			expr: &actionExpr{
-				pos: position{line: 1420, col: 5, offset: 35149},
+				pos: position{line: 1474, col: 5, offset: 36644},
 				run: (*parser).callonIdentifiers1,
 				expr: &seqExpr{
-					pos: position{line: 1420, col: 5, offset: 35149},
+					pos: position{line: 1474, col: 5, offset: 36644},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1420, col: 5, offset: 35149},
+							pos:   position{line: 1474, col: 5, offset: 36644},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1420, col: 11, offset: 35155},
+								pos:  position{line: 1474, col: 11, offset: 36650},
 								name: "Identifier",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1420, col: 22, offset: 35166},
+							pos:   position{line: 1474, col: 22, offset: 36661},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1420, col: 27, offset: 35171},
+								pos: position{line: 1474, col: 27, offset: 36666},
 								expr: &actionExpr{
-									pos: position{line: 1420, col: 28, offset: 35172},
+									pos: position{line: 1474, col: 28, offset: 36667},
 									run: (*parser).callonIdentifiers7,
 									expr: &seqExpr{
-										pos: position{line: 1420, col: 28, offset: 35172},
+										pos: position{line: 1474, col: 28, offset: 36667},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1420, col: 28, offset: 35172},
+												pos:  position{line: 1474, col: 28, offset: 36667},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 1420, col: 31, offset: 35175},
+												pos:        position{line: 1474, col: 31, offset: 36670},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1420, col: 35, offset: 35179},
+												pos:  position{line: 1474, col: 35, offset: 36674},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1420, col: 38, offset: 35182},
+												pos:   position{line: 1474, col: 38, offset: 36677},
 												label: "name",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1420, col: 43, offset: 35187},
+													pos:  position{line: 1474, col: 43, offset: 36682},
 													name: "Identifier",
 												},
 											},
@@ -9873,29 +10355,29 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierName",
-			pos:  position{line: 1424, col: 1, offset: 35265},
+			pos:  position{line: 1478, col: 1, offset: 36760},
 			expr: &choiceExpr{
-				pos: position{line: 1425, col: 5, offset: 35284},
+				pos: position{line: 1479, col: 5, offset: 36779},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1425, col: 5, offset: 35284},
+						pos: position{line: 1479, col: 5, offset: 36779},
 						run: (*parser).callonIdentifierName2,
 						expr: &seqExpr{
-							pos: position{line: 1425, col: 5, offset: 35284},
+							pos: position{line: 1479, col: 5, offset: 36779},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1425, col: 5, offset: 35284},
+									pos: position{line: 1479, col: 5, offset: 36779},
 									expr: &seqExpr{
-										pos: position{line: 1425, col: 7, offset: 35286},
+										pos: position{line: 1479, col: 7, offset: 36781},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1425, col: 7, offset: 35286},
+												pos:  position{line: 1479, col: 7, offset: 36781},
 												name: "IDGuard",
 											},
 											&notExpr{
-												pos: position{line: 1425, col: 15, offset: 35294},
+												pos: position{line: 1479, col: 15, offset: 36789},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1425, col: 16, offset: 35295},
+													pos:  position{line: 1479, col: 16, offset: 36790},
 													name: "IdentifierRest",
 												},
 											},
@@ -9903,13 +10385,13 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1425, col: 32, offset: 35311},
+									pos:  position{line: 1479, col: 32, offset: 36806},
 									name: "IdentifierStart",
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1425, col: 48, offset: 35327},
+									pos: position{line: 1479, col: 48, offset: 36822},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1425, col: 48, offset: 35327},
+										pos:  position{line: 1479, col: 48, offset: 36822},
 										name: "IdentifierRest",
 									},
 								},
@@ -9917,32 +10399,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1426, col: 5, offset: 35378},
+						pos: position{line: 1480, col: 5, offset: 36873},
 						run: (*parser).callonIdentifierName12,
 						expr: &litMatcher{
-							pos:        position{line: 1426, col: 5, offset: 35378},
+							pos:        position{line: 1480, col: 5, offset: 36873},
 							val:        "$",
 							ignoreCase: false,
 							want:       "\"$\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1427, col: 5, offset: 35417},
+						pos: position{line: 1481, col: 5, offset: 36912},
 						run: (*parser).callonIdentifierName14,
 						expr: &seqExpr{
-							pos: position{line: 1427, col: 5, offset: 35417},
+							pos: position{line: 1481, col: 5, offset: 36912},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1427, col: 5, offset: 35417},
+									pos:        position{line: 1481, col: 5, offset: 36912},
 									val:        "\\",
 									ignoreCase: false,
 									want:       "\"\\\\\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1427, col: 10, offset: 35422},
+									pos:   position{line: 1481, col: 10, offset: 36917},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1427, col: 13, offset: 35425},
+										pos:  position{line: 1481, col: 13, offset: 36920},
 										name: "IDGuard",
 									},
 								},
@@ -9950,10 +10432,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1429, col: 5, offset: 35516},
+						pos: position{line: 1483, col: 5, offset: 37011},
 						run: (*parser).callonIdentifierName19,
 						expr: &litMatcher{
-							pos:        position{line: 1429, col: 5, offset: 35516},
+							pos:        position{line: 1483, col: 5, offset: 37011},
 							val:        "type",
 							ignoreCase: false,
 							want:       "\"type\"",
@@ -9966,22 +10448,22 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierStart",
-			pos:  position{line: 1431, col: 1, offset: 35555},
+			pos:  position{line: 1485, col: 1, offset: 37050},
 			expr: &choiceExpr{
-				pos: position{line: 1432, col: 5, offset: 35575},
+				pos: position{line: 1486, col: 5, offset: 37070},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1432, col: 5, offset: 35575},
+						pos:  position{line: 1486, col: 5, offset: 37070},
 						name: "UnicodeLetter",
 					},
 					&litMatcher{
-						pos:        position{line: 1433, col: 5, offset: 35593},
+						pos:        position{line: 1487, col: 5, offset: 37088},
 						val:        "$",
 						ignoreCase: false,
 						want:       "\"$\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1434, col: 5, offset: 35601},
+						pos:        position{line: 1488, col: 5, offset: 37096},
 						val:        "_",
 						ignoreCase: false,
 						want:       "\"_\"",
@@ -9993,24 +10475,24 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierRest",
-			pos:  position{line: 1436, col: 1, offset: 35606},
+			pos:  position{line: 1490, col: 1, offset: 37101},
 			expr: &choiceExpr{
-				pos: position{line: 1437, col: 5, offset: 35625},
+				pos: position{line: 1491, col: 5, offset: 37120},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1437, col: 5, offset: 35625},
+						pos:  position{line: 1491, col: 5, offset: 37120},
 						name: "IdentifierStart",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1438, col: 5, offset: 35645},
+						pos:  position{line: 1492, col: 5, offset: 37140},
 						name: "UnicodeCombiningMark",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1439, col: 5, offset: 35670},
+						pos:  position{line: 1493, col: 5, offset: 37165},
 						name: "UnicodeDigit",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1440, col: 5, offset: 35687},
+						pos:  position{line: 1494, col: 5, offset: 37182},
 						name: "UnicodeConnectorPunctuation",
 					},
 				},
@@ -10020,24 +10502,24 @@ var g = &grammar{
 		},
 		{
 			name: "IDGuard",
-			pos:  position{line: 1442, col: 1, offset: 35716},
+			pos:  position{line: 1496, col: 1, offset: 37211},
 			expr: &choiceExpr{
-				pos: position{line: 1443, col: 5, offset: 35728},
+				pos: position{line: 1497, col: 5, offset: 37223},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1443, col: 5, offset: 35728},
+						pos:  position{line: 1497, col: 5, offset: 37223},
 						name: "BooleanLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1444, col: 5, offset: 35747},
+						pos:  position{line: 1498, col: 5, offset: 37242},
 						name: "NullLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1445, col: 5, offset: 35763},
+						pos:  position{line: 1499, col: 5, offset: 37258},
 						name: "NaN",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1446, col: 5, offset: 35771},
+						pos:  position{line: 1500, col: 5, offset: 37266},
 						name: "Infinity",
 					},
 				},
@@ -10047,25 +10529,25 @@ var g = &grammar{
 		},
 		{
 			name: "Time",
-			pos:  position{line: 1448, col: 1, offset: 35781},
+			pos:  position{line: 1502, col: 1, offset: 37276},
 			expr: &actionExpr{
-				pos: position{line: 1449, col: 5, offset: 35790},
+				pos: position{line: 1503, col: 5, offset: 37285},
 				run: (*parser).callonTime1,
 				expr: &seqExpr{
-					pos: position{line: 1449, col: 5, offset: 35790},
+					pos: position{line: 1503, col: 5, offset: 37285},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1449, col: 5, offset: 35790},
+							pos:  position{line: 1503, col: 5, offset: 37285},
 							name: "FullDate",
 						},
 						&litMatcher{
-							pos:        position{line: 1449, col: 14, offset: 35799},
+							pos:        position{line: 1503, col: 14, offset: 37294},
 							val:        "T",
 							ignoreCase: false,
 							want:       "\"T\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1449, col: 18, offset: 35803},
+							pos:  position{line: 1503, col: 18, offset: 37298},
 							name: "FullTime",
 						},
 					},
@@ -10076,32 +10558,32 @@ var g = &grammar{
 		},
 		{
 			name: "FullDate",
-			pos:  position{line: 1453, col: 1, offset: 35879},
+			pos:  position{line: 1507, col: 1, offset: 37374},
 			expr: &seqExpr{
-				pos: position{line: 1453, col: 12, offset: 35890},
+				pos: position{line: 1507, col: 12, offset: 37385},
 				exprs: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1453, col: 12, offset: 35890},
+						pos:  position{line: 1507, col: 12, offset: 37385},
 						name: "D4",
 					},
 					&litMatcher{
-						pos:        position{line: 1453, col: 15, offset: 35893},
+						pos:        position{line: 1507, col: 15, offset: 37388},
 						val:        "-",
 						ignoreCase: false,
 						want:       "\"-\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1453, col: 19, offset: 35897},
+						pos:  position{line: 1507, col: 19, offset: 37392},
 						name: "D2",
 					},
 					&litMatcher{
-						pos:        position{line: 1453, col: 22, offset: 35900},
+						pos:        position{line: 1507, col: 22, offset: 37395},
 						val:        "-",
 						ignoreCase: false,
 						want:       "\"-\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1453, col: 26, offset: 35904},
+						pos:  position{line: 1507, col: 26, offset: 37399},
 						name: "D2",
 					},
 				},
@@ -10111,33 +10593,33 @@ var g = &grammar{
 		},
 		{
 			name: "D4",
-			pos:  position{line: 1455, col: 1, offset: 35908},
+			pos:  position{line: 1509, col: 1, offset: 37403},
 			expr: &seqExpr{
-				pos: position{line: 1455, col: 6, offset: 35913},
+				pos: position{line: 1509, col: 6, offset: 37408},
 				exprs: []any{
 					&charClassMatcher{
-						pos:        position{line: 1455, col: 6, offset: 35913},
+						pos:        position{line: 1509, col: 6, offset: 37408},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
 						inverted:   false,
 					},
 					&charClassMatcher{
-						pos:        position{line: 1455, col: 11, offset: 35918},
+						pos:        position{line: 1509, col: 11, offset: 37413},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
 						inverted:   false,
 					},
 					&charClassMatcher{
-						pos:        position{line: 1455, col: 16, offset: 35923},
+						pos:        position{line: 1509, col: 16, offset: 37418},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
 						inverted:   false,
 					},
 					&charClassMatcher{
-						pos:        position{line: 1455, col: 21, offset: 35928},
+						pos:        position{line: 1509, col: 21, offset: 37423},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -10150,19 +10632,19 @@ var g = &grammar{
 		},
 		{
 			name: "D2",
-			pos:  position{line: 1456, col: 1, offset: 35934},
+			pos:  position{line: 1510, col: 1, offset: 37429},
 			expr: &seqExpr{
-				pos: position{line: 1456, col: 6, offset: 35939},
+				pos: position{line: 1510, col: 6, offset: 37434},
 				exprs: []any{
 					&charClassMatcher{
-						pos:        position{line: 1456, col: 6, offset: 35939},
+						pos:        position{line: 1510, col: 6, offset: 37434},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
 						inverted:   false,
 					},
 					&charClassMatcher{
-						pos:        position{line: 1456, col: 11, offset: 35944},
+						pos:        position{line: 1510, col: 11, offset: 37439},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -10175,16 +10657,16 @@ var g = &grammar{
 		},
 		{
 			name: "FullTime",
-			pos:  position{line: 1458, col: 1, offset: 35951},
+			pos:  position{line: 1512, col: 1, offset: 37446},
 			expr: &seqExpr{
-				pos: position{line: 1458, col: 12, offset: 35962},
+				pos: position{line: 1512, col: 12, offset: 37457},
 				exprs: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1458, col: 12, offset: 35962},
+						pos:  position{line: 1512, col: 12, offset: 37457},
 						name: "PartialTime",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1458, col: 24, offset: 35974},
+						pos:  position{line: 1512, col: 24, offset: 37469},
 						name: "TimeOffset",
 					},
 				},
@@ -10194,49 +10676,49 @@ var g = &grammar{
 		},
 		{
 			name: "PartialTime",
-			pos:  position{line: 1460, col: 1, offset: 35986},
+			pos:  position{line: 1514, col: 1, offset: 37481},
 			expr: &seqExpr{
-				pos: position{line: 1460, col: 15, offset: 36000},
+				pos: position{line: 1514, col: 15, offset: 37495},
 				exprs: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1460, col: 15, offset: 36000},
+						pos:  position{line: 1514, col: 15, offset: 37495},
 						name: "D2",
 					},
 					&litMatcher{
-						pos:        position{line: 1460, col: 18, offset: 36003},
+						pos:        position{line: 1514, col: 18, offset: 37498},
 						val:        ":",
 						ignoreCase: false,
 						want:       "\":\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1460, col: 22, offset: 36007},
+						pos:  position{line: 1514, col: 22, offset: 37502},
 						name: "D2",
 					},
 					&litMatcher{
-						pos:        position{line: 1460, col: 25, offset: 36010},
+						pos:        position{line: 1514, col: 25, offset: 37505},
 						val:        ":",
 						ignoreCase: false,
 						want:       "\":\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1460, col: 29, offset: 36014},
+						pos:  position{line: 1514, col: 29, offset: 37509},
 						name: "D2",
 					},
 					&zeroOrOneExpr{
-						pos: position{line: 1460, col: 32, offset: 36017},
+						pos: position{line: 1514, col: 32, offset: 37512},
 						expr: &seqExpr{
-							pos: position{line: 1460, col: 33, offset: 36018},
+							pos: position{line: 1514, col: 33, offset: 37513},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1460, col: 33, offset: 36018},
+									pos:        position{line: 1514, col: 33, offset: 37513},
 									val:        ".",
 									ignoreCase: false,
 									want:       "\".\"",
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 1460, col: 37, offset: 36022},
+									pos: position{line: 1514, col: 37, offset: 37517},
 									expr: &charClassMatcher{
-										pos:        position{line: 1460, col: 37, offset: 36022},
+										pos:        position{line: 1514, col: 37, offset: 37517},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -10253,30 +10735,30 @@ var g = &grammar{
 		},
 		{
 			name: "TimeOffset",
-			pos:  position{line: 1462, col: 1, offset: 36032},
+			pos:  position{line: 1516, col: 1, offset: 37527},
 			expr: &choiceExpr{
-				pos: position{line: 1463, col: 5, offset: 36047},
+				pos: position{line: 1517, col: 5, offset: 37542},
 				alternatives: []any{
 					&litMatcher{
-						pos:        position{line: 1463, col: 5, offset: 36047},
+						pos:        position{line: 1517, col: 5, offset: 37542},
 						val:        "Z",
 						ignoreCase: false,
 						want:       "\"Z\"",
 					},
 					&seqExpr{
-						pos: position{line: 1464, col: 5, offset: 36055},
+						pos: position{line: 1518, col: 5, offset: 37550},
 						exprs: []any{
 							&choiceExpr{
-								pos: position{line: 1464, col: 6, offset: 36056},
+								pos: position{line: 1518, col: 6, offset: 37551},
 								alternatives: []any{
 									&litMatcher{
-										pos:        position{line: 1464, col: 6, offset: 36056},
+										pos:        position{line: 1518, col: 6, offset: 37551},
 										val:        "+",
 										ignoreCase: false,
 										want:       "\"+\"",
 									},
 									&litMatcher{
-										pos:        position{line: 1464, col: 12, offset: 36062},
+										pos:        position{line: 1518, col: 12, offset: 37557},
 										val:        "-",
 										ignoreCase: false,
 										want:       "\"-\"",
@@ -10284,34 +10766,34 @@ var g = &grammar{
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1464, col: 17, offset: 36067},
+								pos:  position{line: 1518, col: 17, offset: 37562},
 								name: "D2",
 							},
 							&litMatcher{
-								pos:        position{line: 1464, col: 20, offset: 36070},
+								pos:        position{line: 1518, col: 20, offset: 37565},
 								val:        ":",
 								ignoreCase: false,
 								want:       "\":\"",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1464, col: 24, offset: 36074},
+								pos:  position{line: 1518, col: 24, offset: 37569},
 								name: "D2",
 							},
 							&zeroOrOneExpr{
-								pos: position{line: 1464, col: 27, offset: 36077},
+								pos: position{line: 1518, col: 27, offset: 37572},
 								expr: &seqExpr{
-									pos: position{line: 1464, col: 28, offset: 36078},
+									pos: position{line: 1518, col: 28, offset: 37573},
 									exprs: []any{
 										&litMatcher{
-											pos:        position{line: 1464, col: 28, offset: 36078},
+											pos:        position{line: 1518, col: 28, offset: 37573},
 											val:        ".",
 											ignoreCase: false,
 											want:       "\".\"",
 										},
 										&oneOrMoreExpr{
-											pos: position{line: 1464, col: 32, offset: 36082},
+											pos: position{line: 1518, col: 32, offset: 37577},
 											expr: &charClassMatcher{
-												pos:        position{line: 1464, col: 32, offset: 36082},
+												pos:        position{line: 1518, col: 32, offset: 37577},
 												val:        "[0-9]",
 												ranges:     []rune{'0', '9'},
 												ignoreCase: false,
@@ -10330,33 +10812,33 @@ var g = &grammar{
 		},
 		{
 			name: "Duration",
-			pos:  position{line: 1466, col: 1, offset: 36092},
+			pos:  position{line: 1520, col: 1, offset: 37587},
 			expr: &actionExpr{
-				pos: position{line: 1467, col: 5, offset: 36105},
+				pos: position{line: 1521, col: 5, offset: 37600},
 				run: (*parser).callonDuration1,
 				expr: &seqExpr{
-					pos: position{line: 1467, col: 5, offset: 36105},
+					pos: position{line: 1521, col: 5, offset: 37600},
 					exprs: []any{
 						&zeroOrOneExpr{
-							pos: position{line: 1467, col: 5, offset: 36105},
+							pos: position{line: 1521, col: 5, offset: 37600},
 							expr: &litMatcher{
-								pos:        position{line: 1467, col: 5, offset: 36105},
+								pos:        position{line: 1521, col: 5, offset: 37600},
 								val:        "-",
 								ignoreCase: false,
 								want:       "\"-\"",
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 1467, col: 10, offset: 36110},
+							pos: position{line: 1521, col: 10, offset: 37605},
 							expr: &seqExpr{
-								pos: position{line: 1467, col: 11, offset: 36111},
+								pos: position{line: 1521, col: 11, offset: 37606},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 1467, col: 11, offset: 36111},
+										pos:  position{line: 1521, col: 11, offset: 37606},
 										name: "Decimal",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1467, col: 19, offset: 36119},
+										pos:  position{line: 1521, col: 19, offset: 37614},
 										name: "TimeUnit",
 									},
 								},
@@ -10370,27 +10852,27 @@ var g = &grammar{
 		},
 		{
 			name: "Decimal",
-			pos:  position{line: 1471, col: 1, offset: 36201},
+			pos:  position{line: 1525, col: 1, offset: 37696},
 			expr: &seqExpr{
-				pos: position{line: 1471, col: 11, offset: 36211},
+				pos: position{line: 1525, col: 11, offset: 37706},
 				exprs: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1471, col: 11, offset: 36211},
+						pos:  position{line: 1525, col: 11, offset: 37706},
 						name: "UInt",
 					},
 					&zeroOrOneExpr{
-						pos: position{line: 1471, col: 16, offset: 36216},
+						pos: position{line: 1525, col: 16, offset: 37711},
 						expr: &seqExpr{
-							pos: position{line: 1471, col: 17, offset: 36217},
+							pos: position{line: 1525, col: 17, offset: 37712},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1471, col: 17, offset: 36217},
+									pos:        position{line: 1525, col: 17, offset: 37712},
 									val:        ".",
 									ignoreCase: false,
 									want:       "\".\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1471, col: 21, offset: 36221},
+									pos:  position{line: 1525, col: 21, offset: 37716},
 									name: "UInt",
 								},
 							},
@@ -10403,60 +10885,60 @@ var g = &grammar{
 		},
 		{
 			name: "TimeUnit",
-			pos:  position{line: 1473, col: 1, offset: 36229},
+			pos:  position{line: 1527, col: 1, offset: 37724},
 			expr: &choiceExpr{
-				pos: position{line: 1474, col: 5, offset: 36242},
+				pos: position{line: 1528, col: 5, offset: 37737},
 				alternatives: []any{
 					&litMatcher{
-						pos:        position{line: 1474, col: 5, offset: 36242},
+						pos:        position{line: 1528, col: 5, offset: 37737},
 						val:        "ns",
 						ignoreCase: false,
 						want:       "\"ns\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1475, col: 5, offset: 36251},
+						pos:        position{line: 1529, col: 5, offset: 37746},
 						val:        "us",
 						ignoreCase: false,
 						want:       "\"us\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1476, col: 5, offset: 36260},
+						pos:        position{line: 1530, col: 5, offset: 37755},
 						val:        "ms",
 						ignoreCase: false,
 						want:       "\"ms\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1477, col: 5, offset: 36269},
+						pos:        position{line: 1531, col: 5, offset: 37764},
 						val:        "s",
 						ignoreCase: false,
 						want:       "\"s\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1478, col: 5, offset: 36277},
+						pos:        position{line: 1532, col: 5, offset: 37772},
 						val:        "m",
 						ignoreCase: false,
 						want:       "\"m\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1479, col: 5, offset: 36285},
+						pos:        position{line: 1533, col: 5, offset: 37780},
 						val:        "h",
 						ignoreCase: false,
 						want:       "\"h\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1480, col: 5, offset: 36293},
+						pos:        position{line: 1534, col: 5, offset: 37788},
 						val:        "d",
 						ignoreCase: false,
 						want:       "\"d\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1481, col: 5, offset: 36301},
+						pos:        position{line: 1535, col: 5, offset: 37796},
 						val:        "w",
 						ignoreCase: false,
 						want:       "\"w\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1482, col: 5, offset: 36309},
+						pos:        position{line: 1536, col: 5, offset: 37804},
 						val:        "y",
 						ignoreCase: false,
 						want:       "\"y\"",
@@ -10468,45 +10950,45 @@ var g = &grammar{
 		},
 		{
 			name: "IP",
-			pos:  position{line: 1484, col: 1, offset: 36314},
+			pos:  position{line: 1538, col: 1, offset: 37809},
 			expr: &actionExpr{
-				pos: position{line: 1485, col: 5, offset: 36321},
+				pos: position{line: 1539, col: 5, offset: 37816},
 				run: (*parser).callonIP1,
 				expr: &seqExpr{
-					pos: position{line: 1485, col: 5, offset: 36321},
+					pos: position{line: 1539, col: 5, offset: 37816},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1485, col: 5, offset: 36321},
+							pos:  position{line: 1539, col: 5, offset: 37816},
 							name: "UInt",
 						},
 						&litMatcher{
-							pos:        position{line: 1485, col: 10, offset: 36326},
+							pos:        position{line: 1539, col: 10, offset: 37821},
 							val:        ".",
 							ignoreCase: false,
 							want:       "\".\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1485, col: 14, offset: 36330},
+							pos:  position{line: 1539, col: 14, offset: 37825},
 							name: "UInt",
 						},
 						&litMatcher{
-							pos:        position{line: 1485, col: 19, offset: 36335},
+							pos:        position{line: 1539, col: 19, offset: 37830},
 							val:        ".",
 							ignoreCase: false,
 							want:       "\".\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1485, col: 23, offset: 36339},
+							pos:  position{line: 1539, col: 23, offset: 37834},
 							name: "UInt",
 						},
 						&litMatcher{
-							pos:        position{line: 1485, col: 28, offset: 36344},
+							pos:        position{line: 1539, col: 28, offset: 37839},
 							val:        ".",
 							ignoreCase: false,
 							want:       "\".\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1485, col: 32, offset: 36348},
+							pos:  position{line: 1539, col: 32, offset: 37843},
 							name: "UInt",
 						},
 					},
@@ -10517,43 +10999,43 @@ var g = &grammar{
 		},
 		{
 			name: "IP6",
-			pos:  position{line: 1487, col: 1, offset: 36385},
+			pos:  position{line: 1541, col: 1, offset: 37880},
 			expr: &actionExpr{
-				pos: position{line: 1488, col: 5, offset: 36393},
+				pos: position{line: 1542, col: 5, offset: 37888},
 				run: (*parser).callonIP61,
 				expr: &seqExpr{
-					pos: position{line: 1488, col: 5, offset: 36393},
+					pos: position{line: 1542, col: 5, offset: 37888},
 					exprs: []any{
 						&notExpr{
-							pos: position{line: 1488, col: 5, offset: 36393},
+							pos: position{line: 1542, col: 5, offset: 37888},
 							expr: &seqExpr{
-								pos: position{line: 1488, col: 7, offset: 36395},
+								pos: position{line: 1542, col: 7, offset: 37890},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 1488, col: 7, offset: 36395},
+										pos:  position{line: 1542, col: 7, offset: 37890},
 										name: "Hex",
 									},
 									&litMatcher{
-										pos:        position{line: 1488, col: 11, offset: 36399},
+										pos:        position{line: 1542, col: 11, offset: 37894},
 										val:        ":",
 										ignoreCase: false,
 										want:       "\":\"",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1488, col: 15, offset: 36403},
+										pos:  position{line: 1542, col: 15, offset: 37898},
 										name: "Hex",
 									},
 									&notExpr{
-										pos: position{line: 1488, col: 19, offset: 36407},
+										pos: position{line: 1542, col: 19, offset: 37902},
 										expr: &choiceExpr{
-											pos: position{line: 1488, col: 21, offset: 36409},
+											pos: position{line: 1542, col: 21, offset: 37904},
 											alternatives: []any{
 												&ruleRefExpr{
-													pos:  position{line: 1488, col: 21, offset: 36409},
+													pos:  position{line: 1542, col: 21, offset: 37904},
 													name: "HexDigit",
 												},
 												&litMatcher{
-													pos:        position{line: 1488, col: 32, offset: 36420},
+													pos:        position{line: 1542, col: 32, offset: 37915},
 													val:        ":",
 													ignoreCase: false,
 													want:       "\":\"",
@@ -10565,10 +11047,10 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1488, col: 38, offset: 36426},
+							pos:   position{line: 1542, col: 38, offset: 37921},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1488, col: 40, offset: 36428},
+								pos:  position{line: 1542, col: 40, offset: 37923},
 								name: "IP6Variations",
 							},
 						},
@@ -10580,32 +11062,32 @@ var g = &grammar{
 		},
 		{
 			name: "IP6Variations",
-			pos:  position{line: 1492, col: 1, offset: 36592},
+			pos:  position{line: 1546, col: 1, offset: 38087},
 			expr: &choiceExpr{
-				pos: position{line: 1493, col: 5, offset: 36610},
+				pos: position{line: 1547, col: 5, offset: 38105},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1493, col: 5, offset: 36610},
+						pos: position{line: 1547, col: 5, offset: 38105},
 						run: (*parser).callonIP6Variations2,
 						expr: &seqExpr{
-							pos: position{line: 1493, col: 5, offset: 36610},
+							pos: position{line: 1547, col: 5, offset: 38105},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1493, col: 5, offset: 36610},
+									pos:   position{line: 1547, col: 5, offset: 38105},
 									label: "a",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 1493, col: 7, offset: 36612},
+										pos: position{line: 1547, col: 7, offset: 38107},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1493, col: 7, offset: 36612},
+											pos:  position{line: 1547, col: 7, offset: 38107},
 											name: "HexColon",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1493, col: 17, offset: 36622},
+									pos:   position{line: 1547, col: 17, offset: 38117},
 									label: "b",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1493, col: 19, offset: 36624},
+										pos:  position{line: 1547, col: 19, offset: 38119},
 										name: "IP6Tail",
 									},
 								},
@@ -10613,52 +11095,52 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1496, col: 5, offset: 36688},
+						pos: position{line: 1550, col: 5, offset: 38183},
 						run: (*parser).callonIP6Variations9,
 						expr: &seqExpr{
-							pos: position{line: 1496, col: 5, offset: 36688},
+							pos: position{line: 1550, col: 5, offset: 38183},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1496, col: 5, offset: 36688},
+									pos:   position{line: 1550, col: 5, offset: 38183},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1496, col: 7, offset: 36690},
+										pos:  position{line: 1550, col: 7, offset: 38185},
 										name: "Hex",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1496, col: 11, offset: 36694},
+									pos:   position{line: 1550, col: 11, offset: 38189},
 									label: "b",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1496, col: 13, offset: 36696},
+										pos: position{line: 1550, col: 13, offset: 38191},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1496, col: 13, offset: 36696},
+											pos:  position{line: 1550, col: 13, offset: 38191},
 											name: "ColonHex",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1496, col: 23, offset: 36706},
+									pos:        position{line: 1550, col: 23, offset: 38201},
 									val:        "::",
 									ignoreCase: false,
 									want:       "\"::\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1496, col: 28, offset: 36711},
+									pos:   position{line: 1550, col: 28, offset: 38206},
 									label: "d",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1496, col: 30, offset: 36713},
+										pos: position{line: 1550, col: 30, offset: 38208},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1496, col: 30, offset: 36713},
+											pos:  position{line: 1550, col: 30, offset: 38208},
 											name: "HexColon",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1496, col: 40, offset: 36723},
+									pos:   position{line: 1550, col: 40, offset: 38218},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1496, col: 42, offset: 36725},
+										pos:  position{line: 1550, col: 42, offset: 38220},
 										name: "IP6Tail",
 									},
 								},
@@ -10666,33 +11148,33 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1499, col: 5, offset: 36824},
+						pos: position{line: 1553, col: 5, offset: 38319},
 						run: (*parser).callonIP6Variations22,
 						expr: &seqExpr{
-							pos: position{line: 1499, col: 5, offset: 36824},
+							pos: position{line: 1553, col: 5, offset: 38319},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1499, col: 5, offset: 36824},
+									pos:        position{line: 1553, col: 5, offset: 38319},
 									val:        "::",
 									ignoreCase: false,
 									want:       "\"::\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1499, col: 10, offset: 36829},
+									pos:   position{line: 1553, col: 10, offset: 38324},
 									label: "a",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1499, col: 12, offset: 36831},
+										pos: position{line: 1553, col: 12, offset: 38326},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1499, col: 12, offset: 36831},
+											pos:  position{line: 1553, col: 12, offset: 38326},
 											name: "HexColon",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1499, col: 22, offset: 36841},
+									pos:   position{line: 1553, col: 22, offset: 38336},
 									label: "b",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1499, col: 24, offset: 36843},
+										pos:  position{line: 1553, col: 24, offset: 38338},
 										name: "IP6Tail",
 									},
 								},
@@ -10700,32 +11182,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1502, col: 5, offset: 36914},
+						pos: position{line: 1556, col: 5, offset: 38409},
 						run: (*parser).callonIP6Variations30,
 						expr: &seqExpr{
-							pos: position{line: 1502, col: 5, offset: 36914},
+							pos: position{line: 1556, col: 5, offset: 38409},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1502, col: 5, offset: 36914},
+									pos:   position{line: 1556, col: 5, offset: 38409},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1502, col: 7, offset: 36916},
+										pos:  position{line: 1556, col: 7, offset: 38411},
 										name: "Hex",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1502, col: 11, offset: 36920},
+									pos:   position{line: 1556, col: 11, offset: 38415},
 									label: "b",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1502, col: 13, offset: 36922},
+										pos: position{line: 1556, col: 13, offset: 38417},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1502, col: 13, offset: 36922},
+											pos:  position{line: 1556, col: 13, offset: 38417},
 											name: "ColonHex",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1502, col: 23, offset: 36932},
+									pos:        position{line: 1556, col: 23, offset: 38427},
 									val:        "::",
 									ignoreCase: false,
 									want:       "\"::\"",
@@ -10734,10 +11216,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1505, col: 5, offset: 37000},
+						pos: position{line: 1559, col: 5, offset: 38495},
 						run: (*parser).callonIP6Variations38,
 						expr: &litMatcher{
-							pos:        position{line: 1505, col: 5, offset: 37000},
+							pos:        position{line: 1559, col: 5, offset: 38495},
 							val:        "::",
 							ignoreCase: false,
 							want:       "\"::\"",
@@ -10750,16 +11232,16 @@ var g = &grammar{
 		},
 		{
 			name: "IP6Tail",
-			pos:  position{line: 1509, col: 1, offset: 37037},
+			pos:  position{line: 1563, col: 1, offset: 38532},
 			expr: &choiceExpr{
-				pos: position{line: 1510, col: 5, offset: 37049},
+				pos: position{line: 1564, col: 5, offset: 38544},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1510, col: 5, offset: 37049},
+						pos:  position{line: 1564, col: 5, offset: 38544},
 						name: "IP",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1511, col: 5, offset: 37056},
+						pos:  position{line: 1565, col: 5, offset: 38551},
 						name: "Hex",
 					},
 				},
@@ -10769,24 +11251,24 @@ var g = &grammar{
 		},
 		{
 			name: "ColonHex",
-			pos:  position{line: 1513, col: 1, offset: 37061},
+			pos:  position{line: 1567, col: 1, offset: 38556},
 			expr: &actionExpr{
-				pos: position{line: 1513, col: 12, offset: 37072},
+				pos: position{line: 1567, col: 12, offset: 38567},
 				run: (*parser).callonColonHex1,
 				expr: &seqExpr{
-					pos: position{line: 1513, col: 12, offset: 37072},
+					pos: position{line: 1567, col: 12, offset: 38567},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1513, col: 12, offset: 37072},
+							pos:        position{line: 1567, col: 12, offset: 38567},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1513, col: 16, offset: 37076},
+							pos:   position{line: 1567, col: 16, offset: 38571},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1513, col: 18, offset: 37078},
+								pos:  position{line: 1567, col: 18, offset: 38573},
 								name: "Hex",
 							},
 						},
@@ -10798,23 +11280,23 @@ var g = &grammar{
 		},
 		{
 			name: "HexColon",
-			pos:  position{line: 1515, col: 1, offset: 37116},
+			pos:  position{line: 1569, col: 1, offset: 38611},
 			expr: &actionExpr{
-				pos: position{line: 1515, col: 12, offset: 37127},
+				pos: position{line: 1569, col: 12, offset: 38622},
 				run: (*parser).callonHexColon1,
 				expr: &seqExpr{
-					pos: position{line: 1515, col: 12, offset: 37127},
+					pos: position{line: 1569, col: 12, offset: 38622},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1515, col: 12, offset: 37127},
+							pos:   position{line: 1569, col: 12, offset: 38622},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1515, col: 14, offset: 37129},
+								pos:  position{line: 1569, col: 14, offset: 38624},
 								name: "Hex",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1515, col: 18, offset: 37133},
+							pos:        position{line: 1569, col: 18, offset: 38628},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
@@ -10827,32 +11309,32 @@ var g = &grammar{
 		},
 		{
 			name: "IP4Net",
-			pos:  position{line: 1517, col: 1, offset: 37171},
+			pos:  position{line: 1571, col: 1, offset: 38666},
 			expr: &actionExpr{
-				pos: position{line: 1518, col: 5, offset: 37182},
+				pos: position{line: 1572, col: 5, offset: 38677},
 				run: (*parser).callonIP4Net1,
 				expr: &seqExpr{
-					pos: position{line: 1518, col: 5, offset: 37182},
+					pos: position{line: 1572, col: 5, offset: 38677},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1518, col: 5, offset: 37182},
+							pos:   position{line: 1572, col: 5, offset: 38677},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1518, col: 7, offset: 37184},
+								pos:  position{line: 1572, col: 7, offset: 38679},
 								name: "IP",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1518, col: 10, offset: 37187},
+							pos:        position{line: 1572, col: 10, offset: 38682},
 							val:        "/",
 							ignoreCase: false,
 							want:       "\"/\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1518, col: 14, offset: 37191},
+							pos:   position{line: 1572, col: 14, offset: 38686},
 							label: "m",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1518, col: 16, offset: 37193},
+								pos:  position{line: 1572, col: 16, offset: 38688},
 								name: "UIntString",
 							},
 						},
@@ -10864,32 +11346,32 @@ var g = &grammar{
 		},
 		{
 			name: "IP6Net",
-			pos:  position{line: 1522, col: 1, offset: 37261},
+			pos:  position{line: 1576, col: 1, offset: 38756},
 			expr: &actionExpr{
-				pos: position{line: 1523, col: 5, offset: 37272},
+				pos: position{line: 1577, col: 5, offset: 38767},
 				run: (*parser).callonIP6Net1,
 				expr: &seqExpr{
-					pos: position{line: 1523, col: 5, offset: 37272},
+					pos: position{line: 1577, col: 5, offset: 38767},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1523, col: 5, offset: 37272},
+							pos:   position{line: 1577, col: 5, offset: 38767},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1523, col: 7, offset: 37274},
+								pos:  position{line: 1577, col: 7, offset: 38769},
 								name: "IP6",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1523, col: 11, offset: 37278},
+							pos:        position{line: 1577, col: 11, offset: 38773},
 							val:        "/",
 							ignoreCase: false,
 							want:       "\"/\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1523, col: 15, offset: 37282},
+							pos:   position{line: 1577, col: 15, offset: 38777},
 							label: "m",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1523, col: 17, offset: 37284},
+								pos:  position{line: 1577, col: 17, offset: 38779},
 								name: "UIntString",
 							},
 						},
@@ -10901,15 +11383,15 @@ var g = &grammar{
 		},
 		{
 			name: "UInt",
-			pos:  position{line: 1527, col: 1, offset: 37352},
+			pos:  position{line: 1581, col: 1, offset: 38847},
 			expr: &actionExpr{
-				pos: position{line: 1528, col: 4, offset: 37360},
+				pos: position{line: 1582, col: 4, offset: 38855},
 				run: (*parser).callonUInt1,
 				expr: &labeledExpr{
-					pos:   position{line: 1528, col: 4, offset: 37360},
+					pos:   position{line: 1582, col: 4, offset: 38855},
 					label: "s",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1528, col: 6, offset: 37362},
+						pos:  position{line: 1582, col: 6, offset: 38857},
 						name: "UIntString",
 					},
 				},
@@ -10919,16 +11401,16 @@ var g = &grammar{
 		},
 		{
 			name: "IntString",
-			pos:  position{line: 1530, col: 1, offset: 37402},
+			pos:  position{line: 1584, col: 1, offset: 38897},
 			expr: &choiceExpr{
-				pos: position{line: 1531, col: 5, offset: 37416},
+				pos: position{line: 1585, col: 5, offset: 38911},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1531, col: 5, offset: 37416},
+						pos:  position{line: 1585, col: 5, offset: 38911},
 						name: "UIntString",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1532, col: 5, offset: 37431},
+						pos:  position{line: 1586, col: 5, offset: 38926},
 						name: "MinusIntString",
 					},
 				},
@@ -10938,14 +11420,14 @@ var g = &grammar{
 		},
 		{
 			name: "UIntString",
-			pos:  position{line: 1534, col: 1, offset: 37447},
+			pos:  position{line: 1588, col: 1, offset: 38942},
 			expr: &actionExpr{
-				pos: position{line: 1534, col: 14, offset: 37460},
+				pos: position{line: 1588, col: 14, offset: 38955},
 				run: (*parser).callonUIntString1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1534, col: 14, offset: 37460},
+					pos: position{line: 1588, col: 14, offset: 38955},
 					expr: &charClassMatcher{
-						pos:        position{line: 1534, col: 14, offset: 37460},
+						pos:        position{line: 1588, col: 14, offset: 38955},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -10958,21 +11440,21 @@ var g = &grammar{
 		},
 		{
 			name: "MinusIntString",
-			pos:  position{line: 1536, col: 1, offset: 37499},
+			pos:  position{line: 1590, col: 1, offset: 38994},
 			expr: &actionExpr{
-				pos: position{line: 1537, col: 5, offset: 37518},
+				pos: position{line: 1591, col: 5, offset: 39013},
 				run: (*parser).callonMinusIntString1,
 				expr: &seqExpr{
-					pos: position{line: 1537, col: 5, offset: 37518},
+					pos: position{line: 1591, col: 5, offset: 39013},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1537, col: 5, offset: 37518},
+							pos:        position{line: 1591, col: 5, offset: 39013},
 							val:        "-",
 							ignoreCase: false,
 							want:       "\"-\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1537, col: 9, offset: 37522},
+							pos:  position{line: 1591, col: 9, offset: 39017},
 							name: "UIntString",
 						},
 					},
@@ -10983,29 +11465,29 @@ var g = &grammar{
 		},
 		{
 			name: "FloatString",
-			pos:  position{line: 1539, col: 1, offset: 37565},
+			pos:  position{line: 1593, col: 1, offset: 39060},
 			expr: &choiceExpr{
-				pos: position{line: 1540, col: 5, offset: 37581},
+				pos: position{line: 1594, col: 5, offset: 39076},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1540, col: 5, offset: 37581},
+						pos: position{line: 1594, col: 5, offset: 39076},
 						run: (*parser).callonFloatString2,
 						expr: &seqExpr{
-							pos: position{line: 1540, col: 5, offset: 37581},
+							pos: position{line: 1594, col: 5, offset: 39076},
 							exprs: []any{
 								&zeroOrOneExpr{
-									pos: position{line: 1540, col: 5, offset: 37581},
+									pos: position{line: 1594, col: 5, offset: 39076},
 									expr: &litMatcher{
-										pos:        position{line: 1540, col: 5, offset: 37581},
+										pos:        position{line: 1594, col: 5, offset: 39076},
 										val:        "-",
 										ignoreCase: false,
 										want:       "\"-\"",
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 1540, col: 10, offset: 37586},
+									pos: position{line: 1594, col: 10, offset: 39081},
 									expr: &charClassMatcher{
-										pos:        position{line: 1540, col: 10, offset: 37586},
+										pos:        position{line: 1594, col: 10, offset: 39081},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -11013,15 +11495,15 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1540, col: 17, offset: 37593},
+									pos:        position{line: 1594, col: 17, offset: 39088},
 									val:        ".",
 									ignoreCase: false,
 									want:       "\".\"",
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1540, col: 21, offset: 37597},
+									pos: position{line: 1594, col: 21, offset: 39092},
 									expr: &charClassMatcher{
-										pos:        position{line: 1540, col: 21, offset: 37597},
+										pos:        position{line: 1594, col: 21, offset: 39092},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -11029,9 +11511,9 @@ var g = &grammar{
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 1540, col: 28, offset: 37604},
+									pos: position{line: 1594, col: 28, offset: 39099},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1540, col: 28, offset: 37604},
+										pos:  position{line: 1594, col: 28, offset: 39099},
 										name: "ExponentPart",
 									},
 								},
@@ -11039,30 +11521,30 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1541, col: 5, offset: 37653},
+						pos: position{line: 1595, col: 5, offset: 39148},
 						run: (*parser).callonFloatString13,
 						expr: &seqExpr{
-							pos: position{line: 1541, col: 5, offset: 37653},
+							pos: position{line: 1595, col: 5, offset: 39148},
 							exprs: []any{
 								&zeroOrOneExpr{
-									pos: position{line: 1541, col: 5, offset: 37653},
+									pos: position{line: 1595, col: 5, offset: 39148},
 									expr: &litMatcher{
-										pos:        position{line: 1541, col: 5, offset: 37653},
+										pos:        position{line: 1595, col: 5, offset: 39148},
 										val:        "-",
 										ignoreCase: false,
 										want:       "\"-\"",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1541, col: 10, offset: 37658},
+									pos:        position{line: 1595, col: 10, offset: 39153},
 									val:        ".",
 									ignoreCase: false,
 									want:       "\".\"",
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 1541, col: 14, offset: 37662},
+									pos: position{line: 1595, col: 14, offset: 39157},
 									expr: &charClassMatcher{
-										pos:        position{line: 1541, col: 14, offset: 37662},
+										pos:        position{line: 1595, col: 14, offset: 39157},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -11070,9 +11552,9 @@ var g = &grammar{
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 1541, col: 21, offset: 37669},
+									pos: position{line: 1595, col: 21, offset: 39164},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1541, col: 21, offset: 37669},
+										pos:  position{line: 1595, col: 21, offset: 39164},
 										name: "ExponentPart",
 									},
 								},
@@ -11080,17 +11562,17 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1542, col: 5, offset: 37718},
+						pos: position{line: 1596, col: 5, offset: 39213},
 						run: (*parser).callonFloatString22,
 						expr: &choiceExpr{
-							pos: position{line: 1542, col: 6, offset: 37719},
+							pos: position{line: 1596, col: 6, offset: 39214},
 							alternatives: []any{
 								&ruleRefExpr{
-									pos:  position{line: 1542, col: 6, offset: 37719},
+									pos:  position{line: 1596, col: 6, offset: 39214},
 									name: "NaN",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1542, col: 12, offset: 37725},
+									pos:  position{line: 1596, col: 12, offset: 39220},
 									name: "Infinity",
 								},
 							},
@@ -11103,20 +11585,20 @@ var g = &grammar{
 		},
 		{
 			name: "ExponentPart",
-			pos:  position{line: 1545, col: 1, offset: 37768},
+			pos:  position{line: 1599, col: 1, offset: 39263},
 			expr: &seqExpr{
-				pos: position{line: 1545, col: 16, offset: 37783},
+				pos: position{line: 1599, col: 16, offset: 39278},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 1545, col: 16, offset: 37783},
+						pos:        position{line: 1599, col: 16, offset: 39278},
 						val:        "e",
 						ignoreCase: true,
 						want:       "\"e\"i",
 					},
 					&zeroOrOneExpr{
-						pos: position{line: 1545, col: 21, offset: 37788},
+						pos: position{line: 1599, col: 21, offset: 39283},
 						expr: &charClassMatcher{
-							pos:        position{line: 1545, col: 21, offset: 37788},
+							pos:        position{line: 1599, col: 21, offset: 39283},
 							val:        "[+-]",
 							chars:      []rune{'+', '-'},
 							ignoreCase: false,
@@ -11124,7 +11606,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1545, col: 27, offset: 37794},
+						pos:  position{line: 1599, col: 27, offset: 39289},
 						name: "UIntString",
 					},
 				},
@@ -11134,9 +11616,9 @@ var g = &grammar{
 		},
 		{
 			name: "NaN",
-			pos:  position{line: 1547, col: 1, offset: 37806},
+			pos:  position{line: 1601, col: 1, offset: 39301},
 			expr: &litMatcher{
-				pos:        position{line: 1547, col: 7, offset: 37812},
+				pos:        position{line: 1601, col: 7, offset: 39307},
 				val:        "NaN",
 				ignoreCase: false,
 				want:       "\"NaN\"",
@@ -11146,23 +11628,23 @@ var g = &grammar{
 		},
 		{
 			name: "Infinity",
-			pos:  position{line: 1549, col: 1, offset: 37819},
+			pos:  position{line: 1603, col: 1, offset: 39314},
 			expr: &seqExpr{
-				pos: position{line: 1549, col: 12, offset: 37830},
+				pos: position{line: 1603, col: 12, offset: 39325},
 				exprs: []any{
 					&zeroOrOneExpr{
-						pos: position{line: 1549, col: 12, offset: 37830},
+						pos: position{line: 1603, col: 12, offset: 39325},
 						expr: &choiceExpr{
-							pos: position{line: 1549, col: 13, offset: 37831},
+							pos: position{line: 1603, col: 13, offset: 39326},
 							alternatives: []any{
 								&litMatcher{
-									pos:        position{line: 1549, col: 13, offset: 37831},
+									pos:        position{line: 1603, col: 13, offset: 39326},
 									val:        "-",
 									ignoreCase: false,
 									want:       "\"-\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1549, col: 19, offset: 37837},
+									pos:        position{line: 1603, col: 19, offset: 39332},
 									val:        "+",
 									ignoreCase: false,
 									want:       "\"+\"",
@@ -11171,7 +11653,7 @@ var g = &grammar{
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 1549, col: 25, offset: 37843},
+						pos:        position{line: 1603, col: 25, offset: 39338},
 						val:        "Inf",
 						ignoreCase: false,
 						want:       "\"Inf\"",
@@ -11183,14 +11665,14 @@ var g = &grammar{
 		},
 		{
 			name: "Hex",
-			pos:  position{line: 1551, col: 1, offset: 37850},
+			pos:  position{line: 1605, col: 1, offset: 39345},
 			expr: &actionExpr{
-				pos: position{line: 1551, col: 7, offset: 37856},
+				pos: position{line: 1605, col: 7, offset: 39351},
 				run: (*parser).callonHex1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1551, col: 7, offset: 37856},
+					pos: position{line: 1605, col: 7, offset: 39351},
 					expr: &ruleRefExpr{
-						pos:  position{line: 1551, col: 7, offset: 37856},
+						pos:  position{line: 1605, col: 7, offset: 39351},
 						name: "HexDigit",
 					},
 				},
@@ -11200,9 +11682,9 @@ var g = &grammar{
 		},
 		{
 			name: "HexDigit",
-			pos:  position{line: 1553, col: 1, offset: 37898},
+			pos:  position{line: 1607, col: 1, offset: 39393},
 			expr: &charClassMatcher{
-				pos:        position{line: 1553, col: 12, offset: 37909},
+				pos:        position{line: 1607, col: 12, offset: 39404},
 				val:        "[0-9a-fA-F]",
 				ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
 				ignoreCase: false,
@@ -11213,35 +11695,35 @@ var g = &grammar{
 		},
 		{
 			name: "QuotedString",
-			pos:  position{line: 1555, col: 1, offset: 37922},
+			pos:  position{line: 1609, col: 1, offset: 39417},
 			expr: &choiceExpr{
-				pos: position{line: 1556, col: 5, offset: 37939},
+				pos: position{line: 1610, col: 5, offset: 39434},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1556, col: 5, offset: 37939},
+						pos: position{line: 1610, col: 5, offset: 39434},
 						run: (*parser).callonQuotedString2,
 						expr: &seqExpr{
-							pos: position{line: 1556, col: 5, offset: 37939},
+							pos: position{line: 1610, col: 5, offset: 39434},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1556, col: 5, offset: 37939},
+									pos:        position{line: 1610, col: 5, offset: 39434},
 									val:        "\"",
 									ignoreCase: false,
 									want:       "\"\\\"\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1556, col: 9, offset: 37943},
+									pos:   position{line: 1610, col: 9, offset: 39438},
 									label: "v",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1556, col: 11, offset: 37945},
+										pos: position{line: 1610, col: 11, offset: 39440},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1556, col: 11, offset: 37945},
+											pos:  position{line: 1610, col: 11, offset: 39440},
 											name: "DoubleQuotedChar",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1556, col: 29, offset: 37963},
+									pos:        position{line: 1610, col: 29, offset: 39458},
 									val:        "\"",
 									ignoreCase: false,
 									want:       "\"\\\"\"",
@@ -11250,30 +11732,30 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1557, col: 5, offset: 38000},
+						pos: position{line: 1611, col: 5, offset: 39495},
 						run: (*parser).callonQuotedString9,
 						expr: &seqExpr{
-							pos: position{line: 1557, col: 5, offset: 38000},
+							pos: position{line: 1611, col: 5, offset: 39495},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1557, col: 5, offset: 38000},
+									pos:        position{line: 1611, col: 5, offset: 39495},
 									val:        "'",
 									ignoreCase: false,
 									want:       "\"'\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1557, col: 9, offset: 38004},
+									pos:   position{line: 1611, col: 9, offset: 39499},
 									label: "v",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1557, col: 11, offset: 38006},
+										pos: position{line: 1611, col: 11, offset: 39501},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1557, col: 11, offset: 38006},
+											pos:  position{line: 1611, col: 11, offset: 39501},
 											name: "SingleQuotedChar",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1557, col: 29, offset: 38024},
+									pos:        position{line: 1611, col: 29, offset: 39519},
 									val:        "'",
 									ignoreCase: false,
 									want:       "\"'\"",
@@ -11288,57 +11770,57 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuotedChar",
-			pos:  position{line: 1559, col: 1, offset: 38058},
+			pos:  position{line: 1613, col: 1, offset: 39553},
 			expr: &choiceExpr{
-				pos: position{line: 1560, col: 5, offset: 38079},
+				pos: position{line: 1614, col: 5, offset: 39574},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1560, col: 5, offset: 38079},
+						pos: position{line: 1614, col: 5, offset: 39574},
 						run: (*parser).callonDoubleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 1560, col: 5, offset: 38079},
+							pos: position{line: 1614, col: 5, offset: 39574},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1560, col: 5, offset: 38079},
+									pos: position{line: 1614, col: 5, offset: 39574},
 									expr: &choiceExpr{
-										pos: position{line: 1560, col: 7, offset: 38081},
+										pos: position{line: 1614, col: 7, offset: 39576},
 										alternatives: []any{
 											&litMatcher{
-												pos:        position{line: 1560, col: 7, offset: 38081},
+												pos:        position{line: 1614, col: 7, offset: 39576},
 												val:        "\"",
 												ignoreCase: false,
 												want:       "\"\\\"\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1560, col: 13, offset: 38087},
+												pos:  position{line: 1614, col: 13, offset: 39582},
 												name: "EscapedChar",
 											},
 										},
 									},
 								},
 								&anyMatcher{
-									line: 1560, col: 26, offset: 38100,
+									line: 1614, col: 26, offset: 39595,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1561, col: 5, offset: 38137},
+						pos: position{line: 1615, col: 5, offset: 39632},
 						run: (*parser).callonDoubleQuotedChar9,
 						expr: &seqExpr{
-							pos: position{line: 1561, col: 5, offset: 38137},
+							pos: position{line: 1615, col: 5, offset: 39632},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1561, col: 5, offset: 38137},
+									pos:        position{line: 1615, col: 5, offset: 39632},
 									val:        "\\",
 									ignoreCase: false,
 									want:       "\"\\\\\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1561, col: 10, offset: 38142},
+									pos:   position{line: 1615, col: 10, offset: 39637},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1561, col: 12, offset: 38144},
+										pos:  position{line: 1615, col: 12, offset: 39639},
 										name: "EscapeSequence",
 									},
 								},
@@ -11352,28 +11834,28 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWord",
-			pos:  position{line: 1563, col: 1, offset: 38178},
+			pos:  position{line: 1617, col: 1, offset: 39673},
 			expr: &actionExpr{
-				pos: position{line: 1564, col: 5, offset: 38190},
+				pos: position{line: 1618, col: 5, offset: 39685},
 				run: (*parser).callonKeyWord1,
 				expr: &seqExpr{
-					pos: position{line: 1564, col: 5, offset: 38190},
+					pos: position{line: 1618, col: 5, offset: 39685},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1564, col: 5, offset: 38190},
+							pos:   position{line: 1618, col: 5, offset: 39685},
 							label: "head",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1564, col: 10, offset: 38195},
+								pos:  position{line: 1618, col: 10, offset: 39690},
 								name: "KeyWordStart",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1564, col: 23, offset: 38208},
+							pos:   position{line: 1618, col: 23, offset: 39703},
 							label: "tail",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1564, col: 28, offset: 38213},
+								pos: position{line: 1618, col: 28, offset: 39708},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1564, col: 28, offset: 38213},
+									pos:  position{line: 1618, col: 28, offset: 39708},
 									name: "KeyWordRest",
 								},
 							},
@@ -11386,16 +11868,16 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWordStart",
-			pos:  position{line: 1566, col: 1, offset: 38275},
+			pos:  position{line: 1620, col: 1, offset: 39770},
 			expr: &choiceExpr{
-				pos: position{line: 1567, col: 5, offset: 38292},
+				pos: position{line: 1621, col: 5, offset: 39787},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1567, col: 5, offset: 38292},
+						pos:  position{line: 1621, col: 5, offset: 39787},
 						name: "KeyWordChars",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1568, col: 5, offset: 38309},
+						pos:  position{line: 1622, col: 5, offset: 39804},
 						name: "KeyWordEsc",
 					},
 				},
@@ -11405,16 +11887,16 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWordRest",
-			pos:  position{line: 1570, col: 1, offset: 38321},
+			pos:  position{line: 1624, col: 1, offset: 39816},
 			expr: &choiceExpr{
-				pos: position{line: 1571, col: 5, offset: 38337},
+				pos: position{line: 1625, col: 5, offset: 39832},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1571, col: 5, offset: 38337},
+						pos:  position{line: 1625, col: 5, offset: 39832},
 						name: "KeyWordStart",
 					},
 					&charClassMatcher{
-						pos:        position{line: 1572, col: 5, offset: 38354},
+						pos:        position{line: 1626, col: 5, offset: 39849},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -11427,19 +11909,19 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWordChars",
-			pos:  position{line: 1574, col: 1, offset: 38361},
+			pos:  position{line: 1628, col: 1, offset: 39856},
 			expr: &actionExpr{
-				pos: position{line: 1574, col: 16, offset: 38376},
+				pos: position{line: 1628, col: 16, offset: 39871},
 				run: (*parser).callonKeyWordChars1,
 				expr: &choiceExpr{
-					pos: position{line: 1574, col: 17, offset: 38377},
+					pos: position{line: 1628, col: 17, offset: 39872},
 					alternatives: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1574, col: 17, offset: 38377},
+							pos:  position{line: 1628, col: 17, offset: 39872},
 							name: "UnicodeLetter",
 						},
 						&charClassMatcher{
-							pos:        position{line: 1574, col: 33, offset: 38393},
+							pos:        position{line: 1628, col: 33, offset: 39888},
 							val:        "[_.:/%#@~]",
 							chars:      []rune{'_', '.', ':', '/', '%', '#', '@', '~'},
 							ignoreCase: false,
@@ -11453,31 +11935,31 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWordEsc",
-			pos:  position{line: 1576, col: 1, offset: 38437},
+			pos:  position{line: 1630, col: 1, offset: 39932},
 			expr: &actionExpr{
-				pos: position{line: 1576, col: 14, offset: 38450},
+				pos: position{line: 1630, col: 14, offset: 39945},
 				run: (*parser).callonKeyWordEsc1,
 				expr: &seqExpr{
-					pos: position{line: 1576, col: 14, offset: 38450},
+					pos: position{line: 1630, col: 14, offset: 39945},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1576, col: 14, offset: 38450},
+							pos:        position{line: 1630, col: 14, offset: 39945},
 							val:        "\\",
 							ignoreCase: false,
 							want:       "\"\\\\\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1576, col: 19, offset: 38455},
+							pos:   position{line: 1630, col: 19, offset: 39950},
 							label: "s",
 							expr: &choiceExpr{
-								pos: position{line: 1576, col: 22, offset: 38458},
+								pos: position{line: 1630, col: 22, offset: 39953},
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 1576, col: 22, offset: 38458},
+										pos:  position{line: 1630, col: 22, offset: 39953},
 										name: "KeywordEscape",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1576, col: 38, offset: 38474},
+										pos:  position{line: 1630, col: 38, offset: 39969},
 										name: "EscapeSequence",
 									},
 								},
@@ -11491,42 +11973,42 @@ var g = &grammar{
 		},
 		{
 			name: "GlobPattern",
-			pos:  position{line: 1578, col: 1, offset: 38509},
+			pos:  position{line: 1632, col: 1, offset: 40004},
 			expr: &actionExpr{
-				pos: position{line: 1579, col: 5, offset: 38525},
+				pos: position{line: 1633, col: 5, offset: 40020},
 				run: (*parser).callonGlobPattern1,
 				expr: &seqExpr{
-					pos: position{line: 1579, col: 5, offset: 38525},
+					pos: position{line: 1633, col: 5, offset: 40020},
 					exprs: []any{
 						&andExpr{
-							pos: position{line: 1579, col: 5, offset: 38525},
+							pos: position{line: 1633, col: 5, offset: 40020},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1579, col: 6, offset: 38526},
+								pos:  position{line: 1633, col: 6, offset: 40021},
 								name: "GlobProperStart",
 							},
 						},
 						&andExpr{
-							pos: position{line: 1579, col: 22, offset: 38542},
+							pos: position{line: 1633, col: 22, offset: 40037},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1579, col: 23, offset: 38543},
+								pos:  position{line: 1633, col: 23, offset: 40038},
 								name: "GlobHasStar",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1579, col: 35, offset: 38555},
+							pos:   position{line: 1633, col: 35, offset: 40050},
 							label: "head",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1579, col: 40, offset: 38560},
+								pos:  position{line: 1633, col: 40, offset: 40055},
 								name: "GlobStart",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1579, col: 50, offset: 38570},
+							pos:   position{line: 1633, col: 50, offset: 40065},
 							label: "tail",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1579, col: 55, offset: 38575},
+								pos: position{line: 1633, col: 55, offset: 40070},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1579, col: 55, offset: 38575},
+									pos:  position{line: 1633, col: 55, offset: 40070},
 									name: "GlobRest",
 								},
 							},
@@ -11539,28 +12021,28 @@ var g = &grammar{
 		},
 		{
 			name: "GlobProperStart",
-			pos:  position{line: 1583, col: 1, offset: 38644},
+			pos:  position{line: 1637, col: 1, offset: 40139},
 			expr: &choiceExpr{
-				pos: position{line: 1583, col: 19, offset: 38662},
+				pos: position{line: 1637, col: 19, offset: 40157},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1583, col: 19, offset: 38662},
+						pos:  position{line: 1637, col: 19, offset: 40157},
 						name: "KeyWordStart",
 					},
 					&seqExpr{
-						pos: position{line: 1583, col: 34, offset: 38677},
+						pos: position{line: 1637, col: 34, offset: 40172},
 						exprs: []any{
 							&oneOrMoreExpr{
-								pos: position{line: 1583, col: 34, offset: 38677},
+								pos: position{line: 1637, col: 34, offset: 40172},
 								expr: &litMatcher{
-									pos:        position{line: 1583, col: 34, offset: 38677},
+									pos:        position{line: 1637, col: 34, offset: 40172},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1583, col: 39, offset: 38682},
+								pos:  position{line: 1637, col: 39, offset: 40177},
 								name: "KeyWordRest",
 							},
 						},
@@ -11572,19 +12054,19 @@ var g = &grammar{
 		},
 		{
 			name: "GlobHasStar",
-			pos:  position{line: 1584, col: 1, offset: 38694},
+			pos:  position{line: 1638, col: 1, offset: 40189},
 			expr: &seqExpr{
-				pos: position{line: 1584, col: 15, offset: 38708},
+				pos: position{line: 1638, col: 15, offset: 40203},
 				exprs: []any{
 					&zeroOrMoreExpr{
-						pos: position{line: 1584, col: 15, offset: 38708},
+						pos: position{line: 1638, col: 15, offset: 40203},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1584, col: 15, offset: 38708},
+							pos:  position{line: 1638, col: 15, offset: 40203},
 							name: "KeyWordRest",
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 1584, col: 28, offset: 38721},
+						pos:        position{line: 1638, col: 28, offset: 40216},
 						val:        "*",
 						ignoreCase: false,
 						want:       "\"*\"",
@@ -11596,23 +12078,23 @@ var g = &grammar{
 		},
 		{
 			name: "GlobStart",
-			pos:  position{line: 1586, col: 1, offset: 38726},
+			pos:  position{line: 1640, col: 1, offset: 40221},
 			expr: &choiceExpr{
-				pos: position{line: 1587, col: 5, offset: 38740},
+				pos: position{line: 1641, col: 5, offset: 40235},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1587, col: 5, offset: 38740},
+						pos:  position{line: 1641, col: 5, offset: 40235},
 						name: "KeyWordChars",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1588, col: 5, offset: 38757},
+						pos:  position{line: 1642, col: 5, offset: 40252},
 						name: "GlobEsc",
 					},
 					&actionExpr{
-						pos: position{line: 1589, col: 5, offset: 38769},
+						pos: position{line: 1643, col: 5, offset: 40264},
 						run: (*parser).callonGlobStart4,
 						expr: &litMatcher{
-							pos:        position{line: 1589, col: 5, offset: 38769},
+							pos:        position{line: 1643, col: 5, offset: 40264},
 							val:        "*",
 							ignoreCase: false,
 							want:       "\"*\"",
@@ -11625,16 +12107,16 @@ var g = &grammar{
 		},
 		{
 			name: "GlobRest",
-			pos:  position{line: 1591, col: 1, offset: 38794},
+			pos:  position{line: 1645, col: 1, offset: 40289},
 			expr: &choiceExpr{
-				pos: position{line: 1592, col: 5, offset: 38807},
+				pos: position{line: 1646, col: 5, offset: 40302},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1592, col: 5, offset: 38807},
+						pos:  position{line: 1646, col: 5, offset: 40302},
 						name: "GlobStart",
 					},
 					&charClassMatcher{
-						pos:        position{line: 1593, col: 5, offset: 38821},
+						pos:        position{line: 1647, col: 5, offset: 40316},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -11647,31 +12129,31 @@ var g = &grammar{
 		},
 		{
 			name: "GlobEsc",
-			pos:  position{line: 1595, col: 1, offset: 38828},
+			pos:  position{line: 1649, col: 1, offset: 40323},
 			expr: &actionExpr{
-				pos: position{line: 1595, col: 11, offset: 38838},
+				pos: position{line: 1649, col: 11, offset: 40333},
 				run: (*parser).callonGlobEsc1,
 				expr: &seqExpr{
-					pos: position{line: 1595, col: 11, offset: 38838},
+					pos: position{line: 1649, col: 11, offset: 40333},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1595, col: 11, offset: 38838},
+							pos:        position{line: 1649, col: 11, offset: 40333},
 							val:        "\\",
 							ignoreCase: false,
 							want:       "\"\\\\\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1595, col: 16, offset: 38843},
+							pos:   position{line: 1649, col: 16, offset: 40338},
 							label: "s",
 							expr: &choiceExpr{
-								pos: position{line: 1595, col: 19, offset: 38846},
+								pos: position{line: 1649, col: 19, offset: 40341},
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 1595, col: 19, offset: 38846},
+										pos:  position{line: 1649, col: 19, offset: 40341},
 										name: "GlobEscape",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1595, col: 32, offset: 38859},
+										pos:  position{line: 1649, col: 32, offset: 40354},
 										name: "EscapeSequence",
 									},
 								},
@@ -11685,32 +12167,32 @@ var g = &grammar{
 		},
 		{
 			name: "GlobEscape",
-			pos:  position{line: 1597, col: 1, offset: 38894},
+			pos:  position{line: 1651, col: 1, offset: 40389},
 			expr: &choiceExpr{
-				pos: position{line: 1598, col: 5, offset: 38909},
+				pos: position{line: 1652, col: 5, offset: 40404},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1598, col: 5, offset: 38909},
+						pos: position{line: 1652, col: 5, offset: 40404},
 						run: (*parser).callonGlobEscape2,
 						expr: &litMatcher{
-							pos:        position{line: 1598, col: 5, offset: 38909},
+							pos:        position{line: 1652, col: 5, offset: 40404},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1599, col: 5, offset: 38937},
+						pos: position{line: 1653, col: 5, offset: 40432},
 						run: (*parser).callonGlobEscape4,
 						expr: &litMatcher{
-							pos:        position{line: 1599, col: 5, offset: 38937},
+							pos:        position{line: 1653, col: 5, offset: 40432},
 							val:        "*",
 							ignoreCase: false,
 							want:       "\"*\"",
 						},
 					},
 					&charClassMatcher{
-						pos:        position{line: 1600, col: 5, offset: 38967},
+						pos:        position{line: 1654, col: 5, offset: 40462},
 						val:        "[+-]",
 						chars:      []rune{'+', '-'},
 						ignoreCase: false,
@@ -11723,57 +12205,57 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuotedChar",
-			pos:  position{line: 1602, col: 1, offset: 38973},
+			pos:  position{line: 1656, col: 1, offset: 40468},
 			expr: &choiceExpr{
-				pos: position{line: 1603, col: 5, offset: 38994},
+				pos: position{line: 1657, col: 5, offset: 40489},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1603, col: 5, offset: 38994},
+						pos: position{line: 1657, col: 5, offset: 40489},
 						run: (*parser).callonSingleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 1603, col: 5, offset: 38994},
+							pos: position{line: 1657, col: 5, offset: 40489},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1603, col: 5, offset: 38994},
+									pos: position{line: 1657, col: 5, offset: 40489},
 									expr: &choiceExpr{
-										pos: position{line: 1603, col: 7, offset: 38996},
+										pos: position{line: 1657, col: 7, offset: 40491},
 										alternatives: []any{
 											&litMatcher{
-												pos:        position{line: 1603, col: 7, offset: 38996},
+												pos:        position{line: 1657, col: 7, offset: 40491},
 												val:        "'",
 												ignoreCase: false,
 												want:       "\"'\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1603, col: 13, offset: 39002},
+												pos:  position{line: 1657, col: 13, offset: 40497},
 												name: "EscapedChar",
 											},
 										},
 									},
 								},
 								&anyMatcher{
-									line: 1603, col: 26, offset: 39015,
+									line: 1657, col: 26, offset: 40510,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1604, col: 5, offset: 39052},
+						pos: position{line: 1658, col: 5, offset: 40547},
 						run: (*parser).callonSingleQuotedChar9,
 						expr: &seqExpr{
-							pos: position{line: 1604, col: 5, offset: 39052},
+							pos: position{line: 1658, col: 5, offset: 40547},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1604, col: 5, offset: 39052},
+									pos:        position{line: 1658, col: 5, offset: 40547},
 									val:        "\\",
 									ignoreCase: false,
 									want:       "\"\\\\\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1604, col: 10, offset: 39057},
+									pos:   position{line: 1658, col: 10, offset: 40552},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1604, col: 12, offset: 39059},
+										pos:  position{line: 1658, col: 12, offset: 40554},
 										name: "EscapeSequence",
 									},
 								},
@@ -11787,16 +12269,16 @@ var g = &grammar{
 		},
 		{
 			name: "EscapeSequence",
-			pos:  position{line: 1606, col: 1, offset: 39093},
+			pos:  position{line: 1660, col: 1, offset: 40588},
 			expr: &choiceExpr{
-				pos: position{line: 1607, col: 5, offset: 39112},
+				pos: position{line: 1661, col: 5, offset: 40607},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1607, col: 5, offset: 39112},
+						pos:  position{line: 1661, col: 5, offset: 40607},
 						name: "SingleCharEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1608, col: 5, offset: 39133},
+						pos:  position{line: 1662, col: 5, offset: 40628},
 						name: "UnicodeEscape",
 					},
 				},
@@ -11806,87 +12288,87 @@ var g = &grammar{
 		},
 		{
 			name: "SingleCharEscape",
-			pos:  position{line: 1610, col: 1, offset: 39148},
+			pos:  position{line: 1664, col: 1, offset: 40643},
 			expr: &choiceExpr{
-				pos: position{line: 1611, col: 5, offset: 39169},
+				pos: position{line: 1665, col: 5, offset: 40664},
 				alternatives: []any{
 					&litMatcher{
-						pos:        position{line: 1611, col: 5, offset: 39169},
+						pos:        position{line: 1665, col: 5, offset: 40664},
 						val:        "'",
 						ignoreCase: false,
 						want:       "\"'\"",
 					},
 					&actionExpr{
-						pos: position{line: 1612, col: 5, offset: 39177},
+						pos: position{line: 1666, col: 5, offset: 40672},
 						run: (*parser).callonSingleCharEscape3,
 						expr: &litMatcher{
-							pos:        position{line: 1612, col: 5, offset: 39177},
+							pos:        position{line: 1666, col: 5, offset: 40672},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 1613, col: 5, offset: 39217},
+						pos:        position{line: 1667, col: 5, offset: 40712},
 						val:        "\\",
 						ignoreCase: false,
 						want:       "\"\\\\\"",
 					},
 					&actionExpr{
-						pos: position{line: 1614, col: 5, offset: 39226},
+						pos: position{line: 1668, col: 5, offset: 40721},
 						run: (*parser).callonSingleCharEscape6,
 						expr: &litMatcher{
-							pos:        position{line: 1614, col: 5, offset: 39226},
+							pos:        position{line: 1668, col: 5, offset: 40721},
 							val:        "b",
 							ignoreCase: false,
 							want:       "\"b\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1615, col: 5, offset: 39255},
+						pos: position{line: 1669, col: 5, offset: 40750},
 						run: (*parser).callonSingleCharEscape8,
 						expr: &litMatcher{
-							pos:        position{line: 1615, col: 5, offset: 39255},
+							pos:        position{line: 1669, col: 5, offset: 40750},
 							val:        "f",
 							ignoreCase: false,
 							want:       "\"f\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1616, col: 5, offset: 39284},
+						pos: position{line: 1670, col: 5, offset: 40779},
 						run: (*parser).callonSingleCharEscape10,
 						expr: &litMatcher{
-							pos:        position{line: 1616, col: 5, offset: 39284},
+							pos:        position{line: 1670, col: 5, offset: 40779},
 							val:        "n",
 							ignoreCase: false,
 							want:       "\"n\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1617, col: 5, offset: 39313},
+						pos: position{line: 1671, col: 5, offset: 40808},
 						run: (*parser).callonSingleCharEscape12,
 						expr: &litMatcher{
-							pos:        position{line: 1617, col: 5, offset: 39313},
+							pos:        position{line: 1671, col: 5, offset: 40808},
 							val:        "r",
 							ignoreCase: false,
 							want:       "\"r\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1618, col: 5, offset: 39342},
+						pos: position{line: 1672, col: 5, offset: 40837},
 						run: (*parser).callonSingleCharEscape14,
 						expr: &litMatcher{
-							pos:        position{line: 1618, col: 5, offset: 39342},
+							pos:        position{line: 1672, col: 5, offset: 40837},
 							val:        "t",
 							ignoreCase: false,
 							want:       "\"t\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1619, col: 5, offset: 39371},
+						pos: position{line: 1673, col: 5, offset: 40866},
 						run: (*parser).callonSingleCharEscape16,
 						expr: &litMatcher{
-							pos:        position{line: 1619, col: 5, offset: 39371},
+							pos:        position{line: 1673, col: 5, offset: 40866},
 							val:        "v",
 							ignoreCase: false,
 							want:       "\"v\"",
@@ -11899,32 +12381,32 @@ var g = &grammar{
 		},
 		{
 			name: "KeywordEscape",
-			pos:  position{line: 1621, col: 1, offset: 39397},
+			pos:  position{line: 1675, col: 1, offset: 40892},
 			expr: &choiceExpr{
-				pos: position{line: 1622, col: 5, offset: 39415},
+				pos: position{line: 1676, col: 5, offset: 40910},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1622, col: 5, offset: 39415},
+						pos: position{line: 1676, col: 5, offset: 40910},
 						run: (*parser).callonKeywordEscape2,
 						expr: &litMatcher{
-							pos:        position{line: 1622, col: 5, offset: 39415},
+							pos:        position{line: 1676, col: 5, offset: 40910},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1623, col: 5, offset: 39443},
+						pos: position{line: 1677, col: 5, offset: 40938},
 						run: (*parser).callonKeywordEscape4,
 						expr: &litMatcher{
-							pos:        position{line: 1623, col: 5, offset: 39443},
+							pos:        position{line: 1677, col: 5, offset: 40938},
 							val:        "*",
 							ignoreCase: false,
 							want:       "\"*\"",
 						},
 					},
 					&charClassMatcher{
-						pos:        position{line: 1624, col: 5, offset: 39471},
+						pos:        position{line: 1678, col: 5, offset: 40966},
 						val:        "[+-]",
 						chars:      []rune{'+', '-'},
 						ignoreCase: false,
@@ -11937,42 +12419,42 @@ var g = &grammar{
 		},
 		{
 			name: "UnicodeEscape",
-			pos:  position{line: 1626, col: 1, offset: 39477},
+			pos:  position{line: 1680, col: 1, offset: 40972},
 			expr: &choiceExpr{
-				pos: position{line: 1627, col: 5, offset: 39495},
+				pos: position{line: 1681, col: 5, offset: 40990},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1627, col: 5, offset: 39495},
+						pos: position{line: 1681, col: 5, offset: 40990},
 						run: (*parser).callonUnicodeEscape2,
 						expr: &seqExpr{
-							pos: position{line: 1627, col: 5, offset: 39495},
+							pos: position{line: 1681, col: 5, offset: 40990},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1627, col: 5, offset: 39495},
+									pos:        position{line: 1681, col: 5, offset: 40990},
 									val:        "u",
 									ignoreCase: false,
 									want:       "\"u\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1627, col: 9, offset: 39499},
+									pos:   position{line: 1681, col: 9, offset: 40994},
 									label: "chars",
 									expr: &seqExpr{
-										pos: position{line: 1627, col: 16, offset: 39506},
+										pos: position{line: 1681, col: 16, offset: 41001},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1627, col: 16, offset: 39506},
+												pos:  position{line: 1681, col: 16, offset: 41001},
 												name: "HexDigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1627, col: 25, offset: 39515},
+												pos:  position{line: 1681, col: 25, offset: 41010},
 												name: "HexDigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1627, col: 34, offset: 39524},
+												pos:  position{line: 1681, col: 34, offset: 41019},
 												name: "HexDigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1627, col: 43, offset: 39533},
+												pos:  position{line: 1681, col: 43, offset: 41028},
 												name: "HexDigit",
 											},
 										},
@@ -11982,65 +12464,65 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1630, col: 5, offset: 39596},
+						pos: position{line: 1684, col: 5, offset: 41091},
 						run: (*parser).callonUnicodeEscape11,
 						expr: &seqExpr{
-							pos: position{line: 1630, col: 5, offset: 39596},
+							pos: position{line: 1684, col: 5, offset: 41091},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1630, col: 5, offset: 39596},
+									pos:        position{line: 1684, col: 5, offset: 41091},
 									val:        "u",
 									ignoreCase: false,
 									want:       "\"u\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1630, col: 9, offset: 39600},
+									pos:        position{line: 1684, col: 9, offset: 41095},
 									val:        "{",
 									ignoreCase: false,
 									want:       "\"{\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1630, col: 13, offset: 39604},
+									pos:   position{line: 1684, col: 13, offset: 41099},
 									label: "chars",
 									expr: &seqExpr{
-										pos: position{line: 1630, col: 20, offset: 39611},
+										pos: position{line: 1684, col: 20, offset: 41106},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1630, col: 20, offset: 39611},
+												pos:  position{line: 1684, col: 20, offset: 41106},
 												name: "HexDigit",
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1630, col: 29, offset: 39620},
+												pos: position{line: 1684, col: 29, offset: 41115},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1630, col: 29, offset: 39620},
+													pos:  position{line: 1684, col: 29, offset: 41115},
 													name: "HexDigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1630, col: 39, offset: 39630},
+												pos: position{line: 1684, col: 39, offset: 41125},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1630, col: 39, offset: 39630},
+													pos:  position{line: 1684, col: 39, offset: 41125},
 													name: "HexDigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1630, col: 49, offset: 39640},
+												pos: position{line: 1684, col: 49, offset: 41135},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1630, col: 49, offset: 39640},
+													pos:  position{line: 1684, col: 49, offset: 41135},
 													name: "HexDigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1630, col: 59, offset: 39650},
+												pos: position{line: 1684, col: 59, offset: 41145},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1630, col: 59, offset: 39650},
+													pos:  position{line: 1684, col: 59, offset: 41145},
 													name: "HexDigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1630, col: 69, offset: 39660},
+												pos: position{line: 1684, col: 69, offset: 41155},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1630, col: 69, offset: 39660},
+													pos:  position{line: 1684, col: 69, offset: 41155},
 													name: "HexDigit",
 												},
 											},
@@ -12048,7 +12530,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1630, col: 80, offset: 39671},
+									pos:        position{line: 1684, col: 80, offset: 41166},
 									val:        "}",
 									ignoreCase: false,
 									want:       "\"}\"",
@@ -12063,37 +12545,37 @@ var g = &grammar{
 		},
 		{
 			name: "RegexpPattern",
-			pos:  position{line: 1634, col: 1, offset: 39725},
+			pos:  position{line: 1688, col: 1, offset: 41220},
 			expr: &actionExpr{
-				pos: position{line: 1635, col: 5, offset: 39743},
+				pos: position{line: 1689, col: 5, offset: 41238},
 				run: (*parser).callonRegexpPattern1,
 				expr: &seqExpr{
-					pos: position{line: 1635, col: 5, offset: 39743},
+					pos: position{line: 1689, col: 5, offset: 41238},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1635, col: 5, offset: 39743},
+							pos:        position{line: 1689, col: 5, offset: 41238},
 							val:        "/",
 							ignoreCase: false,
 							want:       "\"/\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1635, col: 9, offset: 39747},
+							pos:   position{line: 1689, col: 9, offset: 41242},
 							label: "body",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1635, col: 14, offset: 39752},
+								pos:  position{line: 1689, col: 14, offset: 41247},
 								name: "RegexpBody",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1635, col: 25, offset: 39763},
+							pos:        position{line: 1689, col: 25, offset: 41258},
 							val:        "/",
 							ignoreCase: false,
 							want:       "\"/\"",
 						},
 						&notExpr{
-							pos: position{line: 1635, col: 29, offset: 39767},
+							pos: position{line: 1689, col: 29, offset: 41262},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1635, col: 30, offset: 39768},
+								pos:  position{line: 1689, col: 30, offset: 41263},
 								name: "KeyWordStart",
 							},
 						},
@@ -12105,33 +12587,33 @@ var g = &grammar{
 		},
 		{
 			name: "RegexpBody",
-			pos:  position{line: 1637, col: 1, offset: 39803},
+			pos:  position{line: 1691, col: 1, offset: 41298},
 			expr: &actionExpr{
-				pos: position{line: 1638, col: 5, offset: 39818},
+				pos: position{line: 1692, col: 5, offset: 41313},
 				run: (*parser).callonRegexpBody1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1638, col: 5, offset: 39818},
+					pos: position{line: 1692, col: 5, offset: 41313},
 					expr: &choiceExpr{
-						pos: position{line: 1638, col: 6, offset: 39819},
+						pos: position{line: 1692, col: 6, offset: 41314},
 						alternatives: []any{
 							&charClassMatcher{
-								pos:        position{line: 1638, col: 6, offset: 39819},
+								pos:        position{line: 1692, col: 6, offset: 41314},
 								val:        "[^/\\\\]",
 								chars:      []rune{'/', '\\'},
 								ignoreCase: false,
 								inverted:   true,
 							},
 							&seqExpr{
-								pos: position{line: 1638, col: 15, offset: 39828},
+								pos: position{line: 1692, col: 15, offset: 41323},
 								exprs: []any{
 									&litMatcher{
-										pos:        position{line: 1638, col: 15, offset: 39828},
+										pos:        position{line: 1692, col: 15, offset: 41323},
 										val:        "\\",
 										ignoreCase: false,
 										want:       "\"\\\\\"",
 									},
 									&anyMatcher{
-										line: 1638, col: 20, offset: 39833,
+										line: 1692, col: 20, offset: 41328,
 									},
 								},
 							},
@@ -12144,9 +12626,9 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedChar",
-			pos:  position{line: 1640, col: 1, offset: 39869},
+			pos:  position{line: 1694, col: 1, offset: 41364},
 			expr: &charClassMatcher{
-				pos:        position{line: 1641, col: 5, offset: 39885},
+				pos:        position{line: 1695, col: 5, offset: 41380},
 				val:        "[\\x00-\\x1f\\\\]",
 				chars:      []rune{'\\'},
 				ranges:     []rune{'\x00', '\x1f'},
@@ -12158,11 +12640,11 @@ var g = &grammar{
 		},
 		{
 			name: "_",
-			pos:  position{line: 1643, col: 1, offset: 39900},
+			pos:  position{line: 1697, col: 1, offset: 41395},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 1643, col: 5, offset: 39904},
+				pos: position{line: 1697, col: 5, offset: 41399},
 				expr: &ruleRefExpr{
-					pos:  position{line: 1643, col: 5, offset: 39904},
+					pos:  position{line: 1697, col: 5, offset: 41399},
 					name: "AnySpace",
 				},
 			},
@@ -12171,11 +12653,11 @@ var g = &grammar{
 		},
 		{
 			name: "__",
-			pos:  position{line: 1645, col: 1, offset: 39915},
+			pos:  position{line: 1699, col: 1, offset: 41410},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1645, col: 6, offset: 39920},
+				pos: position{line: 1699, col: 6, offset: 41415},
 				expr: &ruleRefExpr{
-					pos:  position{line: 1645, col: 6, offset: 39920},
+					pos:  position{line: 1699, col: 6, offset: 41415},
 					name: "AnySpace",
 				},
 			},
@@ -12184,20 +12666,20 @@ var g = &grammar{
 		},
 		{
 			name: "AnySpace",
-			pos:  position{line: 1647, col: 1, offset: 39931},
+			pos:  position{line: 1701, col: 1, offset: 41426},
 			expr: &choiceExpr{
-				pos: position{line: 1648, col: 5, offset: 39944},
+				pos: position{line: 1702, col: 5, offset: 41439},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1648, col: 5, offset: 39944},
+						pos:  position{line: 1702, col: 5, offset: 41439},
 						name: "WhiteSpace",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1649, col: 5, offset: 39959},
+						pos:  position{line: 1703, col: 5, offset: 41454},
 						name: "LineTerminator",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1650, col: 5, offset: 39978},
+						pos:  position{line: 1704, col: 5, offset: 41473},
 						name: "Comment",
 					},
 				},
@@ -12207,32 +12689,32 @@ var g = &grammar{
 		},
 		{
 			name: "UnicodeLetter",
-			pos:  position{line: 1652, col: 1, offset: 39987},
+			pos:  position{line: 1706, col: 1, offset: 41482},
 			expr: &choiceExpr{
-				pos: position{line: 1653, col: 5, offset: 40005},
+				pos: position{line: 1707, col: 5, offset: 41500},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1653, col: 5, offset: 40005},
+						pos:  position{line: 1707, col: 5, offset: 41500},
 						name: "Lu",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1654, col: 5, offset: 40012},
+						pos:  position{line: 1708, col: 5, offset: 41507},
 						name: "Ll",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1655, col: 5, offset: 40019},
+						pos:  position{line: 1709, col: 5, offset: 41514},
 						name: "Lt",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1656, col: 5, offset: 40026},
+						pos:  position{line: 1710, col: 5, offset: 41521},
 						name: "Lm",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1657, col: 5, offset: 40033},
+						pos:  position{line: 1711, col: 5, offset: 41528},
 						name: "Lo",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1658, col: 5, offset: 40040},
+						pos:  position{line: 1712, col: 5, offset: 41535},
 						name: "Nl",
 					},
 				},
@@ -12242,16 +12724,16 @@ var g = &grammar{
 		},
 		{
 			name: "UnicodeCombiningMark",
-			pos:  position{line: 1660, col: 1, offset: 40044},
+			pos:  position{line: 1714, col: 1, offset: 41539},
 			expr: &choiceExpr{
-				pos: position{line: 1661, col: 5, offset: 40069},
+				pos: position{line: 1715, col: 5, offset: 41564},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1661, col: 5, offset: 40069},
+						pos:  position{line: 1715, col: 5, offset: 41564},
 						name: "Mn",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1662, col: 5, offset: 40076},
+						pos:  position{line: 1716, col: 5, offset: 41571},
 						name: "Mc",
 					},
 				},
@@ -12261,9 +12743,9 @@ var g = &grammar{
 		},
 		{
 			name: "UnicodeDigit",
-			pos:  position{line: 1664, col: 1, offset: 40080},
+			pos:  position{line: 1718, col: 1, offset: 41575},
 			expr: &ruleRefExpr{
-				pos:  position{line: 1665, col: 5, offset: 40097},
+				pos:  position{line: 1719, col: 5, offset: 41592},
 				name: "Nd",
 			},
 			leader:        false,
@@ -12271,9 +12753,9 @@ var g = &grammar{
 		},
 		{
 			name: "UnicodeConnectorPunctuation",
-			pos:  position{line: 1667, col: 1, offset: 40101},
+			pos:  position{line: 1721, col: 1, offset: 41596},
 			expr: &ruleRefExpr{
-				pos:  position{line: 1668, col: 5, offset: 40133},
+				pos:  position{line: 1722, col: 5, offset: 41628},
 				name: "Pc",
 			},
 			leader:        false,
@@ -12281,9 +12763,9 @@ var g = &grammar{
 		},
 		{
 			name: "Ll",
-			pos:  position{line: 1674, col: 1, offset: 40314},
+			pos:  position{line: 1728, col: 1, offset: 41809},
 			expr: &charClassMatcher{
-				pos:        position{line: 1674, col: 6, offset: 40319},
+				pos:        position{line: 1728, col: 6, offset: 41814},
 				val:        "[\\u0061-\\u007A\\u00B5\\u00DF-\\u00F6\\u00F8-\\u00FF\\u0101\\u0103\\u0105\\u0107\\u0109\\u010B\\u010D\\u010F\\u0111\\u0113\\u0115\\u0117\\u0119\\u011B\\u011D\\u011F\\u0121\\u0123\\u0125\\u0127\\u0129\\u012B\\u012D\\u012F\\u0131\\u0133\\u0135\\u0137-\\u0138\\u013A\\u013C\\u013E\\u0140\\u0142\\u0144\\u0146\\u0148-\\u0149\\u014B\\u014D\\u014F\\u0151\\u0153\\u0155\\u0157\\u0159\\u015B\\u015D\\u015F\\u0161\\u0163\\u0165\\u0167\\u0169\\u016B\\u016D\\u016F\\u0171\\u0173\\u0175\\u0177\\u017A\\u017C\\u017E-\\u0180\\u0183\\u0185\\u0188\\u018C-\\u018D\\u0192\\u0195\\u0199-\\u019B\\u019E\\u01A1\\u01A3\\u01A5\\u01A8\\u01AA-\\u01AB\\u01AD\\u01B0\\u01B4\\u01B6\\u01B9-\\u01BA\\u01BD-\\u01BF\\u01C6\\u01C9\\u01CC\\u01CE\\u01D0\\u01D2\\u01D4\\u01D6\\u01D8\\u01DA\\u01DC-\\u01DD\\u01DF\\u01E1\\u01E3\\u01E5\\u01E7\\u01E9\\u01EB\\u01ED\\u01EF-\\u01F0\\u01F3\\u01F5\\u01F9\\u01FB\\u01FD\\u01FF\\u0201\\u0203\\u0205\\u0207\\u0209\\u020B\\u020D\\u020F\\u0211\\u0213\\u0215\\u0217\\u0219\\u021B\\u021D\\u021F\\u0221\\u0223\\u0225\\u0227\\u0229\\u022B\\u022D\\u022F\\u0231\\u0233-\\u0239\\u023C\\u023F-\\u0240\\u0242\\u0247\\u0249\\u024B\\u024D\\u024F-\\u0293\\u0295-\\u02AF\\u0371\\u0373\\u0377\\u037B-\\u037D\\u0390\\u03AC-\\u03CE\\u03D0-\\u03D1\\u03D5-\\u03D7\\u03D9\\u03DB\\u03DD\\u03DF\\u03E1\\u03E3\\u03E5\\u03E7\\u03E9\\u03EB\\u03ED\\u03EF-\\u03F3\\u03F5\\u03F8\\u03FB-\\u03FC\\u0430-\\u045F\\u0461\\u0463\\u0465\\u0467\\u0469\\u046B\\u046D\\u046F\\u0471\\u0473\\u0475\\u0477\\u0479\\u047B\\u047D\\u047F\\u0481\\u048B\\u048D\\u048F\\u0491\\u0493\\u0495\\u0497\\u0499\\u049B\\u049D\\u049F\\u04A1\\u04A3\\u04A5\\u04A7\\u04A9\\u04AB\\u04AD\\u04AF\\u04B1\\u04B3\\u04B5\\u04B7\\u04B9\\u04BB\\u04BD\\u04BF\\u04C2\\u04C4\\u04C6\\u04C8\\u04CA\\u04CC\\u04CE-\\u04CF\\u04D1\\u04D3\\u04D5\\u04D7\\u04D9\\u04DB\\u04DD\\u04DF\\u04E1\\u04E3\\u04E5\\u04E7\\u04E9\\u04EB\\u04ED\\u04EF\\u04F1\\u04F3\\u04F5\\u04F7\\u04F9\\u04FB\\u04FD\\u04FF\\u0501\\u0503\\u0505\\u0507\\u0509\\u050B\\u050D\\u050F\\u0511\\u0513\\u0515\\u0517\\u0519\\u051B\\u051D\\u051F\\u0521\\u0523\\u0525\\u0527\\u0529\\u052B\\u052D\\u052F\\u0560-\\u0588\\u10D0-\\u10FA\\u10FD-\\u10FF\\u13F8-\\u13FD\\u1C80-\\u1C88\\u1D00-\\u1D2B\\u1D6B-\\u1D77\\u1D79-\\u1D9A\\u1E01\\u1E03\\u1E05\\u1E07\\u1E09\\u1E0B\\u1E0D\\u1E0F\\u1E11\\u1E13\\u1E15\\u1E17\\u1E19\\u1E1B\\u1E1D\\u1E1F\\u1E21\\u1E23\\u1E25\\u1E27\\u1E29\\u1E2B\\u1E2D\\u1E2F\\u1E31\\u1E33\\u1E35\\u1E37\\u1E39\\u1E3B\\u1E3D\\u1E3F\\u1E41\\u1E43\\u1E45\\u1E47\\u1E49\\u1E4B\\u1E4D\\u1E4F\\u1E51\\u1E53\\u1E55\\u1E57\\u1E59\\u1E5B\\u1E5D\\u1E5F\\u1E61\\u1E63\\u1E65\\u1E67\\u1E69\\u1E6B\\u1E6D\\u1E6F\\u1E71\\u1E73\\u1E75\\u1E77\\u1E79\\u1E7B\\u1E7D\\u1E7F\\u1E81\\u1E83\\u1E85\\u1E87\\u1E89\\u1E8B\\u1E8D\\u1E8F\\u1E91\\u1E93\\u1E95-\\u1E9D\\u1E9F\\u1EA1\\u1EA3\\u1EA5\\u1EA7\\u1EA9\\u1EAB\\u1EAD\\u1EAF\\u1EB1\\u1EB3\\u1EB5\\u1EB7\\u1EB9\\u1EBB\\u1EBD\\u1EBF\\u1EC1\\u1EC3\\u1EC5\\u1EC7\\u1EC9\\u1ECB\\u1ECD\\u1ECF\\u1ED1\\u1ED3\\u1ED5\\u1ED7\\u1ED9\\u1EDB\\u1EDD\\u1EDF\\u1EE1\\u1EE3\\u1EE5\\u1EE7\\u1EE9\\u1EEB\\u1EED\\u1EEF\\u1EF1\\u1EF3\\u1EF5\\u1EF7\\u1EF9\\u1EFB\\u1EFD\\u1EFF-\\u1F07\\u1F10-\\u1F15\\u1F20-\\u1F27\\u1F30-\\u1F37\\u1F40-\\u1F45\\u1F50-\\u1F57\\u1F60-\\u1F67\\u1F70-\\u1F7D\\u1F80-\\u1F87\\u1F90-\\u1F97\\u1FA0-\\u1FA7\\u1FB0-\\u1FB4\\u1FB6-\\u1FB7\\u1FBE\\u1FC2-\\u1FC4\\u1FC6-\\u1FC7\\u1FD0-\\u1FD3\\u1FD6-\\u1FD7\\u1FE0-\\u1FE7\\u1FF2-\\u1FF4\\u1FF6-\\u1FF7\\u210A\\u210E-\\u210F\\u2113\\u212F\\u2134\\u2139\\u213C-\\u213D\\u2146-\\u2149\\u214E\\u2184\\u2C30-\\u2C5E\\u2C61\\u2C65-\\u2C66\\u2C68\\u2C6A\\u2C6C\\u2C71\\u2C73-\\u2C74\\u2C76-\\u2C7B\\u2C81\\u2C83\\u2C85\\u2C87\\u2C89\\u2C8B\\u2C8D\\u2C8F\\u2C91\\u2C93\\u2C95\\u2C97\\u2C99\\u2C9B\\u2C9D\\u2C9F\\u2CA1\\u2CA3\\u2CA5\\u2CA7\\u2CA9\\u2CAB\\u2CAD\\u2CAF\\u2CB1\\u2CB3\\u2CB5\\u2CB7\\u2CB9\\u2CBB\\u2CBD\\u2CBF\\u2CC1\\u2CC3\\u2CC5\\u2CC7\\u2CC9\\u2CCB\\u2CCD\\u2CCF\\u2CD1\\u2CD3\\u2CD5\\u2CD7\\u2CD9\\u2CDB\\u2CDD\\u2CDF\\u2CE1\\u2CE3-\\u2CE4\\u2CEC\\u2CEE\\u2CF3\\u2D00-\\u2D25\\u2D27\\u2D2D\\uA641\\uA643\\uA645\\uA647\\uA649\\uA64B\\uA64D\\uA64F\\uA651\\uA653\\uA655\\uA657\\uA659\\uA65B\\uA65D\\uA65F\\uA661\\uA663\\uA665\\uA667\\uA669\\uA66B\\uA66D\\uA681\\uA683\\uA685\\uA687\\uA689\\uA68B\\uA68D\\uA68F\\uA691\\uA693\\uA695\\uA697\\uA699\\uA69B\\uA723\\uA725\\uA727\\uA729\\uA72B\\uA72D\\uA72F-\\uA731\\uA733\\uA735\\uA737\\uA739\\uA73B\\uA73D\\uA73F\\uA741\\uA743\\uA745\\uA747\\uA749\\uA74B\\uA74D\\uA74F\\uA751\\uA753\\uA755\\uA757\\uA759\\uA75B\\uA75D\\uA75F\\uA761\\uA763\\uA765\\uA767\\uA769\\uA76B\\uA76D\\uA76F\\uA771-\\uA778\\uA77A\\uA77C\\uA77F\\uA781\\uA783\\uA785\\uA787\\uA78C\\uA78E\\uA791\\uA793-\\uA795\\uA797\\uA799\\uA79B\\uA79D\\uA79F\\uA7A1\\uA7A3\\uA7A5\\uA7A7\\uA7A9\\uA7AF\\uA7B5\\uA7B7\\uA7B9\\uA7FA\\uAB30-\\uAB5A\\uAB60-\\uAB65\\uAB70-\\uABBF\\uFB00-\\uFB06\\uFB13-\\uFB17\\uFF41-\\uFF5A]",
 				chars:      []rune{'µ', 'ā', 'ă', 'ą', 'ć', 'ĉ', 'ċ', 'č', 'ď', 'đ', 'ē', 'ĕ', 'ė', 'ę', 'ě', 'ĝ', 'ğ', 'ġ', 'ģ', 'ĥ', 'ħ', 'ĩ', 'ī', 'ĭ', 'į', 'ı', 'ĳ', 'ĵ', 'ĺ', 'ļ', 'ľ', 'ŀ', 'ł', 'ń', 'ņ', 'ŋ', 'ō', 'ŏ', 'ő', 'œ', 'ŕ', 'ŗ', 'ř', 'ś', 'ŝ', 'ş', 'š', 'ţ', 'ť', 'ŧ', 'ũ', 'ū', 'ŭ', 'ů', 'ű', 'ų', 'ŵ', 'ŷ', 'ź', 'ż', 'ƃ', 'ƅ', 'ƈ', 'ƒ', 'ƕ', 'ƞ', 'ơ', 'ƣ', 'ƥ', 'ƨ', 'ƭ', 'ư', 'ƴ', 'ƶ', 'ǆ', 'ǉ', 'ǌ', 'ǎ', 'ǐ', 'ǒ', 'ǔ', 'ǖ', 'ǘ', 'ǚ', 'ǟ', 'ǡ', 'ǣ', 'ǥ', 'ǧ', 'ǩ', 'ǫ', 'ǭ', 'ǳ', 'ǵ', 'ǹ', 'ǻ', 'ǽ', 'ǿ', 'ȁ', 'ȃ', 'ȅ', 'ȇ', 'ȉ', 'ȋ', 'ȍ', 'ȏ', 'ȑ', 'ȓ', 'ȕ', 'ȗ', 'ș', 'ț', 'ȝ', 'ȟ', 'ȡ', 'ȣ', 'ȥ', 'ȧ', 'ȩ', 'ȫ', 'ȭ', 'ȯ', 'ȱ', 'ȼ', 'ɂ', 'ɇ', 'ɉ', 'ɋ', 'ɍ', 'ͱ', 'ͳ', 'ͷ', 'ΐ', 'ϙ', 'ϛ', 'ϝ', 'ϟ', 'ϡ', 'ϣ', 'ϥ', 'ϧ', 'ϩ', 'ϫ', 'ϭ', 'ϵ', 'ϸ', 'ѡ', 'ѣ', 'ѥ', 'ѧ', 'ѩ', 'ѫ', 'ѭ', 'ѯ', 'ѱ', 'ѳ', 'ѵ', 'ѷ', 'ѹ', 'ѻ', 'ѽ', 'ѿ', 'ҁ', 'ҋ', 'ҍ', 'ҏ', 'ґ', 'ғ', 'ҕ', 'җ', 'ҙ', 'қ', 'ҝ', 'ҟ', 'ҡ', 'ң', 'ҥ', 'ҧ', 'ҩ', 'ҫ', 'ҭ', 'ү', 'ұ', 'ҳ', 'ҵ', 'ҷ', 'ҹ', 'һ', 'ҽ', 'ҿ', 'ӂ', 'ӄ', 'ӆ', 'ӈ', 'ӊ', 'ӌ', 'ӑ', 'ӓ', 'ӕ', 'ӗ', 'ә', 'ӛ', 'ӝ', 'ӟ', 'ӡ', 'ӣ', 'ӥ', 'ӧ', 'ө', 'ӫ', 'ӭ', 'ӯ', 'ӱ', 'ӳ', 'ӵ', 'ӷ', 'ӹ', 'ӻ', 'ӽ', 'ӿ', 'ԁ', 'ԃ', 'ԅ', 'ԇ', 'ԉ', 'ԋ', 'ԍ', 'ԏ', 'ԑ', 'ԓ', 'ԕ', 'ԗ', 'ԙ', 'ԛ', 'ԝ', 'ԟ', 'ԡ', 'ԣ', 'ԥ', 'ԧ', 'ԩ', 'ԫ', 'ԭ', 'ԯ', 'ḁ', 'ḃ', 'ḅ', 'ḇ', 'ḉ', 'ḋ', 'ḍ', 'ḏ', 'ḑ', 'ḓ', 'ḕ', 'ḗ', 'ḙ', 'ḛ', 'ḝ', 'ḟ', 'ḡ', 'ḣ', 'ḥ', 'ḧ', 'ḩ', 'ḫ', 'ḭ', 'ḯ', 'ḱ', 'ḳ', 'ḵ', 'ḷ', 'ḹ', 'ḻ', 'ḽ', 'ḿ', 'ṁ', 'ṃ', 'ṅ', 'ṇ', 'ṉ', 'ṋ', 'ṍ', 'ṏ', 'ṑ', 'ṓ', 'ṕ', 'ṗ', 'ṙ', 'ṛ', 'ṝ', 'ṟ', 'ṡ', 'ṣ', 'ṥ', 'ṧ', 'ṩ', 'ṫ', 'ṭ', 'ṯ', 'ṱ', 'ṳ', 'ṵ', 'ṷ', 'ṹ', 'ṻ', 'ṽ', 'ṿ', 'ẁ', 'ẃ', 'ẅ', 'ẇ', 'ẉ', 'ẋ', 'ẍ', 'ẏ', 'ẑ', 'ẓ', 'ẟ', 'ạ', 'ả', 'ấ', 'ầ', 'ẩ', 'ẫ', 'ậ', 'ắ', 'ằ', 'ẳ', 'ẵ', 'ặ', 'ẹ', 'ẻ', 'ẽ', 'ế', 'ề', 'ể', 'ễ', 'ệ', 'ỉ', 'ị', 'ọ', 'ỏ', 'ố', 'ồ', 'ổ', 'ỗ', 'ộ', 'ớ', 'ờ', 'ở', 'ỡ', 'ợ', 'ụ', 'ủ', 'ứ', 'ừ', 'ử', 'ữ', 'ự', 'ỳ', 'ỵ', 'ỷ', 'ỹ', 'ỻ', 'ỽ', 'ι', 'ℊ', 'ℓ', 'ℯ', 'ℴ', 'ℹ', 'ⅎ', 'ↄ', 'ⱡ', 'ⱨ', 'ⱪ', 'ⱬ', 'ⱱ', 'ⲁ', 'ⲃ', 'ⲅ', 'ⲇ', 'ⲉ', 'ⲋ', 'ⲍ', 'ⲏ', 'ⲑ', 'ⲓ', 'ⲕ', 'ⲗ', 'ⲙ', 'ⲛ', 'ⲝ', 'ⲟ', 'ⲡ', 'ⲣ', 'ⲥ', 'ⲧ', 'ⲩ', 'ⲫ', 'ⲭ', 'ⲯ', 'ⲱ', 'ⲳ', 'ⲵ', 'ⲷ', 'ⲹ', 'ⲻ', 'ⲽ', 'ⲿ', 'ⳁ', 'ⳃ', 'ⳅ', 'ⳇ', 'ⳉ', 'ⳋ', 'ⳍ', 'ⳏ', 'ⳑ', 'ⳓ', 'ⳕ', 'ⳗ', 'ⳙ', 'ⳛ', 'ⳝ', 'ⳟ', 'ⳡ', 'ⳬ', 'ⳮ', 'ⳳ', 'ⴧ', 'ⴭ', 'ꙁ', 'ꙃ', 'ꙅ', 'ꙇ', 'ꙉ', 'ꙋ', 'ꙍ', 'ꙏ', 'ꙑ', 'ꙓ', 'ꙕ', 'ꙗ', 'ꙙ', 'ꙛ', 'ꙝ', 'ꙟ', 'ꙡ', 'ꙣ', 'ꙥ', 'ꙧ', 'ꙩ', 'ꙫ', 'ꙭ', 'ꚁ', 'ꚃ', 'ꚅ', 'ꚇ', 'ꚉ', 'ꚋ', 'ꚍ', 'ꚏ', 'ꚑ', 'ꚓ', 'ꚕ', 'ꚗ', 'ꚙ', 'ꚛ', 'ꜣ', 'ꜥ', 'ꜧ', 'ꜩ', 'ꜫ', 'ꜭ', 'ꜳ', 'ꜵ', 'ꜷ', 'ꜹ', 'ꜻ', 'ꜽ', 'ꜿ', 'ꝁ', 'ꝃ', 'ꝅ', 'ꝇ', 'ꝉ', 'ꝋ', 'ꝍ', 'ꝏ', 'ꝑ', 'ꝓ', 'ꝕ', 'ꝗ', 'ꝙ', 'ꝛ', 'ꝝ', 'ꝟ', 'ꝡ', 'ꝣ', 'ꝥ', 'ꝧ', 'ꝩ', 'ꝫ', 'ꝭ', 'ꝯ', 'ꝺ', 'ꝼ', 'ꝿ', 'ꞁ', 'ꞃ', 'ꞅ', 'ꞇ', 'ꞌ', 'ꞎ', 'ꞑ', 'ꞗ', 'ꞙ', 'ꞛ', 'ꞝ', 'ꞟ', 'ꞡ', 'ꞣ', 'ꞥ', 'ꞧ', 'ꞩ', 'ꞯ', 'ꞵ', 'ꞷ', 'ꞹ', 'ꟺ'},
 				ranges:     []rune{'a', 'z', 'ß', 'ö', 'ø', 'ÿ', 'ķ', 'ĸ', 'ň', 'ŉ', 'ž', 'ƀ', 'ƌ', 'ƍ', 'ƙ', 'ƛ', 'ƪ', 'ƫ', 'ƹ', 'ƺ', 'ƽ', 'ƿ', 'ǜ', 'ǝ', 'ǯ', 'ǰ', 'ȳ', 'ȹ', 'ȿ', 'ɀ', 'ɏ', 'ʓ', 'ʕ', 'ʯ', 'ͻ', 'ͽ', 'ά', 'ώ', 'ϐ', 'ϑ', 'ϕ', 'ϗ', 'ϯ', 'ϳ', 'ϻ', 'ϼ', 'а', 'џ', 'ӎ', 'ӏ', 'ՠ', 'ֈ', 'ა', 'ჺ', 'ჽ', 'ჿ', 'ᏸ', 'ᏽ', 'ᲀ', 'ᲈ', 'ᴀ', 'ᴫ', 'ᵫ', 'ᵷ', 'ᵹ', 'ᶚ', 'ẕ', 'ẝ', 'ỿ', 'ἇ', 'ἐ', 'ἕ', 'ἠ', 'ἧ', 'ἰ', 'ἷ', 'ὀ', 'ὅ', 'ὐ', 'ὗ', 'ὠ', 'ὧ', 'ὰ', 'ώ', 'ᾀ', 'ᾇ', 'ᾐ', 'ᾗ', 'ᾠ', 'ᾧ', 'ᾰ', 'ᾴ', 'ᾶ', 'ᾷ', 'ῂ', 'ῄ', 'ῆ', 'ῇ', 'ῐ', 'ΐ', 'ῖ', 'ῗ', 'ῠ', 'ῧ', 'ῲ', 'ῴ', 'ῶ', 'ῷ', 'ℎ', 'ℏ', 'ℼ', 'ℽ', 'ⅆ', 'ⅉ', 'ⰰ', 'ⱞ', 'ⱥ', 'ⱦ', 'ⱳ', 'ⱴ', 'ⱶ', 'ⱻ', 'ⳣ', 'ⳤ', 'ⴀ', 'ⴥ', 'ꜯ', 'ꜱ', 'ꝱ', 'ꝸ', 'ꞓ', 'ꞕ', 'ꬰ', 'ꭚ', 'ꭠ', 'ꭥ', 'ꭰ', 'ꮿ', 'ﬀ', 'ﬆ', 'ﬓ', 'ﬗ', 'ａ', 'ｚ'},
@@ -12295,9 +12777,9 @@ var g = &grammar{
 		},
 		{
 			name: "Lm",
-			pos:  position{line: 1677, col: 1, offset: 44471},
+			pos:  position{line: 1731, col: 1, offset: 45966},
 			expr: &charClassMatcher{
-				pos:        position{line: 1677, col: 6, offset: 44476},
+				pos:        position{line: 1731, col: 6, offset: 45971},
 				val:        "[\\u02B0-\\u02C1\\u02C6-\\u02D1\\u02E0-\\u02E4\\u02EC\\u02EE\\u0374\\u037A\\u0559\\u0640\\u06E5-\\u06E6\\u07F4-\\u07F5\\u07FA\\u081A\\u0824\\u0828\\u0971\\u0E46\\u0EC6\\u10FC\\u17D7\\u1843\\u1AA7\\u1C78-\\u1C7D\\u1D2C-\\u1D6A\\u1D78\\u1D9B-\\u1DBF\\u2071\\u207F\\u2090-\\u209C\\u2C7C-\\u2C7D\\u2D6F\\u2E2F\\u3005\\u3031-\\u3035\\u303B\\u309D-\\u309E\\u30FC-\\u30FE\\uA015\\uA4F8-\\uA4FD\\uA60C\\uA67F\\uA69C-\\uA69D\\uA717-\\uA71F\\uA770\\uA788\\uA7F8-\\uA7F9\\uA9CF\\uA9E6\\uAA70\\uAADD\\uAAF3-\\uAAF4\\uAB5C-\\uAB5F\\uFF70\\uFF9E-\\uFF9F]",
 				chars:      []rune{'ˬ', 'ˮ', 'ʹ', 'ͺ', 'ՙ', 'ـ', 'ߺ', 'ࠚ', 'ࠤ', 'ࠨ', 'ॱ', 'ๆ', 'ໆ', 'ჼ', 'ៗ', 'ᡃ', 'ᪧ', 'ᵸ', 'ⁱ', 'ⁿ', 'ⵯ', 'ⸯ', '々', '〻', 'ꀕ', 'ꘌ', 'ꙿ', 'ꝰ', 'ꞈ', 'ꧏ', 'ꧦ', 'ꩰ', 'ꫝ', 'ｰ'},
 				ranges:     []rune{'ʰ', 'ˁ', 'ˆ', 'ˑ', 'ˠ', 'ˤ', 'ۥ', 'ۦ', 'ߴ', 'ߵ', 'ᱸ', 'ᱽ', 'ᴬ', 'ᵪ', 'ᶛ', 'ᶿ', 'ₐ', 'ₜ', 'ⱼ', 'ⱽ', '〱', '〵', 'ゝ', 'ゞ', 'ー', 'ヾ', 'ꓸ', 'ꓽ', 'ꚜ', 'ꚝ', 'ꜗ', 'ꜟ', 'ꟸ', 'ꟹ', 'ꫳ', 'ꫴ', 'ꭜ', 'ꭟ', 'ﾞ', 'ﾟ'},
@@ -12309,9 +12791,9 @@ var g = &grammar{
 		},
 		{
 			name: "Lo",
-			pos:  position{line: 1680, col: 1, offset: 44961},
+			pos:  position{line: 1734, col: 1, offset: 46456},
 			expr: &charClassMatcher{
-				pos:        position{line: 1680, col: 6, offset: 44966},
+				pos:        position{line: 1734, col: 6, offset: 46461},
 				val:        "[\\u00AA\\u00BA\\u01BB\\u01C0-\\u01C3\\u0294\\u05D0-\\u05EA\\u05EF-\\u05F2\\u0620-\\u063F\\u0641-\\u064A\\u066E-\\u066F\\u0671-\\u06D3\\u06D5\\u06EE-\\u06EF\\u06FA-\\u06FC\\u06FF\\u0710\\u0712-\\u072F\\u074D-\\u07A5\\u07B1\\u07CA-\\u07EA\\u0800-\\u0815\\u0840-\\u0858\\u0860-\\u086A\\u08A0-\\u08B4\\u08B6-\\u08BD\\u0904-\\u0939\\u093D\\u0950\\u0958-\\u0961\\u0972-\\u0980\\u0985-\\u098C\\u098F-\\u0990\\u0993-\\u09A8\\u09AA-\\u09B0\\u09B2\\u09B6-\\u09B9\\u09BD\\u09CE\\u09DC-\\u09DD\\u09DF-\\u09E1\\u09F0-\\u09F1\\u09FC\\u0A05-\\u0A0A\\u0A0F-\\u0A10\\u0A13-\\u0A28\\u0A2A-\\u0A30\\u0A32-\\u0A33\\u0A35-\\u0A36\\u0A38-\\u0A39\\u0A59-\\u0A5C\\u0A5E\\u0A72-\\u0A74\\u0A85-\\u0A8D\\u0A8F-\\u0A91\\u0A93-\\u0AA8\\u0AAA-\\u0AB0\\u0AB2-\\u0AB3\\u0AB5-\\u0AB9\\u0ABD\\u0AD0\\u0AE0-\\u0AE1\\u0AF9\\u0B05-\\u0B0C\\u0B0F-\\u0B10\\u0B13-\\u0B28\\u0B2A-\\u0B30\\u0B32-\\u0B33\\u0B35-\\u0B39\\u0B3D\\u0B5C-\\u0B5D\\u0B5F-\\u0B61\\u0B71\\u0B83\\u0B85-\\u0B8A\\u0B8E-\\u0B90\\u0B92-\\u0B95\\u0B99-\\u0B9A\\u0B9C\\u0B9E-\\u0B9F\\u0BA3-\\u0BA4\\u0BA8-\\u0BAA\\u0BAE-\\u0BB9\\u0BD0\\u0C05-\\u0C0C\\u0C0E-\\u0C10\\u0C12-\\u0C28\\u0C2A-\\u0C39\\u0C3D\\u0C58-\\u0C5A\\u0C60-\\u0C61\\u0C80\\u0C85-\\u0C8C\\u0C8E-\\u0C90\\u0C92-\\u0CA8\\u0CAA-\\u0CB3\\u0CB5-\\u0CB9\\u0CBD\\u0CDE\\u0CE0-\\u0CE1\\u0CF1-\\u0CF2\\u0D05-\\u0D0C\\u0D0E-\\u0D10\\u0D12-\\u0D3A\\u0D3D\\u0D4E\\u0D54-\\u0D56\\u0D5F-\\u0D61\\u0D7A-\\u0D7F\\u0D85-\\u0D96\\u0D9A-\\u0DB1\\u0DB3-\\u0DBB\\u0DBD\\u0DC0-\\u0DC6\\u0E01-\\u0E30\\u0E32-\\u0E33\\u0E40-\\u0E45\\u0E81-\\u0E82\\u0E84\\u0E87-\\u0E88\\u0E8A\\u0E8D\\u0E94-\\u0E97\\u0E99-\\u0E9F\\u0EA1-\\u0EA3\\u0EA5\\u0EA7\\u0EAA-\\u0EAB\\u0EAD-\\u0EB0\\u0EB2-\\u0EB3\\u0EBD\\u0EC0-\\u0EC4\\u0EDC-\\u0EDF\\u0F00\\u0F40-\\u0F47\\u0F49-\\u0F6C\\u0F88-\\u0F8C\\u1000-\\u102A\\u103F\\u1050-\\u1055\\u105A-\\u105D\\u1061\\u1065-\\u1066\\u106E-\\u1070\\u1075-\\u1081\\u108E\\u1100-\\u1248\\u124A-\\u124D\\u1250-\\u1256\\u1258\\u125A-\\u125D\\u1260-\\u1288\\u128A-\\u128D\\u1290-\\u12B0\\u12B2-\\u12B5\\u12B8-\\u12BE\\u12C0\\u12C2-\\u12C5\\u12C8-\\u12D6\\u12D8-\\u1310\\u1312-\\u1315\\u1318-\\u135A\\u1380-\\u138F\\u1401-\\u166C\\u166F-\\u167F\\u1681-\\u169A\\u16A0-\\u16EA\\u16F1-\\u16F8\\u1700-\\u170C\\u170E-\\u1711\\u1720-\\u1731\\u1740-\\u1751\\u1760-\\u176C\\u176E-\\u1770\\u1780-\\u17B3\\u17DC\\u1820-\\u1842\\u1844-\\u1878\\u1880-\\u1884\\u1887-\\u18A8\\u18AA\\u18B0-\\u18F5\\u1900-\\u191E\\u1950-\\u196D\\u1970-\\u1974\\u1980-\\u19AB\\u19B0-\\u19C9\\u1A00-\\u1A16\\u1A20-\\u1A54\\u1B05-\\u1B33\\u1B45-\\u1B4B\\u1B83-\\u1BA0\\u1BAE-\\u1BAF\\u1BBA-\\u1BE5\\u1C00-\\u1C23\\u1C4D-\\u1C4F\\u1C5A-\\u1C77\\u1CE9-\\u1CEC\\u1CEE-\\u1CF1\\u1CF5-\\u1CF6\\u2135-\\u2138\\u2D30-\\u2D67\\u2D80-\\u2D96\\u2DA0-\\u2DA6\\u2DA8-\\u2DAE\\u2DB0-\\u2DB6\\u2DB8-\\u2DBE\\u2DC0-\\u2DC6\\u2DC8-\\u2DCE\\u2DD0-\\u2DD6\\u2DD8-\\u2DDE\\u3006\\u303C\\u3041-\\u3096\\u309F\\u30A1-\\u30FA\\u30FF\\u3105-\\u312F\\u3131-\\u318E\\u31A0-\\u31BA\\u31F0-\\u31FF\\u3400-\\u4DB5\\u4E00-\\u9FEF\\uA000-\\uA014\\uA016-\\uA48C\\uA4D0-\\uA4F7\\uA500-\\uA60B\\uA610-\\uA61F\\uA62A-\\uA62B\\uA66E\\uA6A0-\\uA6E5\\uA78F\\uA7F7\\uA7FB-\\uA801\\uA803-\\uA805\\uA807-\\uA80A\\uA80C-\\uA822\\uA840-\\uA873\\uA882-\\uA8B3\\uA8F2-\\uA8F7\\uA8FB\\uA8FD-\\uA8FE\\uA90A-\\uA925\\uA930-\\uA946\\uA960-\\uA97C\\uA984-\\uA9B2\\uA9E0-\\uA9E4\\uA9E7-\\uA9EF\\uA9FA-\\uA9FE\\uAA00-\\uAA28\\uAA40-\\uAA42\\uAA44-\\uAA4B\\uAA60-\\uAA6F\\uAA71-\\uAA76\\uAA7A\\uAA7E-\\uAAAF\\uAAB1\\uAAB5-\\uAAB6\\uAAB9-\\uAABD\\uAAC0\\uAAC2\\uAADB-\\uAADC\\uAAE0-\\uAAEA\\uAAF2\\uAB01-\\uAB06\\uAB09-\\uAB0E\\uAB11-\\uAB16\\uAB20-\\uAB26\\uAB28-\\uAB2E\\uABC0-\\uABE2\\uAC00-\\uD7A3\\uD7B0-\\uD7C6\\uD7CB-\\uD7FB\\uF900-\\uFA6D\\uFA70-\\uFAD9\\uFB1D\\uFB1F-\\uFB28\\uFB2A-\\uFB36\\uFB38-\\uFB3C\\uFB3E\\uFB40-\\uFB41\\uFB43-\\uFB44\\uFB46-\\uFBB1\\uFBD3-\\uFD3D\\uFD50-\\uFD8F\\uFD92-\\uFDC7\\uFDF0-\\uFDFB\\uFE70-\\uFE74\\uFE76-\\uFEFC\\uFF66-\\uFF6F\\uFF71-\\uFF9D\\uFFA0-\\uFFBE\\uFFC2-\\uFFC7\\uFFCA-\\uFFCF\\uFFD2-\\uFFD7\\uFFDA-\\uFFDC]",
 				chars:      []rune{'ª', 'º', 'ƻ', 'ʔ', 'ە', 'ۿ', 'ܐ', 'ޱ', 'ऽ', 'ॐ', 'ল', 'ঽ', 'ৎ', 'ৼ', 'ਫ਼', 'ઽ', 'ૐ', 'ૹ', 'ଽ', 'ୱ', 'ஃ', 'ஜ', 'ௐ', 'ఽ', 'ಀ', 'ಽ', 'ೞ', 'ഽ', 'ൎ', 'ල', 'ຄ', 'ຊ', 'ຍ', 'ລ', 'ວ', 'ຽ', 'ༀ', 'ဿ', 'ၡ', 'ႎ', 'ቘ', 'ዀ', 'ៜ', 'ᢪ', '〆', '〼', 'ゟ', 'ヿ', 'ꙮ', 'ꞏ', 'ꟷ', 'ꣻ', 'ꩺ', 'ꪱ', 'ꫀ', 'ꫂ', 'ꫲ', 'יִ', 'מּ'},
 				ranges:     []rune{'ǀ', 'ǃ', 'א', 'ת', 'ׯ', 'ײ', 'ؠ', 'ؿ', 'ف', 'ي', 'ٮ', 'ٯ', 'ٱ', 'ۓ', 'ۮ', 'ۯ', 'ۺ', 'ۼ', 'ܒ', 'ܯ', 'ݍ', 'ޥ', 'ߊ', 'ߪ', 'ࠀ', 'ࠕ', 'ࡀ', 'ࡘ', 'ࡠ', 'ࡪ', 'ࢠ', 'ࢴ', 'ࢶ', 'ࢽ', 'ऄ', 'ह', 'क़', 'ॡ', 'ॲ', 'ঀ', 'অ', 'ঌ', 'এ', 'ঐ', 'ও', 'ন', 'প', 'র', 'শ', 'হ', 'ড়', 'ঢ়', 'য়', 'ৡ', 'ৰ', 'ৱ', 'ਅ', 'ਊ', 'ਏ', 'ਐ', 'ਓ', 'ਨ', 'ਪ', 'ਰ', 'ਲ', 'ਲ਼', 'ਵ', 'ਸ਼', 'ਸ', 'ਹ', 'ਖ਼', 'ੜ', 'ੲ', 'ੴ', 'અ', 'ઍ', 'એ', 'ઑ', 'ઓ', 'ન', 'પ', 'ર', 'લ', 'ળ', 'વ', 'હ', 'ૠ', 'ૡ', 'ଅ', 'ଌ', 'ଏ', 'ଐ', 'ଓ', 'ନ', 'ପ', 'ର', 'ଲ', 'ଳ', 'ଵ', 'ହ', 'ଡ଼', 'ଢ଼', 'ୟ', 'ୡ', 'அ', 'ஊ', 'எ', 'ஐ', 'ஒ', 'க', 'ங', 'ச', 'ஞ', 'ட', 'ண', 'த', 'ந', 'ப', 'ம', 'ஹ', 'అ', 'ఌ', 'ఎ', 'ఐ', 'ఒ', 'న', 'ప', 'హ', 'ౘ', 'ౚ', 'ౠ', 'ౡ', 'ಅ', 'ಌ', 'ಎ', 'ಐ', 'ಒ', 'ನ', 'ಪ', 'ಳ', 'ವ', 'ಹ', 'ೠ', 'ೡ', 'ೱ', 'ೲ', 'അ', 'ഌ', 'എ', 'ഐ', 'ഒ', 'ഺ', 'ൔ', 'ൖ', 'ൟ', 'ൡ', 'ൺ', 'ൿ', 'අ', 'ඖ', 'ක', 'න', 'ඳ', 'ර', 'ව', 'ෆ', 'ก', 'ะ', 'า', 'ำ', 'เ', 'ๅ', 'ກ', 'ຂ', 'ງ', 'ຈ', 'ດ', 'ທ', 'ນ', 'ຟ', 'ມ', 'ຣ', 'ສ', 'ຫ', 'ອ', 'ະ', 'າ', 'ຳ', 'ເ', 'ໄ', 'ໜ', 'ໟ', 'ཀ', 'ཇ', 'ཉ', 'ཬ', 'ྈ', 'ྌ', 'က', 'ဪ', 'ၐ', 'ၕ', 'ၚ', 'ၝ', 'ၥ', 'ၦ', 'ၮ', 'ၰ', 'ၵ', 'ႁ', 'ᄀ', 'ቈ', 'ቊ', 'ቍ', 'ቐ', 'ቖ', 'ቚ', 'ቝ', 'በ', 'ኈ', 'ኊ', 'ኍ', 'ነ', 'ኰ', 'ኲ', 'ኵ', 'ኸ', 'ኾ', 'ዂ', 'ዅ', 'ወ', 'ዖ', 'ዘ', 'ጐ', 'ጒ', 'ጕ', 'ጘ', 'ፚ', 'ᎀ', 'ᎏ', 'ᐁ', 'ᙬ', 'ᙯ', 'ᙿ', 'ᚁ', 'ᚚ', 'ᚠ', 'ᛪ', 'ᛱ', 'ᛸ', 'ᜀ', 'ᜌ', 'ᜎ', 'ᜑ', 'ᜠ', 'ᜱ', 'ᝀ', 'ᝑ', 'ᝠ', 'ᝬ', 'ᝮ', 'ᝰ', 'ក', 'ឳ', 'ᠠ', 'ᡂ', 'ᡄ', 'ᡸ', 'ᢀ', 'ᢄ', 'ᢇ', 'ᢨ', 'ᢰ', 'ᣵ', 'ᤀ', 'ᤞ', 'ᥐ', 'ᥭ', 'ᥰ', 'ᥴ', 'ᦀ', 'ᦫ', 'ᦰ', 'ᧉ', 'ᨀ', 'ᨖ', 'ᨠ', 'ᩔ', 'ᬅ', 'ᬳ', 'ᭅ', 'ᭋ', 'ᮃ', 'ᮠ', 'ᮮ', 'ᮯ', 'ᮺ', 'ᯥ', 'ᰀ', 'ᰣ', 'ᱍ', 'ᱏ', 'ᱚ', 'ᱷ', 'ᳩ', 'ᳬ', 'ᳮ', 'ᳱ', 'ᳵ', 'ᳶ', 'ℵ', 'ℸ', 'ⴰ', 'ⵧ', 'ⶀ', 'ⶖ', 'ⶠ', 'ⶦ', 'ⶨ', 'ⶮ', 'ⶰ', 'ⶶ', 'ⶸ', 'ⶾ', 'ⷀ', 'ⷆ', 'ⷈ', 'ⷎ', 'ⷐ', 'ⷖ', 'ⷘ', 'ⷞ', 'ぁ', 'ゖ', 'ァ', 'ヺ', 'ㄅ', 'ㄯ', 'ㄱ', 'ㆎ', 'ㆠ', 'ㆺ', 'ㇰ', 'ㇿ', '㐀', '䶵', '一', '鿯', 'ꀀ', 'ꀔ', 'ꀖ', 'ꒌ', 'ꓐ', 'ꓷ', 'ꔀ', 'ꘋ', 'ꘐ', 'ꘟ', 'ꘪ', 'ꘫ', 'ꚠ', 'ꛥ', 'ꟻ', 'ꠁ', 'ꠃ', 'ꠅ', 'ꠇ', 'ꠊ', 'ꠌ', 'ꠢ', 'ꡀ', 'ꡳ', 'ꢂ', 'ꢳ', 'ꣲ', 'ꣷ', 'ꣽ', 'ꣾ', 'ꤊ', 'ꤥ', 'ꤰ', 'ꥆ', 'ꥠ', 'ꥼ', 'ꦄ', 'ꦲ', 'ꧠ', 'ꧤ', 'ꧧ', 'ꧯ', 'ꧺ', 'ꧾ', 'ꨀ', 'ꨨ', 'ꩀ', 'ꩂ', 'ꩄ', 'ꩋ', 'ꩠ', 'ꩯ', 'ꩱ', 'ꩶ', 'ꩾ', 'ꪯ', 'ꪵ', 'ꪶ', 'ꪹ', 'ꪽ', 'ꫛ', 'ꫜ', 'ꫠ', 'ꫪ', 'ꬁ', 'ꬆ', 'ꬉ', 'ꬎ', 'ꬑ', 'ꬖ', 'ꬠ', 'ꬦ', 'ꬨ', 'ꬮ', 'ꯀ', 'ꯢ', '가', '힣', 'ힰ', 'ퟆ', 'ퟋ', 'ퟻ', '豈', '舘', '並', '龎', 'ײַ', 'ﬨ', 'שׁ', 'זּ', 'טּ', 'לּ', 'נּ', 'סּ', 'ףּ', 'פּ', 'צּ', 'ﮱ', 'ﯓ', 'ﴽ', 'ﵐ', 'ﶏ', 'ﶒ', 'ﷇ', 'ﷰ', 'ﷻ', 'ﹰ', 'ﹴ', 'ﹶ', 'ﻼ', 'ｦ', 'ｯ', 'ｱ', 'ﾝ', 'ﾠ', 'ﾾ', 'ￂ', 'ￇ', 'ￊ', 'ￏ', 'ￒ', 'ￗ', 'ￚ', 'ￜ'},
@@ -12323,9 +12805,9 @@ var g = &grammar{
 		},
 		{
 			name: "Lt",
-			pos:  position{line: 1683, col: 1, offset: 48413},
+			pos:  position{line: 1737, col: 1, offset: 49908},
 			expr: &charClassMatcher{
-				pos:        position{line: 1683, col: 6, offset: 48418},
+				pos:        position{line: 1737, col: 6, offset: 49913},
 				val:        "[\\u01C5\\u01C8\\u01CB\\u01F2\\u1F88-\\u1F8F\\u1F98-\\u1F9F\\u1FA8-\\u1FAF\\u1FBC\\u1FCC\\u1FFC]",
 				chars:      []rune{'ǅ', 'ǈ', 'ǋ', 'ǲ', 'ᾼ', 'ῌ', 'ῼ'},
 				ranges:     []rune{'ᾈ', 'ᾏ', 'ᾘ', 'ᾟ', 'ᾨ', 'ᾯ'},
@@ -12337,9 +12819,9 @@ var g = &grammar{
 		},
 		{
 			name: "Lu",
-			pos:  position{line: 1686, col: 1, offset: 48524},
+			pos:  position{line: 1740, col: 1, offset: 50019},
 			expr: &charClassMatcher{
-				pos:        position{line: 1686, col: 6, offset: 48529},
+				pos:        position{line: 1740, col: 6, offset: 50024},
 				val:        "[\\u0041-\\u005A\\u00C0-\\u00D6\\u00D8-\\u00DE\\u0100\\u0102\\u0104\\u0106\\u0108\\u010A\\u010C\\u010E\\u0110\\u0112\\u0114\\u0116\\u0118\\u011A\\u011C\\u011E\\u0120\\u0122\\u0124\\u0126\\u0128\\u012A\\u012C\\u012E\\u0130\\u0132\\u0134\\u0136\\u0139\\u013B\\u013D\\u013F\\u0141\\u0143\\u0145\\u0147\\u014A\\u014C\\u014E\\u0150\\u0152\\u0154\\u0156\\u0158\\u015A\\u015C\\u015E\\u0160\\u0162\\u0164\\u0166\\u0168\\u016A\\u016C\\u016E\\u0170\\u0172\\u0174\\u0176\\u0178-\\u0179\\u017B\\u017D\\u0181-\\u0182\\u0184\\u0186-\\u0187\\u0189-\\u018B\\u018E-\\u0191\\u0193-\\u0194\\u0196-\\u0198\\u019C-\\u019D\\u019F-\\u01A0\\u01A2\\u01A4\\u01A6-\\u01A7\\u01A9\\u01AC\\u01AE-\\u01AF\\u01B1-\\u01B3\\u01B5\\u01B7-\\u01B8\\u01BC\\u01C4\\u01C7\\u01CA\\u01CD\\u01CF\\u01D1\\u01D3\\u01D5\\u01D7\\u01D9\\u01DB\\u01DE\\u01E0\\u01E2\\u01E4\\u01E6\\u01E8\\u01EA\\u01EC\\u01EE\\u01F1\\u01F4\\u01F6-\\u01F8\\u01FA\\u01FC\\u01FE\\u0200\\u0202\\u0204\\u0206\\u0208\\u020A\\u020C\\u020E\\u0210\\u0212\\u0214\\u0216\\u0218\\u021A\\u021C\\u021E\\u0220\\u0222\\u0224\\u0226\\u0228\\u022A\\u022C\\u022E\\u0230\\u0232\\u023A-\\u023B\\u023D-\\u023E\\u0241\\u0243-\\u0246\\u0248\\u024A\\u024C\\u024E\\u0370\\u0372\\u0376\\u037F\\u0386\\u0388-\\u038A\\u038C\\u038E-\\u038F\\u0391-\\u03A1\\u03A3-\\u03AB\\u03CF\\u03D2-\\u03D4\\u03D8\\u03DA\\u03DC\\u03DE\\u03E0\\u03E2\\u03E4\\u03E6\\u03E8\\u03EA\\u03EC\\u03EE\\u03F4\\u03F7\\u03F9-\\u03FA\\u03FD-\\u042F\\u0460\\u0462\\u0464\\u0466\\u0468\\u046A\\u046C\\u046E\\u0470\\u0472\\u0474\\u0476\\u0478\\u047A\\u047C\\u047E\\u0480\\u048A\\u048C\\u048E\\u0490\\u0492\\u0494\\u0496\\u0498\\u049A\\u049C\\u049E\\u04A0\\u04A2\\u04A4\\u04A6\\u04A8\\u04AA\\u04AC\\u04AE\\u04B0\\u04B2\\u04B4\\u04B6\\u04B8\\u04BA\\u04BC\\u04BE\\u04C0-\\u04C1\\u04C3\\u04C5\\u04C7\\u04C9\\u04CB\\u04CD\\u04D0\\u04D2\\u04D4\\u04D6\\u04D8\\u04DA\\u04DC\\u04DE\\u04E0\\u04E2\\u04E4\\u04E6\\u04E8\\u04EA\\u04EC\\u04EE\\u04F0\\u04F2\\u04F4\\u04F6\\u04F8\\u04FA\\u04FC\\u04FE\\u0500\\u0502\\u0504\\u0506\\u0508\\u050A\\u050C\\u050E\\u0510\\u0512\\u0514\\u0516\\u0518\\u051A\\u051C\\u051E\\u0520\\u0522\\u0524\\u0526\\u0528\\u052A\\u052C\\u052E\\u0531-\\u0556\\u10A0-\\u10C5\\u10C7\\u10CD\\u13A0-\\u13F5\\u1C90-\\u1CBA\\u1CBD-\\u1CBF\\u1E00\\u1E02\\u1E04\\u1E06\\u1E08\\u1E0A\\u1E0C\\u1E0E\\u1E10\\u1E12\\u1E14\\u1E16\\u1E18\\u1E1A\\u1E1C\\u1E1E\\u1E20\\u1E22\\u1E24\\u1E26\\u1E28\\u1E2A\\u1E2C\\u1E2E\\u1E30\\u1E32\\u1E34\\u1E36\\u1E38\\u1E3A\\u1E3C\\u1E3E\\u1E40\\u1E42\\u1E44\\u1E46\\u1E48\\u1E4A\\u1E4C\\u1E4E\\u1E50\\u1E52\\u1E54\\u1E56\\u1E58\\u1E5A\\u1E5C\\u1E5E\\u1E60\\u1E62\\u1E64\\u1E66\\u1E68\\u1E6A\\u1E6C\\u1E6E\\u1E70\\u1E72\\u1E74\\u1E76\\u1E78\\u1E7A\\u1E7C\\u1E7E\\u1E80\\u1E82\\u1E84\\u1E86\\u1E88\\u1E8A\\u1E8C\\u1E8E\\u1E90\\u1E92\\u1E94\\u1E9E\\u1EA0\\u1EA2\\u1EA4\\u1EA6\\u1EA8\\u1EAA\\u1EAC\\u1EAE\\u1EB0\\u1EB2\\u1EB4\\u1EB6\\u1EB8\\u1EBA\\u1EBC\\u1EBE\\u1EC0\\u1EC2\\u1EC4\\u1EC6\\u1EC8\\u1ECA\\u1ECC\\u1ECE\\u1ED0\\u1ED2\\u1ED4\\u1ED6\\u1ED8\\u1EDA\\u1EDC\\u1EDE\\u1EE0\\u1EE2\\u1EE4\\u1EE6\\u1EE8\\u1EEA\\u1EEC\\u1EEE\\u1EF0\\u1EF2\\u1EF4\\u1EF6\\u1EF8\\u1EFA\\u1EFC\\u1EFE\\u1F08-\\u1F0F\\u1F18-\\u1F1D\\u1F28-\\u1F2F\\u1F38-\\u1F3F\\u1F48-\\u1F4D\\u1F59\\u1F5B\\u1F5D\\u1F5F\\u1F68-\\u1F6F\\u1FB8-\\u1FBB\\u1FC8-\\u1FCB\\u1FD8-\\u1FDB\\u1FE8-\\u1FEC\\u1FF8-\\u1FFB\\u2102\\u2107\\u210B-\\u210D\\u2110-\\u2112\\u2115\\u2119-\\u211D\\u2124\\u2126\\u2128\\u212A-\\u212D\\u2130-\\u2133\\u213E-\\u213F\\u2145\\u2183\\u2C00-\\u2C2E\\u2C60\\u2C62-\\u2C64\\u2C67\\u2C69\\u2C6B\\u2C6D-\\u2C70\\u2C72\\u2C75\\u2C7E-\\u2C80\\u2C82\\u2C84\\u2C86\\u2C88\\u2C8A\\u2C8C\\u2C8E\\u2C90\\u2C92\\u2C94\\u2C96\\u2C98\\u2C9A\\u2C9C\\u2C9E\\u2CA0\\u2CA2\\u2CA4\\u2CA6\\u2CA8\\u2CAA\\u2CAC\\u2CAE\\u2CB0\\u2CB2\\u2CB4\\u2CB6\\u2CB8\\u2CBA\\u2CBC\\u2CBE\\u2CC0\\u2CC2\\u2CC4\\u2CC6\\u2CC8\\u2CCA\\u2CCC\\u2CCE\\u2CD0\\u2CD2\\u2CD4\\u2CD6\\u2CD8\\u2CDA\\u2CDC\\u2CDE\\u2CE0\\u2CE2\\u2CEB\\u2CED\\u2CF2\\uA640\\uA642\\uA644\\uA646\\uA648\\uA64A\\uA64C\\uA64E\\uA650\\uA652\\uA654\\uA656\\uA658\\uA65A\\uA65C\\uA65E\\uA660\\uA662\\uA664\\uA666\\uA668\\uA66A\\uA66C\\uA680\\uA682\\uA684\\uA686\\uA688\\uA68A\\uA68C\\uA68E\\uA690\\uA692\\uA694\\uA696\\uA698\\uA69A\\uA722\\uA724\\uA726\\uA728\\uA72A\\uA72C\\uA72E\\uA732\\uA734\\uA736\\uA738\\uA73A\\uA73C\\uA73E\\uA740\\uA742\\uA744\\uA746\\uA748\\uA74A\\uA74C\\uA74E\\uA750\\uA752\\uA754\\uA756\\uA758\\uA75A\\uA75C\\uA75E\\uA760\\uA762\\uA764\\uA766\\uA768\\uA76A\\uA76C\\uA76E\\uA779\\uA77B\\uA77D-\\uA77E\\uA780\\uA782\\uA784\\uA786\\uA78B\\uA78D\\uA790\\uA792\\uA796\\uA798\\uA79A\\uA79C\\uA79E\\uA7A0\\uA7A2\\uA7A4\\uA7A6\\uA7A8\\uA7AA-\\uA7AE\\uA7B0-\\uA7B4\\uA7B6\\uA7B8\\uFF21-\\uFF3A]",
 				chars:      []rune{'Ā', 'Ă', 'Ą', 'Ć', 'Ĉ', 'Ċ', 'Č', 'Ď', 'Đ', 'Ē', 'Ĕ', 'Ė', 'Ę', 'Ě', 'Ĝ', 'Ğ', 'Ġ', 'Ģ', 'Ĥ', 'Ħ', 'Ĩ', 'Ī', 'Ĭ', 'Į', 'İ', 'Ĳ', 'Ĵ', 'Ķ', 'Ĺ', 'Ļ', 'Ľ', 'Ŀ', 'Ł', 'Ń', 'Ņ', 'Ň', 'Ŋ', 'Ō', 'Ŏ', 'Ő', 'Œ', 'Ŕ', 'Ŗ', 'Ř', 'Ś', 'Ŝ', 'Ş', 'Š', 'Ţ', 'Ť', 'Ŧ', 'Ũ', 'Ū', 'Ŭ', 'Ů', 'Ű', 'Ų', 'Ŵ', 'Ŷ', 'Ż', 'Ž', 'Ƅ', 'Ƣ', 'Ƥ', 'Ʃ', 'Ƭ', 'Ƶ', 'Ƽ', 'Ǆ', 'Ǉ', 'Ǌ', 'Ǎ', 'Ǐ', 'Ǒ', 'Ǔ', 'Ǖ', 'Ǘ', 'Ǚ', 'Ǜ', 'Ǟ', 'Ǡ', 'Ǣ', 'Ǥ', 'Ǧ', 'Ǩ', 'Ǫ', 'Ǭ', 'Ǯ', 'Ǳ', 'Ǵ', 'Ǻ', 'Ǽ', 'Ǿ', 'Ȁ', 'Ȃ', 'Ȅ', 'Ȇ', 'Ȉ', 'Ȋ', 'Ȍ', 'Ȏ', 'Ȑ', 'Ȓ', 'Ȕ', 'Ȗ', 'Ș', 'Ț', 'Ȝ', 'Ȟ', 'Ƞ', 'Ȣ', 'Ȥ', 'Ȧ', 'Ȩ', 'Ȫ', 'Ȭ', 'Ȯ', 'Ȱ', 'Ȳ', 'Ɂ', 'Ɉ', 'Ɋ', 'Ɍ', 'Ɏ', 'Ͱ', 'Ͳ', 'Ͷ', 'Ϳ', 'Ά', 'Ό', 'Ϗ', 'Ϙ', 'Ϛ', 'Ϝ', 'Ϟ', 'Ϡ', 'Ϣ', 'Ϥ', 'Ϧ', 'Ϩ', 'Ϫ', 'Ϭ', 'Ϯ', 'ϴ', 'Ϸ', 'Ѡ', 'Ѣ', 'Ѥ', 'Ѧ', 'Ѩ', 'Ѫ', 'Ѭ', 'Ѯ', 'Ѱ', 'Ѳ', 'Ѵ', 'Ѷ', 'Ѹ', 'Ѻ', 'Ѽ', 'Ѿ', 'Ҁ', 'Ҋ', 'Ҍ', 'Ҏ', 'Ґ', 'Ғ', 'Ҕ', 'Җ', 'Ҙ', 'Қ', 'Ҝ', 'Ҟ', 'Ҡ', 'Ң', 'Ҥ', 'Ҧ', 'Ҩ', 'Ҫ', 'Ҭ', 'Ү', 'Ұ', 'Ҳ', 'Ҵ', 'Ҷ', 'Ҹ', 'Һ', 'Ҽ', 'Ҿ', 'Ӄ', 'Ӆ', 'Ӈ', 'Ӊ', 'Ӌ', 'Ӎ', 'Ӑ', 'Ӓ', 'Ӕ', 'Ӗ', 'Ә', 'Ӛ', 'Ӝ', 'Ӟ', 'Ӡ', 'Ӣ', 'Ӥ', 'Ӧ', 'Ө', 'Ӫ', 'Ӭ', 'Ӯ', 'Ӱ', 'Ӳ', 'Ӵ', 'Ӷ', 'Ӹ', 'Ӻ', 'Ӽ', 'Ӿ', 'Ԁ', 'Ԃ', 'Ԅ', 'Ԇ', 'Ԉ', 'Ԋ', 'Ԍ', 'Ԏ', 'Ԑ', 'Ԓ', 'Ԕ', 'Ԗ', 'Ԙ', 'Ԛ', 'Ԝ', 'Ԟ', 'Ԡ', 'Ԣ', 'Ԥ', 'Ԧ', 'Ԩ', 'Ԫ', 'Ԭ', 'Ԯ', 'Ⴧ', 'Ⴭ', 'Ḁ', 'Ḃ', 'Ḅ', 'Ḇ', 'Ḉ', 'Ḋ', 'Ḍ', 'Ḏ', 'Ḑ', 'Ḓ', 'Ḕ', 'Ḗ', 'Ḙ', 'Ḛ', 'Ḝ', 'Ḟ', 'Ḡ', 'Ḣ', 'Ḥ', 'Ḧ', 'Ḩ', 'Ḫ', 'Ḭ', 'Ḯ', 'Ḱ', 'Ḳ', 'Ḵ', 'Ḷ', 'Ḹ', 'Ḻ', 'Ḽ', 'Ḿ', 'Ṁ', 'Ṃ', 'Ṅ', 'Ṇ', 'Ṉ', 'Ṋ', 'Ṍ', 'Ṏ', 'Ṑ', 'Ṓ', 'Ṕ', 'Ṗ', 'Ṙ', 'Ṛ', 'Ṝ', 'Ṟ', 'Ṡ', 'Ṣ', 'Ṥ', 'Ṧ', 'Ṩ', 'Ṫ', 'Ṭ', 'Ṯ', 'Ṱ', 'Ṳ', 'Ṵ', 'Ṷ', 'Ṹ', 'Ṻ', 'Ṽ', 'Ṿ', 'Ẁ', 'Ẃ', 'Ẅ', 'Ẇ', 'Ẉ', 'Ẋ', 'Ẍ', 'Ẏ', 'Ẑ', 'Ẓ', 'Ẕ', 'ẞ', 'Ạ', 'Ả', 'Ấ', 'Ầ', 'Ẩ', 'Ẫ', 'Ậ', 'Ắ', 'Ằ', 'Ẳ', 'Ẵ', 'Ặ', 'Ẹ', 'Ẻ', 'Ẽ', 'Ế', 'Ề', 'Ể', 'Ễ', 'Ệ', 'Ỉ', 'Ị', 'Ọ', 'Ỏ', 'Ố', 'Ồ', 'Ổ', 'Ỗ', 'Ộ', 'Ớ', 'Ờ', 'Ở', 'Ỡ', 'Ợ', 'Ụ', 'Ủ', 'Ứ', 'Ừ', 'Ử', 'Ữ', 'Ự', 'Ỳ', 'Ỵ', 'Ỷ', 'Ỹ', 'Ỻ', 'Ỽ', 'Ỿ', 'Ὑ', 'Ὓ', 'Ὕ', 'Ὗ', 'ℂ', 'ℇ', 'ℕ', 'ℤ', 'Ω', 'ℨ', 'ⅅ', 'Ↄ', 'Ⱡ', 'Ⱨ', 'Ⱪ', 'Ⱬ', 'Ⱳ', 'Ⱶ', 'Ⲃ', 'Ⲅ', 'Ⲇ', 'Ⲉ', 'Ⲋ', 'Ⲍ', 'Ⲏ', 'Ⲑ', 'Ⲓ', 'Ⲕ', 'Ⲗ', 'Ⲙ', 'Ⲛ', 'Ⲝ', 'Ⲟ', 'Ⲡ', 'Ⲣ', 'Ⲥ', 'Ⲧ', 'Ⲩ', 'Ⲫ', 'Ⲭ', 'Ⲯ', 'Ⲱ', 'Ⲳ', 'Ⲵ', 'Ⲷ', 'Ⲹ', 'Ⲻ', 'Ⲽ', 'Ⲿ', 'Ⳁ', 'Ⳃ', 'Ⳅ', 'Ⳇ', 'Ⳉ', 'Ⳋ', 'Ⳍ', 'Ⳏ', 'Ⳑ', 'Ⳓ', 'Ⳕ', 'Ⳗ', 'Ⳙ', 'Ⳛ', 'Ⳝ', 'Ⳟ', 'Ⳡ', 'Ⳣ', 'Ⳬ', 'Ⳮ', 'Ⳳ', 'Ꙁ', 'Ꙃ', 'Ꙅ', 'Ꙇ', 'Ꙉ', 'Ꙋ', 'Ꙍ', 'Ꙏ', 'Ꙑ', 'Ꙓ', 'Ꙕ', 'Ꙗ', 'Ꙙ', 'Ꙛ', 'Ꙝ', 'Ꙟ', 'Ꙡ', 'Ꙣ', 'Ꙥ', 'Ꙧ', 'Ꙩ', 'Ꙫ', 'Ꙭ', 'Ꚁ', 'Ꚃ', 'Ꚅ', 'Ꚇ', 'Ꚉ', 'Ꚋ', 'Ꚍ', 'Ꚏ', 'Ꚑ', 'Ꚓ', 'Ꚕ', 'Ꚗ', 'Ꚙ', 'Ꚛ', 'Ꜣ', 'Ꜥ', 'Ꜧ', 'Ꜩ', 'Ꜫ', 'Ꜭ', 'Ꜯ', 'Ꜳ', 'Ꜵ', 'Ꜷ', 'Ꜹ', 'Ꜻ', 'Ꜽ', 'Ꜿ', 'Ꝁ', 'Ꝃ', 'Ꝅ', 'Ꝇ', 'Ꝉ', 'Ꝋ', 'Ꝍ', 'Ꝏ', 'Ꝑ', 'Ꝓ', 'Ꝕ', 'Ꝗ', 'Ꝙ', 'Ꝛ', 'Ꝝ', 'Ꝟ', 'Ꝡ', 'Ꝣ', 'Ꝥ', 'Ꝧ', 'Ꝩ', 'Ꝫ', 'Ꝭ', 'Ꝯ', 'Ꝺ', 'Ꝼ', 'Ꞁ', 'Ꞃ', 'Ꞅ', 'Ꞇ', 'Ꞌ', 'Ɥ', 'Ꞑ', 'Ꞓ', 'Ꞗ', 'Ꞙ', 'Ꞛ', 'Ꞝ', 'Ꞟ', 'Ꞡ', 'Ꞣ', 'Ꞥ', 'Ꞧ', 'Ꞩ', 'Ꞷ', 'Ꞹ'},
 				ranges:     []rune{'A', 'Z', 'À', 'Ö', 'Ø', 'Þ', 'Ÿ', 'Ź', 'Ɓ', 'Ƃ', 'Ɔ', 'Ƈ', 'Ɖ', 'Ƌ', 'Ǝ', 'Ƒ', 'Ɠ', 'Ɣ', 'Ɩ', 'Ƙ', 'Ɯ', 'Ɲ', 'Ɵ', 'Ơ', 'Ʀ', 'Ƨ', 'Ʈ', 'Ư', 'Ʊ', 'Ƴ', 'Ʒ', 'Ƹ', 'Ƕ', 'Ǹ', 'Ⱥ', 'Ȼ', 'Ƚ', 'Ⱦ', 'Ƀ', 'Ɇ', 'Έ', 'Ί', 'Ύ', 'Ώ', 'Α', 'Ρ', 'Σ', 'Ϋ', 'ϒ', 'ϔ', 'Ϲ', 'Ϻ', 'Ͻ', 'Я', 'Ӏ', 'Ӂ', 'Ա', 'Ֆ', 'Ⴀ', 'Ⴥ', 'Ꭰ', 'Ᏽ', 'Ა', 'Ჺ', 'Ჽ', 'Ჿ', 'Ἀ', 'Ἇ', 'Ἐ', 'Ἕ', 'Ἠ', 'Ἧ', 'Ἰ', 'Ἷ', 'Ὀ', 'Ὅ', 'Ὠ', 'Ὧ', 'Ᾰ', 'Ά', 'Ὲ', 'Ή', 'Ῐ', 'Ί', 'Ῠ', 'Ῥ', 'Ὸ', 'Ώ', 'ℋ', 'ℍ', 'ℐ', 'ℒ', 'ℙ', 'ℝ', 'K', 'ℭ', 'ℰ', 'ℳ', 'ℾ', 'ℿ', 'Ⰰ', 'Ⱞ', 'Ɫ', 'Ɽ', 'Ɑ', 'Ɒ', 'Ȿ', 'Ⲁ', 'Ᵹ', 'Ꝿ', 'Ɦ', 'Ɪ', 'Ʞ', 'Ꞵ', 'Ａ', 'Ｚ'},
@@ -12351,9 +12833,9 @@ var g = &grammar{
 		},
 		{
 			name: "Mc",
-			pos:  position{line: 1689, col: 1, offset: 52530},
+			pos:  position{line: 1743, col: 1, offset: 54025},
 			expr: &charClassMatcher{
-				pos:        position{line: 1689, col: 6, offset: 52535},
+				pos:        position{line: 1743, col: 6, offset: 54030},
 				val:        "[\\u0903\\u093B\\u093E-\\u0940\\u0949-\\u094C\\u094E-\\u094F\\u0982-\\u0983\\u09BE-\\u09C0\\u09C7-\\u09C8\\u09CB-\\u09CC\\u09D7\\u0A03\\u0A3E-\\u0A40\\u0A83\\u0ABE-\\u0AC0\\u0AC9\\u0ACB-\\u0ACC\\u0B02-\\u0B03\\u0B3E\\u0B40\\u0B47-\\u0B48\\u0B4B-\\u0B4C\\u0B57\\u0BBE-\\u0BBF\\u0BC1-\\u0BC2\\u0BC6-\\u0BC8\\u0BCA-\\u0BCC\\u0BD7\\u0C01-\\u0C03\\u0C41-\\u0C44\\u0C82-\\u0C83\\u0CBE\\u0CC0-\\u0CC4\\u0CC7-\\u0CC8\\u0CCA-\\u0CCB\\u0CD5-\\u0CD6\\u0D02-\\u0D03\\u0D3E-\\u0D40\\u0D46-\\u0D48\\u0D4A-\\u0D4C\\u0D57\\u0D82-\\u0D83\\u0DCF-\\u0DD1\\u0DD8-\\u0DDF\\u0DF2-\\u0DF3\\u0F3E-\\u0F3F\\u0F7F\\u102B-\\u102C\\u1031\\u1038\\u103B-\\u103C\\u1056-\\u1057\\u1062-\\u1064\\u1067-\\u106D\\u1083-\\u1084\\u1087-\\u108C\\u108F\\u109A-\\u109C\\u17B6\\u17BE-\\u17C5\\u17C7-\\u17C8\\u1923-\\u1926\\u1929-\\u192B\\u1930-\\u1931\\u1933-\\u1938\\u1A19-\\u1A1A\\u1A55\\u1A57\\u1A61\\u1A63-\\u1A64\\u1A6D-\\u1A72\\u1B04\\u1B35\\u1B3B\\u1B3D-\\u1B41\\u1B43-\\u1B44\\u1B82\\u1BA1\\u1BA6-\\u1BA7\\u1BAA\\u1BE7\\u1BEA-\\u1BEC\\u1BEE\\u1BF2-\\u1BF3\\u1C24-\\u1C2B\\u1C34-\\u1C35\\u1CE1\\u1CF2-\\u1CF3\\u1CF7\\u302E-\\u302F\\uA823-\\uA824\\uA827\\uA880-\\uA881\\uA8B4-\\uA8C3\\uA952-\\uA953\\uA983\\uA9B4-\\uA9B5\\uA9BA-\\uA9BB\\uA9BD-\\uA9C0\\uAA2F-\\uAA30\\uAA33-\\uAA34\\uAA4D\\uAA7B\\uAA7D\\uAAEB\\uAAEE-\\uAAEF\\uAAF5\\uABE3-\\uABE4\\uABE6-\\uABE7\\uABE9-\\uABEA\\uABEC]",
 				chars:      []rune{'ः', 'ऻ', 'ৗ', 'ਃ', 'ઃ', 'ૉ', 'ା', 'ୀ', 'ୗ', 'ௗ', 'ಾ', 'ൗ', 'ཿ', 'ေ', 'း', 'ႏ', 'ា', 'ᩕ', 'ᩗ', 'ᩡ', 'ᬄ', 'ᬵ', 'ᬻ', 'ᮂ', 'ᮡ', '᮪', 'ᯧ', 'ᯮ', '᳡', '᳷', 'ꠧ', 'ꦃ', 'ꩍ', 'ꩻ', 'ꩽ', 'ꫫ', 'ꫵ', '꯬'},
 				ranges:     []rune{'ा', 'ी', 'ॉ', 'ौ', 'ॎ', 'ॏ', 'ং', 'ঃ', 'া', 'ী', 'ে', 'ৈ', 'ো', 'ৌ', 'ਾ', 'ੀ', 'ા', 'ી', 'ો', 'ૌ', 'ଂ', 'ଃ', 'େ', 'ୈ', 'ୋ', 'ୌ', 'ா', 'ி', 'ு', 'ூ', 'ெ', 'ை', 'ொ', 'ௌ', 'ఁ', 'ః', 'ు', 'ౄ', 'ಂ', 'ಃ', 'ೀ', 'ೄ', 'ೇ', 'ೈ', 'ೊ', 'ೋ', 'ೕ', 'ೖ', 'ം', 'ഃ', 'ാ', 'ീ', 'െ', 'ൈ', 'ൊ', 'ൌ', 'ං', 'ඃ', 'ා', 'ෑ', 'ෘ', 'ෟ', 'ෲ', 'ෳ', '༾', '༿', 'ါ', 'ာ', 'ျ', 'ြ', 'ၖ', 'ၗ', 'ၢ', 'ၤ', 'ၧ', 'ၭ', 'ႃ', 'ႄ', 'ႇ', 'ႌ', 'ႚ', 'ႜ', 'ើ', 'ៅ', 'ះ', 'ៈ', 'ᤣ', 'ᤦ', 'ᤩ', 'ᤫ', 'ᤰ', 'ᤱ', 'ᤳ', 'ᤸ', 'ᨙ', 'ᨚ', 'ᩣ', 'ᩤ', 'ᩭ', 'ᩲ', 'ᬽ', 'ᭁ', 'ᭃ', '᭄', 'ᮦ', 'ᮧ', 'ᯪ', 'ᯬ', '᯲', '᯳', 'ᰤ', 'ᰫ', 'ᰴ', 'ᰵ', 'ᳲ', 'ᳳ', '〮', '〯', 'ꠣ', 'ꠤ', 'ꢀ', 'ꢁ', 'ꢴ', 'ꣃ', 'ꥒ', '꥓', 'ꦴ', 'ꦵ', 'ꦺ', 'ꦻ', 'ꦽ', '꧀', 'ꨯ', 'ꨰ', 'ꨳ', 'ꨴ', 'ꫮ', 'ꫯ', 'ꯣ', 'ꯤ', 'ꯦ', 'ꯧ', 'ꯩ', 'ꯪ'},
@@ -12365,9 +12847,9 @@ var g = &grammar{
 		},
 		{
 			name: "Mn",
-			pos:  position{line: 1692, col: 1, offset: 53723},
+			pos:  position{line: 1746, col: 1, offset: 55218},
 			expr: &charClassMatcher{
-				pos:        position{line: 1692, col: 6, offset: 53728},
+				pos:        position{line: 1746, col: 6, offset: 55223},
 				val:        "[\\u0300-\\u036F\\u0483-\\u0487\\u0591-\\u05BD\\u05BF\\u05C1-\\u05C2\\u05C4-\\u05C5\\u05C7\\u0610-\\u061A\\u064B-\\u065F\\u0670\\u06D6-\\u06DC\\u06DF-\\u06E4\\u06E7-\\u06E8\\u06EA-\\u06ED\\u0711\\u0730-\\u074A\\u07A6-\\u07B0\\u07EB-\\u07F3\\u07FD\\u0816-\\u0819\\u081B-\\u0823\\u0825-\\u0827\\u0829-\\u082D\\u0859-\\u085B\\u08D3-\\u08E1\\u08E3-\\u0902\\u093A\\u093C\\u0941-\\u0948\\u094D\\u0951-\\u0957\\u0962-\\u0963\\u0981\\u09BC\\u09C1-\\u09C4\\u09CD\\u09E2-\\u09E3\\u09FE\\u0A01-\\u0A02\\u0A3C\\u0A41-\\u0A42\\u0A47-\\u0A48\\u0A4B-\\u0A4D\\u0A51\\u0A70-\\u0A71\\u0A75\\u0A81-\\u0A82\\u0ABC\\u0AC1-\\u0AC5\\u0AC7-\\u0AC8\\u0ACD\\u0AE2-\\u0AE3\\u0AFA-\\u0AFF\\u0B01\\u0B3C\\u0B3F\\u0B41-\\u0B44\\u0B4D\\u0B56\\u0B62-\\u0B63\\u0B82\\u0BC0\\u0BCD\\u0C00\\u0C04\\u0C3E-\\u0C40\\u0C46-\\u0C48\\u0C4A-\\u0C4D\\u0C55-\\u0C56\\u0C62-\\u0C63\\u0C81\\u0CBC\\u0CBF\\u0CC6\\u0CCC-\\u0CCD\\u0CE2-\\u0CE3\\u0D00-\\u0D01\\u0D3B-\\u0D3C\\u0D41-\\u0D44\\u0D4D\\u0D62-\\u0D63\\u0DCA\\u0DD2-\\u0DD4\\u0DD6\\u0E31\\u0E34-\\u0E3A\\u0E47-\\u0E4E\\u0EB1\\u0EB4-\\u0EB9\\u0EBB-\\u0EBC\\u0EC8-\\u0ECD\\u0F18-\\u0F19\\u0F35\\u0F37\\u0F39\\u0F71-\\u0F7E\\u0F80-\\u0F84\\u0F86-\\u0F87\\u0F8D-\\u0F97\\u0F99-\\u0FBC\\u0FC6\\u102D-\\u1030\\u1032-\\u1037\\u1039-\\u103A\\u103D-\\u103E\\u1058-\\u1059\\u105E-\\u1060\\u1071-\\u1074\\u1082\\u1085-\\u1086\\u108D\\u109D\\u135D-\\u135F\\u1712-\\u1714\\u1732-\\u1734\\u1752-\\u1753\\u1772-\\u1773\\u17B4-\\u17B5\\u17B7-\\u17BD\\u17C6\\u17C9-\\u17D3\\u17DD\\u180B-\\u180D\\u1885-\\u1886\\u18A9\\u1920-\\u1922\\u1927-\\u1928\\u1932\\u1939-\\u193B\\u1A17-\\u1A18\\u1A1B\\u1A56\\u1A58-\\u1A5E\\u1A60\\u1A62\\u1A65-\\u1A6C\\u1A73-\\u1A7C\\u1A7F\\u1AB0-\\u1ABD\\u1B00-\\u1B03\\u1B34\\u1B36-\\u1B3A\\u1B3C\\u1B42\\u1B6B-\\u1B73\\u1B80-\\u1B81\\u1BA2-\\u1BA5\\u1BA8-\\u1BA9\\u1BAB-\\u1BAD\\u1BE6\\u1BE8-\\u1BE9\\u1BED\\u1BEF-\\u1BF1\\u1C2C-\\u1C33\\u1C36-\\u1C37\\u1CD0-\\u1CD2\\u1CD4-\\u1CE0\\u1CE2-\\u1CE8\\u1CED\\u1CF4\\u1CF8-\\u1CF9\\u1DC0-\\u1DF9\\u1DFB-\\u1DFF\\u20D0-\\u20DC\\u20E1\\u20E5-\\u20F0\\u2CEF-\\u2CF1\\u2D7F\\u2DE0-\\u2DFF\\u302A-\\u302D\\u3099-\\u309A\\uA66F\\uA674-\\uA67D\\uA69E-\\uA69F\\uA6F0-\\uA6F1\\uA802\\uA806\\uA80B\\uA825-\\uA826\\uA8C4-\\uA8C5\\uA8E0-\\uA8F1\\uA8FF\\uA926-\\uA92D\\uA947-\\uA951\\uA980-\\uA982\\uA9B3\\uA9B6-\\uA9B9\\uA9BC\\uA9E5\\uAA29-\\uAA2E\\uAA31-\\uAA32\\uAA35-\\uAA36\\uAA43\\uAA4C\\uAA7C\\uAAB0\\uAAB2-\\uAAB4\\uAAB7-\\uAAB8\\uAABE-\\uAABF\\uAAC1\\uAAEC-\\uAAED\\uAAF6\\uABE5\\uABE8\\uABED\\uFB1E\\uFE00-\\uFE0F\\uFE20-\\uFE2F]",
 				chars:      []rune{'ֿ', 'ׇ', 'ٰ', 'ܑ', '߽', 'ऺ', '़', '्', 'ঁ', '়', '্', '৾', '਼', 'ੑ', 'ੵ', '઼', '્', 'ଁ', '଼', 'ି', '୍', 'ୖ', 'ஂ', 'ீ', '்', 'ఀ', 'ఄ', 'ಁ', '಼', 'ಿ', 'ೆ', '്', '්', 'ූ', 'ั', 'ັ', '༵', '༷', '༹', '࿆', 'ႂ', 'ႍ', 'ႝ', 'ំ', '៝', 'ᢩ', 'ᤲ', 'ᨛ', 'ᩖ', '᩠', 'ᩢ', '᩿', '᬴', 'ᬼ', 'ᭂ', '᯦', 'ᯭ', '᳭', '᳴', '⃡', '⵿', '꙯', 'ꠂ', '꠆', 'ꠋ', 'ꣿ', '꦳', 'ꦼ', 'ꧥ', 'ꩃ', 'ꩌ', 'ꩼ', 'ꪰ', '꫁', '꫶', 'ꯥ', 'ꯨ', '꯭', 'ﬞ'},
 				ranges:     []rune{'̀', 'ͯ', '҃', '҇', '֑', 'ֽ', 'ׁ', 'ׂ', 'ׄ', 'ׅ', 'ؐ', 'ؚ', 'ً', 'ٟ', 'ۖ', 'ۜ', '۟', 'ۤ', 'ۧ', 'ۨ', '۪', 'ۭ', 'ܰ', '݊', 'ަ', 'ް', '߫', '߳', 'ࠖ', '࠙', 'ࠛ', 'ࠣ', 'ࠥ', 'ࠧ', 'ࠩ', '࠭', '࡙', '࡛', '࣓', '࣡', 'ࣣ', 'ं', 'ु', 'ै', '॑', 'ॗ', 'ॢ', 'ॣ', 'ু', 'ৄ', 'ৢ', 'ৣ', 'ਁ', 'ਂ', 'ੁ', 'ੂ', 'ੇ', 'ੈ', 'ੋ', '੍', 'ੰ', 'ੱ', 'ઁ', 'ં', 'ુ', 'ૅ', 'ે', 'ૈ', 'ૢ', 'ૣ', 'ૺ', '૿', 'ୁ', 'ୄ', 'ୢ', 'ୣ', 'ా', 'ీ', 'ె', 'ై', 'ొ', '్', 'ౕ', 'ౖ', 'ౢ', 'ౣ', 'ೌ', '್', 'ೢ', 'ೣ', 'ഀ', 'ഁ', '഻', '഼', 'ു', 'ൄ', 'ൢ', 'ൣ', 'ි', 'ු', 'ิ', 'ฺ', '็', '๎', 'ິ', 'ູ', 'ົ', 'ຼ', '່', 'ໍ', '༘', '༙', 'ཱ', 'ཾ', 'ྀ', '྄', '྆', '྇', 'ྍ', 'ྗ', 'ྙ', 'ྼ', 'ိ', 'ူ', 'ဲ', '့', '္', '်', 'ွ', 'ှ', 'ၘ', 'ၙ', 'ၞ', 'ၠ', 'ၱ', 'ၴ', 'ႅ', 'ႆ', '፝', '፟', 'ᜒ', '᜔', 'ᜲ', '᜴', 'ᝒ', 'ᝓ', 'ᝲ', 'ᝳ', '឴', '឵', 'ិ', 'ួ', '៉', '៓', '᠋', '᠍', 'ᢅ', 'ᢆ', 'ᤠ', 'ᤢ', 'ᤧ', 'ᤨ', '᤹', '᤻', 'ᨗ', 'ᨘ', 'ᩘ', 'ᩞ', 'ᩥ', 'ᩬ', 'ᩳ', '᩼', '᪰', '᪽', 'ᬀ', 'ᬃ', 'ᬶ', 'ᬺ', '᭫', '᭳', 'ᮀ', 'ᮁ', 'ᮢ', 'ᮥ', 'ᮨ', 'ᮩ', '᮫', 'ᮭ', 'ᯨ', 'ᯩ', 'ᯯ', 'ᯱ', 'ᰬ', 'ᰳ', 'ᰶ', '᰷', '᳐', '᳒', '᳔', '᳠', '᳢', '᳨', '᳸', '᳹', '᷀', '᷹', '᷻', '᷿', '⃐', '⃜', '⃥', '⃰', '⳯', '⳱', 'ⷠ', 'ⷿ', '〪', '〭', '゙', '゚', 'ꙴ', '꙽', 'ꚞ', 'ꚟ', '꛰', '꛱', 'ꠥ', 'ꠦ', '꣄', 'ꣅ', '꣠', '꣱', 'ꤦ', '꤭', 'ꥇ', 'ꥑ', 'ꦀ', 'ꦂ', 'ꦶ', 'ꦹ', 'ꨩ', 'ꨮ', 'ꨱ', 'ꨲ', 'ꨵ', 'ꨶ', 'ꪲ', 'ꪴ', 'ꪷ', 'ꪸ', 'ꪾ', '꪿', 'ꫬ', 'ꫭ', '︀', '️', '︠', '︯'},
@@ -12379,9 +12861,9 @@ var g = &grammar{
 		},
 		{
 			name: "Nd",
-			pos:  position{line: 1695, col: 1, offset: 55908},
+			pos:  position{line: 1749, col: 1, offset: 57403},
 			expr: &charClassMatcher{
-				pos:        position{line: 1695, col: 6, offset: 55913},
+				pos:        position{line: 1749, col: 6, offset: 57408},
 				val:        "[\\u0030-\\u0039\\u0660-\\u0669\\u06F0-\\u06F9\\u07C0-\\u07C9\\u0966-\\u096F\\u09E6-\\u09EF\\u0A66-\\u0A6F\\u0AE6-\\u0AEF\\u0B66-\\u0B6F\\u0BE6-\\u0BEF\\u0C66-\\u0C6F\\u0CE6-\\u0CEF\\u0D66-\\u0D6F\\u0DE6-\\u0DEF\\u0E50-\\u0E59\\u0ED0-\\u0ED9\\u0F20-\\u0F29\\u1040-\\u1049\\u1090-\\u1099\\u17E0-\\u17E9\\u1810-\\u1819\\u1946-\\u194F\\u19D0-\\u19D9\\u1A80-\\u1A89\\u1A90-\\u1A99\\u1B50-\\u1B59\\u1BB0-\\u1BB9\\u1C40-\\u1C49\\u1C50-\\u1C59\\uA620-\\uA629\\uA8D0-\\uA8D9\\uA900-\\uA909\\uA9D0-\\uA9D9\\uA9F0-\\uA9F9\\uAA50-\\uAA59\\uABF0-\\uABF9\\uFF10-\\uFF19]",
 				ranges:     []rune{'0', '9', '٠', '٩', '۰', '۹', '߀', '߉', '०', '९', '০', '৯', '੦', '੯', '૦', '૯', '୦', '୯', '௦', '௯', '౦', '౯', '೦', '೯', '൦', '൯', '෦', '෯', '๐', '๙', '໐', '໙', '༠', '༩', '၀', '၉', '႐', '႙', '០', '៩', '᠐', '᠙', '᥆', '᥏', '᧐', '᧙', '᪀', '᪉', '᪐', '᪙', '᭐', '᭙', '᮰', '᮹', '᱀', '᱉', '᱐', '᱙', '꘠', '꘩', '꣐', '꣙', '꤀', '꤉', '꧐', '꧙', '꧰', '꧹', '꩐', '꩙', '꯰', '꯹', '０', '９'},
 				ignoreCase: false,
@@ -12392,9 +12874,9 @@ var g = &grammar{
 		},
 		{
 			name: "Nl",
-			pos:  position{line: 1698, col: 1, offset: 56416},
+			pos:  position{line: 1752, col: 1, offset: 57911},
 			expr: &charClassMatcher{
-				pos:        position{line: 1698, col: 6, offset: 56421},
+				pos:        position{line: 1752, col: 6, offset: 57916},
 				val:        "[\\u16EE-\\u16F0\\u2160-\\u2182\\u2185-\\u2188\\u3007\\u3021-\\u3029\\u3038-\\u303A\\uA6E6-\\uA6EF]",
 				chars:      []rune{'〇'},
 				ranges:     []rune{'ᛮ', 'ᛰ', 'Ⅰ', 'ↂ', 'ↅ', 'ↈ', '〡', '〩', '〸', '〺', 'ꛦ', 'ꛯ'},
@@ -12406,9 +12888,9 @@ var g = &grammar{
 		},
 		{
 			name: "Pc",
-			pos:  position{line: 1701, col: 1, offset: 56535},
+			pos:  position{line: 1755, col: 1, offset: 58030},
 			expr: &charClassMatcher{
-				pos:        position{line: 1701, col: 6, offset: 56540},
+				pos:        position{line: 1755, col: 6, offset: 58035},
 				val:        "[\\u005F\\u203F-\\u2040\\u2054\\uFE33-\\uFE34\\uFE4D-\\uFE4F\\uFF3F]",
 				chars:      []rune{'_', '⁔', '＿'},
 				ranges:     []rune{'‿', '⁀', '︳', '︴', '﹍', '﹏'},
@@ -12420,9 +12902,9 @@ var g = &grammar{
 		},
 		{
 			name: "Zs",
-			pos:  position{line: 1704, col: 1, offset: 56621},
+			pos:  position{line: 1758, col: 1, offset: 58116},
 			expr: &charClassMatcher{
-				pos:        position{line: 1704, col: 6, offset: 56626},
+				pos:        position{line: 1758, col: 6, offset: 58121},
 				val:        "[\\u0020\\u00A0\\u1680\\u2000-\\u200A\\u202F\\u205F\\u3000]",
 				chars:      []rune{' ', '\u00a0', '\u1680', '\u202f', '\u205f', '\u3000'},
 				ranges:     []rune{'\u2000', '\u200a'},
@@ -12434,9 +12916,9 @@ var g = &grammar{
 		},
 		{
 			name: "SourceCharacter",
-			pos:  position{line: 1706, col: 1, offset: 56679},
+			pos:  position{line: 1760, col: 1, offset: 58174},
 			expr: &anyMatcher{
-				line: 1707, col: 5, offset: 56699,
+				line: 1761, col: 5, offset: 58194,
 			},
 			leader:        false,
 			leftRecursive: false,
@@ -12444,48 +12926,48 @@ var g = &grammar{
 		{
 			name:        "WhiteSpace",
 			displayName: "\"whitespace\"",
-			pos:         position{line: 1709, col: 1, offset: 56702},
+			pos:         position{line: 1763, col: 1, offset: 58197},
 			expr: &choiceExpr{
-				pos: position{line: 1710, col: 5, offset: 56730},
+				pos: position{line: 1764, col: 5, offset: 58225},
 				alternatives: []any{
 					&litMatcher{
-						pos:        position{line: 1710, col: 5, offset: 56730},
+						pos:        position{line: 1764, col: 5, offset: 58225},
 						val:        "\t",
 						ignoreCase: false,
 						want:       "\"\\t\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1711, col: 5, offset: 56739},
+						pos:        position{line: 1765, col: 5, offset: 58234},
 						val:        "\v",
 						ignoreCase: false,
 						want:       "\"\\v\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1712, col: 5, offset: 56748},
+						pos:        position{line: 1766, col: 5, offset: 58243},
 						val:        "\f",
 						ignoreCase: false,
 						want:       "\"\\f\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1713, col: 5, offset: 56757},
+						pos:        position{line: 1767, col: 5, offset: 58252},
 						val:        " ",
 						ignoreCase: false,
 						want:       "\" \"",
 					},
 					&litMatcher{
-						pos:        position{line: 1714, col: 5, offset: 56765},
+						pos:        position{line: 1768, col: 5, offset: 58260},
 						val:        "\u00a0",
 						ignoreCase: false,
 						want:       "\"\\u00a0\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1715, col: 5, offset: 56778},
+						pos:        position{line: 1769, col: 5, offset: 58273},
 						val:        "\ufeff",
 						ignoreCase: false,
 						want:       "\"\\ufeff\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1716, col: 5, offset: 56791},
+						pos:  position{line: 1770, col: 5, offset: 58286},
 						name: "Zs",
 					},
 				},
@@ -12495,9 +12977,9 @@ var g = &grammar{
 		},
 		{
 			name: "LineTerminator",
-			pos:  position{line: 1718, col: 1, offset: 56795},
+			pos:  position{line: 1772, col: 1, offset: 58290},
 			expr: &charClassMatcher{
-				pos:        position{line: 1719, col: 5, offset: 56814},
+				pos:        position{line: 1773, col: 5, offset: 58309},
 				val:        "[\\n\\r\\u2028\\u2029]",
 				chars:      []rune{'\n', '\r', '\u2028', '\u2029'},
 				ignoreCase: false,
@@ -12509,9 +12991,9 @@ var g = &grammar{
 		{
 			name:        "Comment",
 			displayName: "\"comment\"",
-			pos:         position{line: 1725, col: 1, offset: 57144},
+			pos:         position{line: 1779, col: 1, offset: 58639},
 			expr: &ruleRefExpr{
-				pos:  position{line: 1728, col: 5, offset: 57215},
+				pos:  position{line: 1782, col: 5, offset: 58710},
 				name: "SingleLineComment",
 			},
 			leader:        false,
@@ -12519,39 +13001,39 @@ var g = &grammar{
 		},
 		{
 			name: "MultiLineComment",
-			pos:  position{line: 1730, col: 1, offset: 57234},
+			pos:  position{line: 1784, col: 1, offset: 58729},
 			expr: &seqExpr{
-				pos: position{line: 1731, col: 5, offset: 57255},
+				pos: position{line: 1785, col: 5, offset: 58750},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 1731, col: 5, offset: 57255},
+						pos:        position{line: 1785, col: 5, offset: 58750},
 						val:        "/*",
 						ignoreCase: false,
 						want:       "\"/*\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1731, col: 10, offset: 57260},
+						pos: position{line: 1785, col: 10, offset: 58755},
 						expr: &seqExpr{
-							pos: position{line: 1731, col: 11, offset: 57261},
+							pos: position{line: 1785, col: 11, offset: 58756},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1731, col: 11, offset: 57261},
+									pos: position{line: 1785, col: 11, offset: 58756},
 									expr: &litMatcher{
-										pos:        position{line: 1731, col: 12, offset: 57262},
+										pos:        position{line: 1785, col: 12, offset: 58757},
 										val:        "*/",
 										ignoreCase: false,
 										want:       "\"*/\"",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1731, col: 17, offset: 57267},
+									pos:  position{line: 1785, col: 17, offset: 58762},
 									name: "SourceCharacter",
 								},
 							},
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 1731, col: 35, offset: 57285},
+						pos:        position{line: 1785, col: 35, offset: 58780},
 						val:        "*/",
 						ignoreCase: false,
 						want:       "\"*/\"",
@@ -12563,30 +13045,30 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineComment",
-			pos:  position{line: 1733, col: 1, offset: 57291},
+			pos:  position{line: 1787, col: 1, offset: 58786},
 			expr: &seqExpr{
-				pos: position{line: 1734, col: 5, offset: 57313},
+				pos: position{line: 1788, col: 5, offset: 58808},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 1734, col: 5, offset: 57313},
+						pos:        position{line: 1788, col: 5, offset: 58808},
 						val:        "//",
 						ignoreCase: false,
 						want:       "\"//\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1734, col: 10, offset: 57318},
+						pos: position{line: 1788, col: 10, offset: 58813},
 						expr: &seqExpr{
-							pos: position{line: 1734, col: 11, offset: 57319},
+							pos: position{line: 1788, col: 11, offset: 58814},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1734, col: 11, offset: 57319},
+									pos: position{line: 1788, col: 11, offset: 58814},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1734, col: 12, offset: 57320},
+										pos:  position{line: 1788, col: 12, offset: 58815},
 										name: "LineTerminator",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1734, col: 27, offset: 57335},
+									pos:  position{line: 1788, col: 27, offset: 58830},
 									name: "SourceCharacter",
 								},
 							},
@@ -12599,19 +13081,19 @@ var g = &grammar{
 		},
 		{
 			name: "EOL",
-			pos:  position{line: 1736, col: 1, offset: 57354},
+			pos:  position{line: 1790, col: 1, offset: 58849},
 			expr: &seqExpr{
-				pos: position{line: 1736, col: 7, offset: 57360},
+				pos: position{line: 1790, col: 7, offset: 58855},
 				exprs: []any{
 					&zeroOrMoreExpr{
-						pos: position{line: 1736, col: 7, offset: 57360},
+						pos: position{line: 1790, col: 7, offset: 58855},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1736, col: 7, offset: 57360},
+							pos:  position{line: 1790, col: 7, offset: 58855},
 							name: "WhiteSpace",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1736, col: 19, offset: 57372},
+						pos:  position{line: 1790, col: 19, offset: 58867},
 						name: "LineTerminator",
 					},
 				},
@@ -12621,16 +13103,16 @@ var g = &grammar{
 		},
 		{
 			name: "EOT",
-			pos:  position{line: 1738, col: 1, offset: 57388},
+			pos:  position{line: 1792, col: 1, offset: 58883},
 			expr: &choiceExpr{
-				pos: position{line: 1738, col: 7, offset: 57394},
+				pos: position{line: 1792, col: 7, offset: 58889},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1738, col: 7, offset: 57394},
+						pos:  position{line: 1792, col: 7, offset: 58889},
 						name: "_",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1738, col: 11, offset: 57398},
+						pos:  position{line: 1792, col: 11, offset: 58893},
 						name: "EOF",
 					},
 				},
@@ -12640,11 +13122,11 @@ var g = &grammar{
 		},
 		{
 			name: "EOF",
-			pos:  position{line: 1740, col: 1, offset: 57403},
+			pos:  position{line: 1794, col: 1, offset: 58898},
 			expr: &notExpr{
-				pos: position{line: 1740, col: 7, offset: 57409},
+				pos: position{line: 1794, col: 7, offset: 58904},
 				expr: &anyMatcher{
-					line: 1740, col: 8, offset: 57410,
+					line: 1794, col: 8, offset: 58905,
 				},
 			},
 			leader:        false,
@@ -12652,11 +13134,11 @@ var g = &grammar{
 		},
 		{
 			name: "EOKW",
-			pos:  position{line: 1742, col: 1, offset: 57413},
+			pos:  position{line: 1796, col: 1, offset: 58908},
 			expr: &notExpr{
-				pos: position{line: 1742, col: 8, offset: 57420},
+				pos: position{line: 1796, col: 8, offset: 58915},
 				expr: &ruleRefExpr{
-					pos:  position{line: 1742, col: 9, offset: 57421},
+					pos:  position{line: 1796, col: 9, offset: 58916},
 					name: "KeyWordChars",
 				},
 			},
@@ -13316,17 +13798,27 @@ func (p *parser) callonAggAssignment11() (any, error) {
 	return p.cur.onAggAssignment11(stack["agg"])
 }
 
-func (c *current) onAgg17() (any, error) {
-	return c.pos.offset, nil
+func (c *current) onAgg17(arg any) (any, error) {
+	return arg, nil
 }
 
 func (p *parser) callonAgg17() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAgg17()
+	return p.cur.onAgg17(stack["arg"])
 }
 
-func (c *current) onAgg1(op, expr, rparen, where any) (any, error) {
+func (c *current) onAgg26() (any, error) {
+	return c.pos.offset, nil
+}
+
+func (p *parser) callonAgg26() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAgg26()
+}
+
+func (c *current) onAgg1(op, expr, args, rparen, where any) (any, error) {
 	agg := &ast.Agg{
 		Kind:    "Agg",
 		Name:    op.(string),
@@ -13336,6 +13828,7 @@ func (c *current) onAgg1(op, expr, rparen, where any) (any, error) {
 	if expr != nil {
 		agg.Expr = expr.(ast.Expr)
 	}
+	agg.Args = sliceOf[ast.Expr](args)
 	if where != nil {
 		agg.Where = where.(ast.Expr)
 	}
@@ -13346,7 +13839,7 @@ func (c *current) onAgg1(op, expr, rparen, where any) (any, error) {
 func (p *parser) callonAgg1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAgg1(stack["op"], stack["expr"], stack["rparen"], stack["where"])
+	return p.cur.onAgg1(stack["op"], stack["expr"], stack["args"], stack["rparen"], stack["where"])
 }
 
 func (c *current) onWhereClause1(expr any) (any, error) {
@@ -13716,18 +14209,28 @@ func (p *parser) callonShapeOp1() (any, error) {
 	return p.cur.onShapeOp1()
 }
 
-func (c *current) onJoinOp1(style, rightInput, key, optKey, optArgs any) (any, error) {
+func (c *current) onJoinOp14(e any) (any, error) {
+	return e, nil
+}
+
+func (p *parser) callonJoinOp14() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onJoinOp14(stack["e"])
+}
+
+func (c *current) onJoinOp1(style, rightInput, keys, cond, optArgs any) (any, error) {
 	o := &ast.Join{
 		Kind:       "Join",
 		Style:      style.(string),
-		LeftKey:    key.(ast.Expr),
+		Keys:       sliceOf[ast.JoinKey](keys),
 		KeywordPos: c.pos.offset,
 	}
 	if rightInput != nil {
 		o.RightInput = sliceOf[ast.Op](rightInput)
 	}
-	if optKey != nil {
-		o.RightKey = optKey.([]any)[3].(ast.Expr)
+	if cond != nil {
+		o.Cond = cond.(ast.Expr)
 	}
 	if optArgs != nil {
 		o.Args = sliceOf[ast.Assignment](optArgs.([]any)[1])
@@ -13739,7 +14242,7 @@ func (c *current) onJoinOp1(style, rightInput, key, optKey, optArgs any) (any, e
 func (p *parser) callonJoinOp1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onJoinOp1(stack["style"], stack["rightInput"], stack["key"], stack["optKey"], stack["optArgs"])
+	return p.cur.onJoinOp1(stack["style"], stack["rightInput"], stack["keys"], stack["cond"], stack["optArgs"])
 }
 
 func (c *current) onJoinStyle2() (any, error) {
@@ -13753,7 +14256,7 @@ func (p *parser) callonJoinStyle2() (any, error) {
 }
 
 func (c *current) onJoinStyle6() (any, error) {
-	return "inner", nil
+	return "full", nil
 }
 
 func (p *parser) callonJoinStyle6() (any, error) {
@@ -13763,7 +14266,7 @@ func (p *parser) callonJoinStyle6() (any, error) {
 }
 
 func (c *current) onJoinStyle10() (any, error) {
-	return "left", nil
+	return "inner", nil
 }
 
 func (p *parser) callonJoinStyle10() (any, error) {
@@ -13773,7 +14276,7 @@ func (p *parser) callonJoinStyle10() (any, error) {
 }
 
 func (c *current) onJoinStyle14() (any, error) {
-	return "right", nil
+	return "left", nil
 }
 
 func (p *parser) callonJoinStyle14() (any, error) {
@@ -13783,7 +14286,7 @@ func (p *parser) callonJoinStyle14() (any, error) {
 }
 
 func (c *current) onJoinStyle18() (any, error) {
-	return "inner", nil
+	return "right", nil
 }
 
 func (p *parser) callonJoinStyle18() (any, error) {
@@ -13792,6 +14295,16 @@ func (p *parser) callonJoinStyle18() (any, error) {
 	return p.cur.onJoinStyle18()
 }
 
+func (c *current) onJoinStyle22() (any, error) {
+	return "inner", nil
+}
+
+func (p *parser) callonJoinStyle22() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onJoinStyle22()
+}
+
 func (c *current) onJoinRightInput2(s any) (any, error) {
 	return s, nil
 }
@@ -13812,6 +14325,52 @@ func (p *parser) callonJoinRightInput12() (any, error) {
 	return p.cur.onJoinRightInput12()
 }
 
+func (c *current) onJoinKeys7(k any) (any, error) {
+	return k, nil
+}
+
+func (p *parser) callonJoinKeys7() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onJoinKeys7(stack["k"])
+}
+
+func (c *current) onJoinKeys1(first, rest any) (any, error) {
+	return prepend(first, rest), nil
+
+}
+
+func (p *parser) callonJoinKeys1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onJoinKeys1(stack["first"], stack["rest"])
+}
+
+func (c *current) onJoinKeyPair7(k any) (any, error) {
+	return k, nil
+}
+
+func (p *parser) callonJoinKeyPair7() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onJoinKeyPair7(stack["k"])
+}
+
+func (c *current) onJoinKeyPair1(left, right any) (any, error) {
+	k := ast.JoinKey{Kind: "JoinKey", Left: left.(ast.Expr)}
+	if right != nil {
+		k.Right = right.(ast.Expr)
+	}
+	return k, nil
+
+}
+
+func (p *parser) callonJoinKeyPair1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onJoinKeyPair1(stack["left"], stack["right"])
+}
+
 func (c *current) onJoinKey3(expr any) (any, error) {
 	return expr, nil
 }
@@ -14342,6 +14901,115 @@ func (p *parser) callonOverOp1() (any, error) {
 	return p.cur.onOverOp1(stack["exprs"], stack["locals"], stack["body"])
 }
 
+func (c *current) onWindowOp9(k any) (any, error) {
+	return k, nil
+}
+
+func (p *parser) callonWindowOp9() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowOp9(stack["k"])
+}
+
+func (c *current) onWindowOp18(s any) (any, error) {
+	return s, nil
+}
+
+func (p *parser) callonWindowOp18() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowOp18(stack["s"])
+}
+
+func (c *current) onWindowOp1(funcs, keys, sort any) (any, error) {
+	return &ast.Window{
+		Kind:       "Window",
+		KeywordPos: c.pos.offset,
+		Funcs:      sliceOf[ast.WindowFunc](funcs),
+		Keys:       sliceOf[ast.Expr](keys),
+		Sort:       sliceOf[ast.SortExpr](sort),
+	}, nil
+
+}
+
+func (p *parser) callonWindowOp1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowOp1(stack["funcs"], stack["keys"], stack["sort"])
+}
+
+func (c *current) onWindowFuncs7(f any) (any, error) {
+	return f, nil
+}
+
+func (p *parser) callonWindowFuncs7() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowFuncs7(stack["f"])
+}
+
+func (c *current) onWindowFuncs1(first, rest any) (any, error) {
+	return prepend(first, rest), nil
+
+}
+
+func (p *parser) callonWindowFuncs1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowFuncs1(stack["first"], stack["rest"])
+}
+
+func (c *current) onWindowFunc2(lval, running, agg any) (any, error) {
+	return ast.WindowFunc{
+		Kind:    "WindowFunc",
+		LHS:     lval.(ast.Expr),
+		Running: running.(bool),
+		Func:    agg.(*ast.Agg),
+	}, nil
+
+}
+
+func (p *parser) callonWindowFunc2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowFunc2(stack["lval"], stack["running"], stack["agg"])
+}
+
+func (c *current) onWindowFunc13(running, agg any) (any, error) {
+	return ast.WindowFunc{
+		Kind:    "WindowFunc",
+		Running: running.(bool),
+		Func:    agg.(*ast.Agg),
+	}, nil
+
+}
+
+func (p *parser) callonWindowFunc13() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowFunc13(stack["running"], stack["agg"])
+}
+
+func (c *current) onRunning2() (any, error) {
+	return true, nil
+}
+
+func (p *parser) callonRunning2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRunning2()
+}
+
+func (c *current) onRunning6() (any, error) {
+	return false, nil
+}
+
+func (p *parser) callonRunning6() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRunning6()
+}
+
 func (c *current) onLateral2(scope any) (any, error) {
 	return []any{[]any{scope.(*ast.Scope)}, lastPos(c, ")")}, nil
 
@@ -151,11 +151,17 @@ func (a *analyzer) semExpr(e ast.Expr) dag.Expr {
 			a.error(e, fmt.Errorf("aggregator '%s' requires argument", e.Name))
 			return badExpr()
 		}
+		args := a.semExprs(e.Args)
+		if n := agg.NumArgs(e.Name) + agg.NumParams(e.Name); len(args) != n-1 {
+			a.error(e, fmt.Errorf("aggregator '%s' requires %d arguments", e.Name, n))
+			return badExpr()
+		}
 		where := a.semExprNullable(e.Where)
 		return &dag.Agg{
 			Kind:  "Agg",
 			Name:  e.Name,
 			Expr:  expr,
+			Args:  args,
 			Where: where,
 		}
 	case *ast.RecordExpr:
@@ -439,7 +445,7 @@ func (a *analyzer) semCall(call *ast.Call) dag.Expr {
 		if nargs == 1 {
 			exprs = append([]dag.Expr{&dag.This{Kind: "This"}}, exprs...)
 		}
-	case name == "map":
+	case name == "map", name == "map_filter":
 		if err := function.CheckArgCount(nargs, 2, 2); err != nil {
 			a.error(call, err)
 			return badExpr()
@@ -454,6 +460,13 @@ func (a *analyzer) semCall(call *ast.Call) dag.Expr {
 			Name: id,
 			Args: []ast.Expr{&ast.ID{Kind: "ID", Name: "this"}},
 		})
+		if name == "map_filter" {
+			return &dag.MapFilter{
+				Kind:  "MapFilter",
+				Expr:  exprs[0],
+				Inner: inner,
+			}
+		}
 		return &dag.MapCall{
 			Kind:  "MapCall",
 			Expr:  exprs[0],
@@ -576,11 +589,16 @@ func (a *analyzer) semField(f ast.Expr) dag.Expr {
 
 func (a *analyzer) maybeConvertAgg(call *ast.Call) dag.Expr {
 	name := call.Name.Name
-	if _, err := agg.NewPattern(name, true); err != nil {
+	if _, err := agg.NewPattern(name, true, nil); errors.Is(err, agg.ErrUnknown) {
 		return nil
 	}
 	var e dag.Expr
-	if err := function.CheckArgCount(len(call.Args), 0, 1); err != nil {
+	nargs := agg.NumArgs(name) + agg.NumParams(name)
+	minArgs := 0
+	if nargs > 1 {
+		minArgs = nargs
+	}
+	if err := function.CheckArgCount(len(call.Args), minArgs, nargs); err != nil {
 		if name == "min" || name == "max" {
 			// min and max are special cases as they are also functions. If the
 			// number of args is greater than 1 they're probably a function so do not
@@ -590,13 +608,16 @@ func (a *analyzer) maybeConvertAgg(call *ast.Call) dag.Expr {
 		a.error(call, err)
 		return badExpr()
 	}
-	if len(call.Args) == 1 {
+	var args []dag.Expr
+	if len(call.Args) > 0 {
 		e = a.semExpr(call.Args[0])
+		args = a.semExprs(call.Args[1:])
 	}
 	return &dag.Agg{
 		Kind:  "Agg",
 		Name:  name,
 		Expr:  e,
+		Args:  args,
 		Where: a.semExprNullable(call.Where),
 	}
 }
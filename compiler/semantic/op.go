@@ -18,6 +18,7 @@ import (
 	"github.com/brimdata/super/pkg/reglob"
 	"github.com/brimdata/super/runtime/sam/expr"
 	"github.com/brimdata/super/runtime/sam/expr/function"
+	"github.com/brimdata/super/runtime/sam/op/window"
 	"github.com/brimdata/super/zson"
 	"github.com/segmentio/ksuid"
 )
@@ -216,6 +217,77 @@ func (a *analyzer) semSortExpr(s ast.SortExpr) dag.SortExpr {
 	return dag.SortExpr{Key: e, Order: o}
 }
 
+func (a *analyzer) semWindow(w *ast.Window) dag.Op {
+	var funcs []dag.WindowFunc
+	var fields field.List
+	for _, f := range w.Funcs {
+		var lhs dag.Expr = &dag.This{Kind: "This", Path: []string{f.Func.Name}}
+		if f.LHS != nil {
+			lhs = a.semExpr(f.LHS)
+			if !isLval(lhs) {
+				a.error(f.LHS, errors.New("illegal left-hand side of assignment"))
+				lhs = badExpr()
+			}
+		}
+		if this, ok := lhs.(*dag.This); ok {
+			fields = append(fields, this.Path)
+		}
+		fn := a.semWindowFunc(f)
+		if fn == nil {
+			return badOp()
+		}
+		funcs = append(funcs, dag.WindowFunc{LHS: lhs, Func: fn, Running: f.Running})
+	}
+	if err := expr.CheckPutFields(fields); err != nil {
+		a.error(w, err)
+	}
+	var sortExprs []dag.SortExpr
+	for _, s := range w.Sort {
+		sortExprs = append(sortExprs, a.semSortExpr(s))
+	}
+	return &dag.Window{
+		Kind:  "Window",
+		Funcs: funcs,
+		Keys:  a.semExprs(w.Keys),
+		Sort:  sortExprs,
+	}
+}
+
+func (a *analyzer) semWindowFunc(f ast.WindowFunc) *dag.Agg {
+	name := f.Func.Name
+	minArgs, maxArgs, ok := window.NumArgs(name)
+	if !ok {
+		// Any other function must be an aggregate function.
+		agg, ok := a.semExpr(f.Func).(*dag.Agg)
+		if !ok {
+			return nil
+		}
+		return agg
+	}
+	if f.Running {
+		a.error(f.Func, fmt.Errorf("%s: running applies only to aggregate functions", name))
+		return nil
+	}
+	if f.Func.Where != nil {
+		a.error(f.Func.Where, fmt.Errorf("%s: where clause applies only to aggregate functions", name))
+		return nil
+	}
+	nargs := len(f.Func.Args)
+	if f.Func.Expr != nil {
+		nargs++
+	}
+	if err := function.CheckArgCount(nargs, minArgs, maxArgs); err != nil {
+		a.error(f.Func, fmt.Errorf("%s: %w", name, err))
+		return nil
+	}
+	return &dag.Agg{
+		Kind: "Agg",
+		Name: name,
+		Expr: a.semExprNullable(f.Func.Expr),
+		Args: a.semExprs(f.Func.Args),
+	}
+}
+
 func (a *analyzer) semPool(p *ast.Pool) []dag.Op {
 	var poolNames []string
 	var err error
@@ -521,6 +593,8 @@ func (a *analyzer) semOp(o ast.Op, seq dag.Seq) dag.Seq {
 			NullsFirst: o.NullsFirst,
 			Reverse:    o.Reverse,
 		})
+	case *ast.Window:
+		return append(seq, a.semWindow(o))
 	case *ast.Head:
 		val := zed.NewInt64(1)
 		if o.Count != nil {
@@ -650,19 +724,29 @@ func (a *analyzer) semOp(o ast.Op, seq dag.Seq) dag.Seq {
 		return append(seq, &dag.Fuse{Kind: "Fuse"})
 	case *ast.Join:
 		rightInput := a.semSeq(o.RightInput)
-		leftKey := a.semExpr(o.LeftKey)
-		rightKey := leftKey
-		if o.RightKey != nil {
-			rightKey = a.semExpr(o.RightKey)
+		var leftKeys, rightKeys []dag.Expr
+		for _, k := range o.Keys {
+			leftKey := a.semExpr(k.Left)
+			rightKey := leftKey
+			if k.Right != nil {
+				rightKey = a.semExpr(k.Right)
+			}
+			leftKeys = append(leftKeys, leftKey)
+			rightKeys = append(rightKeys, rightKey)
+		}
+		var cond dag.Expr
+		if o.Cond != nil {
+			cond = a.semExpr(o.Cond)
 		}
 		join := &dag.Join{
-			Kind:     "Join",
-			Style:    o.Style,
-			LeftDir:  order.Unknown,
-			LeftKey:  leftKey,
-			RightDir: order.Unknown,
-			RightKey: rightKey,
-			Args:     a.semAssignments(o.Args),
+			Kind:      "Join",
+			Style:     o.Style,
+			LeftDir:   order.Unknown,
+			LeftKeys:  leftKeys,
+			RightDir:  order.Unknown,
+			RightKeys: rightKeys,
+			Cond:      cond,
+			Args:      a.semAssignments(o.Args),
 		}
 		if rightInput != nil {
 			par := &dag.Fork{
@@ -39,6 +39,13 @@ func New(r io.Reader) *Lexer {
 	}
 }
 
+// Reset discards any buffered input and any error and resets l to read
+// from r.
+func (l *Lexer) Reset(r io.Reader) {
+	l.br.Reset(r)
+	l.err = nil
+}
+
 func (l *Lexer) Buf() []byte {
 	return l.buf
 }
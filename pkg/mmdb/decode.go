@@ -0,0 +1,241 @@
+package mmdb
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+const (
+	typeExtended = iota
+	typePointer
+	typeString
+	typeFloat64
+	typeBytes
+	typeUint16
+	typeUint32
+	typeMap
+	typeInt32
+	typeUint64
+	typeUint128
+	typeArray
+	typeContainer
+	typeEndMarker
+	typeBool
+	typeFloat32
+)
+
+// maxDepth bounds the nesting of maps and arrays so a corrupt file cannot
+// exhaust the stack.
+const maxDepth = 512
+
+var errTruncated = errors.New("truncated data section")
+
+// decoder decodes values in the data section format, in which pointers are
+// offsets relative to the start of buf.
+type decoder struct {
+	buf   []byte
+	depth int
+}
+
+// decode decodes the value at off and returns it along with the offset of
+// the next value.
+func (d *decoder) decode(off int) (any, int, error) {
+	typ, size, off, err := d.decodeControl(off)
+	if err != nil {
+		return nil, 0, err
+	}
+	if typ == typePointer {
+		ptr, next, err := d.decodePointer(size, off)
+		if err != nil {
+			return nil, 0, err
+		}
+		// A pointer may not point to another pointer, so the value at ptr
+		// can be decoded without the risk of a cycle.
+		if t, _, _, err := d.decodeControl(ptr); err != nil {
+			return nil, 0, err
+		} else if t == typePointer {
+			return nil, 0, errors.New("pointer to pointer")
+		}
+		v, _, err := d.decode(ptr)
+		return v, next, err
+	}
+	return d.decodeValue(typ, size, off)
+}
+
+// decodeControl decodes the control byte and any extended type and size
+// bytes at off.  For pointers, size holds the control byte's low five bits.
+func (d *decoder) decodeControl(off int) (int, int, int, error) {
+	if off >= len(d.buf) {
+		return 0, 0, 0, errTruncated
+	}
+	ctrl := d.buf[off]
+	off++
+	typ := int(ctrl >> 5)
+	if typ == typePointer {
+		return typ, int(ctrl & 0x1f), off, nil
+	}
+	if typ == typeExtended {
+		if off >= len(d.buf) {
+			return 0, 0, 0, errTruncated
+		}
+		typ = 7 + int(d.buf[off])
+		off++
+		if typ < typeInt32 {
+			return 0, 0, 0, fmt.Errorf("invalid extended type %d", typ)
+		}
+	}
+	size := int(ctrl & 0x1f)
+	if size >= 29 {
+		n := size - 28
+		if off+n > len(d.buf) {
+			return 0, 0, 0, errTruncated
+		}
+		v := beUint(d.buf[off : off+n])
+		off += n
+		switch n {
+		case 1:
+			size = 29 + int(v)
+		case 2:
+			size = 285 + int(v)
+		default:
+			size = 65821 + int(v)
+		}
+	}
+	return typ, size, off, nil
+}
+
+func (d *decoder) decodePointer(bits, off int) (int, int, error) {
+	n := (bits>>3)&0x3 + 1
+	if off+n > len(d.buf) {
+		return 0, 0, errTruncated
+	}
+	b := d.buf[off : off+n]
+	var ptr int
+	switch n {
+	case 1:
+		ptr = (bits&0x7)<<8 | int(b[0])
+	case 2:
+		ptr = 2048 + ((bits&0x7)<<16 | int(beUint(b)))
+	case 3:
+		ptr = 526336 + ((bits&0x7)<<24 | int(beUint(b)))
+	default:
+		ptr = int(beUint(b))
+	}
+	return ptr, off + n, nil
+}
+
+func (d *decoder) decodeValue(typ, size, off int) (any, int, error) {
+	switch typ {
+	case typeMap:
+		return d.decodeMap(size, off)
+	case typeArray:
+		return d.decodeArray(size, off)
+	case typeBool:
+		if size > 1 {
+			return nil, 0, fmt.Errorf("invalid boolean size %d", size)
+		}
+		return size == 1, off, nil
+	case typeContainer, typeEndMarker:
+		return nil, 0, fmt.Errorf("unexpected type %d", typ)
+	}
+	if off+size > len(d.buf) {
+		return nil, 0, errTruncated
+	}
+	b := d.buf[off : off+size]
+	next := off + size
+	switch typ {
+	case typeString:
+		return string(b), next, nil
+	case typeBytes:
+		return append([]byte(nil), b...), next, nil
+	case typeFloat64:
+		if size != 8 {
+			return nil, 0, fmt.Errorf("invalid double size %d", size)
+		}
+		return math.Float64frombits(beUint(b)), next, nil
+	case typeFloat32:
+		if size != 4 {
+			return nil, 0, fmt.Errorf("invalid float size %d", size)
+		}
+		return math.Float32frombits(uint32(beUint(b))), next, nil
+	case typeUint16:
+		if size > 2 {
+			return nil, 0, fmt.Errorf("invalid uint16 size %d", size)
+		}
+		return uint16(beUint(b)), next, nil
+	case typeUint32:
+		if size > 4 {
+			return nil, 0, fmt.Errorf("invalid uint32 size %d", size)
+		}
+		return uint32(beUint(b)), next, nil
+	case typeInt32:
+		if size > 4 {
+			return nil, 0, fmt.Errorf("invalid int32 size %d", size)
+		}
+		return int32(uint32(beUint(b))), next, nil
+	case typeUint64:
+		if size > 8 {
+			return nil, 0, fmt.Errorf("invalid uint64 size %d", size)
+		}
+		return beUint(b), next, nil
+	case typeUint128:
+		if size > 16 {
+			return nil, 0, fmt.Errorf("invalid uint128 size %d", size)
+		}
+		return new(big.Int).SetBytes(b), next, nil
+	}
+	return nil, 0, fmt.Errorf("unknown type %d", typ)
+}
+
+func (d *decoder) decodeMap(size, off int) (any, int, error) {
+	if d.depth++; d.depth > maxDepth {
+		return nil, 0, errors.New("maximum nesting depth exceeded")
+	}
+	defer func() { d.depth-- }()
+	m := make(map[string]any, min(size, len(d.buf)))
+	for range size {
+		k, next, err := d.decode(off)
+		if err != nil {
+			return nil, 0, err
+		}
+		key, ok := k.(string)
+		if !ok {
+			return nil, 0, errors.New("map key is not a string")
+		}
+		v, next, err := d.decode(next)
+		if err != nil {
+			return nil, 0, err
+		}
+		m[key] = v
+		off = next
+	}
+	return m, off, nil
+}
+
+func (d *decoder) decodeArray(size, off int) (any, int, error) {
+	if d.depth++; d.depth > maxDepth {
+		return nil, 0, errors.New("maximum nesting depth exceeded")
+	}
+	defer func() { d.depth-- }()
+	a := make([]any, 0, min(size, len(d.buf)))
+	for range size {
+		v, next, err := d.decode(off)
+		if err != nil {
+			return nil, 0, err
+		}
+		a = append(a, v)
+		off = next
+	}
+	return a, off, nil
+}
+
+// beUint decodes the big-endian unsigned integer in b.
+func beUint(b []byte) uint64 {
+	var v uint64
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+	return v
+}
@@ -0,0 +1,30 @@
+//go:build !windows
+
+package mmdb
+
+import (
+	"os"
+	"syscall"
+)
+
+// mmap maps the file at path into memory read-only and returns its contents
+// along with a function that unmaps them.
+func mmap(path string) ([]byte, func() error, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+	if info.Size() == 0 {
+		return nil, func() error { return nil }, nil
+	}
+	buf, err := syscall.Mmap(int(f.Fd()), 0, int(info.Size()), syscall.PROT_READ, syscall.MAP_SHARED)
+	if err != nil {
+		return nil, nil, &os.PathError{Op: "mmap", Path: path, Err: err}
+	}
+	return buf, func() error { return syscall.Munmap(buf) }, nil
+}
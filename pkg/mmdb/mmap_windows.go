@@ -0,0 +1,13 @@
+package mmdb
+
+import "github.com/brimdata/super/pkg/fs"
+
+// mmap reads the file at path into memory.  Windows files are not memory
+// mapped since a mapped file cannot be replaced while it is in use.
+func mmap(path string) ([]byte, func() error, error) {
+	buf, err := fs.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return buf, func() error { return nil }, nil
+}
@@ -0,0 +1,183 @@
+// Package mmdb implements a reader for the MaxMind DB file format used by
+// GeoIP and ASN databases.
+//
+// See https://maxmind.github.io/MaxMind-DB/ for the format specification.
+package mmdb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/netip"
+)
+
+var metadataMarker = []byte("\xab\xcd\xefMaxMind.com")
+
+// dataSectionSeparatorSize is the number of zero bytes between the search
+// tree and the data section.
+const dataSectionSeparatorSize = 16
+
+var ErrInvalid = errors.New("invalid MaxMind DB file")
+
+type Metadata struct {
+	DatabaseType string
+	IPVersion    int
+	NodeCount    uint32
+	RecordSize   int
+}
+
+// Reader looks up IP addresses in a MaxMind DB.  A Reader is safe for
+// concurrent use.
+type Reader struct {
+	Metadata
+	buf        []byte
+	unmap      func() error
+	tree       []byte
+	data       []byte
+	nodeSize   int
+	ipv4Start  uint32
+	ipv4Height int
+}
+
+// Open memory maps the MaxMind DB file at path and returns a Reader for it.
+// The caller should call Close when the Reader is no longer needed.
+func Open(path string) (*Reader, error) {
+	buf, unmap, err := mmap(path)
+	if err != nil {
+		return nil, err
+	}
+	r, err := NewReader(buf)
+	if err != nil {
+		unmap()
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	r.unmap = unmap
+	return r, nil
+}
+
+// NewReader returns a Reader for the MaxMind DB in buf.
+func NewReader(buf []byte) (*Reader, error) {
+	off := bytes.LastIndex(buf, metadataMarker)
+	if off < 0 {
+		return nil, fmt.Errorf("%w: metadata not found", ErrInvalid)
+	}
+	meta := buf[off+len(metadataMarker):]
+	v, _, err := (&decoder{buf: meta}).decode(0)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalid, err)
+	}
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: metadata is not a map", ErrInvalid)
+	}
+	r := &Reader{buf: buf}
+	r.DatabaseType, _ = m["database_type"].(string)
+	nodeCount, _ := asUint(m["node_count"])
+	recordSize, _ := asUint(m["record_size"])
+	ipVersion, _ := asUint(m["ip_version"])
+	r.NodeCount = uint32(nodeCount)
+	r.RecordSize = int(recordSize)
+	r.IPVersion = int(ipVersion)
+	switch r.RecordSize {
+	case 24, 28, 32:
+	default:
+		return nil, fmt.Errorf("%w: unsupported record size %d", ErrInvalid, r.RecordSize)
+	}
+	if r.IPVersion != 4 && r.IPVersion != 6 {
+		return nil, fmt.Errorf("%w: unsupported IP version %d", ErrInvalid, r.IPVersion)
+	}
+	r.nodeSize = r.RecordSize / 4
+	treeSize := int(r.NodeCount) * r.nodeSize
+	if treeSize+dataSectionSeparatorSize > off {
+		return nil, fmt.Errorf("%w: search tree exceeds file size", ErrInvalid)
+	}
+	r.tree = buf[:treeSize]
+	r.data = buf[treeSize+dataSectionSeparatorSize : off]
+	if r.IPVersion == 6 {
+		// IPv4 addresses are looked up in the ::/96 subtree of an IPv6
+		// database, so find its root once.
+		for ; r.ipv4Height < 96 && r.ipv4Start < r.NodeCount; r.ipv4Height++ {
+			r.ipv4Start = r.record(r.ipv4Start, 0)
+		}
+	}
+	return r, nil
+}
+
+func (r *Reader) Close() error {
+	if r.unmap == nil {
+		return nil
+	}
+	err := r.unmap()
+	r.unmap = nil
+	return err
+}
+
+// Lookup returns the data record for addr decoded as a map[string]any, a
+// []any, a string, a []byte, a bool, a float32 or float64, an int32, a uint16,
+// uint32, or uint64, or a *big.Int.  It returns nil if addr is not in the
+// database.
+func (r *Reader) Lookup(addr netip.Addr) (any, error) {
+	addr = addr.Unmap()
+	var node uint32
+	var ip []byte
+	if addr.Is4() {
+		a := addr.As4()
+		ip = a[:]
+		node = r.ipv4Start
+	} else {
+		if r.IPVersion == 4 {
+			return nil, nil
+		}
+		a := addr.As16()
+		ip = a[:]
+	}
+	for i := 0; i < len(ip)*8 && node < r.NodeCount; i++ {
+		bit := (ip[i/8] >> (7 - i%8)) & 1
+		node = r.record(node, int(bit))
+	}
+	switch {
+	case node == r.NodeCount:
+		return nil, nil
+	case node < r.NodeCount:
+		return nil, fmt.Errorf("%w: search tree is too deep", ErrInvalid)
+	}
+	off := int(node-r.NodeCount) - dataSectionSeparatorSize
+	if off < 0 || off >= len(r.data) {
+		return nil, fmt.Errorf("%w: data pointer out of range", ErrInvalid)
+	}
+	v, _, err := (&decoder{buf: r.data}).decode(off)
+	return v, err
+}
+
+// record returns the left (if bit is 0) or right (if bit is 1) record of the
+// search tree node with index node.
+func (r *Reader) record(node uint32, bit int) uint32 {
+	b := r.tree[int(node)*r.nodeSize:]
+	switch r.RecordSize {
+	case 24:
+		b = b[bit*3:]
+		return uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2])
+	case 28:
+		if bit == 0 {
+			return uint32(b[3]&0xf0)<<20 | uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2])
+		}
+		return uint32(b[3]&0x0f)<<24 | uint32(b[4])<<16 | uint32(b[5])<<8 | uint32(b[6])
+	default:
+		b = b[bit*4:]
+		return uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3])
+	}
+}
+
+func asUint(v any) (uint64, bool) {
+	switch v := v.(type) {
+	case uint16:
+		return uint64(v), true
+	case uint32:
+		return uint64(v), true
+	case uint64:
+		return v, true
+	case int32:
+		return uint64(v), v >= 0
+	}
+	return 0, false
+}
@@ -0,0 +1,264 @@
+package mmdb
+
+import (
+	"bytes"
+	"math"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type entry struct {
+	prefix string
+	data   any
+}
+
+var cityEntries = []entry{
+	{"81.2.69.142/31", map[string]any{
+		"city":    map[string]any{"names": map[string]any{"en": "London"}},
+		"country": map[string]any{"iso_code": "GB", "names": map[string]any{"en": "United Kingdom"}},
+		"location": map[string]any{
+			"latitude":  51.5142,
+			"longitude": -0.0931,
+			"time_zone": "Europe/London",
+		},
+		"postal": map[string]any{"code": "EC2V"},
+		"subdivisions": []any{
+			map[string]any{"iso_code": "ENG", "names": map[string]any{"en": "England"}},
+		},
+	}},
+	{"2.125.160.216/29", map[string]any{
+		"country": map[string]any{"iso_code": "GB", "names": map[string]any{"en": "United Kingdom"}},
+	}},
+	{"2001:218::/32", map[string]any{
+		"country":  map[string]any{"iso_code": "JP", "names": map[string]any{"en": "Japan"}},
+		"location": map[string]any{"latitude": 35.68536, "longitude": 139.75309, "time_zone": "Asia/Tokyo"},
+	}},
+}
+
+var asnEntries = []entry{
+	{"1.128.0.0/11", map[string]any{
+		"autonomous_system_number":       uint32(1221),
+		"autonomous_system_organization": "Telstra Pty Ltd",
+	}},
+	{"2600:6000::/20", map[string]any{
+		"autonomous_system_number":       uint32(237),
+		"autonomous_system_organization": "Merit Network Inc.",
+	}},
+}
+
+func TestLookup(t *testing.T) {
+	for _, recordSize := range []int{24, 28, 32} {
+		r, err := NewReader(build(t, "GeoLite2-City", recordSize, cityEntries))
+		require.NoError(t, err)
+		assert.Equal(t, "GeoLite2-City", r.DatabaseType)
+		assert.Equal(t, 6, r.IPVersion)
+		assert.Equal(t, recordSize, r.RecordSize)
+		for _, s := range []string{"81.2.69.142", "81.2.69.143", "::ffff:81.2.69.142"} {
+			v, err := r.Lookup(netip.MustParseAddr(s))
+			require.NoError(t, err)
+			assert.Equal(t, cityEntries[0].data, v, s)
+		}
+		v, err := r.Lookup(netip.MustParseAddr("2.125.160.223"))
+		require.NoError(t, err)
+		assert.Equal(t, cityEntries[1].data, v)
+		v, err = r.Lookup(netip.MustParseAddr("2001:218:1::1"))
+		require.NoError(t, err)
+		assert.Equal(t, cityEntries[2].data, v)
+		for _, s := range []string{"81.2.69.144", "10.0.0.1", "2001:219::1"} {
+			v, err := r.Lookup(netip.MustParseAddr(s))
+			require.NoError(t, err)
+			assert.Nil(t, v, s)
+		}
+	}
+}
+
+func TestInvalid(t *testing.T) {
+	_, err := NewReader([]byte("not a database"))
+	assert.ErrorIs(t, err, ErrInvalid)
+	buf := build(t, "test", 24, asnEntries)
+	// Truncate the search tree.
+	off := bytes.LastIndex(buf, metadataMarker)
+	_, err = NewReader(slices.Concat(buf[:10], buf[off:]))
+	assert.ErrorIs(t, err, ErrInvalid)
+}
+
+func TestDecodePointer(t *testing.T) {
+	// A map whose value is a pointer to the string at offset 0.
+	buf := []byte{
+		0x43, 'f', 'o', 'o', // "foo"
+		0xe1,      // map with one entry
+		0x41, 'k', // "k"
+		0x20, 0x00, // pointer to offset 0
+	}
+	v, next, err := (&decoder{buf: buf}).decode(4)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"k": "foo"}, v)
+	assert.Equal(t, len(buf), next)
+	// Pointers to pointers are not allowed.
+	_, _, err = (&decoder{buf: []byte{0x20, 0x00}}).decode(0)
+	assert.Error(t, err)
+}
+
+// TestTestdata checks that the databases in testdata, which are used by
+// other tests, are those generated by build.
+func TestTestdata(t *testing.T) {
+	for _, c := range []struct {
+		name    string
+		entries []entry
+	}{
+		{"GeoLite2-ASN-Test", asnEntries},
+		{"GeoLite2-City-Test", cityEntries},
+	} {
+		path := filepath.Join("testdata", c.name+".mmdb")
+		expected := build(t, c.name, 24, c.entries)
+		if os.Getenv("MMDB_WRITE_TESTDATA") != "" {
+			require.NoError(t, os.WriteFile(path, expected, 0666))
+		}
+		r, err := Open(path)
+		require.NoError(t, err)
+		assert.Equal(t, expected, r.buf, path)
+		require.NoError(t, r.Close())
+	}
+}
+
+// build returns an IPv6 database containing entries.  IPv4 prefixes are
+// stored in the ::/96 subtree.
+func build(t *testing.T, dbType string, recordSize int, entries []entry) []byte {
+	type child struct {
+		node int // index of child node or -1
+		data int // offset of data or -1
+	}
+	nodes := [][2]child{{{-1, -1}, {-1, -1}}}
+	var data bytes.Buffer
+	for _, e := range entries {
+		prefix := netip.MustParsePrefix(e.prefix)
+		a := prefix.Addr().As16()
+		bits := prefix.Bits()
+		if prefix.Addr().Is4() {
+			a = [16]byte{}
+			copy(a[12:], prefix.Addr().AsSlice())
+			bits += 96
+		}
+		off := data.Len()
+		encode(t, &data, e.data)
+		node := 0
+		for i := range bits {
+			bit := (a[i/8] >> (7 - i%8)) & 1
+			if i == bits-1 {
+				nodes[node][bit] = child{-1, off}
+				break
+			}
+			if nodes[node][bit].node < 0 {
+				require.Equal(t, -1, nodes[node][bit].data, "overlapping prefixes")
+				nodes = append(nodes, [2]child{{-1, -1}, {-1, -1}})
+				nodes[node][bit].node = len(nodes) - 1
+			}
+			node = nodes[node][bit].node
+		}
+	}
+	var out bytes.Buffer
+	n := uint32(len(nodes))
+	for _, node := range nodes {
+		var records [2]uint32
+		for i, c := range node {
+			switch {
+			case c.node >= 0:
+				records[i] = uint32(c.node)
+			case c.data >= 0:
+				records[i] = n + dataSectionSeparatorSize + uint32(c.data)
+			default:
+				records[i] = n
+			}
+		}
+		l, r := records[0], records[1]
+		switch recordSize {
+		case 24:
+			out.Write([]byte{byte(l >> 16), byte(l >> 8), byte(l), byte(r >> 16), byte(r >> 8), byte(r)})
+		case 28:
+			out.Write([]byte{byte(l >> 16), byte(l >> 8), byte(l), byte(l>>20&0xf0 | r>>24&0x0f), byte(r >> 16), byte(r >> 8), byte(r)})
+		case 32:
+			out.Write([]byte{byte(l >> 24), byte(l >> 16), byte(l >> 8), byte(l), byte(r >> 24), byte(r >> 16), byte(r >> 8), byte(r)})
+		}
+	}
+	out.Write(make([]byte, dataSectionSeparatorSize))
+	out.Write(data.Bytes())
+	out.Write(metadataMarker)
+	encode(t, &out, map[string]any{
+		"binary_format_major_version": uint16(2),
+		"binary_format_minor_version": uint16(0),
+		"database_type":               dbType,
+		"ip_version":                  uint16(6),
+		"node_count":                  n,
+		"record_size":                 uint16(recordSize),
+	})
+	return out.Bytes()
+}
+
+func encode(t *testing.T, b *bytes.Buffer, v any) {
+	switch v := v.(type) {
+	case map[string]any:
+		encodeControl(b, typeMap, len(v))
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			encode(t, b, k)
+			encode(t, b, v[k])
+		}
+	case []any:
+		encodeControl(b, typeArray, len(v))
+		for _, elem := range v {
+			encode(t, b, elem)
+		}
+	case string:
+		encodeControl(b, typeString, len(v))
+		b.WriteString(v)
+	case float64:
+		encodeControl(b, typeFloat64, 8)
+		encodeUint(b, math.Float64bits(v), 8)
+	case uint16:
+		encodeControl(b, typeUint16, 2)
+		encodeUint(b, uint64(v), 2)
+	case uint32:
+		encodeControl(b, typeUint32, 4)
+		encodeUint(b, uint64(v), 4)
+	default:
+		t.Fatalf("cannot encode %T", v)
+	}
+}
+
+func encodeControl(b *bytes.Buffer, typ, size int) {
+	var ctrl byte
+	var ext []byte
+	switch {
+	case size < 29:
+		ctrl = byte(size)
+	case size < 285:
+		ctrl, ext = 29, []byte{byte(size - 29)}
+	case size < 65821:
+		ctrl, ext = 30, []byte{byte((size - 285) >> 8), byte(size - 285)}
+	default:
+		size -= 65821
+		ctrl, ext = 31, []byte{byte(size >> 16), byte(size >> 8), byte(size)}
+	}
+	if typ < 8 {
+		b.WriteByte(byte(typ<<5) | ctrl)
+	} else {
+		b.Write([]byte{ctrl, byte(typ - 7)})
+	}
+	b.Write(ext)
+}
+
+func encodeUint(b *bytes.Buffer, v uint64, n int) {
+	for i := n - 1; i >= 0; i-- {
+		b.WriteByte(byte(v >> (8 * i)))
+	}
+}
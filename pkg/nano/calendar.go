@@ -0,0 +1,288 @@
+package nano
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+	_ "time/tzdata" // Ensure LoadLocation works without a system time zone database.
+)
+
+// A calendar unit is one of the units of time accepted by TruncUnit, AddUnits,
+// and DiffUnits.  Units of a day or longer follow the calendar of a time zone
+// while shorter units are fixed durations.
+type calendarUnit int
+
+const (
+	unitNanosecond calendarUnit = iota
+	unitMicrosecond
+	unitMillisecond
+	unitSecond
+	unitMinute
+	unitHour
+	unitDay
+	unitWeek
+	unitMonth
+	unitQuarter
+	unitYear
+)
+
+func parseUnit(s string) (calendarUnit, error) {
+	switch strings.TrimSuffix(strings.ToLower(s), "s") {
+	case "nanosecond":
+		return unitNanosecond, nil
+	case "microsecond":
+		return unitMicrosecond, nil
+	case "millisecond":
+		return unitMillisecond, nil
+	case "second":
+		return unitSecond, nil
+	case "minute":
+		return unitMinute, nil
+	case "hour":
+		return unitHour, nil
+	case "day":
+		return unitDay, nil
+	case "week":
+		return unitWeek, nil
+	case "month":
+		return unitMonth, nil
+	case "quarter":
+		return unitQuarter, nil
+	case "year":
+		return unitYear, nil
+	}
+	return 0, fmt.Errorf("unknown unit %q", s)
+}
+
+func (u calendarUnit) duration() Duration {
+	switch u {
+	case unitNanosecond:
+		return Nanosecond
+	case unitMicrosecond:
+		return Microsecond
+	case unitMillisecond:
+		return Millisecond
+	case unitSecond:
+		return Second
+	case unitMinute:
+		return Minute
+	case unitHour:
+		return Hour
+	}
+	panic(fmt.Sprintf("calendar unit %d has no fixed duration", u))
+}
+
+var locations sync.Map
+
+// LoadLocation is like time.LoadLocation but caches its result.
+func LoadLocation(name string) (*time.Location, error) {
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone %q", name)
+	}
+	locations.Store(name, loc)
+	return loc, nil
+}
+
+// TruncUnit returns t truncated to the start of the calendar unit that
+// contains it in time zone loc.  Weeks begin on Monday.
+func (t Ts) TruncUnit(unit string, loc *time.Location) (Ts, error) {
+	u, err := parseUnit(unit)
+	if err != nil {
+		return 0, err
+	}
+	return TimeToTs(truncTime(t.Time().In(loc), u)), nil
+}
+
+func truncTime(t time.Time, u calendarUnit) time.Time {
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	nsec := t.Nanosecond()
+	switch u {
+	case unitNanosecond:
+	case unitMicrosecond, unitMillisecond:
+		nsec -= nsec % int(u.duration())
+	case unitSecond:
+		nsec = 0
+	case unitMinute:
+		sec, nsec = 0, 0
+	case unitHour:
+		min, sec, nsec = 0, 0, 0
+	case unitDay, unitWeek, unitMonth, unitQuarter, unitYear:
+		hour, min, sec, nsec = 0, 0, 0, 0
+		switch u {
+		case unitWeek:
+			// Go numbers the weekdays from Sunday but weeks begin on Monday.
+			day -= (int(t.Weekday()) + 6) % 7
+		case unitMonth:
+			day = 1
+		case unitQuarter:
+			month -= (month - 1) % 3
+			day = 1
+		case unitYear:
+			month, day = time.January, 1
+		}
+	}
+	return time.Date(year, month, day, hour, min, sec, nsec, t.Location())
+}
+
+var errTimeOutOfRange = errors.New("time out of range")
+
+// maxCalendarUnits bounds the number of units of a day or longer that
+// AddUnits adds.  It exceeds the number of days spanned by a Ts.
+const maxCalendarUnits = 1 << 20
+
+// Bounds of the times representable by a Ts
+var (
+	minTime = time.Unix(0, math.MinInt64)
+	maxTime = time.Unix(0, math.MaxInt64)
+)
+
+// AddUnits returns t plus n calendar units in time zone loc.  When adding
+// months, quarters, or years would yield a day past the end of a month, the
+// result is the last day of that month.  An error is returned if the result
+// cannot be represented by a Ts.
+func (t Ts) AddUnits(unit string, n int64, loc *time.Location) (Ts, error) {
+	u, err := parseUnit(unit)
+	if err != nil {
+		return 0, err
+	}
+	if u < unitDay {
+		d := int64(u.duration())
+		if n > math.MaxInt64/d || n < math.MinInt64/d {
+			return 0, errTimeOutOfRange
+		}
+		d *= n
+		if d > 0 && int64(t) > math.MaxInt64-d || d < 0 && int64(t) < math.MinInt64-d {
+			return 0, errTimeOutOfRange
+		}
+		return t + Ts(d), nil
+	}
+	if n > maxCalendarUnits || n < -maxCalendarUnits {
+		return 0, errTimeOutOfRange
+	}
+	tm := t.Time().In(loc)
+	switch u {
+	case unitDay:
+		tm = tm.AddDate(0, 0, int(n))
+	case unitWeek:
+		tm = tm.AddDate(0, 0, 7*int(n))
+	default:
+		months := int(n)
+		switch u {
+		case unitQuarter:
+			months *= 3
+		case unitYear:
+			months *= 12
+		}
+		year, month, day := tm.Date()
+		months += year*12 + int(month) - 1
+		year, month = months/12, time.Month(months%12+1)
+		if months < 0 && months%12 != 0 {
+			year, month = year-1, month+12
+		}
+		day = min(day, daysIn(year, month))
+		hour, min, sec := tm.Clock()
+		tm = time.Date(year, month, day, hour, min, sec, tm.Nanosecond(), loc)
+	}
+	if tm.Before(minTime) || tm.After(maxTime) {
+		return 0, errTimeOutOfRange
+	}
+	return TimeToTs(tm), nil
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// DiffUnits returns the number of calendar unit boundaries in time zone loc
+// crossed going from start to end.  The result is negative if end precedes
+// start.
+func DiffUnits(unit string, start, end Ts, loc *time.Location) (int64, error) {
+	u, err := parseUnit(unit)
+	if err != nil {
+		return 0, err
+	}
+	s, e := start.Time().In(loc), end.Time().In(loc)
+	switch u {
+	case unitDay:
+		return civilDay(e) - civilDay(s), nil
+	case unitWeek:
+		return (civilDay(truncTime(e, u)) - civilDay(truncTime(s, u))) / 7, nil
+	case unitMonth, unitQuarter, unitYear:
+		return monthIndex(e, u) - monthIndex(s, u), nil
+	}
+	d := u.duration()
+	return int64(TimeToTs(truncTime(e, u)).SubTs(TimeToTs(truncTime(s, u))) / d), nil
+}
+
+// civilDay returns the number of days from the Unix epoch to the date of t.
+func civilDay(t time.Time) int64 {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / 86400
+}
+
+// monthIndex returns the number of months, quarters, or years from year zero
+// to the date of t.
+func monthIndex(t time.Time, u calendarUnit) int64 {
+	year, month := int64(t.Year()), int64(t.Month()-1)
+	switch u {
+	case unitQuarter:
+		return year*4 + month/3
+	case unitYear:
+		return year
+	}
+	return year*12 + month
+}
+
+// Part returns the named component of t in time zone loc.  The components
+// are year, quarter, month, week (the ISO 8601 week number), day, hour,
+// minute, second, millisecond, microsecond, and nanosecond (each of the last
+// three counting from the start of the current second), dow (the day of the
+// week from Sunday as 0), doy (the day of the year from 1), isoyear (the
+// ISO 8601 week-numbering year), and epoch (the number of seconds since the
+// Unix epoch).
+func (t Ts) Part(part string, loc *time.Location) (int64, error) {
+	tm := t.Time().In(loc)
+	switch strings.ToLower(part) {
+	case "year":
+		return int64(tm.Year()), nil
+	case "quarter":
+		return int64(tm.Month()-1)/3 + 1, nil
+	case "month":
+		return int64(tm.Month()), nil
+	case "week":
+		_, week := tm.ISOWeek()
+		return int64(week), nil
+	case "day":
+		return int64(tm.Day()), nil
+	case "hour":
+		return int64(tm.Hour()), nil
+	case "minute":
+		return int64(tm.Minute()), nil
+	case "second":
+		return int64(tm.Second()), nil
+	case "millisecond":
+		return int64(tm.Nanosecond()) / int64(Millisecond), nil
+	case "microsecond":
+		return int64(tm.Nanosecond()) / int64(Microsecond), nil
+	case "nanosecond":
+		return int64(tm.Nanosecond()), nil
+	case "dow", "dayofweek":
+		return int64(tm.Weekday()), nil
+	case "doy", "dayofyear":
+		return int64(tm.YearDay()), nil
+	case "isoyear":
+		year, _ := tm.ISOWeek()
+		return int64(year), nil
+	case "epoch":
+		return tm.Unix(), nil
+	}
+	return 0, fmt.Errorf("unknown part %q", part)
+}
@@ -0,0 +1,158 @@
+package nano_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parseTs(t *testing.T, s string) nano.Ts {
+	tm, err := time.Parse(time.RFC3339Nano, s)
+	require.NoError(t, err)
+	return nano.TimeToTs(tm)
+}
+
+func TestTruncUnit(t *testing.T) {
+	t.Parallel()
+	ny, err := nano.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	cases := []struct {
+		unit     string
+		in       string
+		loc      *time.Location
+		expected string
+	}{
+		{"millisecond", "2024-05-15T10:20:30.123456789Z", time.UTC, "2024-05-15T10:20:30.123Z"},
+		{"minute", "2024-05-15T10:20:30Z", time.UTC, "2024-05-15T10:20:00Z"},
+		{"day", "2024-05-15T10:20:30Z", time.UTC, "2024-05-15T00:00:00Z"},
+		{"week", "2024-05-15T10:20:30Z", time.UTC, "2024-05-13T00:00:00Z"},
+		{"week", "2024-05-19T10:20:30Z", time.UTC, "2024-05-13T00:00:00Z"},
+		{"month", "2024-05-15T10:20:30Z", time.UTC, "2024-05-01T00:00:00Z"},
+		{"quarter", "2024-05-15T10:20:30Z", time.UTC, "2024-04-01T00:00:00Z"},
+		{"years", "2024-05-15T10:20:30Z", time.UTC, "2024-01-01T00:00:00Z"},
+		{"day", "2024-05-15T02:00:00Z", ny, "2024-05-14T04:00:00Z"},
+		{"month", "2024-03-15T12:00:00Z", ny, "2024-03-01T05:00:00Z"},
+	}
+	for _, c := range cases {
+		ts, err := parseTs(t, c.in).TruncUnit(c.unit, c.loc)
+		require.NoError(t, err)
+		assert.Equal(t, c.expected, ts.Time().Format(time.RFC3339Nano), "%s of %s", c.unit, c.in)
+	}
+	_, err = nano.Ts(0).TruncUnit("fortnight", time.UTC)
+	assert.EqualError(t, err, `unknown unit "fortnight"`)
+}
+
+func TestAddUnits(t *testing.T) {
+	t.Parallel()
+	ny, err := nano.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	cases := []struct {
+		unit     string
+		n        int64
+		in       string
+		loc      *time.Location
+		expected string
+	}{
+		{"hour", 25, "2024-05-15T10:00:00Z", time.UTC, "2024-05-16T11:00:00Z"},
+		{"month", 1, "2024-01-31T10:00:00Z", time.UTC, "2024-02-29T10:00:00Z"},
+		{"month", -13, "2024-01-15T10:00:00Z", time.UTC, "2022-12-15T10:00:00Z"},
+		{"quarter", 1, "2024-11-30T00:00:00Z", time.UTC, "2025-02-28T00:00:00Z"},
+		{"year", 1, "2024-02-29T00:00:00Z", time.UTC, "2025-02-28T00:00:00Z"},
+		{"week", 2, "2024-05-15T10:00:00Z", time.UTC, "2024-05-29T10:00:00Z"},
+		// Across the start of daylight saving time, a day is 23 hours.
+		{"day", 1, "2024-03-09T17:00:00Z", ny, "2024-03-10T16:00:00Z"},
+		{"hour", 24, "2024-03-09T17:00:00Z", ny, "2024-03-10T17:00:00Z"},
+	}
+	for _, c := range cases {
+		ts, err := parseTs(t, c.in).AddUnits(c.unit, c.n, c.loc)
+		require.NoError(t, err)
+		assert.Equal(t, c.expected, ts.Time().Format(time.RFC3339Nano), "%d %s plus %s", c.n, c.unit, c.in)
+	}
+}
+
+func TestAddUnitsOutOfRange(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		unit string
+		n    int64
+		in   string
+	}{
+		{"year", 300, "2024-01-01T00:00:00Z"},
+		{"year", -400, "2024-01-01T00:00:00Z"},
+		{"month", math.MaxInt64, "2024-01-01T00:00:00Z"},
+		{"day", math.MinInt64, "2024-01-01T00:00:00Z"},
+		{"week", 20000, "2024-01-01T00:00:00Z"},
+		{"hour", math.MaxInt64, "2024-01-01T00:00:00Z"},
+		{"second", 1 << 33, "2024-01-01T00:00:00Z"},
+		{"nanosecond", math.MaxInt64, "2024-01-01T00:00:00Z"},
+		{"nanosecond", math.MinInt64, "1969-12-31T00:00:00Z"},
+	}
+	for _, c := range cases {
+		_, err := parseTs(t, c.in).AddUnits(c.unit, c.n, time.UTC)
+		assert.EqualError(t, err, "time out of range", "%d %s plus %s", c.n, c.unit, c.in)
+	}
+	// The extremes of the range are representable.
+	ts, err := nano.Ts(math.MaxInt64-1).AddUnits("nanosecond", 1, time.UTC)
+	require.NoError(t, err)
+	assert.Equal(t, nano.Ts(math.MaxInt64), ts)
+	ts, err = nano.Ts(math.MinInt64+1).AddUnits("nanosecond", -1, time.UTC)
+	require.NoError(t, err)
+	assert.Equal(t, nano.Ts(math.MinInt64), ts)
+}
+
+func TestDiffUnits(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		unit     string
+		start    string
+		end      string
+		expected int64
+	}{
+		{"second", "2024-05-15T10:00:00.9Z", "2024-05-15T10:00:01.1Z", 1},
+		{"hour", "2024-05-15T10:59:00Z", "2024-05-15T08:00:00Z", -2},
+		{"day", "2024-05-15T23:59:00Z", "2024-05-16T00:01:00Z", 1},
+		{"week", "2024-05-19T00:00:00Z", "2024-05-20T00:00:00Z", 1},
+		{"month", "2024-01-31T00:00:00Z", "2024-02-01T00:00:00Z", 1},
+		{"quarter", "2023-12-31T00:00:00Z", "2024-05-01T00:00:00Z", 2},
+		{"year", "2024-12-31T00:00:00Z", "2020-01-01T00:00:00Z", -4},
+	}
+	for _, c := range cases {
+		n, err := nano.DiffUnits(c.unit, parseTs(t, c.start), parseTs(t, c.end), time.UTC)
+		require.NoError(t, err)
+		assert.Equal(t, c.expected, n, "%s from %s to %s", c.unit, c.start, c.end)
+	}
+}
+
+func TestPart(t *testing.T) {
+	t.Parallel()
+	ts := parseTs(t, "2021-01-03T04:05:06.789Z")
+	cases := []struct {
+		part     string
+		expected int64
+	}{
+		{"year", 2021},
+		{"quarter", 1},
+		{"month", 1},
+		{"week", 53},
+		{"isoyear", 2020},
+		{"day", 3},
+		{"dow", 0},
+		{"doy", 3},
+		{"hour", 4},
+		{"minute", 5},
+		{"second", 6},
+		{"millisecond", 789},
+		{"epoch", 1609646706},
+	}
+	for _, c := range cases {
+		n, err := ts.Part(c.part, time.UTC)
+		require.NoError(t, err)
+		assert.Equal(t, c.expected, n, c.part)
+	}
+	_, err := ts.Part("century", time.UTC)
+	assert.EqualError(t, err, `unknown part "century"`)
+}
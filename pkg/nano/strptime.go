@@ -0,0 +1,377 @@
+package nano
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var errStrptimeMismatch = errors.New("value does not match format")
+
+// Strptime parses s according to the strftime-style format and returns the
+// time it represents.  Fields missing from the format default to those of
+// 1970-01-01T00:00:00 and times without a %z, %Z, or %s directive are
+// interpreted in time zone loc.  Whitespace in format matches any amount of
+// whitespace, including none, in s.
+//
+// The supported directives are %a, %A, %b, %B, and %h (day and month names,
+// which are matched but otherwise ignored for days), %d and %e (day of month),
+// %D (%m/%d/%y), %f (fractional seconds), %F (%Y-%m-%d), %H and %I (hour),
+// %j (day of year), %m (month), %M (minute), %n and %t (whitespace), %p (AM or
+// PM), %R (%H:%M), %s (seconds since the Unix epoch), %S (seconds, with an
+// optional fraction), %T (%H:%M:%S), %y (two-digit year, with 69-99 in the
+// 1900s), %Y (year), %z (numeric time zone offset), %Z (time zone name), and
+// %% (a literal percent sign).  %S consumes a fraction only when it is not
+// followed in format by a literal '.' or ',', so %S.%f also parses
+// fractional seconds.
+func Strptime(format, s string, loc *time.Location) (Ts, error) {
+	p := strptime{s: s, year: 1970, month: 1, day: 1, loc: loc}
+	if err := p.parse(format, ""); err != nil {
+		return 0, err
+	}
+	if strings.TrimSpace(p.s) != "" {
+		return 0, fmt.Errorf("extra text %q after value", p.s)
+	}
+	if p.epoch != nil {
+		return Ts(*p.epoch*int64(Second) + int64(p.nsec)), nil
+	}
+	if p.pm != nil {
+		if p.hour < 1 || p.hour > 12 {
+			return 0, errors.New("hour out of range for 12-hour clock")
+		}
+		p.hour %= 12
+		if *p.pm {
+			p.hour += 12
+		}
+	}
+	if p.yday > 0 {
+		t := time.Date(p.year, time.January, p.yday, p.hour, p.min, p.sec, p.nsec, p.loc)
+		if t.Year() != p.year {
+			return 0, errors.New("day of year out of range")
+		}
+		return TimeToTs(t), nil
+	}
+	if p.day > daysIn(p.year, time.Month(p.month)) {
+		return 0, errors.New("day out of range")
+	}
+	return TimeToTs(time.Date(p.year, time.Month(p.month), p.day, p.hour, p.min, p.sec, p.nsec, p.loc)), nil
+}
+
+type strptime struct {
+	s     string
+	year  int
+	month int
+	day   int
+	yday  int
+	hour  int
+	min   int
+	sec   int
+	nsec  int
+	pm    *bool
+	epoch *int64
+	loc   *time.Location
+}
+
+// parse matches s against format.  rest is the format that follows format
+// when format is the expansion of a directive like %T.
+func (p *strptime) parse(format, rest string) error {
+	for len(format) > 0 {
+		c := format[0]
+		format = format[1:]
+		if isSpace(c) {
+			p.skipSpace()
+			continue
+		}
+		if c != '%' {
+			if len(p.s) == 0 || p.s[0] != c {
+				return errStrptimeMismatch
+			}
+			p.s = p.s[1:]
+			continue
+		}
+		if len(format) == 0 {
+			return errors.New("format ends with %")
+		}
+		c = format[0]
+		format = format[1:]
+		next := format
+		if next == "" {
+			next = rest
+		}
+		if err := p.directive(c, next); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// directive parses the value of directive c.  next is the remainder of the
+// format.
+func (p *strptime) directive(c byte, next string) error {
+	var err error
+	switch c {
+	case 'a', 'A':
+		_, err = p.name(longDayNames[:])
+	case 'b', 'B', 'h':
+		var m int
+		m, err = p.name(longMonthNames[:])
+		p.month = m + 1
+	case 'd', 'e':
+		p.skipSpace()
+		p.day, err = p.number(2, 1, 31)
+	case 'D':
+		err = p.parse("%m/%d/%y", next)
+	case 'f':
+		p.nsec, err = p.fraction()
+	case 'F':
+		err = p.parse("%Y-%m-%d", next)
+	case 'H':
+		p.hour, err = p.number(2, 0, 23)
+	case 'I':
+		p.hour, err = p.number(2, 1, 12)
+	case 'j':
+		p.yday, err = p.number(3, 1, 366)
+	case 'm':
+		p.month, err = p.number(2, 1, 12)
+	case 'M':
+		p.min, err = p.number(2, 0, 59)
+	case 'n', 't':
+		p.skipSpace()
+	case 'p':
+		var i int
+		i, err = p.name([]string{"AM", "PM"})
+		pm := i == 1
+		p.pm = &pm
+	case 'R':
+		err = p.parse("%H:%M", next)
+	case 's':
+		var n int64
+		n, err = p.integer()
+		p.epoch = &n
+	case 'S':
+		p.sec, err = p.number(2, 0, 60)
+		if err == nil && !hasFractionSeparator(next) && hasFractionSeparator(p.s) && len(p.s) > 1 && isDigit(p.s[1]) {
+			p.s = p.s[1:]
+			p.nsec, err = p.fraction()
+		}
+	case 'T':
+		err = p.parse("%H:%M:%S", next)
+	case 'y':
+		p.year, err = p.number(2, 0, 99)
+		if p.year < 69 {
+			p.year += 2000
+		} else {
+			p.year += 1900
+		}
+	case 'Y':
+		sign := 1
+		if len(p.s) > 0 && (p.s[0] == '-' || p.s[0] == '+') {
+			if p.s[0] == '-' {
+				sign = -1
+			}
+			p.s = p.s[1:]
+		}
+		p.year, err = p.number(4, 0, 9999)
+		p.year *= sign
+	case 'z':
+		err = p.offset()
+	case 'Z':
+		err = p.zone()
+	case '%':
+		if len(p.s) == 0 || p.s[0] != '%' {
+			return errStrptimeMismatch
+		}
+		p.s = p.s[1:]
+	default:
+		return fmt.Errorf("unsupported directive %%%c", c)
+	}
+	return err
+}
+
+func (p *strptime) skipSpace() {
+	p.s = strings.TrimLeft(p.s, " \t\n\r\v\f")
+}
+
+// number parses an unsigned decimal number of at most width digits in the
+// range [min, max].
+func (p *strptime) number(width, min, max int) (int, error) {
+	var n, i int
+	for ; i < width && i < len(p.s) && isDigit(p.s[i]); i++ {
+		n = n*10 + int(p.s[i]-'0')
+	}
+	if i == 0 {
+		return 0, errStrptimeMismatch
+	}
+	if n < min || n > max {
+		return 0, fmt.Errorf("value %q out of range", p.s[:i])
+	}
+	p.s = p.s[i:]
+	return n, nil
+}
+
+// integer parses an optionally signed decimal integer.
+func (p *strptime) integer() (int64, error) {
+	var neg bool
+	if len(p.s) > 0 && (p.s[0] == '-' || p.s[0] == '+') {
+		neg = p.s[0] == '-'
+		p.s = p.s[1:]
+	}
+	var n int64
+	var i int
+	for ; i < len(p.s) && isDigit(p.s[i]); i++ {
+		if n > (1<<63-1)/10 {
+			return 0, errors.New("integer out of range")
+		}
+		n = n*10 + int64(p.s[i]-'0')
+	}
+	if i == 0 {
+		return 0, errStrptimeMismatch
+	}
+	p.s = p.s[i:]
+	if neg {
+		n = -n
+	}
+	return n, nil
+}
+
+// fraction parses the digits of a decimal fraction and returns it in
+// nanoseconds.  Digits beyond nanosecond precision are ignored.
+func (p *strptime) fraction() (int, error) {
+	var n, i int
+	scale := int(Second)
+	for ; i < len(p.s) && isDigit(p.s[i]); i++ {
+		if scale > 1 {
+			scale /= 10
+			n += int(p.s[i]-'0') * scale
+		}
+	}
+	if i == 0 {
+		return 0, errStrptimeMismatch
+	}
+	p.s = p.s[i:]
+	return n, nil
+}
+
+// name matches the longest of names or its three-letter abbreviation,
+// ignoring case, and returns its index.
+func (p *strptime) name(names []string) (int, error) {
+	for i, name := range names {
+		if hasPrefixFold(p.s, name) {
+			p.s = p.s[len(name):]
+			return i, nil
+		}
+	}
+	for i, name := range names {
+		if len(name) > 3 && hasPrefixFold(p.s, name[:3]) {
+			p.s = p.s[3:]
+			return i, nil
+		}
+	}
+	return 0, errStrptimeMismatch
+}
+
+// offset parses a time zone offset of the form Z, ±hh, ±hhmm, or ±hh:mm.
+func (p *strptime) offset() error {
+	if len(p.s) > 0 && (p.s[0] == 'Z' || p.s[0] == 'z') {
+		p.s = p.s[1:]
+		p.loc = time.UTC
+		return nil
+	}
+	if len(p.s) == 0 || (p.s[0] != '+' && p.s[0] != '-') {
+		return errStrptimeMismatch
+	}
+	sign := 1
+	if p.s[0] == '-' {
+		sign = -1
+	}
+	p.s = p.s[1:]
+	if len(p.s) < 2 {
+		return errStrptimeMismatch
+	}
+	hours, err := p.number(2, 0, 23)
+	if err != nil {
+		return err
+	}
+	var minutes int
+	if len(p.s) > 0 && p.s[0] == ':' {
+		p.s = p.s[1:]
+		if minutes, err = p.number(2, 0, 59); err != nil {
+			return err
+		}
+	} else if len(p.s) > 1 && isDigit(p.s[0]) && isDigit(p.s[1]) {
+		if minutes, err = p.number(2, 0, 59); err != nil {
+			return err
+		}
+	}
+	p.loc = time.FixedZone("", sign*(hours*3600+minutes*60))
+	return nil
+}
+
+// zone parses UTC, GMT, Z, or an IANA time zone name such as
+// America/New_York.
+func (p *strptime) zone() error {
+	i := strings.IndexFunc(p.s, func(r rune) bool {
+		return !(r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '/' || r == '_' || r == '-' || r == '+')
+	})
+	if i < 0 {
+		i = len(p.s)
+	}
+	if i == 0 {
+		return errStrptimeMismatch
+	}
+	name := p.s[:i]
+	switch strings.ToUpper(name) {
+	case "UTC", "GMT", "Z":
+		p.loc = time.UTC
+	default:
+		loc, err := LoadLocation(name)
+		if err != nil {
+			return err
+		}
+		p.loc = loc
+	}
+	p.s = p.s[i:]
+	return nil
+}
+
+func hasFractionSeparator(s string) bool {
+	return len(s) > 0 && (s[0] == '.' || s[0] == ',')
+}
+
+func hasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+}
+
+var longDayNames = [...]string{
+	"Sunday",
+	"Monday",
+	"Tuesday",
+	"Wednesday",
+	"Thursday",
+	"Friday",
+	"Saturday",
+}
+
+var longMonthNames = [...]string{
+	"January",
+	"February",
+	"March",
+	"April",
+	"May",
+	"June",
+	"July",
+	"August",
+	"September",
+	"October",
+	"November",
+	"December",
+}
@@ -0,0 +1,65 @@
+package nano_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrptime(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		format   string
+		in       string
+		expected string
+	}{
+		{"%Y-%m-%d", "2024-02-29", "2024-02-29T00:00:00Z"},
+		{"%F %T", "2024-03-01 12:34:56", "2024-03-01T12:34:56Z"},
+		{"%Y-%m-%dT%H:%M:%S%z", "2024-03-01T12:34:56.25-05:00", "2024-03-01T17:34:56.25Z"},
+		{"%d/%b/%Y:%H:%M:%S %z", "10/Oct/2000:13:55:36 -0700", "2000-10-10T20:55:36Z"},
+		{"%a, %d %B %Y %I:%M %p", "Mon, 4 March 2024 12:05 am", "2024-03-04T00:05:00Z"},
+		{"%m/%d/%y %I%p", "07/04/99 3PM", "1999-07-04T15:00:00Z"},
+		{"%Y %j", "2024 366", "2024-12-31T00:00:00Z"},
+		{"%s.%f", "1700000000.5", "2023-11-14T22:13:20.5Z"},
+		{"%H:%M:%S.%f", "03:04:05.5", "1970-01-01T03:04:05.5Z"},
+		{"%T,%f", "03:04:05,25", "1970-01-01T03:04:05.25Z"},
+		{"%Y-%m-%d %H:%M %Z", "2024-07-01 09:00 America/New_York", "2024-07-01T13:00:00Z"},
+		{"%Y%%%m", "2024%05", "2024-05-01T00:00:00Z"},
+	}
+	for _, c := range cases {
+		ts, err := nano.Strptime(c.format, c.in, time.UTC)
+		require.NoError(t, err, "format %q, input %q", c.format, c.in)
+		assert.Equal(t, c.expected, ts.Time().Format(time.RFC3339Nano), "format %q, input %q", c.format, c.in)
+	}
+}
+
+func TestStrptimeLocation(t *testing.T) {
+	t.Parallel()
+	loc, err := nano.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	ts, err := nano.Strptime("%F %T", "2024-01-01 09:00:00", loc)
+	require.NoError(t, err)
+	assert.Equal(t, "2024-01-01T00:00:00Z", ts.Time().Format(time.RFC3339))
+}
+
+func TestStrptimeError(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		format string
+		in     string
+	}{
+		{"%Y-%m-%d", "2023-02-29"},
+		{"%Y-%m-%d", "2023-13-01"},
+		{"%Y-%m-%d", "2023-01-01 junk"},
+		{"%H:%M", "12"},
+		{"%Q", "x"},
+		{"%Z", "Not/AZone"},
+	}
+	for _, c := range cases {
+		_, err := nano.Strptime(c.format, c.in, time.UTC)
+		assert.Error(t, err, "format %q, input %q", c.format, c.in)
+	}
+}
@@ -0,0 +1,195 @@
+// Package tdigest implements the merging t-digest of Ted Dunning and Otmar
+// Ertl, a compact sketch of a distribution that yields accurate estimates
+// of its quantiles, especially near the tails.  Digests built from disjoint
+// sets of values may be merged to estimate the quantiles of their union.
+//
+// See https://arxiv.org/abs/1902.04023.
+package tdigest
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"slices"
+)
+
+// DefaultCompression bounds a digest to roughly 100 centroids and yields
+// quantile estimates with errors well under 1% of the distribution.
+const DefaultCompression = 100
+
+type centroid struct {
+	mean   float64
+	weight float64
+}
+
+type Digest struct {
+	compression float64
+	// centroids is sorted by mean and compressed.
+	centroids []centroid
+	// unmerged holds values and centroids added since the last compress.
+	unmerged []centroid
+	weight   float64
+	min      float64
+	max      float64
+}
+
+func New(compression float64) *Digest {
+	return &Digest{
+		compression: compression,
+		min:         math.Inf(1),
+		max:         math.Inf(-1),
+	}
+}
+
+// Count returns the number of values added to d.
+func (d *Digest) Count() float64 {
+	return d.weight
+}
+
+func (d *Digest) Add(x float64) {
+	if math.IsNaN(x) {
+		return
+	}
+	d.add(centroid{x, 1})
+}
+
+// Merge adds the values summarized by other to d.
+func (d *Digest) Merge(other *Digest) {
+	for _, c := range other.centroids {
+		d.add(c)
+	}
+	for _, c := range other.unmerged {
+		d.add(c)
+	}
+}
+
+func (d *Digest) add(c centroid) {
+	d.unmerged = append(d.unmerged, c)
+	d.weight += c.weight
+	d.min = min(d.min, c.mean)
+	d.max = max(d.max, c.mean)
+	if len(d.unmerged) > 8*int(d.compression) {
+		d.compress()
+	}
+}
+
+// compress merges the unmerged centroids into the sorted centroids so that
+// no centroid spans more than one unit of the k1 scale function.
+func (d *Digest) compress() {
+	if len(d.unmerged) == 0 {
+		return
+	}
+	all := append(d.centroids, d.unmerged...)
+	slices.SortFunc(all, func(a, b centroid) int {
+		switch {
+		case a.mean < b.mean:
+			return -1
+		case a.mean > b.mean:
+			return 1
+		}
+		return 0
+	})
+	out := all[:1]
+	var sofar float64
+	klimit := d.scale(0) + 1
+	for _, c := range all[1:] {
+		cur := &out[len(out)-1]
+		if d.scale((sofar+cur.weight+c.weight)/d.weight) <= klimit {
+			cur.weight += c.weight
+			cur.mean += (c.mean - cur.mean) * c.weight / cur.weight
+			continue
+		}
+		sofar += cur.weight
+		klimit = d.scale(sofar/d.weight) + 1
+		out = append(out, c)
+	}
+	d.centroids = out
+	d.unmerged = d.unmerged[:0]
+}
+
+// scale is the k1 scale function, which makes centroids near the tails of
+// the distribution smaller than those near the median.
+func (d *Digest) scale(q float64) float64 {
+	return d.compression / (2 * math.Pi) * math.Asin(2*min(q, 1)-1)
+}
+
+// Quantile returns an estimate of the value below which the fraction q of
+// the values added to d fall.  It returns NaN if d is empty.
+func (d *Digest) Quantile(q float64) float64 {
+	d.compress()
+	n := len(d.centroids)
+	switch {
+	case n == 0:
+		return math.NaN()
+	case q <= 0:
+		return d.min
+	case q >= 1:
+		return d.max
+	}
+	// Each centroid's mean is taken to lie at the midpoint of its weight
+	// and the quantile is interpolated between adjacent midpoints, or
+	// between a midpoint and the minimum or maximum at the tails.
+	target := q * d.weight
+	first := d.centroids[0]
+	if target < first.weight/2 {
+		return d.min + (first.mean-d.min)*target/(first.weight/2)
+	}
+	var sofar float64
+	for i := 0; i < n-1; i++ {
+		c, next := d.centroids[i], d.centroids[i+1]
+		left := sofar + c.weight/2
+		right := sofar + c.weight + next.weight/2
+		if target < right {
+			return c.mean + (next.mean-c.mean)*(target-left)/(right-left)
+		}
+		sofar += c.weight
+	}
+	last := d.centroids[n-1]
+	left := d.weight - last.weight/2
+	return last.mean + (d.max-last.mean)*(target-left)/(last.weight/2)
+}
+
+const version = 1
+
+// MarshalBinary encodes d as a version byte followed by little-endian
+// float64s for the compression, minimum, maximum, and the mean and weight
+// of each centroid.
+func (d *Digest) MarshalBinary() ([]byte, error) {
+	d.compress()
+	b := make([]byte, 1, 1+8*(3+2*len(d.centroids)))
+	b[0] = version
+	b = binary.LittleEndian.AppendUint64(b, math.Float64bits(d.compression))
+	b = binary.LittleEndian.AppendUint64(b, math.Float64bits(d.min))
+	b = binary.LittleEndian.AppendUint64(b, math.Float64bits(d.max))
+	for _, c := range d.centroids {
+		b = binary.LittleEndian.AppendUint64(b, math.Float64bits(c.mean))
+		b = binary.LittleEndian.AppendUint64(b, math.Float64bits(c.weight))
+	}
+	return b, nil
+}
+
+func (d *Digest) UnmarshalBinary(b []byte) error {
+	if len(b) < 1+8*3 || (len(b)-1)%16 != 8 {
+		return errors.New("tdigest: bad encoding length")
+	}
+	if b[0] != version {
+		return errors.New("tdigest: unknown encoding version")
+	}
+	float := func() float64 {
+		f := math.Float64frombits(binary.LittleEndian.Uint64(b))
+		b = b[8:]
+		return f
+	}
+	b = b[1:]
+	*d = Digest{
+		compression: float(),
+		min:         float(),
+		max:         float(),
+	}
+	for len(b) > 0 {
+		c := centroid{mean: float(), weight: float()}
+		d.centroids = append(d.centroids, c)
+		d.weight += c.weight
+	}
+	return nil
+}
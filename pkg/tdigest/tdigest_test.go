@@ -0,0 +1,54 @@
+package tdigest
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuantile(t *testing.T) {
+	const n = 100000
+	d := New(DefaultCompression)
+	for _, i := range rand.New(rand.NewSource(1)).Perm(n) {
+		d.Add(float64(i))
+	}
+	require.Equal(t, float64(n), d.Count())
+	require.Equal(t, 0.0, d.Quantile(0))
+	require.Equal(t, float64(n-1), d.Quantile(1))
+	for _, q := range []float64{0.001, 0.01, 0.25, 0.5, 0.75, 0.99, 0.999} {
+		require.InDelta(t, q*n, d.Quantile(q), 0.005*n, "q=%g", q)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	const n = 100000
+	var digests []*Digest
+	for i := range 10 {
+		d := New(DefaultCompression)
+		for j := i; j < n; j += 10 {
+			d.Add(float64(j))
+		}
+		b, err := d.MarshalBinary()
+		require.NoError(t, err)
+		var out Digest
+		require.NoError(t, out.UnmarshalBinary(b))
+		digests = append(digests, &out)
+	}
+	d := New(DefaultCompression)
+	for _, other := range digests {
+		d.Merge(other)
+	}
+	require.Equal(t, float64(n), d.Count())
+	for _, q := range []float64{0.01, 0.5, 0.99} {
+		require.InDelta(t, q*n, d.Quantile(q), 0.005*n, "q=%g", q)
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	require.True(t, math.IsNaN(New(DefaultCompression).Quantile(0.5)))
+	d := New(DefaultCompression)
+	d.Add(42)
+	require.Equal(t, 42.0, d.Quantile(0.5))
+}
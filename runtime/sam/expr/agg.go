@@ -11,8 +11,8 @@ type Aggregator struct {
 	where   Evaluator
 }
 
-func NewAggregator(op string, expr Evaluator, where Evaluator) (*Aggregator, error) {
-	pattern, err := agg.NewPattern(op, expr != nil)
+func NewAggregator(op string, expr Evaluator, where Evaluator, mem *agg.QuantileMemory, params ...zed.Value) (*Aggregator, error) {
+	pattern, err := agg.NewPattern(op, expr != nil, mem, params...)
 	if err != nil {
 		return nil, err
 	}
@@ -70,6 +70,9 @@ func (s *AggregatorExpr) Eval(ectx Context, val zed.Value) zed.Value {
 }
 
 func (s *AggregatorExpr) Reset() {
+	if s.fn != nil {
+		agg.Cleanup(s.fn)
+	}
 	s.fn = nil
 }
 
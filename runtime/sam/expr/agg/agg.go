@@ -1,10 +1,13 @@
 package agg
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/pkg/anymath"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+	"github.com/brimdata/super/zson"
 )
 
 // MaxValueSize limits the size of a value produced by an aggregate function
@@ -16,6 +19,9 @@ var MaxValueSize = 1024 * 1024 * 1024
 // an instance is created by simply invoking the pattern funtion.
 type Pattern func() Function
 
+// ErrUnknown is returned by NewPattern for an unknown aggregate function.
+var ErrUnknown = errors.New("unknown aggregation function")
+
 type Function interface {
 	Consume(zed.Value)
 	ConsumeAsPartial(zed.Value)
@@ -23,9 +29,48 @@ type Function interface {
 	ResultAsPartial(*zed.Context) zed.Value
 }
 
-func NewPattern(op string, hasarg bool) (Pattern, error) {
+// A Cleaner is a Function that holds resources, such as spill files, that
+// must be released by calling Cleanup once its results are no longer needed.
+type Cleaner interface {
+	Cleanup()
+}
+
+// Cleanup releases the resources held by f if it is a Cleaner.
+func Cleanup(f Function) {
+	if c, ok := f.(Cleaner); ok {
+		c.Cleanup()
+	}
+}
+
+// NumArgs returns the number of arguments of aggregate function op that are
+// evaluated for each input value.  The arguments of functions with two
+// arguments are passed to Function.Consume as a record with fields "y" and
+// "x" in that order.
+func NumArgs(op string) int {
+	switch op {
+	case "covar", "covar_pop", "corr", "regr_slope", "regr_intercept":
+		return 2
+	}
+	return 1
+}
+
+// NumParams returns the number of constant parameters that follow the
+// arguments of aggregate function op.
+func NumParams(op string) int {
+	switch op {
+	case "quantile", "approx_quantile":
+		return 1
+	}
+	return 0
+}
+
+// NewPattern returns a pattern for aggregate function op.  mem is the memory
+// budget of quantile functions, which do not spill if it is nil.  params
+// holds the values of the function's constant parameters, if any.
+func NewPattern(op string, hasarg bool, mem *QuantileMemory, params ...zed.Value) (Pattern, error) {
 	needarg := true
 	var pattern Pattern
+	var q float64 // Set from params below.
 	switch op {
 	case "count":
 		needarg = false
@@ -73,6 +118,54 @@ func NewPattern(op string, hasarg bool) (Pattern, error) {
 		pattern = func() Function {
 			return &Collect{}
 		}
+	case "var":
+		pattern = func() Function {
+			return newVariance(false, false)
+		}
+	case "var_pop":
+		pattern = func() Function {
+			return newVariance(true, false)
+		}
+	case "stddev":
+		pattern = func() Function {
+			return newVariance(false, true)
+		}
+	case "stddev_pop":
+		pattern = func() Function {
+			return newVariance(true, true)
+		}
+	case "covar":
+		pattern = func() Function {
+			return newBivariate(covarSamp)
+		}
+	case "covar_pop":
+		pattern = func() Function {
+			return newBivariate(covarPop)
+		}
+	case "corr":
+		pattern = func() Function {
+			return newBivariate(corr)
+		}
+	case "regr_slope":
+		pattern = func() Function {
+			return newBivariate(regrSlope)
+		}
+	case "regr_intercept":
+		pattern = func() Function {
+			return newBivariate(regrIntercept)
+		}
+	case "median":
+		pattern = func() Function {
+			return newQuantile(0.5, mem)
+		}
+	case "quantile":
+		pattern = func() Function {
+			return newQuantile(q, mem)
+		}
+	case "approx_quantile":
+		pattern = func() Function {
+			return newApproxQuantile(q)
+		}
 	case "and":
 		pattern = func() Function {
 			return &And{}
@@ -82,10 +175,30 @@ func NewPattern(op string, hasarg bool) (Pattern, error) {
 			return &Or{}
 		}
 	default:
-		return nil, fmt.Errorf("unknown aggregation function: %s", op)
+		return nil, fmt.Errorf("%w: %s", ErrUnknown, op)
 	}
 	if needarg && !hasarg {
 		return nil, fmt.Errorf("%s: argument required", op)
 	}
+	if n := NumParams(op); len(params) != n {
+		if n == 0 {
+			return nil, fmt.Errorf("%s: too many arguments", op)
+		}
+		return nil, fmt.Errorf("%s: %d arguments required", op, n+1)
+	}
+	if len(params) > 0 {
+		var err error
+		if q, err = quantileParam(op, params[0]); err != nil {
+			return nil, err
+		}
+	}
 	return pattern, nil
 }
+
+func quantileParam(op string, val zed.Value) (float64, error) {
+	q, ok := coerce.ToFloat(val)
+	if !ok || val.IsNull() || !(q >= 0 && q <= 1) {
+		return 0, fmt.Errorf("%s: quantile must be a number between 0 and 1: %s", op, zson.FormatValue(val))
+	}
+	return q, nil
+}
@@ -0,0 +1,345 @@
+package agg
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sync/atomic"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/tdigest"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+	"github.com/brimdata/super/zcode"
+	"github.com/brimdata/super/zio"
+	"github.com/brimdata/super/zson"
+)
+
+// QuantileMemMaxValues is the number of values that the quantile functions
+// of a query together hold in memory.  Beyond this, a function holding at
+// least its share of them spills its values as a sorted run, and the runs are
+// merged when the result is computed.
+var QuantileMemMaxValues = 16 * 1024 * 1024
+
+// quantileMinRun is the smallest run that a quantile function spills so that
+// many small groups do not each create spill files.
+const quantileMinRun = 64 * 1024
+
+// A Spiller is an external merge sort of values in ascending order.  It is
+// implemented by spill.MergeSort.
+type Spiller interface {
+	Spill(ctx context.Context, vals []zed.Value) error
+	SpillSorted(ctx context.Context, zr zio.Reader) error
+	Read() (*zed.Value, error)
+	Cleanup()
+}
+
+// QuantileMemory is the memory budget shared by the quantile functions of a
+// query.  It is safe for concurrent use.
+type QuantileMemory struct {
+	maxValues  int
+	newSpiller func() (Spiller, error)
+	// values is the number of values held in memory by the functions and
+	// funcs is the number of functions holding them.
+	values atomic.Int64
+	funcs  atomic.Int64
+}
+
+// NewQuantileMemory returns a QuantileMemory that lets quantile functions
+// hold maxValues values in memory before they spill them to a Spiller
+// returned by newSpiller.
+func NewQuantileMemory(maxValues int, newSpiller func() (Spiller, error)) *QuantileMemory {
+	return &QuantileMemory{maxValues: maxValues, newSpiller: newSpiller}
+}
+
+// add accounts for n more values held by a function that was already holding
+// held values and reports whether the function should spill.
+func (m *QuantileMemory) add(n, held int) bool {
+	if m == nil {
+		return false
+	}
+	if held == 0 {
+		m.funcs.Add(1)
+	}
+	if m.values.Add(int64(n)) <= int64(m.maxValues) {
+		return false
+	}
+	share := m.maxValues / int(max(m.funcs.Load(), 1))
+	return held+n >= max(share, min(quantileMinRun, m.maxValues))
+}
+
+// release accounts for a function no longer holding n values.
+func (m *QuantileMemory) release(n int) {
+	if m != nil && n > 0 {
+		m.values.Add(-int64(n))
+		m.funcs.Add(-1)
+	}
+}
+
+// Quantile computes an exact quantile of its numeric input values by
+// linearly interpolating between the two closest ranks.  If a partial result
+// is a t-digest because its values would exceed MaxValueSize, the quantile
+// is instead estimated from the merged t-digests.
+type Quantile struct {
+	q       float64
+	mem     *QuantileMemory
+	values  []float64
+	spiller Spiller
+	count   int
+	digest  *tdigest.Digest
+	err     error
+}
+
+var _ Function = (*Quantile)(nil)
+var _ Cleaner = (*Quantile)(nil)
+
+func newQuantile(q float64, mem *QuantileMemory) *Quantile {
+	return &Quantile{q: q, mem: mem}
+}
+
+func (q *Quantile) Consume(val zed.Value) {
+	if val.IsNull() {
+		return
+	}
+	if f, ok := coerce.ToFloat(val); ok && !math.IsNaN(f) {
+		q.add(f)
+	}
+}
+
+func (q *Quantile) add(f float64) {
+	if q.digest != nil {
+		q.digest.Add(f)
+		return
+	}
+	spill := q.mem.add(1, len(q.values))
+	q.values = append(q.values, f)
+	q.count++
+	if spill && q.err == nil {
+		q.err = q.spill()
+	}
+}
+
+// release drops the values held in memory.
+func (q *Quantile) release() {
+	q.mem.release(len(q.values))
+	q.values = nil
+}
+
+// spill writes the values held in memory as a sorted run.
+func (q *Quantile) spill() error {
+	if q.mem == nil || q.mem.newSpiller == nil {
+		return nil
+	}
+	if q.spiller == nil {
+		var err error
+		if q.spiller, err = q.mem.newSpiller(); err != nil {
+			return err
+		}
+	}
+	vals := make([]zed.Value, 0, len(q.values))
+	for _, v := range q.values {
+		vals = append(vals, zed.NewFloat64(v))
+	}
+	q.release()
+	return q.spiller.Spill(context.Background(), vals)
+}
+
+func (q *Quantile) Result(zctx *zed.Context) zed.Value {
+	if q.err != nil {
+		return zctx.NewErrorf("quantile: %s", q.err)
+	}
+	if q.digest != nil {
+		if q.digest.Count() == 0 {
+			return zed.NullFloat64
+		}
+		return zed.NewFloat64(q.digest.Quantile(q.q))
+	}
+	if q.count == 0 {
+		return zed.NullFloat64
+	}
+	pos := q.q * float64(q.count-1)
+	lo := int(math.Floor(pos))
+	hi := int(math.Ceil(pos))
+	var vlo, vhi float64
+	var k int
+	err := q.scan(func(v float64) {
+		if k == lo {
+			vlo = v
+		}
+		if k == hi {
+			vhi = v
+		}
+		k++
+	})
+	if err != nil {
+		return zctx.NewErrorf("quantile: %s", err)
+	}
+	return zed.NewFloat64(vlo + (vhi-vlo)*(pos-float64(lo)))
+}
+
+// scan calls yield with each value in ascending order.  Since reading the
+// spilled runs consumes them, they are merged into a single new run as they
+// are read so that q may be scanned again.
+func (q *Quantile) scan(yield func(float64)) error {
+	if q.err != nil {
+		return q.err
+	}
+	if q.spiller == nil {
+		slices.Sort(q.values)
+		for _, v := range q.values {
+			yield(v)
+		}
+		return nil
+	}
+	if len(q.values) > 0 {
+		if q.err = q.spill(); q.err != nil {
+			return q.err
+		}
+	}
+	merged, err := q.mem.newSpiller()
+	if err != nil {
+		return err
+	}
+	q.err = merged.SpillSorted(context.Background(), &yieldReader{q.spiller, yield})
+	q.spiller.Cleanup()
+	q.spiller = merged
+	return q.err
+}
+
+// yieldReader is a zio.Reader that passes each float64 it reads to yield.
+type yieldReader struct {
+	zio.Reader
+	yield func(float64)
+}
+
+func (y *yieldReader) Read() (*zed.Value, error) {
+	val, err := y.Reader.Read()
+	if val != nil {
+		y.yield(val.Float())
+	}
+	return val, err
+}
+
+// Cleanup removes any runs spilled by q.
+func (q *Quantile) Cleanup() {
+	q.release()
+	if q.spiller != nil {
+		q.spiller.Cleanup()
+		q.spiller = nil
+	}
+}
+
+func (q *Quantile) ConsumeAsPartial(partial zed.Value) {
+	if partial.Type() == zed.TypeBytes {
+		var d tdigest.Digest
+		if err := d.UnmarshalBinary(partial.Bytes()); err != nil {
+			panic(fmt.Errorf("quantile: unmarshaling partial: %w", err))
+		}
+		q.estimate()
+		q.digest.Merge(&d)
+		return
+	}
+	typ, ok := partial.Type().(*zed.TypeArray)
+	if !ok || typ.Type != zed.TypeFloat64 {
+		panic(fmt.Errorf("quantile: partial has bad type: %s", zson.FormatValue(partial)))
+	}
+	for it := partial.Iter(); !it.Done(); {
+		q.add(zed.DecodeFloat64(it.Next()))
+	}
+}
+
+// estimate switches q to estimating the quantile with a t-digest.
+func (q *Quantile) estimate() {
+	if q.digest != nil {
+		return
+	}
+	d := tdigest.New(tdigest.DefaultCompression)
+	if q.count > 0 {
+		q.err = q.scan(d.Add)
+	}
+	q.Cleanup()
+	q.digest = d
+}
+
+// encodedFloat64Size is the size of a float64 in an array.
+const encodedFloat64Size = 9
+
+func (q *Quantile) ResultAsPartial(zctx *zed.Context) zed.Value {
+	if q.err != nil {
+		return zctx.NewErrorf("quantile: %s", q.err)
+	}
+	if q.digest == nil && q.count*encodedFloat64Size <= MaxValueSize {
+		var b zcode.Builder
+		err := q.scan(func(v float64) {
+			b.Append(zed.EncodeFloat64(v))
+		})
+		if err != nil {
+			return zctx.NewErrorf("quantile: %s", err)
+		}
+		return zed.NewValue(zctx.LookupTypeArray(zed.TypeFloat64), b.Bytes())
+	}
+	// The values would exceed MaxValueSize so send a t-digest.
+	d := q.digest
+	if d == nil {
+		d = tdigest.New(tdigest.DefaultCompression)
+		if err := q.scan(d.Add); err != nil {
+			return zctx.NewErrorf("quantile: %s", err)
+		}
+	}
+	b, err := d.MarshalBinary()
+	if err != nil {
+		return zctx.NewErrorf("quantile: marshaling partial: %s", err)
+	}
+	return zed.NewBytes(b)
+}
+
+// ApproxQuantile estimates a quantile of its numeric input values with a
+// t-digest.
+type ApproxQuantile struct {
+	q      float64
+	digest *tdigest.Digest
+}
+
+var _ Function = (*ApproxQuantile)(nil)
+
+func newApproxQuantile(q float64) *ApproxQuantile {
+	return &ApproxQuantile{
+		q:      q,
+		digest: tdigest.New(tdigest.DefaultCompression),
+	}
+}
+
+func (a *ApproxQuantile) Consume(val zed.Value) {
+	if val.IsNull() {
+		return
+	}
+	if f, ok := coerce.ToFloat(val); ok && !math.IsNaN(f) {
+		a.digest.Add(f)
+	}
+}
+
+func (a *ApproxQuantile) Result(*zed.Context) zed.Value {
+	if a.digest.Count() == 0 {
+		return zed.NullFloat64
+	}
+	return zed.NewFloat64(a.digest.Quantile(a.q))
+}
+
+func (a *ApproxQuantile) ConsumeAsPartial(partial zed.Value) {
+	if partial.Type() != zed.TypeBytes {
+		panic(fmt.Errorf("approx_quantile: partial has bad type: %s", zson.FormatValue(partial)))
+	}
+	var d tdigest.Digest
+	if err := d.UnmarshalBinary(partial.Bytes()); err != nil {
+		panic(fmt.Errorf("approx_quantile: unmarshaling partial: %w", err))
+	}
+	a.digest.Merge(&d)
+}
+
+func (a *ApproxQuantile) ResultAsPartial(zctx *zed.Context) zed.Value {
+	b, err := a.digest.MarshalBinary()
+	if err != nil {
+		return zctx.NewErrorf("approx_quantile: marshaling partial: %s", err)
+	}
+	return zed.NewBytes(b)
+}
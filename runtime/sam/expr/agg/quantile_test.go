@@ -0,0 +1,84 @@
+package agg_test
+
+import (
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr/agg"
+	"github.com/brimdata/super/runtime/sam/op/spill"
+	"github.com/stretchr/testify/require"
+)
+
+func newQuantile(t *testing.T, q float64, mem *agg.QuantileMemory) agg.Function {
+	pattern, err := agg.NewPattern("quantile", true, mem, zed.NewFloat64(q))
+	require.NoError(t, err)
+	return pattern()
+}
+
+func newQuantileMemory(maxValues int) *agg.QuantileMemory {
+	return agg.NewQuantileMemory(maxValues, func() (agg.Spiller, error) {
+		return spill.NewValueSort()
+	})
+}
+
+func TestQuantileSpill(t *testing.T) {
+	tmp := t.TempDir()
+	t.Setenv("TMPDIR", tmp)
+	mem := newQuantileMemory(100)
+	q := newQuantile(t, 0.25, mem)
+	for _, i := range rand.New(rand.NewSource(1)).Perm(1001) {
+		q.Consume(zed.NewInt64(int64(i)))
+	}
+	entries, err := os.ReadDir(tmp)
+	require.NoError(t, err)
+	require.NotEmpty(t, entries)
+	zctx := zed.NewContext()
+	require.Equal(t, zed.NewFloat64(250), q.Result(zctx))
+	// Result may be called again.
+	require.Equal(t, zed.NewFloat64(250), q.Result(zctx))
+	partial := newQuantile(t, 0.5, mem)
+	partial.ConsumeAsPartial(q.ResultAsPartial(zctx))
+	require.Equal(t, zed.NewFloat64(500), partial.Result(zctx))
+	agg.Cleanup(q)
+	agg.Cleanup(partial)
+	entries, err = os.ReadDir(tmp)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestQuantileDigestPartial(t *testing.T) {
+	saved := agg.MaxValueSize
+	agg.MaxValueSize = 100
+	t.Cleanup(func() { agg.MaxValueSize = saved })
+	q := newQuantile(t, 0.5, nil)
+	for i := range 1001 {
+		q.Consume(zed.NewInt64(int64(i)))
+	}
+	zctx := zed.NewContext()
+	partial := q.ResultAsPartial(zctx)
+	require.Equal(t, zed.TypeBytes, partial.Type())
+	merged := newQuantile(t, 0.5, nil)
+	merged.ConsumeAsPartial(partial)
+	require.InDelta(t, 500, merged.Result(zctx).Float(), 5)
+	agg.Cleanup(q)
+	agg.Cleanup(merged)
+}
+
+func TestQuantileMemoryPerQuery(t *testing.T) {
+	tmp := t.TempDir()
+	t.Setenv("TMPDIR", tmp)
+	// A query near its budget does not make another query spill.
+	q1 := newQuantile(t, 0.5, newQuantileMemory(100))
+	q2 := newQuantile(t, 0.5, newQuantileMemory(100))
+	for i := range 100 {
+		q1.Consume(zed.NewInt64(int64(i)))
+		q2.Consume(zed.NewInt64(int64(i)))
+	}
+	entries, err := os.ReadDir(tmp)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+	agg.Cleanup(q1)
+	agg.Cleanup(q2)
+}
@@ -0,0 +1,258 @@
+package agg
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+	"github.com/brimdata/super/zcode"
+	"github.com/brimdata/super/zson"
+)
+
+// moments accumulates the count, mean, and sum of squared deviations from
+// the mean of a sequence of values using Welford's algorithm, which avoids
+// the catastrophic cancellation of the textbook formula.
+type moments struct {
+	count float64
+	mean  float64
+	m2    float64
+}
+
+func (m *moments) add(x float64) {
+	m.count++
+	delta := x - m.mean
+	m.mean += delta / m.count
+	m.m2 += delta * (x - m.mean)
+}
+
+// merge combines the moments of two disjoint sequences using the method of
+// Chan, Golub, and LeVeque.
+func (m *moments) merge(other moments) {
+	if other.count == 0 {
+		return
+	}
+	count := m.count + other.count
+	delta := other.mean - m.mean
+	m.m2 += other.m2 + delta*delta*m.count*other.count/count
+	m.mean += delta * other.count / count
+	m.count = count
+}
+
+// Variance computes the sample or population variance or standard deviation
+// of its numeric input values.
+type Variance struct {
+	moments
+	pop    bool
+	stddev bool
+}
+
+var _ Function = (*Variance)(nil)
+
+func newVariance(pop, stddev bool) *Variance {
+	return &Variance{pop: pop, stddev: stddev}
+}
+
+func (v *Variance) Consume(val zed.Value) {
+	if val.IsNull() {
+		return
+	}
+	if x, ok := coerce.ToFloat(val); ok {
+		v.add(x)
+	}
+}
+
+func (v *Variance) Result(*zed.Context) zed.Value {
+	n := v.count
+	if !v.pop {
+		n--
+	}
+	if n <= 0 {
+		return zed.NullFloat64
+	}
+	result := v.m2 / n
+	if v.stddev {
+		result = math.Sqrt(result)
+	}
+	return zed.NewFloat64(result)
+}
+
+const (
+	meanName = "mean"
+	m2Name   = "m2"
+)
+
+func (v *Variance) ConsumeAsPartial(partial zed.Value) {
+	v.merge(moments{
+		count: float64(partialUint64(partial, "variance", countName)),
+		mean:  partialFloat64(partial, "variance", meanName),
+		m2:    partialFloat64(partial, "variance", m2Name),
+	})
+}
+
+func (v *Variance) ResultAsPartial(zctx *zed.Context) zed.Value {
+	var zv zcode.Bytes
+	zv = zed.NewUint64(uint64(v.count)).Encode(zv)
+	zv = zed.NewFloat64(v.mean).Encode(zv)
+	zv = zed.NewFloat64(v.m2).Encode(zv)
+	typ := zctx.MustLookupTypeRecord([]zed.Field{
+		zed.NewField(countName, zed.TypeUint64),
+		zed.NewField(meanName, zed.TypeFloat64),
+		zed.NewField(m2Name, zed.TypeFloat64),
+	})
+	return zed.NewValue(typ, zv)
+}
+
+// Values for Bivariate.op.
+const (
+	covarSamp = iota
+	covarPop
+	corr
+	regrSlope
+	regrIntercept
+)
+
+// Bivariate computes statistics of pairs of numeric values.  Its input is a
+// record with fields "y" and "x", where y is the dependent variable of a
+// regression.  Pairs in which either value is not a number are ignored.
+type Bivariate struct {
+	op  int
+	x   moments
+	y   moments
+	cxy float64 // Sum of products of deviations from the means.
+}
+
+var _ Function = (*Bivariate)(nil)
+
+func newBivariate(op int) *Bivariate {
+	return &Bivariate{op: op}
+}
+
+func (b *Bivariate) Consume(val zed.Value) {
+	x, ok := derefFloat(val, "x")
+	if !ok {
+		return
+	}
+	y, ok := derefFloat(val, "y")
+	if !ok {
+		return
+	}
+	dx := x - b.x.mean
+	b.x.add(x)
+	b.y.add(y)
+	b.cxy += dx * (y - b.y.mean)
+}
+
+func derefFloat(val zed.Value, name string) (float64, bool) {
+	v := val.Deref(name)
+	if v == nil || v.IsNull() {
+		return 0, false
+	}
+	return coerce.ToFloat(*v)
+}
+
+func (b *Bivariate) Result(*zed.Context) zed.Value {
+	n := b.x.count
+	var result float64
+	switch b.op {
+	case covarSamp:
+		if n < 2 {
+			return zed.NullFloat64
+		}
+		result = b.cxy / (n - 1)
+	case covarPop:
+		if n < 1 {
+			return zed.NullFloat64
+		}
+		result = b.cxy / n
+	case corr:
+		if n < 1 || b.x.m2 == 0 || b.y.m2 == 0 {
+			return zed.NullFloat64
+		}
+		result = b.cxy / math.Sqrt(b.x.m2*b.y.m2)
+	case regrSlope:
+		if n < 1 || b.x.m2 == 0 {
+			return zed.NullFloat64
+		}
+		result = b.cxy / b.x.m2
+	case regrIntercept:
+		if n < 1 || b.x.m2 == 0 {
+			return zed.NullFloat64
+		}
+		result = b.y.mean - b.cxy/b.x.m2*b.x.mean
+	default:
+		panic(fmt.Sprintf("unknown bivariate op %d", b.op))
+	}
+	return zed.NewFloat64(result)
+}
+
+const (
+	meanXName = "mean_x"
+	meanYName = "mean_y"
+	m2XName   = "m2_x"
+	m2YName   = "m2_y"
+	cxyName   = "c_xy"
+)
+
+func (b *Bivariate) ConsumeAsPartial(partial zed.Value) {
+	count := float64(partialUint64(partial, "bivariate", countName))
+	x := moments{
+		count: count,
+		mean:  partialFloat64(partial, "bivariate", meanXName),
+		m2:    partialFloat64(partial, "bivariate", m2XName),
+	}
+	y := moments{
+		count: count,
+		mean:  partialFloat64(partial, "bivariate", meanYName),
+		m2:    partialFloat64(partial, "bivariate", m2YName),
+	}
+	cxy := partialFloat64(partial, "bivariate", cxyName)
+	if count == 0 {
+		return
+	}
+	total := b.x.count + count
+	b.cxy += cxy + (x.mean-b.x.mean)*(y.mean-b.y.mean)*b.x.count*count/total
+	b.x.merge(x)
+	b.y.merge(y)
+}
+
+func (b *Bivariate) ResultAsPartial(zctx *zed.Context) zed.Value {
+	var zv zcode.Bytes
+	zv = zed.NewUint64(uint64(b.x.count)).Encode(zv)
+	zv = zed.NewFloat64(b.x.mean).Encode(zv)
+	zv = zed.NewFloat64(b.y.mean).Encode(zv)
+	zv = zed.NewFloat64(b.x.m2).Encode(zv)
+	zv = zed.NewFloat64(b.y.m2).Encode(zv)
+	zv = zed.NewFloat64(b.cxy).Encode(zv)
+	typ := zctx.MustLookupTypeRecord([]zed.Field{
+		zed.NewField(countName, zed.TypeUint64),
+		zed.NewField(meanXName, zed.TypeFloat64),
+		zed.NewField(meanYName, zed.TypeFloat64),
+		zed.NewField(m2XName, zed.TypeFloat64),
+		zed.NewField(m2YName, zed.TypeFloat64),
+		zed.NewField(cxyName, zed.TypeFloat64),
+	})
+	return zed.NewValue(typ, zv)
+}
+
+func partialFloat64(partial zed.Value, op, name string) float64 {
+	val := partial.Deref(name)
+	if val == nil {
+		panic(fmt.Errorf("%s: partial %s is missing", op, name))
+	}
+	if val.Type() != zed.TypeFloat64 {
+		panic(fmt.Errorf("%s: partial %s has bad type: %s", op, name, zson.FormatValue(*val)))
+	}
+	return val.Float()
+}
+
+func partialUint64(partial zed.Value, op, name string) uint64 {
+	val := partial.Deref(name)
+	if val == nil {
+		panic(fmt.Errorf("%s: partial %s is missing", op, name))
+	}
+	if val.Type() != zed.TypeUint64 {
+		panic(fmt.Errorf("%s: partial %s has bad type: %s", op, name, zson.FormatValue(*val)))
+	}
+	return val.Uint()
+}
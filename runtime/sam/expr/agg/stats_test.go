@@ -0,0 +1,61 @@
+package agg
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsPartials(t *testing.T) {
+	// Values with a large offset are a classic source of cancellation
+	// error in naive variance formulas.
+	r := rand.New(rand.NewSource(1))
+	xs := make([]float64, 1000)
+	ys := make([]float64, 1000)
+	for i := range xs {
+		xs[i] = 1e9 + r.Float64()
+		ys[i] = 3*xs[i] + r.Float64()
+	}
+	zctx := zed.NewContext()
+	for _, op := range []string{"var", "var_pop", "stddev", "stddev_pop", "covar", "covar_pop", "corr", "regr_slope", "regr_intercept"} {
+		pattern, err := NewPattern(op, true, nil)
+		require.NoError(t, err)
+		whole := pattern()
+		merged := pattern()
+		var part Function
+		for i := range xs {
+			if i%100 == 0 {
+				if part != nil {
+					merged.ConsumeAsPartial(part.ResultAsPartial(zctx))
+				}
+				part = pattern()
+			}
+			val := zed.NewFloat64(xs[i])
+			if NumArgs(op) == 2 {
+				val = pair(zctx, ys[i], xs[i])
+			}
+			whole.Consume(val)
+			part.Consume(val)
+		}
+		merged.ConsumeAsPartial(part.ResultAsPartial(zctx))
+		expected := whole.Result(zctx).Float()
+		require.InEpsilon(t, expected, merged.Result(zctx).Float(), 1e-6, op)
+	}
+	v := newVariance(true, false)
+	for _, x := range xs {
+		v.Consume(zed.NewFloat64(x))
+	}
+	require.InEpsilon(t, 1.0/12, v.Result(zctx).Float(), 0.1)
+}
+
+func pair(zctx *zed.Context, y, x float64) zed.Value {
+	typ := zctx.MustLookupTypeRecord([]zed.Field{
+		zed.NewField("y", zed.TypeFloat64),
+		zed.NewField("x", zed.TypeFloat64),
+	})
+	bytes := zed.NewFloat64(y).Encode(nil)
+	bytes = zed.NewFloat64(x).Encode(bytes)
+	return zed.NewValue(typ, bytes)
+}
@@ -2,9 +2,11 @@ package coerce
 
 import (
 	"bytes"
+	"math"
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/pkg/byteconv"
+	"github.com/brimdata/super/zcode"
 	"github.com/brimdata/super/zson"
 	"golang.org/x/exp/constraints"
 )
@@ -40,6 +42,55 @@ func Equal(a, b zed.Value) bool {
 	}
 }
 
+// AppendKey appends to b a key for val such that the keys of two values
+// from the same type context are equal if the values are equal by Equal.
+// Numbers are encoded by value regardless of type and all nulls have the
+// same key.  A key is self-delimiting so keys of several values may be
+// concatenated.
+func AppendKey(b []byte, val zed.Value) []byte {
+	val = val.Under()
+	if val.IsNull() {
+		b = zcode.AppendCountedUvarint(b, uint64(zed.IDNull))
+		return zcode.Append(b, nil)
+	}
+	id := val.Type().ID()
+	if !zed.IsNumber(id) {
+		b = zcode.AppendCountedUvarint(b, uint64(id))
+		return zcode.Append(b, val.Bytes())
+	}
+	switch {
+	case zed.IsFloat(id):
+		f := val.Float()
+		if f == math.Trunc(f) {
+			if f >= math.MinInt64 && f < math.MaxInt64 {
+				return appendIntKey(b, int64(f))
+			}
+			if f >= 0 && f < math.MaxUint64 {
+				return appendUintKey(b, uint64(f))
+			}
+		}
+		b = zcode.AppendCountedUvarint(b, uint64(zed.IDFloat64))
+		return zcode.Append(b, zed.EncodeFloat64(f))
+	case zed.IsSigned(id):
+		return appendIntKey(b, val.Int())
+	default:
+		return appendUintKey(b, val.Uint())
+	}
+}
+
+func appendIntKey(b []byte, i int64) []byte {
+	b = zcode.AppendCountedUvarint(b, uint64(zed.IDInt64))
+	return zcode.Append(b, zed.EncodeInt(i))
+}
+
+func appendUintKey(b []byte, u uint64) []byte {
+	if u <= math.MaxInt64 {
+		return appendIntKey(b, int64(u))
+	}
+	b = zcode.AppendCountedUvarint(b, uint64(zed.IDUint64))
+	return zcode.Append(b, zed.EncodeUint(u))
+}
+
 func ToNumeric[T constraints.Integer | constraints.Float](val zed.Value) T {
 	if val.IsNull() {
 		return 0
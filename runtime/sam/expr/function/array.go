@@ -0,0 +1,457 @@
+package function
+
+import (
+	"slices"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+	"github.com/brimdata/super/zcode"
+)
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#array_contains
+type ArrayContains struct {
+	zctx *zed.Context
+}
+
+func (a *ArrayContains) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	list := args[0].Under()
+	if !isList(list) {
+		return a.zctx.WrapError("array_contains: array or set required for first arg", args[0])
+	}
+	if list.IsNull() {
+		return zed.NullBool
+	}
+	return zed.NewBool(indexOf(list, args[1]) >= 0)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#array_index_of
+type ArrayIndexOf struct {
+	zctx *zed.Context
+}
+
+func (a *ArrayIndexOf) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	list := args[0].Under()
+	if !isList(list) {
+		return a.zctx.WrapError("array_index_of: array or set required for first arg", args[0])
+	}
+	if list.IsNull() {
+		return zed.NullInt64
+	}
+	return zed.NewInt64(int64(indexOf(list, args[1])))
+}
+
+// indexOf returns the index of the first element of list equal to val or -1
+// if there is no such element.
+func indexOf(list, val zed.Value) int {
+	key := NewElemKey(val)
+	inner := zed.InnerType(list.Type())
+	var i int
+	for it := list.Iter(); !it.Done(); i++ {
+		if NewElemKey(zed.NewValue(inner, it.Next())) == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// ElemKey identifies the elements of arrays and sets that are equal for
+// array_contains, array_distinct, and the set functions.  As with
+// coerce.Equal, numbers are equal if their values are equal regardless of
+// type, nulls are equal, and other values are equal if their types and
+// values are equal.
+type ElemKey string
+
+// NewElemKey returns the ElemKey of val.
+func NewElemKey(val zed.Value) ElemKey {
+	return ElemKey(coerce.AppendKey(nil, val))
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#array_distinct
+type ArrayDistinct struct {
+	zctx    *zed.Context
+	builder zcode.Builder
+	seen    map[ElemKey]struct{}
+}
+
+func (a *ArrayDistinct) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	list := args[0].Under()
+	if !isList(list) {
+		return a.zctx.WrapError("array_distinct: array or set required", args[0])
+	}
+	if list.IsNull() {
+		return args[0]
+	}
+	if a.seen == nil {
+		a.seen = make(map[ElemKey]struct{})
+	}
+	clear(a.seen)
+	a.builder.Reset()
+	inner := zed.InnerType(list.Type())
+	for it := list.Iter(); !it.Done(); {
+		b := it.Next()
+		key := NewElemKey(zed.NewValue(inner, b))
+		if _, ok := a.seen[key]; !ok {
+			a.seen[key] = struct{}{}
+			a.builder.Append(b)
+		}
+	}
+	return newList(args[0].Type(), a.builder.Bytes())
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#array_sort
+type ArraySort struct {
+	zctx    *zed.Context
+	compare expr.CompareFn
+	builder zcode.Builder
+}
+
+func newArraySort(zctx *zed.Context) *ArraySort {
+	return &ArraySort{zctx: zctx, compare: expr.NewValueCompareFn(order.Asc, true)}
+}
+
+func (a *ArraySort) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	list := args[0].Under()
+	if !isList(list) {
+		return a.zctx.WrapError("array_sort: array or set required", args[0])
+	}
+	if list.IsNull() {
+		return args[0]
+	}
+	elems, _ := list.Elements()
+	slices.SortStableFunc(elems, func(x, y zed.Value) int {
+		return a.compare(x.Under(), y.Under())
+	})
+	a.builder.Reset()
+	for _, elem := range elems {
+		a.builder.Append(elem.Bytes())
+	}
+	return newList(args[0].Type(), a.builder.Bytes())
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#array_reverse
+type ArrayReverse struct {
+	zctx    *zed.Context
+	builder zcode.Builder
+}
+
+func (a *ArrayReverse) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	list := args[0].Under()
+	if !isList(list) {
+		return a.zctx.WrapError("array_reverse: array or set required", args[0])
+	}
+	if list.IsNull() {
+		return args[0]
+	}
+	elems, _ := list.Elements()
+	a.builder.Reset()
+	for _, elem := range slices.Backward(elems) {
+		a.builder.Append(elem.Bytes())
+	}
+	return newList(args[0].Type(), a.builder.Bytes())
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#array_slice
+type ArraySlice struct {
+	zctx    *zed.Context
+	builder zcode.Builder
+}
+
+func (a *ArraySlice) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	list := args[0].Under()
+	if !isList(list) {
+		return a.zctx.WrapError("array_slice: array or set required for first arg", args[0])
+	}
+	for _, arg := range args[1:] {
+		if !zed.IsInteger(zed.TypeUnder(arg.Type()).ID()) {
+			return a.zctx.WrapError("array_slice: integer required for index arg", arg)
+		}
+	}
+	if list.IsNull() {
+		return args[0]
+	}
+	elems, _ := list.Elements()
+	from, to := 0, len(elems)
+	if v, ok := coerce.ToInt(args[1]); ok && !args[1].IsNull() {
+		from = SliceIndex(int(v), len(elems))
+	}
+	if len(args) > 2 && !args[2].IsNull() {
+		if v, ok := coerce.ToInt(args[2]); ok {
+			to = SliceIndex(int(v), len(elems))
+		}
+	}
+	a.builder.Reset()
+	for k := from; k < to; k++ {
+		a.builder.Append(elems[k].Bytes())
+	}
+	return newList(args[0].Type(), a.builder.Bytes())
+}
+
+// SliceIndex converts index, which counts from the end of a sequence of
+// length n if negative, to an offset in the sequence.
+func SliceIndex(index, n int) int {
+	if index < 0 {
+		index += n
+	}
+	return max(0, min(index, n))
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#array_concat
+type ArrayConcat struct {
+	zctx    *zed.Context
+	builder zcode.Builder
+	types   []zed.Type
+}
+
+func (a *ArrayConcat) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	a.types = a.types[:0]
+	for _, arg := range args {
+		list := arg.Under()
+		if !isList(list) {
+			return a.zctx.WrapError("array_concat: array or set args required", arg)
+		}
+		a.types = append(a.types, zed.InnerType(list.Type()))
+	}
+	typ := ElemType(a.zctx, a.types)
+	a.builder.Reset()
+	for k, arg := range args {
+		for it := arg.Under().Iter(); !it.Done(); {
+			AppendElem(&a.builder, typ, a.types[k], it.Next())
+		}
+	}
+	return newList(a.zctx.LookupTypeArray(typ), a.builder.Bytes())
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#array_flatten
+type ArrayFlatten struct {
+	zctx    *zed.Context
+	builder zcode.Builder
+}
+
+func (a *ArrayFlatten) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	list := args[0].Under()
+	if !isList(list) {
+		return a.zctx.WrapError("array_flatten: array or set required", args[0])
+	}
+	typ := a.zctx.LookupTypeArray(FlattenType(a.zctx, zed.InnerType(list.Type())))
+	if list.IsNull() {
+		return zed.NewValue(typ, nil)
+	}
+	a.builder.Reset()
+	inner := zed.InnerType(list.Type())
+	for it := list.Iter(); !it.Done(); {
+		elemType, bytes := inner, it.Next()
+		if union, ok := zed.TypeUnder(elemType).(*zed.TypeUnion); ok {
+			elemType, bytes = union.Untag(bytes)
+		}
+		if elemInner := zed.InnerType(zed.TypeUnder(elemType)); elemInner != nil {
+			for it := bytes.Iter(); !it.Done(); {
+				AppendElem(&a.builder, typ.Type, elemInner, it.Next())
+			}
+		} else {
+			AppendElem(&a.builder, typ.Type, elemType, bytes)
+		}
+	}
+	return newList(typ, a.builder.Bytes())
+}
+
+// ElemType returns the element type of a list formed from elements of types,
+// which is the union of types, with union types replaced by their members, or
+// the one type if there is just one.  The null type is omitted unless it is
+// the only type.
+func ElemType(zctx *zed.Context, types []zed.Type) zed.Type {
+	var unique []zed.Type
+	for _, typ := range types {
+		if union, ok := zed.TypeUnder(typ).(*zed.TypeUnion); ok {
+			unique = append(unique, union.Types...)
+		} else if typ != zed.TypeNull {
+			unique = append(unique, typ)
+		}
+	}
+	unique = zed.UniqueTypes(unique)
+	switch len(unique) {
+	case 0:
+		return zed.TypeNull
+	case 1:
+		return unique[0]
+	}
+	return zctx.LookupTypeUnion(unique)
+}
+
+// FlattenType returns the element type of the array formed by array_flatten
+// from a list with element type typ.
+func FlattenType(zctx *zed.Context, typ zed.Type) zed.Type {
+	types := []zed.Type{typ}
+	if union, ok := zed.TypeUnder(typ).(*zed.TypeUnion); ok {
+		types = union.Types
+	}
+	var out []zed.Type
+	for _, typ := range types {
+		if inner := zed.InnerType(zed.TypeUnder(typ)); inner != nil {
+			typ = inner
+		}
+		out = append(out, typ)
+	}
+	return ElemType(zctx, out)
+}
+
+// AppendElem appends bytes, an element of type from, to b as an element of
+// type to, which is either from or a union returned by ElemType whose types
+// include from or, if from is a union, its types.
+func AppendElem(b *zcode.Builder, to, from zed.Type, bytes zcode.Bytes) {
+	if from == to {
+		b.Append(bytes)
+		return
+	}
+	if union, ok := zed.TypeUnder(from).(*zed.TypeUnion); ok {
+		from, bytes = union.Untag(bytes)
+	}
+	if union, ok := to.(*zed.TypeUnion); ok && from != to {
+		zed.BuildUnion(b, union.TagOf(from), bytes)
+		return
+	}
+	b.Append(bytes)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#set_union
+type SetUnion struct {
+	zctx    *zed.Context
+	builder zcode.Builder
+	types   []zed.Type
+	seen    map[ElemKey]struct{}
+}
+
+func newSetUnion(zctx *zed.Context) *SetUnion {
+	return &SetUnion{zctx: zctx, seen: make(map[ElemKey]struct{})}
+}
+
+func (s *SetUnion) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	s.types = s.types[:0]
+	for _, arg := range args {
+		list := arg.Under()
+		if !isList(list) {
+			return s.zctx.WrapError("set_union: array or set args required", arg)
+		}
+		s.types = append(s.types, zed.InnerType(list.Type()))
+	}
+	typ := ElemType(s.zctx, s.types)
+	clear(s.seen)
+	s.builder.Reset()
+	for k, arg := range args {
+		for it := arg.Under().Iter(); !it.Done(); {
+			bytes := it.Next()
+			key := NewElemKey(zed.NewValue(s.types[k], bytes))
+			if _, ok := s.seen[key]; !ok {
+				s.seen[key] = struct{}{}
+				AppendElem(&s.builder, typ, s.types[k], bytes)
+			}
+		}
+	}
+	return newList(s.zctx.LookupTypeSet(typ), s.builder.Bytes())
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#set_intersect
+type SetIntersect struct {
+	zctx       *zed.Context
+	name       string
+	difference bool
+	builder    zcode.Builder
+	elems      map[ElemKey]struct{}
+	seen       map[ElemKey]struct{}
+}
+
+func newSetIntersect(zctx *zed.Context, name string, difference bool) *SetIntersect {
+	return &SetIntersect{
+		zctx:       zctx,
+		name:       name,
+		difference: difference,
+		elems:      make(map[ElemKey]struct{}),
+		seen:       make(map[ElemKey]struct{}),
+	}
+}
+
+func (s *SetIntersect) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	a, b := args[0].Under(), args[1].Under()
+	for _, arg := range []zed.Value{a, b} {
+		if !isList(arg) {
+			return s.zctx.WrapError(s.name+": array or set args required", arg)
+		}
+	}
+	typ := s.zctx.LookupTypeSet(zed.InnerType(a.Type()))
+	if a.IsNull() || b.IsNull() {
+		return zed.NewValue(typ, nil)
+	}
+	clear(s.elems)
+	elems, _ := b.Elements()
+	for _, elem := range elems {
+		s.elems[NewElemKey(elem)] = struct{}{}
+	}
+	clear(s.seen)
+	s.builder.Reset()
+	inner := zed.InnerType(a.Type())
+	for it := a.Iter(); !it.Done(); {
+		bytes := it.Next()
+		key := NewElemKey(zed.NewValue(inner, bytes))
+		if _, ok := s.seen[key]; ok {
+			continue
+		}
+		s.seen[key] = struct{}{}
+		if _, ok := s.elems[key]; ok != s.difference {
+			s.builder.Append(bytes)
+		}
+	}
+	return newList(typ, s.builder.Bytes())
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#zip
+type Zip struct {
+	zctx    *zed.Context
+	builder zcode.Builder
+}
+
+func (z *Zip) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	left, right := args[0].Under(), args[1].Under()
+	for _, arg := range []zed.Value{left, right} {
+		if !isList(arg) {
+			return z.zctx.WrapError("zip: array or set args required", arg)
+		}
+	}
+	recType := z.zctx.MustLookupTypeRecord([]zed.Field{
+		zed.NewField("left", zed.InnerType(left.Type())),
+		zed.NewField("right", zed.InnerType(right.Type())),
+	})
+	typ := z.zctx.LookupTypeArray(recType)
+	if left.IsNull() || right.IsNull() {
+		return zed.NewValue(typ, nil)
+	}
+	z.builder.Reset()
+	for lit, rit := left.Iter(), right.Iter(); !lit.Done() && !rit.Done(); {
+		z.builder.BeginContainer()
+		z.builder.Append(lit.Next())
+		z.builder.Append(rit.Next())
+		z.builder.EndContainer()
+	}
+	return newList(typ, z.builder.Bytes())
+}
+
+// newList returns a value of typ, an array or set type, with the elements in
+// bytes, which are normalized if typ is a set type.
+func newList(typ zed.Type, bytes zcode.Bytes) zed.Value {
+	if bytes == nil {
+		// A nil body is null but we want an empty list.
+		return zed.NewValue(typ, zcode.Bytes{})
+	}
+	if _, ok := zed.TypeUnder(typ).(*zed.TypeSet); ok {
+		bytes = zed.NormalizeSet(bytes)
+	}
+	return zed.NewValue(typ, bytes)
+}
+
+func isList(val zed.Value) bool {
+	switch val.Type().(type) {
+	case *zed.TypeArray, *zed.TypeSet:
+		return true
+	}
+	return false
+}
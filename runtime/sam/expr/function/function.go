@@ -7,7 +7,6 @@ import (
 	"github.com/brimdata/super/pkg/anymath"
 	"github.com/brimdata/super/pkg/field"
 	"github.com/brimdata/super/runtime/sam/expr"
-	"github.com/brimdata/super/zson"
 )
 
 var (
@@ -38,6 +37,31 @@ func New(zctx *zed.Context, name string, narg int) (expr.Function, field.Path, e
 		f = &LenFn{zctx: zctx}
 	case "abs":
 		f = &Abs{zctx: zctx}
+	case "array_concat":
+		argmax = -1
+		f = &ArrayConcat{zctx: zctx}
+	case "array_contains":
+		argmin, argmax = 2, 2
+		f = &ArrayContains{zctx: zctx}
+	case "array_distinct":
+		f = &ArrayDistinct{zctx: zctx}
+	case "array_flatten":
+		f = &ArrayFlatten{zctx: zctx}
+	case "array_index_of":
+		argmin, argmax = 2, 2
+		f = &ArrayIndexOf{zctx: zctx}
+	case "array_reverse":
+		f = &ArrayReverse{zctx: zctx}
+	case "array_slice":
+		argmin, argmax = 2, 3
+		f = &ArraySlice{zctx: zctx}
+	case "array_sort":
+		f = newArraySort(zctx)
+	case "asn":
+		// The optional path argument is a constant that is bound by
+		// NewMMDBFunction when the call is compiled.
+		argmax = 2
+		f = NewASN(zctx, ASNDatabase)
 	case "every":
 		path = field.Path{"ts"}
 		f = &Bucket{
@@ -50,6 +74,9 @@ func New(zctx *zed.Context, name string, narg int) (expr.Function, field.Path, e
 		f = NewFlatten(zctx)
 	case "floor":
 		f = &Floor{zctx: zctx}
+	case "geoip":
+		argmax = 2
+		f = NewGeoIP(zctx, GeoIPDatabase)
 	case "join":
 		argmax = 2
 		f = &Join{zctx: zctx}
@@ -62,6 +89,17 @@ func New(zctx *zed.Context, name string, narg int) (expr.Function, field.Path, e
 		f = &Levenshtein{zctx: zctx}
 	case "log":
 		f = &Log{zctx: zctx}
+	case "map_entries":
+		f = &MapEntries{zctx: zctx}
+	case "map_from_entries":
+		f = NewMapFromEntries(zctx)
+	case "map_keys":
+		f = &MapKeys{zctx: zctx}
+	case "map_merge":
+		argmax = -1
+		f = NewMapMerge(zctx)
+	case "map_values":
+		f = &MapValues{zctx: zctx}
 	case "max":
 		argmax = -1
 		f = &reducer{zctx: zctx, fn: anymath.Max, name: name}
@@ -92,6 +130,15 @@ func New(zctx *zed.Context, name string, narg int) (expr.Function, field.Path, e
 		f = &ToUpper{zctx: zctx}
 	case "trim":
 		f = &Trim{zctx: zctx}
+	case "set_difference":
+		argmin, argmax = 2, 2
+		f = newSetIntersect(zctx, name, true)
+	case "set_intersect":
+		argmin, argmax = 2, 2
+		f = newSetIntersect(zctx, name, false)
+	case "set_union":
+		argmax = -1
+		f = newSetUnion(zctx)
 	case "split":
 		argmin = 2
 		argmax = 2
@@ -123,6 +170,8 @@ func New(zctx *zed.Context, name string, narg int) (expr.Function, field.Path, e
 		f = &Is{zctx: zctx}
 	case "is_error":
 		f = &IsErr{}
+	case "domain_parts":
+		f = newDomainParts(zctx)
 	case "error":
 		f = &Error{zctx: zctx}
 	case "kind":
@@ -131,6 +180,8 @@ func New(zctx *zed.Context, name string, narg int) (expr.Function, field.Path, e
 		f = &Base64{zctx: zctx}
 	case "hex":
 		f = &Hex{zctx: zctx}
+	case "fnv", "md5", "sha1", "sha256", "xxhash":
+		f = newHash(name)
 	case "compare":
 		argmin = 2
 		argmax = 3
@@ -150,9 +201,20 @@ func New(zctx *zed.Context, name string, narg int) (expr.Function, field.Path, e
 		argmin = 0
 		f = NewNestDotted(zctx)
 	case "parse_uri":
-		f = &ParseURI{zctx: zctx, marshaler: zson.NewZNGMarshalerWithContext(zctx)}
+		f = NewParseURI(zctx)
+	case "parse_query":
+		f = NewParseQuery(zctx)
 	case "parse_zson":
-		f = newParseZSON(zctx)
+		f = NewParseZSON(zctx)
+	case "parse_json":
+		f = NewParseJSON(zctx)
+	case "json_extract":
+		argmin, argmax = 2, 2
+		f = NewJSONExtract(zctx)
+	case "to_json":
+		f = NewToJSON(zctx)
+	case "to_zson":
+		f = newToZSON()
 	case "quiet":
 		f = &Quiet{zctx: zctx}
 	case "regexp":
@@ -164,10 +226,32 @@ func New(zctx *zed.Context, name string, narg int) (expr.Function, field.Path, e
 	case "strftime":
 		argmin, argmax = 2, 2
 		f = &Strftime{zctx: zctx}
+	case "strptime":
+		argmin, argmax = 2, 3
+		f = &Strptime{zctx: zctx}
+	case "date_part":
+		argmin, argmax = 2, 3
+		f = &DatePart{zctx: zctx}
+	case "date_trunc":
+		argmin, argmax = 2, 3
+		f = &DateTrunc{zctx: zctx}
+	case "date_add":
+		argmin, argmax = 3, 4
+		f = &DateAdd{zctx: zctx}
+	case "date_diff":
+		argmin, argmax = 3, 4
+		f = &DateDiff{zctx: zctx}
 	case "under":
 		f = &Under{zctx: zctx}
 	case "unflatten":
 		f = NewUnflatten(zctx)
+	case "url_decode":
+		f = &URLDecode{zctx: zctx}
+	case "url_encode":
+		f = &URLEncode{zctx: zctx}
+	case "zip":
+		argmin, argmax = 2, 2
+		f = &Zip{zctx: zctx}
 	}
 	if err := CheckArgCount(narg, argmin, argmax); err != nil {
 		return nil, nil, err
@@ -0,0 +1,236 @@
+package function
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/mmdb"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/zcode"
+)
+
+// GeoIPDatabase and ASNDatabase are the paths of the MaxMind DB files used by
+// the geoip and asn functions when a path argument is not given.
+var (
+	GeoIPDatabase string
+	ASNDatabase   string
+)
+
+// mmdbs holds the databases opened by the geoip and asn functions.  Each
+// database is memory mapped once and shared by all function instances,
+// including those of parallel workers, for the life of the process.  Failed
+// opens are not cached so that a database created or fixed later is used by
+// subsequent queries.
+var mmdbs = struct {
+	sync.Mutex
+	m map[string]*mmdb.Reader
+}{m: make(map[string]*mmdb.Reader)}
+
+// OpenMMDB returns the database at path.
+func OpenMMDB(path string) (*mmdb.Reader, error) {
+	mmdbs.Lock()
+	defer mmdbs.Unlock()
+	if r, ok := mmdbs.m[path]; ok {
+		return r, nil
+	}
+	r, err := mmdb.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	mmdbs.m[path] = r
+	return r, nil
+}
+
+// MMDB is the database of a geoip or asn function instance.  Its path is
+// fixed when the function is compiled and it is opened when first used.
+type MMDB struct {
+	name   string
+	path   string
+	opened bool
+	reader *mmdb.Reader
+	err    error
+}
+
+// NewMMDB returns the database at path for function name.  An empty path
+// means that no database was given.
+func NewMMDB(name, path string) *MMDB {
+	return &MMDB{name: name, path: path}
+}
+
+// Open returns the database, opening it on the first call.
+func (m *MMDB) Open() (*mmdb.Reader, error) {
+	if !m.opened {
+		m.opened = true
+		if m.path == "" {
+			m.err = errors.New("no database (use -" + m.name + "db flag or path arg)")
+		} else {
+			m.reader, m.err = OpenMMDB(m.path)
+		}
+	}
+	return m.reader, m.err
+}
+
+// NewMMDBFunction returns the geoip or asn function that uses the database
+// at path, which is the constant path argument of the call.
+func NewMMDBFunction(zctx *zed.Context, name, path string) expr.Function {
+	if name == "asn" {
+		return NewASN(zctx, path)
+	}
+	return NewGeoIP(zctx, path)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#geoip
+type GeoIP struct {
+	zctx    *zed.Context
+	db      *MMDB
+	typ     *zed.TypeRecord
+	builder zcode.Builder
+}
+
+// NewGeoIP returns a geoip function that uses the database at path.
+func NewGeoIP(zctx *zed.Context, path string) *GeoIP {
+	return &GeoIP{
+		zctx: zctx,
+		db:   NewMMDB("geoip", path),
+		typ: zctx.MustLookupTypeRecord([]zed.Field{
+			zed.NewField("country_code", zed.TypeString),
+			zed.NewField("country", zed.TypeString),
+			zed.NewField("region", zed.TypeString),
+			zed.NewField("city", zed.TypeString),
+			zed.NewField("postal_code", zed.TypeString),
+			zed.NewField("latitude", zed.TypeFloat64),
+			zed.NewField("longitude", zed.TypeFloat64),
+			zed.NewField("time_zone", zed.TypeString),
+		}),
+	}
+}
+
+func (g *GeoIP) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	data, errVal, ok := lookupMMDB(g.zctx, "geoip", g.db, args[0])
+	if !ok {
+		return errVal
+	}
+	return g.Value(data)
+}
+
+// Value returns the record for data, which was returned by a lookup in a
+// database or is nil.
+func (g *GeoIP) Value(data any) zed.Value {
+	if data == nil {
+		return zed.NewValue(g.typ, nil)
+	}
+	b := &g.builder
+	b.Reset()
+	appendMMDBString(b, data, "country", "iso_code")
+	appendMMDBString(b, data, "country", "names", "en")
+	appendMMDBString(b, data, "subdivisions", 0, "names", "en")
+	appendMMDBString(b, data, "city", "names", "en")
+	appendMMDBString(b, data, "postal", "code")
+	appendMMDBFloat(b, data, "location", "latitude")
+	appendMMDBFloat(b, data, "location", "longitude")
+	appendMMDBString(b, data, "location", "time_zone")
+	return zed.NewValue(g.typ, b.Bytes())
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#asn
+type ASN struct {
+	zctx    *zed.Context
+	db      *MMDB
+	typ     *zed.TypeRecord
+	builder zcode.Builder
+}
+
+// NewASN returns an asn function that uses the database at path.
+func NewASN(zctx *zed.Context, path string) *ASN {
+	return &ASN{
+		zctx: zctx,
+		db:   NewMMDB("asn", path),
+		typ: zctx.MustLookupTypeRecord([]zed.Field{
+			zed.NewField("number", zed.TypeUint32),
+			zed.NewField("organization", zed.TypeString),
+		}),
+	}
+}
+
+func (a *ASN) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	data, errVal, ok := lookupMMDB(a.zctx, "asn", a.db, args[0])
+	if !ok {
+		return errVal
+	}
+	return a.Value(data)
+}
+
+// Value returns the record for data, which was returned by a lookup in a
+// database or is nil.
+func (a *ASN) Value(data any) zed.Value {
+	if data == nil {
+		return zed.NewValue(a.typ, nil)
+	}
+	b := &a.builder
+	b.Reset()
+	if n, ok := mmdbPath(data, "autonomous_system_number").(uint32); ok {
+		b.Append(zed.EncodeUint(uint64(n)))
+	} else {
+		b.Append(nil)
+	}
+	appendMMDBString(b, data, "autonomous_system_organization")
+	return zed.NewValue(a.typ, b.Bytes())
+}
+
+// lookupMMDB looks up the IP address in arg in db.  If the lookup succeeds,
+// it returns the decoded data, which is nil if the address is null or not
+// found, and true.  Otherwise, it returns an error value and false.
+func lookupMMDB(zctx *zed.Context, name string, db *MMDB, arg zed.Value) (any, zed.Value, bool) {
+	ip := arg.Under()
+	if ip.Type().ID() != zed.IDIP {
+		return nil, zctx.WrapError(name+": IP arg required", arg), false
+	}
+	r, err := db.Open()
+	if err != nil {
+		return nil, zctx.WrapError(name+": "+err.Error(), arg), false
+	}
+	if ip.IsNull() {
+		return nil, zed.Value{}, true
+	}
+	data, err := r.Lookup(zed.DecodeIP(ip.Bytes()))
+	if err != nil {
+		return nil, zctx.WrapError(name+": "+err.Error(), arg), false
+	}
+	return data, zed.Value{}, true
+}
+
+// mmdbPath returns the value in data at path, whose elements are map keys
+// (strings) or array indexes (ints), or nil if there is no such value.
+func mmdbPath(data any, path ...any) any {
+	for _, p := range path {
+		switch p := p.(type) {
+		case string:
+			m, _ := data.(map[string]any)
+			data = m[p]
+		case int:
+			a, _ := data.([]any)
+			if p >= len(a) {
+				return nil
+			}
+			data = a[p]
+		}
+	}
+	return data
+}
+
+func appendMMDBString(b *zcode.Builder, data any, path ...any) {
+	if s, ok := mmdbPath(data, path...).(string); ok {
+		b.Append(zed.EncodeString(s))
+	} else {
+		b.Append(nil)
+	}
+}
+
+func appendMMDBFloat(b *zcode.Builder, data any, path ...any) {
+	if f, ok := mmdbPath(data, path...).(float64); ok {
+		b.Append(zed.EncodeFloat64(f))
+	} else {
+		b.Append(nil)
+	}
+}
@@ -11,7 +11,7 @@ import (
 type Grok struct {
 	zctx    *zed.Context
 	builder zcode.Builder
-	hosts   map[string]*host
+	hosts   GrokHosts
 	// fields is used as a scratch space to avoid allocating a new slice.
 	fields []zed.Field
 }
@@ -19,7 +19,7 @@ type Grok struct {
 func newGrok(zctx *zed.Context) *Grok {
 	return &Grok{
 		zctx:  zctx,
-		hosts: make(map[string]*host),
+		hosts: make(GrokHosts),
 	}
 }
 
@@ -36,11 +36,11 @@ func (g *Grok) Call(_ zed.Allocator, args []zed.Value) zed.Value {
 	case zed.TypeUnder(inputArg.Type()) != zed.TypeString:
 		return g.error("input argument must be a string", inputArg)
 	}
-	h, err := g.getHost(defArg.AsString())
+	h, err := g.hosts.Lookup(defArg.AsString())
 	if err != nil {
 		return g.error(err.Error(), defArg)
 	}
-	p, err := h.getPattern(patternArg.AsString())
+	p, err := h.Pattern(patternArg.AsString())
 	if err != nil {
 		return g.error(err.Error(), patternArg)
 	}
@@ -64,24 +64,31 @@ func (g *Grok) error(msg string, val zed.Value) zed.Value {
 	return g.zctx.WrapError("grok(): "+msg, val)
 }
 
-func (g *Grok) getHost(defs string) (*host, error) {
-	h, ok := g.hosts[defs]
+// GrokHosts caches grok hosts by the pattern definitions added to them.
+type GrokHosts map[string]*GrokHost
+
+// Lookup returns the host for the pattern definitions in defs, creating it
+// if needed.
+func (g GrokHosts) Lookup(defs string) (*GrokHost, error) {
+	h, ok := g[defs]
 	if !ok {
-		h = &host{Host: grok.NewBase(), patterns: make(map[string]*grok.Pattern)}
+		h = &GrokHost{Host: grok.NewBase(), patterns: make(map[string]*grok.Pattern)}
 		if err := h.AddFromReader(strings.NewReader(defs)); err != nil {
 			return nil, err
 		}
-		g.hosts[defs] = h
+		g[defs] = h
 	}
 	return h, nil
 }
 
-type host struct {
+// GrokHost is a grok.Host that caches its compiled patterns.
+type GrokHost struct {
 	grok.Host
 	patterns map[string]*grok.Pattern
 }
 
-func (h *host) getPattern(patternArg string) (*grok.Pattern, error) {
+// Pattern returns the compiled pattern for patternArg.
+func (h *GrokHost) Pattern(patternArg string) (*grok.Pattern, error) {
 	p, ok := h.patterns[patternArg]
 	if !ok {
 		var err error
@@ -0,0 +1,80 @@
+package function
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"hash"
+	"hash/fnv"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/zcode"
+	"github.com/cespare/xxhash/v2"
+)
+
+// Hash computes a cryptographic digest or a non-cryptographic 64-bit hash of
+// its argument.  The digest of a string or bytes value is that of its
+// contents while the digest of any other value is that of its type and its
+// ZNG encoding, so values of different types hash differently.
+//
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#hash
+type Hash struct {
+	hash    hash.Hash
+	builder zcode.Builder
+}
+
+func newHash(name string) *Hash {
+	return &Hash{hash: NewHasher(name)}
+}
+
+// NewHasher returns the hash.Hash for the hash function name, which must be
+// one of md5, sha1, sha256, fnv, or xxhash.  The hashes returned for fnv and
+// xxhash implement hash.Hash64.
+func NewHasher(name string) hash.Hash {
+	switch name {
+	case "md5":
+		return md5.New()
+	case "sha1":
+		return sha1.New()
+	case "sha256":
+		return sha256.New()
+	case "fnv":
+		return fnv.New64a()
+	case "xxhash":
+		return xxhash.New()
+	}
+	panic("unknown hash function " + name)
+}
+
+func (h *Hash) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	val := args[0]
+	if val.IsError() {
+		return val
+	}
+	h64, ok := h.hash.(hash.Hash64)
+	if val.IsNull() {
+		if ok {
+			return zed.NullUint64
+		}
+		return zed.NullBytes
+	}
+	h.hash.Reset()
+	h.hash.Write(HashInput(&h.builder, val))
+	if ok {
+		return zed.NewUint64(h64.Sum64())
+	}
+	return zed.NewBytes(h.hash.Sum(nil))
+}
+
+// HashInput returns the bytes hashed by the hash functions for val using b
+// as scratch space.
+func HashInput(b *zcode.Builder, val zed.Value) []byte {
+	switch zed.TypeUnder(val.Type()).ID() {
+	case zed.IDString, zed.IDBytes:
+		return val.Bytes()
+	}
+	b.Truncate()
+	b.Append(zed.EncodeTypeValue(val.Type()))
+	b.Append(val.Bytes())
+	return b.Bytes()
+}
@@ -0,0 +1,233 @@
+package function
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/zio"
+	"github.com/brimdata/super/zio/jsonio"
+	"github.com/brimdata/super/zson"
+)
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#json_extract
+type JSONExtract struct {
+	zctx  *zed.Context
+	path  string
+	steps []jsonStep
+	err   error
+	sr    *strings.Reader
+	jr    *jsonio.Reader
+}
+
+func NewJSONExtract(zctx *zed.Context) *JSONExtract {
+	var sr strings.Reader
+	return &JSONExtract{zctx: zctx, sr: &sr, jr: jsonio.NewReader(zctx, &sr)}
+}
+
+func (j *JSONExtract) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	in, pathArg := args[0], args[1]
+	if !in.IsString() {
+		return j.zctx.WrapError("json_extract: string value required for JSON arg", in)
+	}
+	if !pathArg.IsString() || pathArg.IsNull() {
+		return j.zctx.WrapError("json_extract: non-null string value required for path arg", pathArg)
+	}
+	if err := j.SetPath(pathArg.AsString()); err != nil {
+		return j.zctx.WrapError("json_extract: "+err.Error(), pathArg)
+	}
+	if in.IsNull() {
+		return zed.Null
+	}
+	val, err := j.Extract(in.AsString())
+	if err != nil {
+		return j.zctx.WrapError("json_extract: "+err.Error(), in)
+	}
+	return val
+}
+
+// SetPath sets the path of the values returned by Extract.
+func (j *JSONExtract) SetPath(path string) error {
+	if j.steps == nil || j.path != path {
+		j.path = path
+		j.steps, j.err = parseJSONPath(path)
+	}
+	return j.err
+}
+
+// Extract returns the value at the receiver's path in s, which is JSON, or
+// the missing value if there is no such value.
+func (j *JSONExtract) Extract(s string) (zed.Value, error) {
+	raw, err := extractJSON(s, j.steps)
+	if err != nil {
+		return zed.Null, err
+	}
+	if raw == nil {
+		return j.zctx.Missing(), nil
+	}
+	return parseJSON(j.jr, j.sr, string(raw))
+}
+
+// A jsonStep selects the member of an object with a name or, if index is
+// nonnegative, the element of an array with an index.
+type jsonStep struct {
+	name  string
+	index int
+}
+
+// parseJSONPath parses a path of the form $.a.b[0]["c d"], in which the
+// leading $ is optional.
+func parseJSONPath(path string) ([]jsonStep, error) {
+	steps := []jsonStep{}
+	s := strings.TrimPrefix(path, "$")
+	if s != "" && s[0] != '.' && s[0] != '[' {
+		s = "." + s
+	}
+	for s != "" {
+		switch s[0] {
+		case '.':
+			s = s[1:]
+			n := strings.IndexAny(s, ".[")
+			if n < 0 {
+				n = len(s)
+			}
+			if n == 0 {
+				return nil, fmt.Errorf("empty member name in path %q", path)
+			}
+			steps = append(steps, jsonStep{name: s[:n], index: -1})
+			s = s[n:]
+		case '[':
+			n := strings.IndexByte(s, ']')
+			if n < 0 {
+				return nil, fmt.Errorf("unterminated [ in path %q", path)
+			}
+			if s[1] == '"' || s[1] == '\'' {
+				// Find the closing quote, which must precede the closing bracket.
+				n = strings.IndexByte(s[2:], s[1]) + 2
+				if n < 2 || n+1 >= len(s) || s[n+1] != ']' {
+					return nil, fmt.Errorf("bad quoted member name in path %q", path)
+				}
+				steps = append(steps, jsonStep{name: s[2:n], index: -1})
+				s = s[n+2:]
+				continue
+			}
+			index, err := strconv.Atoi(s[1:n])
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("bad array index in path %q", path)
+			}
+			steps = append(steps, jsonStep{index: index})
+			s = s[n+1:]
+		default:
+			return nil, fmt.Errorf("syntax error in path %q", path)
+		}
+	}
+	return steps, nil
+}
+
+// extractJSON returns the JSON text in s at the location described by steps
+// or nil if there is no such location.  Values that are not on the path are
+// scanned but not decoded.
+func extractJSON(s string, steps []jsonStep) (json.RawMessage, error) {
+	dec := json.NewDecoder(strings.NewReader(s))
+	for _, step := range steps {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, jsonError(err)
+		}
+		found, err := findJSONStep(dec, tok, step)
+		if err != nil || !found {
+			return nil, err
+		}
+	}
+	var raw json.RawMessage
+	if err := dec.Decode(&raw); err != nil {
+		return nil, jsonError(err)
+	}
+	return raw, nil
+}
+
+// findJSONStep advances dec to the value selected by step in the object or
+// array that begins with tok and reports whether it was found.
+func findJSONStep(dec *json.Decoder, tok json.Token, step jsonStep) (bool, error) {
+	var skip json.RawMessage
+	switch {
+	case tok == json.Delim('{') && step.index < 0:
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return false, jsonError(err)
+			}
+			if key == step.name {
+				return true, nil
+			}
+			if err := dec.Decode(&skip); err != nil {
+				return false, jsonError(err)
+			}
+		}
+	case tok == json.Delim('[') && step.index >= 0:
+		for i := 0; dec.More(); i++ {
+			if i == step.index {
+				return true, nil
+			}
+			if err := dec.Decode(&skip); err != nil {
+				return false, jsonError(err)
+			}
+		}
+	}
+	return false, nil
+}
+
+func jsonError(err error) error {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return errors.New("unexpected end of JSON input")
+	}
+	return err
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#to_json
+type ToJSON struct {
+	zctx   *zed.Context
+	buf    bytes.Buffer
+	writer *jsonio.Writer
+}
+
+func NewToJSON(zctx *zed.Context) *ToJSON {
+	t := &ToJSON{zctx: zctx}
+	t.writer = jsonio.NewWriter(zio.NopCloser(&t.buf), jsonio.WriterOpts{ColorDisabled: true})
+	return t
+}
+
+func (t *ToJSON) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	s, err := t.Format(args[0])
+	if err != nil {
+		return t.zctx.WrapError("to_json: "+err.Error(), args[0])
+	}
+	return zed.NewString(s)
+}
+
+// Format returns val as JSON.
+func (t *ToJSON) Format(val zed.Value) (string, error) {
+	t.buf.Reset()
+	if err := t.writer.Write(val); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(t.buf.String(), "\n"), nil
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#to_zson
+type ToZSON struct {
+	formatter *zson.Formatter
+}
+
+func newToZSON() *ToZSON {
+	return &ToZSON{zson.NewFormatter(0, true, nil)}
+}
+
+func (t *ToZSON) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	return zed.NewString(t.formatter.FormatRecord(args[0]))
+}
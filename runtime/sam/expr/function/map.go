@@ -0,0 +1,186 @@
+package function
+
+import (
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/zcode"
+)
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#map_keys
+type MapKeys struct {
+	zctx    *zed.Context
+	builder zcode.Builder
+}
+
+func (m *MapKeys) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	val := args[0].Under()
+	typ, ok := val.Type().(*zed.TypeMap)
+	if !ok {
+		return m.zctx.WrapError("map_keys: map arg required", args[0])
+	}
+	out := m.zctx.LookupTypeArray(typ.KeyType)
+	if val.IsNull() {
+		return zed.NewValue(out, nil)
+	}
+	return newList(out, appendMapColumn(&m.builder, val.Bytes(), 0))
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#map_values
+type MapValues struct {
+	zctx    *zed.Context
+	builder zcode.Builder
+}
+
+func (m *MapValues) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	val := args[0].Under()
+	typ, ok := val.Type().(*zed.TypeMap)
+	if !ok {
+		return m.zctx.WrapError("map_values: map arg required", args[0])
+	}
+	out := m.zctx.LookupTypeArray(typ.ValType)
+	if val.IsNull() {
+		return zed.NewValue(out, nil)
+	}
+	return newList(out, appendMapColumn(&m.builder, val.Bytes(), 1))
+}
+
+// appendMapColumn returns the keys (if column is 0) or values (if column is 1)
+// of the map body bytes as an array body.
+func appendMapColumn(b *zcode.Builder, bytes zcode.Bytes, column int) zcode.Bytes {
+	b.Reset()
+	for it, k := bytes.Iter(), 0; !it.Done(); k++ {
+		elem := it.Next()
+		if k%2 == column {
+			b.Append(elem)
+		}
+	}
+	return b.Bytes()
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#map_entries
+type MapEntries struct {
+	zctx    *zed.Context
+	builder zcode.Builder
+}
+
+func (m *MapEntries) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	val := args[0].Under()
+	typ, ok := val.Type().(*zed.TypeMap)
+	if !ok {
+		return m.zctx.WrapError("map_entries: map arg required", args[0])
+	}
+	out := m.zctx.LookupTypeArray(expr.MapEntryType(m.zctx, typ))
+	if val.IsNull() {
+		return zed.NewValue(out, nil)
+	}
+	m.builder.Reset()
+	for it := val.Bytes().Iter(); !it.Done(); {
+		m.builder.BeginContainer()
+		m.builder.Append(it.Next())
+		m.builder.Append(it.Next())
+		m.builder.EndContainer()
+	}
+	return newList(out, m.builder.Bytes())
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#map_from_entries
+type MapFromEntries struct {
+	zctx    *zed.Context
+	builder *expr.MapBuilder
+}
+
+func NewMapFromEntries(zctx *zed.Context) *MapFromEntries {
+	return &MapFromEntries{zctx: zctx, builder: expr.NewMapBuilder(zctx)}
+}
+
+func (m *MapFromEntries) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	list := args[0].Under()
+	if !isList(list) {
+		return m.zctx.WrapError("map_from_entries: array or set arg required", args[0])
+	}
+	return m.Build(zed.InnerType(list.Type()), list.Bytes())
+}
+
+// Build returns the map for the entries in the array or set body bytes,
+// whose elements have type inner.
+func (m *MapFromEntries) Build(inner zed.Type, bytes zcode.Bytes) zed.Value {
+	m.builder.Reset()
+	for it := bytes.Iter(); !it.Done(); {
+		entry := zed.NewValue(inner, it.Next()).Under()
+		if entry.IsNull() {
+			continue
+		}
+		key, ok1 := entryField(entry, "key")
+		val, ok2 := entryField(entry, "value")
+		if !ok1 || !ok2 {
+			return m.zctx.WrapError("map_from_entries: entry must be a record with key and value fields", entry)
+		}
+		m.builder.Append(key, val)
+	}
+	return m.builder.Map()
+}
+
+// entryField returns the value of the named field of record rec.
+func entryField(rec zed.Value, name string) (zed.Value, bool) {
+	i, ok := rec.IndexOfField(name)
+	if !ok {
+		return zed.Null, false
+	}
+	if val := rec.DerefByColumn(i); val != nil {
+		return mapElem(val.Type(), val.Bytes()), true
+	}
+	return zed.NewValue(rec.Fields()[i].Type, nil), true
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#map_merge
+type MapMerge struct {
+	zctx    *zed.Context
+	builder *expr.MapBuilder
+}
+
+func NewMapMerge(zctx *zed.Context) *MapMerge {
+	return &MapMerge{zctx: zctx, builder: expr.NewMapBuilder(zctx)}
+}
+
+func (m *MapMerge) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	m.Reset()
+	for _, arg := range args {
+		val := arg.Under()
+		typ, ok := val.Type().(*zed.TypeMap)
+		if !ok {
+			return m.zctx.WrapError("map_merge: map args required", arg)
+		}
+		m.Add(typ, val.Bytes())
+	}
+	return m.Map()
+}
+
+// Reset prepares the receiver to merge a new sequence of maps.
+func (m *MapMerge) Reset() {
+	m.builder.Reset()
+}
+
+// Add merges the entries of the map body bytes, whose type is typ, replacing
+// any entries with the same keys.  Add retains bytes until the next call to
+// Reset.
+func (m *MapMerge) Add(typ *zed.TypeMap, bytes zcode.Bytes) {
+	for it := bytes.Iter(); !it.Done(); {
+		key := mapElem(typ.KeyType, it.Next())
+		m.builder.Append(key, mapElem(typ.ValType, it.Next()))
+	}
+}
+
+// Map returns the map merged since the last call to Reset.
+func (m *MapMerge) Map() zed.Value {
+	return m.builder.Map()
+}
+
+// mapElem returns a value of type typ with body bytes.  Union values are
+// replaced by their underlying values but other named types are preserved.
+func mapElem(typ zed.Type, bytes zcode.Bytes) zed.Value {
+	val := zed.NewValue(typ, bytes)
+	if _, ok := zed.TypeUnder(typ).(*zed.TypeUnion); ok {
+		return val.Under()
+	}
+	return val
+}
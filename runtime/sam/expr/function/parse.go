@@ -1,11 +1,13 @@
 package function
 
 import (
+	"errors"
 	"net/url"
 	"strconv"
 	"strings"
 
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/zio/jsonio"
 	"github.com/brimdata/super/zio/zsonio"
 	"github.com/brimdata/super/zson"
 )
@@ -16,16 +18,28 @@ type ParseURI struct {
 	marshaler *zson.MarshalZNGContext
 }
 
+func NewParseURI(zctx *zed.Context) *ParseURI {
+	return &ParseURI{zctx: zctx, marshaler: zson.NewZNGMarshalerWithContext(zctx)}
+}
+
 func (p *ParseURI) Call(_ zed.Allocator, args []zed.Value) zed.Value {
 	in := args[0]
 	if !in.IsString() || in.IsNull() {
 		return p.zctx.WrapError("parse_uri: non-empty string arg required", in)
 	}
-	s := zed.DecodeString(in.Bytes())
-	u, err := url.Parse(s)
+	out, err := p.Parse(zed.DecodeString(in.Bytes()))
 	if err != nil {
 		return p.zctx.WrapError("parse_uri: "+err.Error(), in)
 	}
+	return out
+}
+
+// Parse returns the record for the URI in s.
+func (p *ParseURI) Parse(s string) (zed.Value, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return zed.Null, err
+	}
 	var v struct {
 		Scheme   *string    `zed:"scheme"`
 		Opaque   *string    `zed:"opaque"`
@@ -55,7 +69,7 @@ func (p *ParseURI) Call(_ zed.Allocator, args []zed.Value) zed.Value {
 	if portString := u.Port(); portString != "" {
 		u64, err := strconv.ParseUint(portString, 10, 16)
 		if err != nil {
-			return p.zctx.WrapError("parse_uri: invalid port: "+portString, in)
+			return zed.Null, errors.New("invalid port: " + portString)
 		}
 		u16 := uint16(u64)
 		v.Port = &u16
@@ -69,11 +83,7 @@ func (p *ParseURI) Call(_ zed.Allocator, args []zed.Value) zed.Value {
 	if u.Fragment != "" {
 		v.Fragment = &u.Fragment
 	}
-	out, err := p.marshaler.Marshal(v)
-	if err != nil {
-		panic(err)
-	}
-	return out
+	return p.marshaler.Marshal(v)
 }
 
 // https://github.com/brimdata/super/blob/main/docs/language/functions.md#parse_zson
@@ -83,7 +93,7 @@ type ParseZSON struct {
 	zr   *zsonio.Reader
 }
 
-func newParseZSON(zctx *zed.Context) *ParseZSON {
+func NewParseZSON(zctx *zed.Context) *ParseZSON {
 	var sr strings.Reader
 	return &ParseZSON{zctx, &sr, zsonio.NewReader(zctx, &sr)}
 }
@@ -96,13 +106,74 @@ func (p *ParseZSON) Call(_ zed.Allocator, args []zed.Value) zed.Value {
 	if in.IsNull() {
 		return zed.Null
 	}
-	p.sr.Reset(zed.DecodeString(in.Bytes()))
-	val, err := p.zr.Read()
+	val, err := p.Parse(zed.DecodeString(in.Bytes()))
 	if err != nil {
 		return p.zctx.WrapError("parse_zson: "+err.Error(), in)
 	}
+	return val
+}
+
+// Parse returns the first value in s, which is ZSON, or null if s is empty.
+// The value is valid until the next call to Parse.
+func (p *ParseZSON) Parse(s string) (zed.Value, error) {
+	p.sr.Reset(s)
+	val, err := p.zr.Read()
+	if err != nil {
+		return zed.Null, err
+	}
 	if val == nil {
+		return zed.Null, nil
+	}
+	return *val, nil
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#parse_json
+type ParseJSON struct {
+	zctx *zed.Context
+	sr   *strings.Reader
+	jr   *jsonio.Reader
+}
+
+func NewParseJSON(zctx *zed.Context) *ParseJSON {
+	var sr strings.Reader
+	return &ParseJSON{zctx, &sr, jsonio.NewReader(zctx, &sr)}
+}
+
+func (p *ParseJSON) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	in := args[0]
+	if !in.IsString() {
+		return p.zctx.WrapError("parse_json: string arg required", in)
+	}
+	if in.IsNull() {
 		return zed.Null
 	}
-	return *val
+	val, err := p.Parse(zed.DecodeString(in.Bytes()))
+	if err != nil {
+		return p.zctx.WrapError("parse_json: "+err.Error(), in)
+	}
+	return val
+}
+
+// Parse returns the value in s, which must contain exactly one JSON value.
+func (p *ParseJSON) Parse(s string) (zed.Value, error) {
+	return parseJSON(p.jr, p.sr, s)
+}
+
+// parseJSON parses s, which must contain exactly one JSON value, with jr,
+// which must read from sr.
+func parseJSON(jr *jsonio.Reader, sr *strings.Reader, s string) (zed.Value, error) {
+	sr.Reset(s)
+	jr.Reset(sr)
+	val, err := jr.Read()
+	if err != nil {
+		return zed.Null, err
+	}
+	if val == nil {
+		return zed.Null, errors.New("empty input")
+	}
+	out := val.Copy()
+	if val, err := jr.Read(); err != nil || val != nil {
+		return zed.Null, errors.New("invalid data after JSON value")
+	}
+	return out, nil
 }
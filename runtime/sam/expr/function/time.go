@@ -1,6 +1,8 @@
 package function
 
 import (
+	"time"
+
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/pkg/nano"
 	"github.com/brimdata/super/runtime/sam/expr/coerce"
@@ -74,3 +76,167 @@ func (s *Strftime) Call(_ zed.Allocator, args []zed.Value) zed.Value {
 	out := s.formatter.FormatString(timeArg.AsTime().Time())
 	return zed.NewString(out)
 }
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#strptime
+type Strptime struct {
+	zctx *zed.Context
+}
+
+func (s *Strptime) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	formatArg, strArg := args[0], args[1]
+	if !formatArg.IsString() {
+		return s.zctx.WrapError("strptime: string value required for format arg", formatArg)
+	}
+	if !strArg.IsString() {
+		return s.zctx.WrapError("strptime: string value required for value arg", strArg)
+	}
+	loc, errVal := timeZone(s.zctx, "strptime", args[2:])
+	if errVal != nil {
+		return *errVal
+	}
+	if formatArg.IsNull() || strArg.IsNull() {
+		return zed.NullTime
+	}
+	ts, err := nano.Strptime(formatArg.AsString(), strArg.AsString(), loc)
+	if err != nil {
+		return s.zctx.WrapError("strptime: "+err.Error(), strArg)
+	}
+	return zed.NewTime(ts)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#date_part
+type DatePart struct {
+	zctx *zed.Context
+}
+
+func (d *DatePart) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	partArg, timeArg := args[0], args[1]
+	if !partArg.IsString() {
+		return d.zctx.WrapError("date_part: string value required for part arg", partArg)
+	}
+	if zed.TypeUnder(timeArg.Type()) != zed.TypeTime {
+		return d.zctx.WrapError("date_part: time value required for time arg", timeArg)
+	}
+	loc, errVal := timeZone(d.zctx, "date_part", args[2:])
+	if errVal != nil {
+		return *errVal
+	}
+	if partArg.IsNull() || timeArg.IsNull() {
+		return zed.NullInt64
+	}
+	n, err := timeArg.AsTime().Part(partArg.AsString(), loc)
+	if err != nil {
+		return d.zctx.WrapError("date_part: "+err.Error(), partArg)
+	}
+	return zed.NewInt64(n)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#date_trunc
+type DateTrunc struct {
+	zctx *zed.Context
+}
+
+func (d *DateTrunc) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	unitArg, timeArg := args[0], args[1]
+	if !unitArg.IsString() {
+		return d.zctx.WrapError("date_trunc: string value required for unit arg", unitArg)
+	}
+	if zed.TypeUnder(timeArg.Type()) != zed.TypeTime {
+		return d.zctx.WrapError("date_trunc: time value required for time arg", timeArg)
+	}
+	loc, errVal := timeZone(d.zctx, "date_trunc", args[2:])
+	if errVal != nil {
+		return *errVal
+	}
+	if unitArg.IsNull() || timeArg.IsNull() {
+		return zed.NullTime
+	}
+	ts, err := timeArg.AsTime().TruncUnit(unitArg.AsString(), loc)
+	if err != nil {
+		return d.zctx.WrapError("date_trunc: "+err.Error(), unitArg)
+	}
+	return zed.NewTime(ts)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#date_add
+type DateAdd struct {
+	zctx *zed.Context
+}
+
+func (d *DateAdd) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	unitArg, nArg, timeArg := args[0], args[1], args[2]
+	if !unitArg.IsString() {
+		return d.zctx.WrapError("date_add: string value required for unit arg", unitArg)
+	}
+	if !zed.IsInteger(zed.TypeUnder(nArg.Type()).ID()) {
+		return d.zctx.WrapError("date_add: integer value required for count arg", nArg)
+	}
+	if zed.TypeUnder(timeArg.Type()) != zed.TypeTime {
+		return d.zctx.WrapError("date_add: time value required for time arg", timeArg)
+	}
+	loc, errVal := timeZone(d.zctx, "date_add", args[3:])
+	if errVal != nil {
+		return *errVal
+	}
+	if unitArg.IsNull() || nArg.IsNull() || timeArg.IsNull() {
+		return zed.NullTime
+	}
+	n, ok := coerce.ToInt(nArg)
+	if !ok {
+		return d.zctx.WrapError("date_add: count arg out of range", nArg)
+	}
+	ts, err := timeArg.AsTime().AddUnits(unitArg.AsString(), n, loc)
+	if err != nil {
+		return d.zctx.WrapError("date_add: "+err.Error(), unitArg)
+	}
+	return zed.NewTime(ts)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#date_diff
+type DateDiff struct {
+	zctx *zed.Context
+}
+
+func (d *DateDiff) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	unitArg, startArg, endArg := args[0], args[1], args[2]
+	if !unitArg.IsString() {
+		return d.zctx.WrapError("date_diff: string value required for unit arg", unitArg)
+	}
+	if zed.TypeUnder(startArg.Type()) != zed.TypeTime {
+		return d.zctx.WrapError("date_diff: time value required for start arg", startArg)
+	}
+	if zed.TypeUnder(endArg.Type()) != zed.TypeTime {
+		return d.zctx.WrapError("date_diff: time value required for end arg", endArg)
+	}
+	loc, errVal := timeZone(d.zctx, "date_diff", args[3:])
+	if errVal != nil {
+		return *errVal
+	}
+	if unitArg.IsNull() || startArg.IsNull() || endArg.IsNull() {
+		return zed.NullInt64
+	}
+	n, err := nano.DiffUnits(unitArg.AsString(), startArg.AsTime(), endArg.AsTime(), loc)
+	if err != nil {
+		return d.zctx.WrapError("date_diff: "+err.Error(), unitArg)
+	}
+	return zed.NewInt64(n)
+}
+
+// timeZone returns the location named by the optional time zone argument in
+// args or UTC if args is empty or the argument is null.
+func timeZone(zctx *zed.Context, name string, args []zed.Value) (*time.Location, *zed.Value) {
+	if len(args) == 0 {
+		return time.UTC, nil
+	}
+	if !args[0].IsString() {
+		return nil, zctx.WrapError(name+": string value required for time zone arg", args[0]).Ptr()
+	}
+	if args[0].IsNull() {
+		return time.UTC, nil
+	}
+	loc, err := nano.LoadLocation(args[0].AsString())
+	if err != nil {
+		return nil, zctx.WrapError(name+": "+err.Error(), args[0]).Ptr()
+	}
+	return loc, nil
+}
@@ -15,6 +15,7 @@ type Unflatten struct {
 
 	builder     zcode.Builder
 	recordCache recordCache
+	root        *record
 
 	// These exist only to reduce memory allocations.
 	path   field.Path
@@ -34,37 +35,61 @@ func (u *Unflatten) Call(_ zed.Allocator, args []zed.Value) zed.Value {
 	if !ok {
 		return val
 	}
+	u.Reset()
+	for it := val.Bytes().Iter(); !it.Done(); {
+		if errVal, ok := u.Add(array.Type, it.Next()); !ok {
+			return errVal
+		}
+	}
+	out, err := u.Build()
+	if err != nil {
+		return u.zctx.WrapError(err.Error(), val)
+	}
+	return out
+}
+
+// Reset prepares the receiver to unflatten a new array.
+func (u *Unflatten) Reset() {
 	u.recordCache.reset()
-	root := u.recordCache.new()
+	u.root = u.recordCache.new()
 	u.types = u.types[:0]
 	u.values = u.values[:0]
-	for it := val.Bytes().Iter(); !it.Done(); {
-		bytes := it.Next()
-		path, typ, vb, err := u.parseElem(array.Type, bytes)
-		if err != nil {
-			return u.zctx.WrapError(err.Error(), zed.NewValue(array.Type, bytes))
-		}
-		if typ == nil {
-			continue
-		}
-		if removed := root.addPath(&u.recordCache, path); removed > 0 {
-			u.types = u.types[:len(u.types)-removed]
-			u.values = u.values[:len(u.values)-removed]
-		}
-		u.types = append(u.types, typ)
-		u.values = append(u.values, vb)
+}
+
+// Add adds the element of the array being unflattened that has type inner
+// and body bytes.  If the element is invalid, Add returns an error value and
+// false.  Add retains bytes until the next call to Reset.
+func (u *Unflatten) Add(inner zed.Type, bytes zcode.Bytes) (zed.Value, bool) {
+	path, typ, vb, err := u.parseElem(inner, bytes)
+	if err != nil {
+		return u.zctx.WrapError(err.Error(), zed.NewValue(inner, bytes)), false
 	}
+	if typ == nil {
+		return zed.Value{}, true
+	}
+	if removed := u.root.addPath(&u.recordCache, path); removed > 0 {
+		u.types = u.types[:len(u.types)-removed]
+		u.values = u.values[:len(u.values)-removed]
+	}
+	u.types = append(u.types, typ)
+	u.values = append(u.values, vb)
+	return zed.Value{}, true
+}
+
+// Build returns the record built from the elements added since the last call
+// to Reset.
+func (u *Unflatten) Build() (zed.Value, error) {
 	u.builder.Reset()
 	types, values := u.types, u.values
-	typ, err := root.build(u.zctx, &u.builder, func() (zed.Type, zcode.Bytes) {
+	typ, err := u.root.build(u.zctx, &u.builder, func() (zed.Type, zcode.Bytes) {
 		typ, value := types[0], values[0]
 		types, values = types[1:], values[1:]
 		return typ, value
 	})
 	if err != nil {
-		return u.zctx.WrapError(err.Error(), val)
+		return zed.Null, err
 	}
-	return zed.NewValue(typ, u.builder.Bytes())
+	return zed.NewValue(typ, u.builder.Bytes()), nil
 }
 
 func (u *Unflatten) parseElem(inner zed.Type, vb zcode.Bytes) (field.Path, zed.Type, zcode.Bytes, error) {
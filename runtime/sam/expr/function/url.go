@@ -0,0 +1,155 @@
+package function
+
+import (
+	"net/netip"
+	"net/url"
+	"strings"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/zcode"
+	"github.com/brimdata/super/zson"
+	"golang.org/x/net/publicsuffix"
+)
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#url_encode
+type URLEncode struct {
+	zctx *zed.Context
+}
+
+func (u *URLEncode) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	val := args[0].Under()
+	if !val.IsString() {
+		return u.zctx.WrapError("url_encode: string arg required", args[0])
+	}
+	if val.IsNull() {
+		return zed.NullString
+	}
+	return zed.NewString(url.QueryEscape(val.AsString()))
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#url_decode
+type URLDecode struct {
+	zctx *zed.Context
+}
+
+func (u *URLDecode) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	val := args[0].Under()
+	if !val.IsString() {
+		return u.zctx.WrapError("url_decode: string arg required", args[0])
+	}
+	if val.IsNull() {
+		return zed.NullString
+	}
+	s, err := url.QueryUnescape(val.AsString())
+	if err != nil {
+		return u.zctx.WrapError("url_decode: "+err.Error(), args[0])
+	}
+	return zed.NewString(s)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#parse_query
+type ParseQuery struct {
+	zctx      *zed.Context
+	marshaler *zson.MarshalZNGContext
+	typ       zed.Type
+}
+
+func NewParseQuery(zctx *zed.Context) *ParseQuery {
+	return &ParseQuery{
+		zctx:      zctx,
+		marshaler: zson.NewZNGMarshalerWithContext(zctx),
+		typ:       zctx.LookupTypeMap(zed.TypeString, zctx.LookupTypeArray(zed.TypeString)),
+	}
+}
+
+func (p *ParseQuery) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	val := args[0].Under()
+	if !val.IsString() {
+		return p.zctx.WrapError("parse_query: string arg required", args[0])
+	}
+	if val.IsNull() {
+		return zed.NewValue(p.typ, nil)
+	}
+	out, err := p.Parse(val.AsString())
+	if err != nil {
+		return p.zctx.WrapError("parse_query: "+err.Error(), args[0])
+	}
+	return out
+}
+
+// Parse returns the map for the query string in s.
+func (p *ParseQuery) Parse(s string) (zed.Value, error) {
+	q, err := url.ParseQuery(strings.TrimPrefix(s, "?"))
+	if err != nil {
+		return zed.Null, err
+	}
+	if len(q) == 0 {
+		return zed.NewValue(p.typ, zcode.Bytes{}), nil
+	}
+	return p.marshaler.Marshal(q)
+}
+
+// Type returns the type of the map returned by Parse.
+func (p *ParseQuery) Type() zed.Type {
+	return p.typ
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#domain_parts
+type DomainParts struct {
+	zctx    *zed.Context
+	typ     *zed.TypeRecord
+	builder zcode.Builder
+}
+
+func newDomainParts(zctx *zed.Context) *DomainParts {
+	return &DomainParts{zctx: zctx, typ: DomainPartsType(zctx)}
+}
+
+// DomainPartsType returns the type of the record returned by domain_parts.
+func DomainPartsType(zctx *zed.Context) *zed.TypeRecord {
+	return zctx.MustLookupTypeRecord([]zed.Field{
+		zed.NewField("tld", zed.TypeString),
+		zed.NewField("domain", zed.TypeString),
+		zed.NewField("subdomain", zed.TypeString),
+	})
+}
+
+func (d *DomainParts) Call(_ zed.Allocator, args []zed.Value) zed.Value {
+	val := args[0].Under()
+	if !val.IsString() {
+		return d.zctx.WrapError("domain_parts: string arg required", args[0])
+	}
+	if val.IsNull() {
+		return zed.NewValue(d.typ, nil)
+	}
+	d.builder.Reset()
+	for _, s := range SplitDomain(val.AsString()) {
+		if s == "" {
+			d.builder.Append(nil)
+		} else {
+			d.builder.Append(zed.EncodeString(s))
+		}
+	}
+	return zed.NewValue(d.typ, d.builder.Bytes())
+}
+
+// SplitDomain splits hostname into its public suffix (e.g., "co.uk"), its
+// registrable domain (e.g., "example.co.uk"), and the labels preceding the
+// registrable domain (e.g., "www"), any of which may be empty.  IP addresses
+// have no parts.
+func SplitDomain(hostname string) [3]string {
+	host := strings.TrimSuffix(strings.ToLower(hostname), ".")
+	if host == "" {
+		return [3]string{}
+	}
+	if _, err := netip.ParseAddr(strings.Trim(host, "[]")); err == nil {
+		return [3]string{}
+	}
+	tld, _ := publicsuffix.PublicSuffix(host)
+	domain, err := publicsuffix.EffectiveTLDPlusOne(host)
+	if err != nil {
+		// host is a public suffix.
+		return [3]string{tld, "", ""}
+	}
+	return [3]string{tld, domain, strings.TrimSuffix(strings.TrimSuffix(host, domain), ".")}
+}
@@ -69,3 +69,60 @@ func (a *mapCall) innerType(types []zed.Type) zed.Type {
 	}
 	return a.zctx.LookupTypeUnion(types)
 }
+
+type mapFilter struct {
+	builder zcode.Builder
+	entry   zcode.Builder
+	eval    Evaluator
+	inner   Evaluator
+	zctx    *zed.Context
+}
+
+// NewMapFilter returns an Evaluator for the map_filter() function, which keeps
+// the entries of the maps that are the values of e for which inner, applied
+// to a record with fields key and value, is true.
+func NewMapFilter(zctx *zed.Context, e, inner Evaluator) Evaluator {
+	return &mapFilter{eval: e, inner: inner, zctx: zctx}
+}
+
+func (m *mapFilter) Eval(ectx Context, in zed.Value) zed.Value {
+	val := m.eval.Eval(ectx, in)
+	if val.IsError() {
+		return val
+	}
+	val = val.Under()
+	typ, ok := val.Type().(*zed.TypeMap)
+	if !ok {
+		return m.zctx.WrapError("map_filter: map arg required", val)
+	}
+	if val.IsNull() {
+		return val
+	}
+	entryType := MapEntryType(m.zctx, typ)
+	m.builder.Reset()
+	for it := val.Bytes().Iter(); !it.Done(); {
+		key, value := it.Next(), it.Next()
+		m.entry.Reset()
+		m.entry.Append(key)
+		m.entry.Append(value)
+		result := m.inner.Eval(ectx, zed.NewValue(entryType, m.entry.Bytes()))
+		if result.Type() == zed.TypeBool && result.Bool() {
+			m.builder.Append(key)
+			m.builder.Append(value)
+		}
+	}
+	bytes := m.builder.Bytes()
+	if bytes == nil {
+		bytes = zcode.Bytes{}
+	}
+	return zed.NewValue(typ, bytes)
+}
+
+// MapEntryType returns the type of a record with fields key and value holding
+// an entry of a map of type typ.
+func MapEntryType(zctx *zed.Context, typ *zed.TypeMap) *zed.TypeRecord {
+	return zctx.MustLookupTypeRecord([]zed.Field{
+		zed.NewField("key", typ.KeyType),
+		zed.NewField("value", typ.ValType),
+	})
+}
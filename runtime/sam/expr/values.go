@@ -295,6 +295,59 @@ func (m *MapExpr) Eval(ectx Context, this zed.Value) zed.Value {
 	return zed.NewValue(typ, zed.NormalizeMap(bytes))
 }
 
+// MapBuilder builds maps from keys and values of possibly differing types.
+// The key and value types of the result are the unions of the respective
+// types or, if there is just one, that type.
+type MapBuilder struct {
+	zctx    *zed.Context
+	builder zcode.Builder
+	keys    collectionBuilder
+	vals    collectionBuilder
+	index   map[string]int
+	scratch []byte
+}
+
+func NewMapBuilder(zctx *zed.Context) *MapBuilder {
+	return &MapBuilder{zctx: zctx, index: make(map[string]int)}
+}
+
+func (m *MapBuilder) Reset() {
+	m.keys.reset()
+	m.vals.reset()
+	clear(m.index)
+}
+
+// Append adds an entry, whose key and value must not be union values, to the
+// map.  The entry replaces any earlier entry with the same key.
+func (m *MapBuilder) Append(key, val zed.Value) {
+	m.scratch = zed.AppendTypeValue(m.scratch[:0], key.Type())
+	m.scratch = zcode.Append(m.scratch, key.Bytes())
+	if k, ok := m.index[string(m.scratch)]; ok {
+		m.vals.types[k] = val.Type()
+		m.vals.bytes[k] = val.Bytes()
+		return
+	}
+	m.index[string(m.scratch)] = len(m.keys.types)
+	m.keys.append(key)
+	m.vals.append(val)
+}
+
+// Map returns the map.
+func (m *MapBuilder) Map() zed.Value {
+	if len(m.keys.types) == 0 {
+		typ := m.zctx.LookupTypeMap(zed.TypeNull, zed.TypeNull)
+		return zed.NewValue(typ, []byte{})
+	}
+	m.builder.Reset()
+	kIter, vIter := m.keys.iter(m.zctx), m.vals.iter(m.zctx)
+	for !kIter.done() {
+		kIter.appendNext(&m.builder)
+		vIter.appendNext(&m.builder)
+	}
+	typ := m.zctx.LookupTypeMap(kIter.typ, vIter.typ)
+	return zed.NewValue(typ, zed.NormalizeMap(m.builder.Bytes()))
+}
+
 type collectionBuilder struct {
 	types       []zed.Type
 	uniqueTypes []zed.Type
@@ -1,6 +1,9 @@
 package explode
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/runtime/sam/expr"
 	"github.com/brimdata/super/zbuf"
@@ -9,22 +12,42 @@ import (
 
 // A an explode Proc is a proc that, given an input record and a
 // zng type T, outputs one record for each field of the input record of
-// type T. It is useful for type-based indexing.
+// type T. It is useful for type-based indexing.  If T is a map type,
+// it instead outputs one record for each entry of each such map.
 type Op struct {
 	parent   zbuf.Puller
 	outType  zed.Type
 	typ      zed.Type
+	isMap    bool
 	args     []expr.Evaluator
 	resetter expr.Resetter
 }
 
 // New creates a exploder for type typ, where the
-// output records' single field is named name.
+// output records' single field is named name.  If typ is a map type,
+// the output records have a field named key holding an entry's key
+// and a field named name holding its value, so name may not be "key".
 func New(zctx *zed.Context, parent zbuf.Puller, args []expr.Evaluator, typ zed.Type, name string, resetter expr.Resetter) (zbuf.Puller, error) {
+	fields := []zed.Field{{Name: name, Type: typ}}
+	mapType, isMap := zed.TypeUnder(typ).(*zed.TypeMap)
+	if isMap {
+		if name == "key" {
+			return nil, errors.New(`explode: "as key" conflicts with the "key" field holding map keys`)
+		}
+		fields = []zed.Field{
+			{Name: "key", Type: mapType.KeyType},
+			{Name: name, Type: mapType.ValType},
+		}
+	}
+	outType, err := zctx.LookupTypeRecord(fields)
+	if err != nil {
+		return nil, fmt.Errorf("explode: %w", err)
+	}
 	return &Op{
 		parent:   parent,
-		outType:  zctx.MustLookupTypeRecord([]zed.Field{{Name: name, Type: typ}}),
+		outType:  outType,
 		typ:      typ,
+		isMap:    isMap,
 		args:     args,
 		resetter: resetter,
 	}, nil
@@ -50,8 +73,12 @@ func (o *Op) Pull(done bool) (zbuf.Batch, error) {
 				}
 				zed.Walk(val.Type(), val.Bytes(), func(typ zed.Type, body zcode.Bytes) error {
 					if typ == o.typ && body != nil {
-						bytes := zcode.Append(nil, body)
-						out = append(out, zed.NewValue(o.outType, bytes))
+						if o.isMap {
+							out = o.appendEntries(out, body)
+						} else {
+							bytes := zcode.Append(nil, body)
+							out = append(out, zed.NewValue(o.outType, bytes))
+						}
 						return zed.SkipContainer
 					}
 					return nil
@@ -65,3 +92,12 @@ func (o *Op) Pull(done bool) (zbuf.Batch, error) {
 		batch.Unref()
 	}
 }
+
+func (o *Op) appendEntries(out []zed.Value, body zcode.Bytes) []zed.Value {
+	for it := body.Iter(); !it.Done(); {
+		bytes := zcode.Append(nil, it.Next())
+		bytes = zcode.Append(bytes, it.Next())
+		out = append(out, zed.NewValue(o.outType, bytes))
+	}
+	return out
+}
@@ -177,6 +177,7 @@ func (o *Op) Pull(done bool) (zbuf.Batch, error) {
 
 func (o *Op) run() {
 	defer func() {
+		o.agg.cleanupTable()
 		if o.agg.spiller != nil {
 			o.agg.spiller.Cleanup()
 		}
@@ -291,6 +292,7 @@ func (o *Op) sendResult(b zbuf.Batch, err error) (bool, bool) {
 }
 
 func (o *Op) reset() {
+	o.agg.cleanupTable()
 	if o.agg.spiller != nil {
 		o.agg.spiller.Cleanup()
 		o.agg.spiller = nil
@@ -303,6 +305,13 @@ func (o *Op) reset() {
 	o.resetter.Reset()
 }
 
+// cleanupTable releases any resources held by the functions in the table.
+func (a *Aggregator) cleanupTable() {
+	for _, row := range a.table {
+		row.reducers.cleanup()
+	}
+}
+
 // Consume adds a value to an aggregation.
 func (a *Aggregator) Consume(batch zbuf.Batch, this zed.Value) error {
 	// See if we've encountered this row before.
@@ -514,6 +523,7 @@ func (a *Aggregator) nextResultFromSpills(ectx expr.Context) (*zed.Value, error)
 		types = append(types, v.Type())
 		a.builder.Append(v.Bytes())
 	}
+	row.cleanup()
 	typ := a.lookupRecordType(types)
 	bytes, err := a.builder.Encode()
 	if err != nil {
@@ -563,6 +573,7 @@ func (a *Aggregator) readTable(flush, partialsOut bool, batch zbuf.Batch) (zbuf.
 			types = append(types, v.Type())
 			a.builder.Append(v.Bytes())
 		}
+		row.reducers.cleanup()
 		typ := a.lookupRecordType(types)
 		zv, err := a.builder.Encode()
 		if err != nil {
@@ -38,3 +38,10 @@ func (v valRow) consumeAsPartial(rec zed.Value, exprs []expr.Evaluator, ectx exp
 		}
 	}
 }
+
+// cleanup releases any resources held by the functions in v.
+func (v valRow) cleanup() {
+	for _, f := range v {
+		agg.Cleanup(f)
+	}
+}
@@ -0,0 +1,70 @@
+package join
+
+import (
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/zcode"
+)
+
+// Cond is the residual condition of a join, which is evaluated on each pair
+// of values whose keys match.  The condition refers to the pair as the
+// record {left:<left value>,right:<right value>}.
+type Cond struct {
+	zctx    *zed.Context
+	expr    expr.Evaluator
+	swapped bool
+	builder zcode.Builder
+	types   map[int]map[int]*zed.TypeRecord
+}
+
+// NewCond returns a Cond that evaluates e.  If swapped is true, the inputs
+// of the join have been swapped (as they are for a right join) so the
+// values passed to Eval are presented to e in the opposite order.
+func NewCond(zctx *zed.Context, e expr.Evaluator, swapped bool) *Cond {
+	return &Cond{
+		zctx:    zctx,
+		expr:    e,
+		swapped: swapped,
+		types:   make(map[int]map[int]*zed.TypeRecord),
+	}
+}
+
+// Eval returns true if the condition holds for left and right.  A nil Cond
+// always holds.
+func (c *Cond) Eval(ectx expr.Context, left, right zed.Value) bool {
+	if c == nil {
+		return true
+	}
+	if c.swapped {
+		left, right = right, left
+	}
+	typ, err := c.pairType(left.Type(), right.Type())
+	if err != nil {
+		return false
+	}
+	c.builder.Reset()
+	c.builder.Append(left.Bytes())
+	c.builder.Append(right.Bytes())
+	val, ok := expr.EvalBool(c.zctx, ectx, zed.NewValue(typ, c.builder.Bytes()), c.expr)
+	return ok && val.Bool()
+}
+
+func (c *Cond) pairType(left, right zed.Type) (*zed.TypeRecord, error) {
+	table, ok := c.types[left.ID()]
+	if !ok {
+		table = make(map[int]*zed.TypeRecord)
+		c.types[left.ID()] = table
+	}
+	if typ, ok := table[right.ID()]; ok {
+		return typ, nil
+	}
+	typ, err := c.zctx.LookupTypeRecord([]zed.Field{
+		zed.NewField("left", left),
+		zed.NewField("right", right),
+	})
+	if err != nil {
+		return nil, err
+	}
+	table[right.ID()] = typ
+	return typ, nil
+}
@@ -0,0 +1,589 @@
+package join
+
+import (
+	"context"
+	"hash"
+	"hash/fnv"
+	"sync"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+	"github.com/brimdata/super/runtime/sam/op"
+	"github.com/brimdata/super/runtime/sam/op/spill"
+	"github.com/brimdata/super/zbuf"
+)
+
+// MemMaxBytes specifies the maximum amount of memory that each hash join
+// will use to buffer its inputs before partitioning them to disk.
+var MemMaxBytes = 128 * 1024 * 1024
+
+// numPartitions is the number of partitions into which the inputs of a
+// hash join are split when neither input fits in memory.
+const numPartitions = 16
+
+// maxPartitionDepth limits how many times a spilled partition whose smaller
+// side still does not fit in memory is itself partitioned.  Values with equal
+// keys always land in the same partition so further partitioning cannot help
+// a partition dominated by a single key.
+const maxPartitionDepth = 4
+
+// HashOp is a join that does not require sorted inputs.  It builds an
+// in-memory hash table from the smaller input and then probes the table
+// with each value of the other input.  If neither input fits within
+// MemMaxBytes, both inputs are partitioned by key into spill files and
+// each pair of partitions is then joined by building a table from its
+// smaller side and streaming its other side from disk.  A partition whose
+// smaller side does not fit in memory is partitioned again.
+//
+// Unlike Op, HashOp does not preserve the order of its inputs.  Output is
+// ordered by the probe side followed by any unmatched values of the build
+// side.
+type HashOp struct {
+	rctx      *runtime.Context
+	anti      bool
+	inner     bool
+	full      bool
+	ctx       context.Context
+	cancel    context.CancelFunc
+	once      sync.Once
+	left      *puller
+	right     *puller
+	leftKeys  []expr.Evaluator
+	rightKeys []expr.Evaluator
+	cond      *Cond
+	resetter  expr.Resetter
+	cutter    *expr.Cutter
+	splicer   *splicer
+	hash      hash.Hash64
+	key       []byte
+
+	built      bool
+	table      *hashTable
+	probe      []zbuf.Batch
+	probeSrc   zbuf.Puller
+	partitions []*partition
+	// loaded is the spilled partition whose table is being probed.
+	loaded *partition
+}
+
+func NewHash(rctx *runtime.Context, anti, inner, full bool, left, right zbuf.Puller, leftKeys, rightKeys []expr.Evaluator,
+	cond *Cond, lhs []*expr.Lval, rhs []expr.Evaluator, resetter expr.Resetter) *HashOp {
+	ctx, cancel := context.WithCancel(rctx.Context)
+	return &HashOp{
+		rctx:      rctx,
+		anti:      anti,
+		inner:     inner,
+		full:      full,
+		ctx:       ctx,
+		cancel:    cancel,
+		left:      newPuller(left, ctx),
+		right:     newPuller(right, ctx),
+		leftKeys:  leftKeys,
+		rightKeys: rightKeys,
+		cond:      cond,
+		resetter:  resetter,
+		cutter:    expr.NewCutter(rctx.Zctx, lhs, rhs),
+		splicer:   newSplicer(rctx.Zctx),
+		hash:      fnv.New64a(),
+	}
+}
+
+func (o *HashOp) Pull(done bool) (zbuf.Batch, error) {
+	// XXX see issue #3437 regarding done protocol.
+	o.once.Do(func() {
+		go o.left.run()
+		go o.right.run()
+	})
+	if !o.built {
+		o.built = true
+		if err := o.build(); err != nil {
+			o.cleanup()
+			return nil, err
+		}
+	}
+	// See #3366
+	ectx := expr.NewContext()
+	for {
+		if o.table == nil {
+			if len(o.partitions) == 0 {
+				// Reset so the next Pull starts a new join.
+				o.built = false
+				o.resetter.Reset()
+				return nil, nil
+			}
+			if err := o.loadPartition(); err != nil {
+				o.cleanup()
+				return nil, err
+			}
+			continue
+		}
+		batch, err := o.nextProbe()
+		if err != nil {
+			o.cleanup()
+			return nil, err
+		}
+		var out []zed.Value
+		if batch == nil {
+			out = o.table.unmatched(o, out)
+			o.table = nil
+			if o.loaded != nil {
+				o.loaded.cleanup()
+				o.loaded = nil
+			}
+		} else {
+			out, err = o.table.probe(o, ectx, batch.Values(), out)
+			if err != nil {
+				o.cleanup()
+				return nil, err
+			}
+		}
+		if len(out) > 0 {
+			return zbuf.NewArray(out), nil
+		}
+	}
+}
+
+// build reads both inputs concurrently and builds the hash table from the
+// smaller one.  If both inputs end within the memory budget, the table is
+// built from the smaller of the two and the other is probed from memory.
+// If one input exceeds the budget after the other has ended, the table is
+// built from the ended input and the other is streamed.  Otherwise, both
+// inputs are partitioned to disk.
+func (o *HashOp) build() error {
+	var leftBatches, rightBatches []zbuf.Batch
+	var leftBytes, rightBytes int
+	leftCh, rightCh := o.left.ch, o.right.ch
+	for {
+		var r op.Result
+		var isLeft bool
+		select {
+		case r = <-leftCh:
+			isLeft = true
+		case r = <-rightCh:
+		case <-o.ctx.Done():
+			return o.ctx.Err()
+		}
+		if r.Err != nil {
+			return r.Err
+		}
+		switch {
+		case r.Batch == nil && isLeft:
+			leftCh = nil
+		case r.Batch == nil:
+			rightCh = nil
+		case isLeft:
+			leftBatches = append(leftBatches, r.Batch)
+			leftBytes += sizeOf(r.Batch)
+		default:
+			rightBatches = append(rightBatches, r.Batch)
+			rightBytes += sizeOf(r.Batch)
+		}
+		switch {
+		case leftCh == nil && rightCh == nil:
+			if leftBytes < rightBytes {
+				return o.buildTable(true, leftBatches, rightBatches, nil)
+			}
+			return o.buildTable(false, rightBatches, leftBatches, nil)
+		case leftCh == nil && rightBytes >= MemMaxBytes:
+			return o.buildTable(true, leftBatches, rightBatches, o.right)
+		case rightCh == nil && leftBytes >= MemMaxBytes:
+			return o.buildTable(false, rightBatches, leftBatches, o.left)
+		case leftBytes >= MemMaxBytes || rightBytes >= MemMaxBytes:
+			return o.partition(leftBatches, leftCh, rightBatches, rightCh)
+		}
+	}
+}
+
+func (o *HashOp) buildTable(buildLeft bool, build, probe []zbuf.Batch, probeSrc zbuf.Puller) error {
+	table := newHashTable(buildLeft)
+	keys := o.rightKeys
+	if buildLeft {
+		keys = o.leftKeys
+	}
+	// See #3366
+	ectx := expr.NewContext()
+	for _, batch := range build {
+		// We're keeping values owned by batch so don't call Unref.
+		for _, val := range batch.Values() {
+			if key, ok := o.keyBytes(ectx, keys, val); ok {
+				table.add(string(key), val)
+			}
+		}
+	}
+	o.table = table
+	o.probe = probe
+	o.probeSrc = probeSrc
+	return nil
+}
+
+// nextProbe returns the next batch of the probe side, first draining any
+// batches buffered during the build.
+func (o *HashOp) nextProbe() (zbuf.Batch, error) {
+	if len(o.probe) > 0 {
+		batch := o.probe[0]
+		o.probe = o.probe[1:]
+		return batch, nil
+	}
+	if o.probeSrc == nil {
+		return nil, nil
+	}
+	batch, err := o.probeSrc.Pull(false)
+	if batch == nil || err != nil {
+		o.probeSrc = nil
+	}
+	return batch, err
+}
+
+// partition writes the buffered batches and the remainder of both inputs
+// into numPartitions pairs of spill files according to the hash of each
+// value's join key.
+func (o *HashOp) partition(leftBatches []zbuf.Batch, leftCh <-chan op.Result, rightBatches []zbuf.Batch, rightCh <-chan op.Result) error {
+	parts, err := newPartitions(0)
+	if err != nil {
+		return err
+	}
+	o.partitions = parts
+	for _, batch := range leftBatches {
+		if err := o.spill(parts, true, batch); err != nil {
+			return err
+		}
+	}
+	for _, batch := range rightBatches {
+		if err := o.spill(parts, false, batch); err != nil {
+			return err
+		}
+	}
+	// Continue reading both inputs concurrently since they may be fed
+	// by the same upstream operator.
+	for leftCh != nil || rightCh != nil {
+		var r op.Result
+		var isLeft bool
+		select {
+		case r = <-leftCh:
+			isLeft = true
+		case r = <-rightCh:
+		case <-o.ctx.Done():
+			return o.ctx.Err()
+		}
+		if r.Err != nil {
+			return r.Err
+		}
+		if r.Batch == nil {
+			if isLeft {
+				leftCh = nil
+			} else {
+				rightCh = nil
+			}
+			continue
+		}
+		if err := o.spill(parts, isLeft, r.Batch); err != nil {
+			return err
+		}
+	}
+	return rewindPartitions(o.rctx.Zctx, parts)
+}
+
+func (o *HashOp) spill(parts []*partition, isLeft bool, batch zbuf.Batch) error {
+	defer batch.Unref()
+	// See #3366
+	ectx := expr.NewContext()
+	for _, val := range batch.Values() {
+		if err := o.spillValue(ectx, parts, isLeft, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// spillValue writes val to the partition in parts selected by the hash of
+// its join key.  The hash is seeded with the depth of parts so that each
+// level of partitioning distributes keys differently.
+func (o *HashOp) spillValue(ectx expr.Context, parts []*partition, isLeft bool, val zed.Value) error {
+	keys := o.rightKeys
+	if isLeft {
+		keys = o.leftKeys
+	}
+	key, ok := o.keyBytes(ectx, keys, val)
+	if !ok {
+		return nil
+	}
+	o.hash.Reset()
+	o.hash.Write([]byte{byte(parts[0].depth)})
+	o.hash.Write(key)
+	return parts[o.hash.Sum64()%numPartitions].write(isLeft, val)
+}
+
+// loadPartition takes the next partition and, if its smaller side fits in
+// memory, builds a hash table from that side and sets up its other side to
+// be streamed from disk as the probe side.  Otherwise, it partitions the
+// partition again and leaves the table unset.
+func (o *HashOp) loadPartition() error {
+	p := o.partitions[0]
+	o.partitions = o.partitions[1:]
+	buildLeft := p.left.nbytes <= p.right.nbytes
+	build, probe := p.right, p.left
+	if buildLeft {
+		build, probe = p.left, p.right
+	}
+	if build.nbytes >= MemMaxBytes && p.depth < maxPartitionDepth {
+		defer p.cleanup()
+		parts, err := o.repartition(p)
+		if err != nil {
+			return err
+		}
+		o.partitions = append(parts, o.partitions...)
+		return nil
+	}
+	o.loaded = p
+	batch, err := build.readAll()
+	if err != nil {
+		return err
+	}
+	return o.buildTable(buildLeft, []zbuf.Batch{batch}, nil, zbuf.NewPuller(probe))
+}
+
+// repartition splits both sides of p into a new set of partitions.
+func (o *HashOp) repartition(p *partition) ([]*partition, error) {
+	parts, err := newPartitions(p.depth + 1)
+	if err != nil {
+		return nil, err
+	}
+	// See #3366
+	ectx := expr.NewContext()
+	for _, isLeft := range []bool{true, false} {
+		f := p.right
+		if isLeft {
+			f = p.left
+		}
+		for {
+			val, err := f.Read()
+			if val == nil || err != nil {
+				if err != nil {
+					cleanupPartitions(parts)
+					return nil, err
+				}
+				break
+			}
+			if err := o.spillValue(ectx, parts, isLeft, *val); err != nil {
+				cleanupPartitions(parts)
+				return nil, err
+			}
+		}
+	}
+	if err := rewindPartitions(o.rctx.Zctx, parts); err != nil {
+		cleanupPartitions(parts)
+		return nil, err
+	}
+	return parts, nil
+}
+
+func (o *HashOp) cleanup() {
+	cleanupPartitions(o.partitions)
+	o.partitions = nil
+	if o.loaded != nil {
+		o.loaded.cleanup()
+		o.loaded = nil
+	}
+	o.table = nil
+	o.probe = nil
+	o.probeSrc = nil
+	o.cancel()
+}
+
+// keyBytes evaluates keys on val and returns their encoding by
+// coerce.AppendKey, so keys match when the merge join's comparator would
+// find them equal (e.g., int32(1) and int64(1)).  The result is valid until
+// the next call to keyBytes.  The boolean result is false if any key is
+// missing.
+func (o *HashOp) keyBytes(ectx expr.Context, keys []expr.Evaluator, val zed.Value) ([]byte, bool) {
+	o.key = o.key[:0]
+	for _, e := range keys {
+		key := e.Eval(ectx, val)
+		if key.IsMissing() {
+			return nil, false
+		}
+		o.key = coerce.AppendKey(o.key, key)
+	}
+	return o.key, true
+}
+
+func (o *HashOp) join(ectx expr.Context, left, right zed.Value) (zed.Value, error) {
+	return o.splicer.splice(left, o.cutter.Eval(ectx, right))
+}
+
+type hashTable struct {
+	// buildLeft is true if the table was built from the left input.
+	buildLeft bool
+	table     map[string][]int
+	vals      []zed.Value
+	matched   []bool
+}
+
+func newHashTable(buildLeft bool) *hashTable {
+	return &hashTable{
+		buildLeft: buildLeft,
+		table:     make(map[string][]int),
+	}
+}
+
+func (h *hashTable) add(key string, val zed.Value) {
+	h.table[key] = append(h.table[key], len(h.vals))
+	h.vals = append(h.vals, val)
+	h.matched = append(h.matched, false)
+}
+
+// probe looks up each of vals in the table and appends the joined results
+// to out.  Unmatched probe values are appended as is when the join style
+// requires it.
+func (h *hashTable) probe(o *HashOp, ectx expr.Context, vals []zed.Value, out []zed.Value) ([]zed.Value, error) {
+	keys := o.leftKeys
+	if h.buildLeft {
+		keys = o.rightKeys
+	}
+	for _, val := range vals {
+		key, ok := o.keyBytes(ectx, keys, val)
+		if !ok {
+			// If the key isn't present (which is not a thing in a
+			// sql join), then drop the value.
+			continue
+		}
+		var matched bool
+		for _, k := range h.table[string(key)] {
+			left, right := val, h.vals[k]
+			if h.buildLeft {
+				left, right = right, left
+			}
+			if !o.cond.Eval(ectx, left, right) {
+				continue
+			}
+			matched = true
+			h.matched[k] = true
+			if o.anti {
+				continue
+			}
+			rec, err := o.join(ectx, left, right)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, rec)
+		}
+		if !matched && ((h.buildLeft && o.full) || (!h.buildLeft && !o.inner)) {
+			out = append(out, val)
+		}
+	}
+	return out, nil
+}
+
+// unmatched appends to out the values of the table that did not match any
+// probe value when the join style requires them.
+func (h *hashTable) unmatched(o *HashOp, out []zed.Value) []zed.Value {
+	if (h.buildLeft && o.inner) || (!h.buildLeft && !o.full) {
+		return out
+	}
+	for k, val := range h.vals {
+		if !h.matched[k] {
+			out = append(out, val)
+		}
+	}
+	return out
+}
+
+type partition struct {
+	depth int
+	left  *partitionFile
+	right *partitionFile
+}
+
+func newPartitions(depth int) ([]*partition, error) {
+	var parts []*partition
+	for range numPartitions {
+		p, err := newPartition(depth)
+		if err != nil {
+			cleanupPartitions(parts)
+			return nil, err
+		}
+		parts = append(parts, p)
+	}
+	return parts, nil
+}
+
+func rewindPartitions(zctx *zed.Context, parts []*partition) error {
+	for _, p := range parts {
+		if err := p.rewind(zctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func cleanupPartitions(parts []*partition) {
+	for _, p := range parts {
+		p.cleanup()
+	}
+}
+
+func newPartition(depth int) (*partition, error) {
+	left, err := spill.NewTempFile()
+	if err != nil {
+		return nil, err
+	}
+	right, err := spill.NewTempFile()
+	if err != nil {
+		left.CloseAndRemove()
+		return nil, err
+	}
+	return &partition{depth, &partitionFile{File: left}, &partitionFile{File: right}}, nil
+}
+
+func (p *partition) write(isLeft bool, val zed.Value) error {
+	if isLeft {
+		return p.left.write(val)
+	}
+	return p.right.write(val)
+}
+
+func (p *partition) rewind(zctx *zed.Context) error {
+	if err := p.left.Rewind(zctx); err != nil {
+		return err
+	}
+	return p.right.Rewind(zctx)
+}
+
+func (p *partition) cleanup() {
+	p.left.CloseAndRemove()
+	p.right.CloseAndRemove()
+}
+
+type partitionFile struct {
+	*spill.File
+	nbytes int
+}
+
+func (p *partitionFile) write(val zed.Value) error {
+	p.nbytes += len(val.Bytes())
+	return p.File.Write(val)
+}
+
+func (p *partitionFile) readAll() (zbuf.Batch, error) {
+	var vals []zed.Value
+	for {
+		val, err := p.Read()
+		if err != nil {
+			return nil, err
+		}
+		if val == nil {
+			return zbuf.NewArray(vals), nil
+		}
+		vals = append(vals, val.Copy())
+	}
+}
+
+func sizeOf(batch zbuf.Batch) int {
+	var n int
+	for _, val := range batch.Values() {
+		n += len(val.Bytes())
+	}
+	return n
+}
@@ -2,13 +2,13 @@ package join
 
 import (
 	"context"
-	"fmt"
 	"sync"
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/runtime"
 	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/op"
 	"github.com/brimdata/super/runtime/sam/op/sort"
 	"github.com/brimdata/super/zbuf"
 	"github.com/brimdata/super/zio"
@@ -18,10 +18,11 @@ type Op struct {
 	rctx        *runtime.Context
 	anti        bool
 	inner       bool
+	full        bool
 	ctx         context.Context
 	cancel      context.CancelFunc
 	once        sync.Once
-	left        *puller
+	left        *zio.Peeker
 	right       *zio.Peeker
 	getLeftKey  expr.Evaluator
 	getRightKey expr.Evaluator
@@ -30,10 +31,10 @@ type Op struct {
 	cutter      *expr.Cutter
 	joinKey     *zed.Value
 	joinSet     []zed.Value
-	types       map[int]map[int]*zed.TypeRecord
+	splicer     *splicer
 }
 
-func New(rctx *runtime.Context, anti, inner bool, left, right zbuf.Puller, leftKey, rightKey expr.Evaluator,
+func New(rctx *runtime.Context, anti, inner, full bool, left, right zbuf.Puller, leftKey, rightKey expr.Evaluator,
 	leftDir, rightDir order.Direction, lhs []*expr.Lval, rhs []expr.Evaluator, resetter expr.Resetter) (*Op, error) {
 	var o order.Which
 	switch {
@@ -56,16 +57,17 @@ func New(rctx *runtime.Context, anti, inner bool, left, right zbuf.Puller, leftK
 		rctx:        rctx,
 		anti:        anti,
 		inner:       inner,
+		full:        full,
 		ctx:         ctx,
 		cancel:      cancel,
 		getLeftKey:  leftKey,
 		getRightKey: rightKey,
-		left:        newPuller(left, ctx),
+		left:        zio.NewPeeker(newPuller(left, ctx)),
 		right:       zio.NewPeeker(newPuller(right, ctx)),
 		resetter:    resetter,
 		compare:     expr.NewValueCompareFn(o, true),
 		cutter:      expr.NewCutter(rctx.Zctx, lhs, rhs),
-		types:       make(map[int]map[int]*zed.TypeRecord),
+		splicer:     newSplicer(rctx.Zctx),
 	}, nil
 }
 
@@ -73,18 +75,30 @@ func New(rctx *runtime.Context, anti, inner bool, left, right zbuf.Puller, leftK
 func (o *Op) Pull(done bool) (zbuf.Batch, error) {
 	// XXX see issue #3437 regarding done protocol.
 	o.once.Do(func() {
-		go o.left.run()
+		go o.left.Reader.(*puller).run()
 		go o.right.Reader.(*puller).run()
 	})
 	var out []zed.Value
 	// See #3366
 	ectx := expr.NewContext()
 	for {
-		leftRec, err := o.left.Read()
+		if len(out) >= op.BatchLen {
+			return zbuf.NewArray(out), nil
+		}
+		leftRec, err := o.left.Peek()
 		if err != nil {
 			return nil, err
 		}
 		if leftRec == nil {
+			if o.full {
+				// Flush the right values that never matched.  The
+				// left puller keeps returning EOS, so subsequent
+				// calls to Pull resume the flush where this one
+				// stopped.
+				if out, err = o.readUnmatched(out); err != nil {
+					return nil, err
+				}
+			}
 			if len(out) == 0 {
 				o.resetter.Reset()
 				return nil, nil
@@ -97,12 +111,20 @@ func (o *Op) Pull(done bool) (zbuf.Batch, error) {
 			// If the left key isn't present (which is not a thing
 			// in a sql join), then drop the record and return only
 			// left records that can eval the key expression.
+			o.left.Read()
 			continue
 		}
-		rightRecs, err := o.getJoinSet(key)
+		rightRecs, ok, err := o.getJoinSet(key, &out)
 		if err != nil {
 			return nil, err
 		}
+		if !ok {
+			// A full batch of unmatched right values was found
+			// before the join set of leftRec.  Leave leftRec
+			// in the peeker so the next Pull picks it up.
+			return zbuf.NewArray(out), nil
+		}
+		o.left.Read()
 		if rightRecs == nil {
 			// Nothing to add to the left join.
 			// Accumulate this record for an outer join.
@@ -125,7 +147,7 @@ func (o *Op) Pull(done bool) (zbuf.Batch, error) {
 		// release the batch with and bypass GC.
 		for _, rightRec := range rightRecs {
 			cutRec := o.cutter.Eval(ectx, rightRec)
-			rec, err := o.splice(*leftRec, cutRec)
+			rec, err := o.splicer.splice(*leftRec, cutRec)
 			if err != nil {
 				return nil, err
 			}
@@ -134,16 +156,24 @@ func (o *Op) Pull(done bool) (zbuf.Batch, error) {
 	}
 }
 
-func (o *Op) getJoinSet(leftKey zed.Value) ([]zed.Value, error) {
+// getJoinSet returns the righthand values whose key matches leftKey.
+// For a full join, righthand values skipped over because their key is
+// less than leftKey are appended to out, and if out reaches op.BatchLen
+// before the join set is found, getJoinSet returns false so the caller
+// can emit out and try again.
+func (o *Op) getJoinSet(leftKey zed.Value, out *[]zed.Value) ([]zed.Value, bool, error) {
 	if o.joinKey != nil && o.compare(leftKey, *o.joinKey) == 0 {
-		return o.joinSet, nil
+		return o.joinSet, true, nil
 	}
 	// See #3366
 	ectx := expr.NewContext()
 	for {
+		if len(*out) >= op.BatchLen {
+			return nil, false, nil
+		}
 		rec, err := o.right.Peek()
 		if err != nil || rec == nil {
-			return nil, err
+			return nil, err == nil, err
 		}
 		rightKey := o.getRightKey.Eval(ectx, *rec)
 		if rightKey.IsMissing() {
@@ -159,17 +189,21 @@ func (o *Op) getJoinSet(leftKey zed.Value) ([]zed.Value, error) {
 				o.joinKey.CopyFrom(leftKey)
 			}
 			o.joinSet, err = o.readJoinSet(o.joinKey)
-			return o.joinSet, err
+			return o.joinSet, err == nil, err
 		}
 		if cmp < 0 {
 			// If the left key is smaller than the next eligible
 			// join key, then there is nothing to join for this
 			// record.
-			return nil, nil
+			return nil, true, nil
 		}
 		// Discard the peeked-at record and keep looking for
 		// a righthand key that either matches or exceeds the
-		// lefthand key.
+		// lefthand key.  For a full join, the discarded record
+		// has no match so it is output as is.
+		if o.full {
+			*out = append(*out, rec.Copy())
+		}
 		o.right.Read()
 	}
 }
@@ -202,58 +236,22 @@ func (o *Op) readJoinSet(joinKey *zed.Value) ([]zed.Value, error) {
 	}
 }
 
-func (o *Op) lookupType(left, right *zed.TypeRecord) *zed.TypeRecord {
-	if table, ok := o.types[left.ID()]; ok {
-		return table[right.ID()]
-	}
-	return nil
-}
-
-func (o *Op) enterType(combined, left, right *zed.TypeRecord) {
-	id := left.ID()
-	table := o.types[id]
-	if table == nil {
-		table = make(map[int]*zed.TypeRecord)
-		o.types[id] = table
-	}
-	table[right.ID()] = combined
-}
-
-func (o *Op) buildType(left, right *zed.TypeRecord) (*zed.TypeRecord, error) {
-	fields := make([]zed.Field, 0, len(left.Fields)+len(right.Fields))
-	fields = append(fields, left.Fields...)
-	for _, f := range right.Fields {
-		name := f.Name
-		for k := 2; left.HasField(name); k++ {
-			name = fmt.Sprintf("%s_%d", f.Name, k)
+// readUnmatched reads the righthand stream until out holds op.BatchLen
+// records or EOS, appending each record with a non-missing key to out.
+// This is called for a full join after the lefthand stream is exhausted,
+// at which point no remaining righthand record has a match.
+func (o *Op) readUnmatched(out []zed.Value) ([]zed.Value, error) {
+	// See #3366
+	ectx := expr.NewContext()
+	for len(out) < op.BatchLen {
+		rec, err := o.right.Read()
+		if err != nil || rec == nil {
+			return out, err
 		}
-		fields = append(fields, zed.NewField(name, f.Type))
-	}
-	return o.rctx.Zctx.LookupTypeRecord(fields)
-}
-
-func (o *Op) combinedType(left, right *zed.TypeRecord) (*zed.TypeRecord, error) {
-	if typ := o.lookupType(left, right); typ != nil {
-		return typ, nil
-	}
-	typ, err := o.buildType(left, right)
-	if err != nil {
-		return nil, err
-	}
-	o.enterType(typ, left, right)
-	return typ, nil
-}
-
-func (o *Op) splice(left, right zed.Value) (zed.Value, error) {
-	left = left.Under()
-	right = right.Under()
-	typ, err := o.combinedType(zed.TypeRecordOf(left.Type()), zed.TypeRecordOf(right.Type()))
-	if err != nil {
-		return zed.Null, err
+		if key := o.getRightKey.Eval(ectx, *rec); key.IsMissing() {
+			continue
+		}
+		out = append(out, rec.Copy())
 	}
-	n := len(left.Bytes())
-	bytes := make([]byte, n+len(right.Bytes()))
-	copy(bytes, left.Bytes())
-	copy(bytes[n:], right.Bytes())
-	return zed.NewValue(typ, bytes), nil
+	return out, nil
 }
@@ -0,0 +1,65 @@
+package join_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/op"
+	"github.com/brimdata/super/runtime/sam/op/join"
+	"github.com/brimdata/super/zbuf"
+	"github.com/brimdata/super/zson"
+	"github.com/brimdata/super/ztest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashJoinZtestsSpill(t *testing.T) {
+	saved := join.MemMaxBytes
+	t.Cleanup(func() { join.MemMaxBytes = saved })
+	join.MemMaxBytes = 1
+	ztest.Run(t, "ztests")
+}
+
+func TestFullJoinBatchesUnmatched(t *testing.T) {
+	// The right values with keys below the one left key are skipped while
+	// looking for its join set and those above it are flushed after the
+	// left EOS.  Both runs exceed op.BatchLen.
+	const n = 3 * op.BatchLen
+	zctx := zed.NewContext()
+	left := zbuf.NewPuller(zbuf.NewArray([]zed.Value{zson.MustParseValue(zctx, "{a:150}")}))
+	var rights []zed.Value
+	for i := range n {
+		rights = append(rights, zson.MustParseValue(zctx, fmt.Sprintf("{b:%d}", i)))
+	}
+	rctx := runtime.NewContext(context.Background(), zctx)
+	defer rctx.Cancel()
+	lhs := []*expr.Lval{expr.NewLval([]expr.LvalElem{&expr.StaticLvalElem{Name: "c"}})}
+	rhs := []expr.Evaluator{expr.NewDottedExpr(zctx, field.Path{"b"})}
+	o, err := join.New(rctx, false, false, true, left, zbuf.NewPuller(zbuf.NewArray(rights)),
+		expr.NewDottedExpr(zctx, field.Path{"a"}), expr.NewDottedExpr(zctx, field.Path{"b"}),
+		order.Up, order.Up, lhs, rhs, expr.Resetters{})
+	require.NoError(t, err)
+	var vals []zed.Value
+	for {
+		batch, err := o.Pull(false)
+		require.NoError(t, err)
+		if batch == nil {
+			break
+		}
+		require.LessOrEqual(t, len(batch.Values()), op.BatchLen)
+		vals = append(vals, batch.Values()...)
+	}
+	require.Len(t, vals, n)
+	for i, val := range vals {
+		if i == 150 {
+			require.Equal(t, "{a:150,c:150}", zson.FormatValue(val))
+			continue
+		}
+		require.Equal(t, int64(i), val.Deref("b").AsInt())
+	}
+}
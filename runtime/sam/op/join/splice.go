@@ -0,0 +1,78 @@
+package join
+
+import (
+	"fmt"
+
+	"github.com/brimdata/super"
+)
+
+// splicer combines a left record and a right record into a single record
+// comprising the fields of the left followed by the fields of the right,
+// renaming any right field whose name collides with a left field.
+type splicer struct {
+	zctx  *zed.Context
+	types map[int]map[int]*zed.TypeRecord
+}
+
+func newSplicer(zctx *zed.Context) *splicer {
+	return &splicer{
+		zctx:  zctx,
+		types: make(map[int]map[int]*zed.TypeRecord),
+	}
+}
+
+func (s *splicer) lookupType(left, right *zed.TypeRecord) *zed.TypeRecord {
+	if table, ok := s.types[left.ID()]; ok {
+		return table[right.ID()]
+	}
+	return nil
+}
+
+func (s *splicer) enterType(combined, left, right *zed.TypeRecord) {
+	id := left.ID()
+	table := s.types[id]
+	if table == nil {
+		table = make(map[int]*zed.TypeRecord)
+		s.types[id] = table
+	}
+	table[right.ID()] = combined
+}
+
+func (s *splicer) buildType(left, right *zed.TypeRecord) (*zed.TypeRecord, error) {
+	fields := make([]zed.Field, 0, len(left.Fields)+len(right.Fields))
+	fields = append(fields, left.Fields...)
+	for _, f := range right.Fields {
+		name := f.Name
+		for k := 2; left.HasField(name); k++ {
+			name = fmt.Sprintf("%s_%d", f.Name, k)
+		}
+		fields = append(fields, zed.NewField(name, f.Type))
+	}
+	return s.zctx.LookupTypeRecord(fields)
+}
+
+func (s *splicer) combinedType(left, right *zed.TypeRecord) (*zed.TypeRecord, error) {
+	if typ := s.lookupType(left, right); typ != nil {
+		return typ, nil
+	}
+	typ, err := s.buildType(left, right)
+	if err != nil {
+		return nil, err
+	}
+	s.enterType(typ, left, right)
+	return typ, nil
+}
+
+func (s *splicer) splice(left, right zed.Value) (zed.Value, error) {
+	left = left.Under()
+	right = right.Under()
+	typ, err := s.combinedType(zed.TypeRecordOf(left.Type()), zed.TypeRecordOf(right.Type()))
+	if err != nil {
+		return zed.Null, err
+	}
+	n := len(left.Bytes())
+	bytes := make([]byte, n+len(right.Bytes()))
+	copy(bytes, left.Bytes())
+	copy(bytes[n:], right.Bytes())
+	return zed.NewValue(typ, bytes), nil
+}
@@ -8,6 +8,7 @@ import (
 	"strconv"
 
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/runtime/sam/expr"
 	"github.com/brimdata/super/zio"
 )
@@ -49,6 +50,11 @@ func NewMergeSort(comparator *expr.Comparator) (*MergeSort, error) {
 	}, nil
 }
 
+// NewValueSort returns a MergeSort of values in ascending order.
+func NewValueSort() (*MergeSort, error) {
+	return NewMergeSort(expr.NewComparator(false, expr.NewSortEvaluator(&expr.This{}, order.Asc)))
+}
+
 func (r *MergeSort) Cleanup() {
 	for _, run := range r.runs {
 		run.CloseAndRemove()
@@ -68,6 +74,13 @@ func (r *MergeSort) Spill(ctx context.Context, vals []zed.Value) error {
 	}); err != nil {
 		return err
 	}
+	return r.SpillSorted(ctx, zr)
+}
+
+// SpillSorted spills a new run of the records read from zr, which must be in
+// sorted order and must not be empty, to a file in the MergeSort's temp
+// directory.
+func (r *MergeSort) SpillSorted(ctx context.Context, zr zio.Reader) error {
 	filename := filepath.Join(r.tempDir, strconv.Itoa(r.nspill))
 	runFile, err := newPeeker(ctx, r.zctx, filename, r.nspill, zr)
 	if err != nil {
@@ -0,0 +1,220 @@
+package window
+
+import (
+	"fmt"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/expr/agg"
+)
+
+// Function computes a value for each row of a partition.  For each partition,
+// the window operator calls Reset, then Consume with each row of the
+// partition, and then Step with each row in order, after which Eval returns
+// the function's value for that row.
+type Function interface {
+	expr.Evaluator
+	Reset()
+	Consume(ectx expr.Context, row zed.Value)
+	Step(ectx expr.Context, f *Frame)
+}
+
+// Frame describes the current row of a partition.
+type Frame struct {
+	Row zed.Value
+	// Ahead holds the rows of the partition following Row, up to the
+	// greatest offset of any lead function.
+	Ahead []zed.Value
+	// Peer is true if Row sorts equal to the preceding row of the partition.
+	Peer bool
+}
+
+// NumArgs returns the minimum and maximum number of arguments accepted by
+// window function op and false if op is not a window function.
+func NumArgs(op string) (int, int, bool) {
+	switch op {
+	case "row_number", "rank", "dense_rank":
+		return 0, 0, true
+	case "lag", "lead":
+		return 1, 3, true
+	}
+	return 0, 0, false
+}
+
+// NewFunction returns the window function op.  The lag and lead functions
+// evaluate e and take an optional offset and default value in params.
+func NewFunction(op string, e expr.Evaluator, params ...zed.Value) (Function, error) {
+	switch op {
+	case "row_number":
+		return &rowNumber{}, nil
+	case "rank":
+		return &rank{}, nil
+	case "dense_rank":
+		return &rank{dense: true}, nil
+	case "lag", "lead":
+		offset := 1
+		if len(params) > 0 {
+			if !zed.IsInteger(params[0].Type().ID()) || params[0].AsInt() < 1 {
+				return nil, fmt.Errorf("%s: offset must be a positive integer", op)
+			}
+			offset = int(params[0].AsInt())
+		}
+		dflt := zed.Null
+		if len(params) > 1 {
+			dflt = params[1]
+		}
+		if op == "lag" {
+			return &lag{expr: e, offset: offset, dflt: dflt}, nil
+		}
+		return &lead{expr: e, offset: offset, dflt: dflt}, nil
+	}
+	return nil, fmt.Errorf("unknown window function: %s", op)
+}
+
+type rowNumber struct {
+	n int64
+}
+
+func (r *rowNumber) Reset()                        { r.n = 0 }
+func (*rowNumber) Consume(expr.Context, zed.Value) {}
+func (r *rowNumber) Step(expr.Context, *Frame)     { r.n++ }
+
+func (r *rowNumber) Eval(expr.Context, zed.Value) zed.Value {
+	return zed.NewInt64(r.n)
+}
+
+// rank computes the rank of each row with gaps after peers or, if dense is
+// true, without gaps.
+type rank struct {
+	dense bool
+	n     int64
+	rank  int64
+}
+
+func (r *rank) Reset() {
+	r.n = 0
+	r.rank = 0
+}
+
+func (*rank) Consume(expr.Context, zed.Value) {}
+
+func (r *rank) Step(_ expr.Context, f *Frame) {
+	r.n++
+	if !f.Peer {
+		if r.dense {
+			r.rank++
+		} else {
+			r.rank = r.n
+		}
+	}
+}
+
+func (r *rank) Eval(expr.Context, zed.Value) zed.Value {
+	return zed.NewInt64(r.rank)
+}
+
+// lag evaluates its expression on the row offset rows before the current row.
+type lag struct {
+	expr   expr.Evaluator
+	offset int
+	dflt   zed.Value
+	// history holds the values of expr for up to offset+1 rows
+	// ending with the current row.
+	history []zed.Value
+}
+
+func (l *lag) Reset() {
+	l.history = l.history[:0]
+}
+
+func (*lag) Consume(expr.Context, zed.Value) {}
+
+func (l *lag) Step(ectx expr.Context, f *Frame) {
+	if len(l.history) > l.offset {
+		l.history = append(l.history[:0], l.history[1:]...)
+	}
+	l.history = append(l.history, l.expr.Eval(ectx, f.Row).Copy())
+}
+
+func (l *lag) Eval(expr.Context, zed.Value) zed.Value {
+	if len(l.history) <= l.offset {
+		return l.dflt
+	}
+	return l.history[0]
+}
+
+// lead evaluates its expression on the row offset rows after the current row.
+type lead struct {
+	expr   expr.Evaluator
+	offset int
+	dflt   zed.Value
+	val    zed.Value
+}
+
+func (*lead) Reset()                          {}
+func (*lead) Consume(expr.Context, zed.Value) {}
+
+func (l *lead) Step(ectx expr.Context, f *Frame) {
+	if len(f.Ahead) < l.offset {
+		l.val = l.dflt
+		return
+	}
+	l.val = l.expr.Eval(ectx, f.Ahead[l.offset-1]).Copy()
+}
+
+func (l *lead) Eval(expr.Context, zed.Value) zed.Value {
+	return l.val
+}
+
+// aggFunc computes an aggregate function over an entire partition or, if
+// running is true, over the rows of the partition up to and including the
+// current row.
+type aggFunc struct {
+	zctx    *zed.Context
+	agg     *expr.Aggregator
+	fn      agg.Function
+	running bool
+	val     zed.Value
+	// stale is true if val must be recomputed by the next call to Step.
+	stale bool
+}
+
+// NewAggFunction returns a Function that computes the aggregate function agg.
+func NewAggFunction(zctx *zed.Context, agg *expr.Aggregator, running bool) Function {
+	return &aggFunc{zctx: zctx, agg: agg, running: running}
+}
+
+func (a *aggFunc) Reset() {
+	a.Cleanup()
+	a.fn = a.agg.NewFunction()
+	a.stale = true
+}
+
+// Cleanup releases any resources held by the aggregate function.
+func (a *aggFunc) Cleanup() {
+	if a.fn != nil {
+		agg.Cleanup(a.fn)
+		a.fn = nil
+	}
+}
+
+func (a *aggFunc) Consume(ectx expr.Context, row zed.Value) {
+	if !a.running {
+		a.agg.Apply(a.zctx, ectx, a.fn, row)
+	}
+}
+
+func (a *aggFunc) Step(ectx expr.Context, f *Frame) {
+	if a.running {
+		a.agg.Apply(a.zctx, ectx, a.fn, f.Row)
+		a.stale = true
+	}
+	if a.stale {
+		a.val = a.fn.Result(a.zctx)
+		a.stale = false
+	}
+}
+
+func (a *aggFunc) Eval(expr.Context, zed.Value) zed.Value {
+	return a.val
+}
@@ -0,0 +1,341 @@
+package window
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/expr/agg"
+	"github.com/brimdata/super/runtime/sam/op"
+	"github.com/brimdata/super/runtime/sam/op/spill"
+	"github.com/brimdata/super/zbuf"
+	"github.com/brimdata/super/zio"
+)
+
+// MemMaxBytes specifies the maximum amount of memory that each window
+// operator will consume to sort its input and, separately, to hold
+// a partition.  Beyond this, values are spilled to temporary files.
+var MemMaxBytes = 128 * 1024 * 1024
+
+// Op computes window functions over partitions of its input.  It sorts its
+// input by partition key and then by sort key and appends the value of each
+// function to every input value.
+type Op struct {
+	rctx     *runtime.Context
+	parent   zbuf.Puller
+	funcs    []Function
+	putter   *expr.Putter
+	resetter expr.Resetter
+	// comparator orders values by partition key and then by sort key.
+	comparator *expr.Comparator
+	// partitioner orders values by partition key.
+	partitioner *expr.Comparator
+	lookahead   int
+
+	once     sync.Once
+	resultCh chan op.Result
+}
+
+func New(rctx *runtime.Context, parent zbuf.Puller, keys []expr.Evaluator, sortExprs []expr.SortEvaluator, lhs []*expr.Lval, funcs []Function, resetter expr.Resetter) *Op {
+	var keyExprs []expr.SortEvaluator
+	for _, e := range keys {
+		keyExprs = append(keyExprs, expr.NewSortEvaluator(e, order.Asc))
+	}
+	var lookahead int
+	clauses := make([]expr.Assignment, 0, len(funcs))
+	for k, f := range funcs {
+		if l, ok := f.(*lead); ok {
+			lookahead = max(lookahead, l.offset)
+		}
+		clauses = append(clauses, expr.Assignment{LHS: lhs[k], RHS: f})
+	}
+	return &Op{
+		rctx:        rctx,
+		parent:      parent,
+		funcs:       funcs,
+		putter:      expr.NewPutter(rctx.Zctx, clauses),
+		resetter:    resetter,
+		comparator:  expr.NewComparator(true, slices.Concat(keyExprs, sortExprs)...).WithMissingAsNull(),
+		partitioner: expr.NewComparator(true, keyExprs...).WithMissingAsNull(),
+		lookahead:   lookahead,
+		resultCh:    make(chan op.Result),
+	}
+}
+
+func (o *Op) Pull(done bool) (zbuf.Batch, error) {
+	o.once.Do(func() {
+		// Block o.rctx.Cancel until o.run finishes its cleanup.
+		o.rctx.WaitGroup.Add(1)
+		go o.run()
+	})
+	for {
+		r, ok := <-o.resultCh
+		if !ok {
+			return nil, o.rctx.Err()
+		}
+		if !done || r.Batch == nil || r.Err != nil {
+			return r.Batch, r.Err
+		}
+		r.Batch.Unref()
+	}
+}
+
+func (o *Op) run() {
+	defer close(o.resultCh)
+	var spiller *spill.MergeSort
+	defer func() {
+		if spiller != nil {
+			spiller.Cleanup()
+		}
+		for _, f := range o.funcs {
+			if c, ok := f.(agg.Cleaner); ok {
+				c.Cleanup()
+			}
+		}
+		// Tell o.rctx.Cancel that we've finished our cleanup.
+		o.rctx.WaitGroup.Done()
+	}()
+	var nbytes int
+	var vals []zed.Value
+	for {
+		batch, err := o.parent.Pull(false)
+		if err != nil {
+			if ok := o.sendResult(nil, err); !ok {
+				return
+			}
+			continue
+		}
+		if batch == nil {
+			var zr zio.Reader
+			if spiller != nil {
+				if len(vals) > 0 {
+					err = spiller.Spill(o.rctx.Context, vals)
+				}
+				zr = spiller
+			} else {
+				zr = o.comparator.SortStableReader(vals)
+			}
+			if err == nil {
+				err = o.compute(zr)
+			}
+			if spiller != nil {
+				spiller.Cleanup()
+				spiller = nil
+			}
+			nbytes = 0
+			vals = nil
+			if err != nil {
+				if ok := o.sendResult(nil, err); !ok {
+					return
+				}
+			}
+			if ok := o.sendResult(nil, nil); !ok {
+				return
+			}
+			continue
+		}
+		// We're keeping values owned by batch so don't call Unref.
+		for _, val := range batch.Values() {
+			nbytes += len(val.Bytes())
+			vals = append(vals, val)
+		}
+		if nbytes < MemMaxBytes {
+			continue
+		}
+		if spiller == nil {
+			if spiller, err = spill.NewMergeSort(o.comparator); err != nil {
+				if ok := o.sendResult(nil, err); !ok {
+					return
+				}
+				nbytes = 0
+				vals = nil
+				continue
+			}
+		}
+		if err := spiller.Spill(o.rctx.Context, vals); err != nil {
+			if ok := o.sendResult(nil, err); !ok {
+				return
+			}
+		}
+		nbytes = 0
+		vals = nil
+	}
+}
+
+// compute reads sorted values from zr, collects them into partitions, and
+// sends each partition downstream with the window functions applied.
+func (o *Op) compute(zr zio.Reader) error {
+	p := &partition{zctx: o.rctx.Zctx}
+	defer p.reset()
+	ectx := expr.NewContext()
+	for {
+		val, err := zr.Read()
+		if err != nil {
+			return err
+		}
+		if val == nil || (p.len() > 0 && o.partitioner.Compare(p.first, *val) != 0) {
+			if p.len() > 0 {
+				if err := o.send(ectx, p); err != nil {
+					return err
+				}
+			}
+			if val == nil {
+				return nil
+			}
+		}
+		if p.len() == 0 {
+			for _, f := range o.funcs {
+				f.Reset()
+			}
+		}
+		if err := p.append(*val); err != nil {
+			return err
+		}
+		for _, f := range o.funcs {
+			f.Consume(ectx, *val)
+		}
+	}
+}
+
+// send steps the window functions through each row of p and sends the rows
+// with the function values appended.
+func (o *Op) send(ectx expr.Context, p *partition) error {
+	defer p.reset()
+	zr, err := p.reader()
+	if err != nil {
+		return err
+	}
+	// Values read from a spill file must be copied since the reader
+	// reuses its buffers.
+	mustCopy := p.spill != nil
+	// queue holds the current row followed by up to o.lookahead rows.
+	var queue []zed.Value
+	var prev *zed.Value
+	var eof bool
+	var out []zed.Value
+	for {
+		for !eof && len(queue) <= o.lookahead {
+			val, err := zr.Read()
+			if err != nil {
+				return err
+			}
+			if val == nil {
+				eof = true
+				break
+			}
+			if mustCopy {
+				val = val.Copy().Ptr()
+			}
+			queue = append(queue, *val)
+		}
+		if len(queue) == 0 {
+			break
+		}
+		row := queue[0]
+		frame := &Frame{
+			Row:   row,
+			Ahead: queue[1:],
+			Peer:  prev != nil && o.comparator.Compare(*prev, row) == 0,
+		}
+		for _, f := range o.funcs {
+			f.Step(ectx, frame)
+		}
+		if zed.TypeRecordOf(row.Type()) == nil && !row.IsError() {
+			out = append(out, o.rctx.Zctx.WrapError("window: not a record", row))
+		} else {
+			out = append(out, o.putter.Eval(ectx, row).Copy())
+		}
+		prev = &row
+		queue = queue[1:]
+		if len(out) >= zbuf.PullerBatchValues {
+			if ok := o.sendResult(zbuf.NewArray(out), nil); !ok {
+				return o.rctx.Err()
+			}
+			out = nil
+		}
+	}
+	if len(out) > 0 {
+		if ok := o.sendResult(zbuf.NewArray(out), nil); !ok {
+			return o.rctx.Err()
+		}
+	}
+	return nil
+}
+
+func (o *Op) sendResult(b zbuf.Batch, err error) bool {
+	if b == nil && err == nil {
+		// Reset stateful aggregation expressions on EOS.
+		o.resetter.Reset()
+	}
+	select {
+	case o.resultCh <- op.Result{Batch: b, Err: err}:
+		return true
+	case <-o.rctx.Done():
+		return false
+	}
+}
+
+// partition holds the rows of a partition in memory until they exceed
+// MemMaxBytes and in a spill file thereafter.
+type partition struct {
+	zctx   *zed.Context
+	first  zed.Value
+	vals   []zed.Value
+	n      int
+	nbytes int
+	spill  *spill.File
+}
+
+func (p *partition) len() int {
+	return p.n
+}
+
+func (p *partition) append(val zed.Value) error {
+	if p.n == 0 {
+		p.first = val.Copy()
+	}
+	p.n++
+	if p.spill != nil {
+		return p.spill.Write(val)
+	}
+	p.vals = append(p.vals, val)
+	p.nbytes += len(val.Bytes())
+	if p.nbytes < MemMaxBytes {
+		return nil
+	}
+	f, err := spill.NewTempFile()
+	if err != nil {
+		return err
+	}
+	p.spill = f
+	for _, val := range p.vals {
+		if err := f.Write(val); err != nil {
+			return err
+		}
+	}
+	p.vals = nil
+	return nil
+}
+
+func (p *partition) reader() (zio.Reader, error) {
+	if p.spill != nil {
+		if err := p.spill.Rewind(p.zctx); err != nil {
+			return nil, err
+		}
+		return p.spill, nil
+	}
+	return zbuf.NewArray(p.vals), nil
+}
+
+func (p *partition) reset() {
+	if p.spill != nil {
+		p.spill.CloseAndRemove()
+		p.spill = nil
+	}
+	p.vals = nil
+	p.n = 0
+	p.nbytes = 0
+}
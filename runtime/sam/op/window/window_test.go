@@ -0,0 +1,40 @@
+package window_test
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/brimdata/super/runtime/sam/op/window"
+	"github.com/brimdata/super/ztest"
+)
+
+func TestWindowExternal(t *testing.T) {
+	saved := window.MemMaxBytes
+	window.MemMaxBytes = 1024
+	defer func() {
+		window.MemMaxBytes = saved
+	}()
+	// Create enough values that both the input and each partition
+	// exceed window.MemMaxBytes.
+	const n = 3000
+	var input strings.Builder
+	for _, ts := range rand.Perm(n) {
+		fmt.Fprintf(&input, "{k:%d,ts:%d}\n", ts%3, ts)
+	}
+	var output strings.Builder
+	for k := 0; k < 3; k++ {
+		lag, sum := "null", 0
+		for row, ts := 1, k; ts < n; row, ts = row+1, ts+3 {
+			sum += ts
+			fmt.Fprintf(&output, "{k:%d,ts:%d,row_number:%d,lag:%s,sum:%d,count:%d(uint64)}\n", k, ts, row, lag, sum, n/3)
+			lag = fmt.Sprint(ts)
+		}
+	}
+	(&ztest.ZTest{
+		Zed:    "window row_number(), lag(ts), running sum(ts), count() by k sort ts",
+		Input:  input.String(),
+		Output: output.String(),
+	}).Run(t, "", "")
+}
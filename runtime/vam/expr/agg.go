@@ -0,0 +1,84 @@
+package expr
+
+import (
+	"github.com/brimdata/super"
+	samagg "github.com/brimdata/super/runtime/sam/expr/agg"
+	"github.com/brimdata/super/runtime/vam/expr/agg"
+	"github.com/brimdata/super/vector"
+)
+
+type Aggregator struct {
+	pattern agg.Pattern
+	expr    Evaluator
+	where   Evaluator
+}
+
+func NewAggregator(op string, expr Evaluator, where Evaluator, mem *samagg.QuantileMemory, params ...zed.Value) (*Aggregator, error) {
+	pattern, err := agg.NewPattern(op, expr != nil, mem, params...)
+	if err != nil {
+		return nil, err
+	}
+	if expr == nil {
+		// Count is the only that has no argument so we just return
+		// true so it counts each value encountered.
+		expr = NewLiteral(zed.True)
+	}
+	return &Aggregator{
+		pattern: pattern,
+		expr:    expr,
+		where:   where,
+	}, nil
+}
+
+func (a *Aggregator) NewFunction() agg.Func {
+	return a.pattern()
+}
+
+// Eval evaluates the aggregator's argument over this and returns the result
+// along with a mask of the slots whose values should be consumed.  A slot is
+// excluded if the where clause is not true for it or if its value is
+// missing.  The mask is nil if every slot should be consumed.
+func (a *Aggregator) Eval(this vector.Any) (vector.Any, *vector.Bool) {
+	vec := a.expr.Eval(this)
+	var where vector.Any
+	if a.where != nil {
+		// XXX Issue #3401: do something with "where" errors.
+		where = a.where.Eval(this)
+	}
+	var mask *vector.Bool
+	n := vec.Len()
+	for i := range n {
+		if where != nil && !vector.BoolValue(where, i) || isMissing(vec, i) {
+			if mask == nil {
+				mask = vector.NewBoolEmpty(n, nil)
+				for k := range i {
+					mask.Set(k)
+				}
+			}
+			continue
+		}
+		if mask != nil {
+			mask.Set(i)
+		}
+	}
+	return vec, mask
+}
+
+func isMissing(vec vector.Any, slot uint32) bool {
+	switch vec := vec.(type) {
+	case *vector.Const:
+		val := vec.Value()
+		return val.IsMissing()
+	case *vector.Dynamic:
+		return isMissing(vec.Values[vec.Tags[slot]], vec.TagMap.Forward[slot])
+	case *vector.View:
+		return isMissing(vec.Any, vec.Index[slot])
+	case *vector.Error:
+		if vec.Vals.Type() != zed.TypeString || vec.Nulls.Value(slot) {
+			return false
+		}
+		s, null := vector.StringValue(vec.Vals, slot)
+		return !null && s == "missing"
+	}
+	return false
+}
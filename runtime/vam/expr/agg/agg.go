@@ -0,0 +1,95 @@
+package agg
+
+import (
+	"fmt"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/anymath"
+	samagg "github.com/brimdata/super/runtime/sam/expr/agg"
+	"github.com/brimdata/super/vector"
+)
+
+// A Pattern is a template for creating instances of aggregate functions.
+// An instance is created by simply invoking the pattern function.
+type Pattern func() Func
+
+// Func is an aggregate function that computes a result for each of a set of
+// groups numbered from zero.  Consume and ConsumeAsPartial add the value in
+// each slot of a vector to the group given by the corresponding element of
+// groups.  The result of a group to which no values were added is that of an
+// empty aggregation.
+type Func interface {
+	Consume(vec vector.Any, groups []uint32)
+	ConsumeAsPartial(partial vector.Any, groups []uint32)
+	Result(zctx *zed.Context, group uint32) zed.Value
+	ResultAsPartial(zctx *zed.Context, group uint32) zed.Value
+}
+
+// NewPattern returns a pattern for aggregate function op.  mem and params are
+// as for samagg.NewPattern.
+func NewPattern(op string, hasarg bool, mem *samagg.QuantileMemory, params ...zed.Value) (Pattern, error) {
+	// Use the sequential pattern to check op, hasarg, and params and to
+	// create the functions that are computed one value at a time.
+	samPattern, err := samagg.NewPattern(op, hasarg, mem, params...)
+	if err != nil {
+		return nil, err
+	}
+	switch op {
+	case "count":
+		return func() Func {
+			return &count{}
+		}, nil
+	case "avg":
+		return func() Func {
+			return &avg{}
+		}, nil
+	case "sum":
+		return newMathPattern(anymath.Add, samPattern), nil
+	case "min":
+		return newMathPattern(anymath.Min, samPattern), nil
+	case "max":
+		return newMathPattern(anymath.Max, samPattern), nil
+	case "and":
+		return func() Func {
+			return &logical{name: op, and: true}
+		}, nil
+	case "or":
+		return func() Func {
+			return &logical{name: op}
+		}, nil
+	case "any":
+		return func() Func {
+			return &anyFunc{}
+		}, nil
+	case "dcount":
+		return func() Func {
+			return &dcount{}
+		}, nil
+	case "approx_quantile", "collect", "collect_map", "corr", "covar",
+		"covar_pop", "fuse", "median", "quantile", "regr_intercept",
+		"regr_slope", "stddev", "stddev_pop", "union", "var", "var_pop":
+		return func() Func {
+			return &valueFunc{pattern: samPattern}
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown aggregation function: %s", op)
+}
+
+// dynamicGroups returns, for each of d's vectors, the groups of its values
+// given the groups of d's slots.
+func dynamicGroups(d *vector.Dynamic, groups []uint32) [][]uint32 {
+	out := make([][]uint32, len(d.Values))
+	for slot, tag := range d.Tags {
+		out[tag] = append(out[tag], groups[slot])
+	}
+	return out
+}
+
+// grow returns s extended with zero values, if needed, so that it has an
+// element for group.
+func grow[T any](s []T, group uint32) []T {
+	if n := int(group) + 1; n > len(s) {
+		s = append(s, make([]T, n-len(s))...)
+	}
+	return s
+}
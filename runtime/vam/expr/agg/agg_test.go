@@ -0,0 +1,95 @@
+package agg
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/brimdata/super"
+	samagg "github.com/brimdata/super/runtime/sam/expr/agg"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zson"
+	"github.com/stretchr/testify/require"
+)
+
+// Test that each function computes the same result as its sequential
+// counterpart when values are aggregated directly and when partials from
+// two instances are combined by a third.
+func TestPartials(t *testing.T) {
+	cases := []struct {
+		op     string
+		values string
+	}{
+		{"any", "null(int64) 1 null(int64) 2 3 null(int64)"},
+		{"any", `null(string) null(int64) "a" 1`},
+		{"dcount", `1 2 1 "1" 2 3 1(int32) null(int64) 4 4`},
+		{"count", "1 2 3 4 5"},
+		{"sum", "1 2 3. 4(uint8) 5"},
+		{"min", "3 2 1 4 5"},
+		{"max", "3 2. 1 4(int32) 5"},
+		{"avg", "1 2 3 4 5"},
+		{"and", "true false true true null(bool)"},
+		{"or", "false false true false null(bool)"},
+		{"collect", `1 "a" 2 null(int64) 3`},
+		{"union", `1 "a" 1 2 "a" 3`},
+		{"fuse", "{a:1} {b:2} {a:3,c:4} {d:5}"},
+	}
+	for _, c := range cases {
+		t.Run(c.op, func(t *testing.T) {
+			zctx := zed.NewContext()
+			vals := parseValues(zctx, c.values)
+			groups := make([]uint32, len(vals))
+			for i := range groups {
+				groups[i] = uint32(i % 2)
+			}
+			pattern, err := NewPattern(c.op, true, nil)
+			require.NoError(t, err)
+			samPattern, err := samagg.NewPattern(c.op, true, nil)
+			require.NoError(t, err)
+
+			direct := pattern()
+			direct.Consume(newVector(vals), groups)
+			// Each half must have values in both groups since the
+			// partial of an empty group is not meaningful.
+			half := len(vals) / 2
+			first, second := pattern(), pattern()
+			first.Consume(newVector(vals[:half]), groups[:half])
+			second.Consume(newVector(vals[half:]), groups[half:])
+			var partials []zed.Value
+			var partialGroups []uint32
+			for g := range uint32(2) {
+				partials = append(partials, first.ResultAsPartial(zctx, g), second.ResultAsPartial(zctx, g))
+				partialGroups = append(partialGroups, g, g)
+			}
+			combined := pattern()
+			combined.ConsumeAsPartial(newVector(partials), partialGroups)
+
+			for g := range uint32(2) {
+				seq := samPattern()
+				for i, val := range vals {
+					if groups[i] == g {
+						seq.Consume(val)
+					}
+				}
+				expected := zson.FormatValue(seq.Result(zctx))
+				require.Equal(t, expected, zson.FormatValue(direct.Result(zctx, g)), "direct group %d", g)
+				require.Equal(t, expected, zson.FormatValue(combined.Result(zctx, g)), "combined group %d", g)
+			}
+		})
+	}
+}
+
+func parseValues(zctx *zed.Context, s string) []zed.Value {
+	var vals []zed.Value
+	for _, field := range strings.Fields(s) {
+		vals = append(vals, zson.MustParseValue(zctx, field))
+	}
+	return vals
+}
+
+func newVector(vals []zed.Value) vector.Any {
+	b := vector.NewDynamicBuilder()
+	for _, val := range vals {
+		b.Write(val)
+	}
+	return b.Build()
+}
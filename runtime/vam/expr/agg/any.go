@@ -0,0 +1,43 @@
+package agg
+
+import (
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
+)
+
+// anyFunc keeps the first value of each group, favoring non-null values
+// over null values.  Once a group has a non-null value, its remaining
+// values are skipped without being materialized.
+type anyFunc struct {
+	vals []zed.Value
+	b    zcode.Builder
+}
+
+func (a *anyFunc) Consume(vec vector.Any, groups []uint32) {
+	for i, g := range groups {
+		a.vals = grow(a.vals, g)
+		cur := a.vals[g]
+		if cur.Type() != nil && !cur.IsNull() {
+			continue
+		}
+		if val := vector.ValueAt(&a.b, vec, uint32(i)); cur.Type() == nil || !val.IsNull() {
+			a.vals[g] = val.Copy()
+		}
+	}
+}
+
+func (a *anyFunc) ConsumeAsPartial(partial vector.Any, groups []uint32) {
+	a.Consume(partial, groups)
+}
+
+func (a *anyFunc) Result(_ *zed.Context, group uint32) zed.Value {
+	if int(group) >= len(a.vals) || a.vals[group].Type() == nil {
+		return zed.Null
+	}
+	return a.vals[group]
+}
+
+func (a *anyFunc) ResultAsPartial(zctx *zed.Context, group uint32) zed.Value {
+	return a.Result(zctx, group)
+}
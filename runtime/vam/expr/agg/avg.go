@@ -0,0 +1,116 @@
+package agg
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
+	"github.com/brimdata/super/zson"
+)
+
+type avg struct {
+	sums   []float64
+	counts []uint64
+	b      zcode.Builder
+}
+
+func (a *avg) add(group uint32, sum float64, count uint64) {
+	a.sums = grow(a.sums, group)
+	a.counts = grow(a.counts, group)
+	a.sums[group] += sum
+	a.counts[group] += count
+}
+
+func (a *avg) Consume(vec vector.Any, groups []uint32) {
+	if d, ok := vec.(*vector.Dynamic); ok {
+		for tag, groups := range dynamicGroups(d, groups) {
+			a.Consume(d.Values[tag], groups)
+		}
+		return
+	}
+	switch id := vec.Type().ID(); {
+	case zed.IsUnsigned(id):
+		for i, g := range groups {
+			if v, null := vector.UintValue(vec, uint32(i)); !null {
+				a.add(g, float64(v), 1)
+			}
+		}
+	case zed.IsSigned(id):
+		for i, g := range groups {
+			if v, null := vector.IntValue(vec, uint32(i)); !null {
+				a.add(g, float64(v), 1)
+			}
+		}
+	case zed.IsFloat(id):
+		for i, g := range groups {
+			if v, null := vector.FloatValue(vec, uint32(i)); !null {
+				a.add(g, v, 1)
+			}
+		}
+	default:
+		for i, g := range groups {
+			val := vector.ValueAt(&a.b, vec, uint32(i))
+			if val.IsNull() {
+				continue
+			}
+			if v, ok := coerce.ToFloat(val); ok {
+				a.add(g, v, 1)
+			}
+		}
+	}
+}
+
+const (
+	sumName   = "sum"
+	countName = "count"
+)
+
+func (a *avg) ConsumeAsPartial(partial vector.Any, groups []uint32) {
+	for i, g := range groups {
+		val := vector.ValueAt(&a.b, partial, uint32(i))
+		sumVal := val.Deref(sumName)
+		if sumVal == nil {
+			panic(errors.New("avg: partial sum is missing"))
+		}
+		if sumVal.Type() != zed.TypeFloat64 {
+			panic(fmt.Errorf("avg: partial sum has bad type: %s", zson.FormatValue(*sumVal)))
+		}
+		countVal := val.Deref(countName)
+		if countVal == nil {
+			panic("avg: partial count is missing")
+		}
+		if countVal.Type() != zed.TypeUint64 {
+			panic(fmt.Errorf("avg: partial count has bad type: %s", zson.FormatValue(*countVal)))
+		}
+		a.add(g, sumVal.Float(), countVal.Uint())
+	}
+}
+
+func (a *avg) state(group uint32) (float64, uint64) {
+	if int(group) >= len(a.counts) {
+		return 0, 0
+	}
+	return a.sums[group], a.counts[group]
+}
+
+func (a *avg) Result(_ *zed.Context, group uint32) zed.Value {
+	if sum, count := a.state(group); count > 0 {
+		return zed.NewFloat64(sum / float64(count))
+	}
+	return zed.NullFloat64
+}
+
+func (a *avg) ResultAsPartial(zctx *zed.Context, group uint32) zed.Value {
+	sum, count := a.state(group)
+	var zv zcode.Bytes
+	zv = zed.NewFloat64(sum).Encode(zv)
+	zv = zed.NewUint64(count).Encode(zv)
+	typ := zctx.MustLookupTypeRecord([]zed.Field{
+		zed.NewField(sumName, zed.TypeFloat64),
+		zed.NewField(countName, zed.TypeUint64),
+	})
+	return zed.NewValue(typ, zv)
+}
@@ -0,0 +1,40 @@
+package agg
+
+import (
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/vector"
+)
+
+type count struct {
+	counts []uint64
+}
+
+func (c *count) Consume(_ vector.Any, groups []uint32) {
+	for _, g := range groups {
+		c.counts = grow(c.counts, g)
+		c.counts[g]++
+	}
+}
+
+func (c *count) ConsumeAsPartial(partial vector.Any, groups []uint32) {
+	if partial.Type() != zed.TypeUint64 {
+		panic("count: partial not uint64")
+	}
+	for i, g := range groups {
+		n, _ := vector.UintValue(partial, uint32(i))
+		c.counts = grow(c.counts, g)
+		c.counts[g] += n
+	}
+}
+
+func (c *count) Result(_ *zed.Context, group uint32) zed.Value {
+	var n uint64
+	if int(group) < len(c.counts) {
+		n = c.counts[group]
+	}
+	return zed.NewUint64(n)
+}
+
+func (c *count) ResultAsPartial(zctx *zed.Context, group uint32) zed.Value {
+	return c.Result(zctx, group)
+}
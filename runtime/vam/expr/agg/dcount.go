@@ -0,0 +1,64 @@
+package agg
+
+import (
+	"fmt"
+
+	"github.com/axiomhq/hyperloglog"
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
+	"github.com/brimdata/super/zson"
+)
+
+// dcount approximates the number of distinct values of each group with a
+// hyperloglog sketch.  Values are encoded as by the sequential dcount so
+// partials from either runtime may be merged.
+type dcount struct {
+	sketches []*hyperloglog.Sketch
+	b        zcode.Builder
+	scratch  []byte
+}
+
+func (d *dcount) sketch(group uint32) *hyperloglog.Sketch {
+	d.sketches = grow(d.sketches, group)
+	if d.sketches[group] == nil {
+		d.sketches[group] = hyperloglog.New()
+	}
+	return d.sketches[group]
+}
+
+func (d *dcount) Consume(vec vector.Any, groups []uint32) {
+	for i, g := range groups {
+		val := vector.ValueAt(&d.b, vec, uint32(i))
+		// Append the type ID so values with the same bytes but
+		// different types are counted separately.
+		d.scratch = zed.AppendInt(d.scratch[:0], int64(val.Type().ID()))
+		d.scratch = append(d.scratch, val.Bytes()...)
+		d.sketch(g).Insert(d.scratch)
+	}
+}
+
+func (d *dcount) ConsumeAsPartial(partial vector.Any, groups []uint32) {
+	if partial.Type() != zed.TypeBytes {
+		panic(fmt.Errorf("dcount: partial has bad type: %s", zson.FormatType(partial.Type())))
+	}
+	for i, g := range groups {
+		var s hyperloglog.Sketch
+		if err := s.UnmarshalBinary(vector.ValueAt(&d.b, partial, uint32(i)).Bytes()); err != nil {
+			panic(fmt.Errorf("dcount: unmarshaling partial: %w", err))
+		}
+		d.sketch(g).Merge(&s)
+	}
+}
+
+func (d *dcount) Result(_ *zed.Context, group uint32) zed.Value {
+	return zed.NewUint64(d.sketch(group).Estimate())
+}
+
+func (d *dcount) ResultAsPartial(_ *zed.Context, group uint32) zed.Value {
+	b, err := d.sketch(group).MarshalBinary()
+	if err != nil {
+		panic(fmt.Errorf("dcount: marshaling partial: %w", err))
+	}
+	return zed.NewBytes(b)
+}
@@ -0,0 +1,68 @@
+package agg
+
+import (
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/vector"
+)
+
+// logical implements and and or.
+type logical struct {
+	name   string
+	and    bool
+	hasval []bool
+	vals   []bool
+}
+
+func (l *logical) Consume(vec vector.Any, groups []uint32) {
+	if d, ok := vec.(*vector.Dynamic); ok {
+		for tag, groups := range dynamicGroups(d, groups) {
+			l.Consume(d.Values[tag], groups)
+		}
+		return
+	}
+	if zed.TypeUnder(vec.Type()) != zed.TypeBool {
+		return
+	}
+	vec = vector.Under(vec)
+	if c, ok := vec.(*vector.Const); ok && c.Value().IsNull() {
+		return
+	}
+	nulls := vector.NullsOf(vec)
+	for i, g := range groups {
+		if !nulls.Value(uint32(i)) {
+			l.update(g, vector.BoolValue(vec, uint32(i)))
+		}
+	}
+}
+
+func (l *logical) update(group uint32, b bool) {
+	l.hasval = grow(l.hasval, group)
+	l.vals = grow(l.vals, group)
+	switch {
+	case !l.hasval[group]:
+		l.hasval[group] = true
+		l.vals[group] = b
+	case l.and:
+		l.vals[group] = l.vals[group] && b
+	default:
+		l.vals[group] = l.vals[group] || b
+	}
+}
+
+func (l *logical) ConsumeAsPartial(partial vector.Any, groups []uint32) {
+	if partial.Type() != zed.TypeBool {
+		panic(l.name + ": partial not a bool")
+	}
+	l.Consume(partial, groups)
+}
+
+func (l *logical) Result(_ *zed.Context, group uint32) zed.Value {
+	if int(group) >= len(l.hasval) || !l.hasval[group] {
+		return zed.NullBool
+	}
+	return zed.NewBool(l.vals[group])
+}
+
+func (l *logical) ResultAsPartial(zctx *zed.Context, group uint32) zed.Value {
+	return l.Result(zctx, group)
+}
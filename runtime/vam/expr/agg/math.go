@@ -0,0 +1,116 @@
+package agg
+
+import (
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/anymath"
+	"github.com/brimdata/super/pkg/nano"
+	samagg "github.com/brimdata/super/runtime/sam/expr/agg"
+	"github.com/brimdata/super/vector"
+)
+
+// mathFunc implements sum, min, and max.  The values of each group in a
+// numeric vector are reduced to a single value that is passed to the group's
+// sequential function, which handles type promotion across vectors.
+type mathFunc struct {
+	valueFunc
+	function *anymath.Function
+
+	// These hold the reductions of the groups in the vector being consumed.
+	states  []mathState
+	touched []uint32
+}
+
+type mathState struct {
+	touched bool
+	hasval  bool
+	int64   int64
+	uint64  uint64
+	float64 float64
+}
+
+func newMathPattern(f *anymath.Function, samPattern samagg.Pattern) Pattern {
+	return func() Func {
+		return &mathFunc{valueFunc: valueFunc{pattern: samPattern}, function: f}
+	}
+}
+
+func (m *mathFunc) Consume(vec vector.Any, groups []uint32) {
+	if d, ok := vec.(*vector.Dynamic); ok {
+		for tag, groups := range dynamicGroups(d, groups) {
+			m.Consume(d.Values[tag], groups)
+		}
+		return
+	}
+	typ := vec.Type()
+	switch id := typ.ID(); {
+	case zed.IsUnsigned(id):
+		for i, g := range groups {
+			s := m.touch(g)
+			if v, null := vector.UintValue(vec, uint32(i)); !null {
+				s.uint64 = m.function.Uint64(s.uint64, v)
+				s.hasval = true
+			}
+		}
+	case zed.IsSigned(id):
+		for i, g := range groups {
+			s := m.touch(g)
+			if v, null := vector.IntValue(vec, uint32(i)); !null {
+				s.int64 = m.function.Int64(s.int64, v)
+				s.hasval = true
+			}
+		}
+	case zed.IsFloat(id):
+		for i, g := range groups {
+			s := m.touch(g)
+			if v, null := vector.FloatValue(vec, uint32(i)); !null {
+				s.float64 = m.function.Float64(s.float64, v)
+				s.hasval = true
+			}
+		}
+	default:
+		// Let the sequential function decide what to do with values
+		// that aren't numbers.
+		m.valueFunc.Consume(vec, groups)
+		return
+	}
+	for _, g := range m.touched {
+		m.fn(g).Consume(m.reduction(typ, &m.states[g]))
+		m.states[g] = mathState{}
+	}
+	m.touched = m.touched[:0]
+}
+
+func (m *mathFunc) touch(group uint32) *mathState {
+	m.states = grow(m.states, group)
+	s := &m.states[group]
+	if !s.touched {
+		*s = mathState{
+			touched: true,
+			int64:   m.function.Init.Int64,
+			uint64:  m.function.Init.Uint64,
+			float64: m.function.Init.Float64,
+		}
+		m.touched = append(m.touched, group)
+	}
+	return s
+}
+
+// reduction returns the value of s for a vector of type typ or a null of
+// type typ if all of the group's values in the vector were null.
+func (m *mathFunc) reduction(typ zed.Type, s *mathState) zed.Value {
+	if !s.hasval {
+		return zed.NewValue(typ, nil)
+	}
+	switch id := typ.ID(); {
+	case zed.IsUnsigned(id):
+		return zed.NewUint64(s.uint64)
+	case id == zed.IDDuration:
+		return zed.NewDuration(nano.Duration(s.int64))
+	case id == zed.IDTime:
+		return zed.NewTime(nano.Ts(s.int64))
+	case zed.IsSigned(id):
+		return zed.NewInt64(s.int64)
+	default:
+		return zed.NewFloat64(s.float64)
+	}
+}
@@ -0,0 +1,53 @@
+package agg
+
+import (
+	"github.com/brimdata/super"
+	samagg "github.com/brimdata/super/runtime/sam/expr/agg"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
+)
+
+// valueFunc adapts a sequential aggregate function by passing each value of
+// a vector in order to an instance of the function for the value's group.
+type valueFunc struct {
+	pattern samagg.Pattern
+	fns     []samagg.Function
+	b       zcode.Builder
+}
+
+func (v *valueFunc) fn(group uint32) samagg.Function {
+	v.fns = grow(v.fns, group)
+	if v.fns[group] == nil {
+		v.fns[group] = v.pattern()
+	}
+	return v.fns[group]
+}
+
+func (v *valueFunc) Consume(vec vector.Any, groups []uint32) {
+	for i, g := range groups {
+		v.fn(g).Consume(vector.ValueAt(&v.b, vec, uint32(i)))
+	}
+}
+
+func (v *valueFunc) ConsumeAsPartial(partial vector.Any, groups []uint32) {
+	for i, g := range groups {
+		v.fn(g).ConsumeAsPartial(vector.ValueAt(&v.b, partial, uint32(i)))
+	}
+}
+
+func (v *valueFunc) Result(zctx *zed.Context, group uint32) zed.Value {
+	return v.fn(group).Result(zctx)
+}
+
+func (v *valueFunc) ResultAsPartial(zctx *zed.Context, group uint32) zed.Value {
+	return v.fn(group).ResultAsPartial(zctx)
+}
+
+// Cleanup releases any resources held by the sequential functions.
+func (v *valueFunc) Cleanup() {
+	for _, fn := range v.fns {
+		if fn != nil {
+			samagg.Cleanup(fn)
+		}
+	}
+}
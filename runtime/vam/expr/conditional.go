@@ -0,0 +1,60 @@
+package expr
+
+import (
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/vector"
+)
+
+type conditional struct {
+	zctx      *zed.Context
+	predicate Evaluator
+	thenExpr  Evaluator
+	elseExpr  Evaluator
+}
+
+func NewConditional(zctx *zed.Context, predicate, thenExpr, elseExpr Evaluator) Evaluator {
+	return &conditional{
+		zctx:      zctx,
+		predicate: predicate,
+		thenExpr:  thenExpr,
+		elseExpr:  elseExpr,
+	}
+}
+
+func (c *conditional) Eval(this vector.Any) vector.Any {
+	predVec := c.predicate.Eval(this)
+	var thenIndex, elseIndex, errIndex []uint32
+	for slot := range this.Len() {
+		switch {
+		case vector.TypeOf(predVec, slot).ID() != zed.IDBool:
+			errIndex = append(errIndex, slot)
+		case vector.BoolValue(predVec, slot):
+			thenIndex = append(thenIndex, slot)
+		default:
+			// Null predicates take the else branch.
+			elseIndex = append(elseIndex, slot)
+		}
+	}
+	if len(thenIndex) == int(this.Len()) {
+		return c.thenExpr.Eval(this)
+	}
+	if len(elseIndex) == int(this.Len()) {
+		return c.elseExpr.Eval(this)
+	}
+	var base vector.Any = vector.NewConst(zed.Null, 0, nil)
+	if len(elseIndex) > 0 {
+		base = c.elseExpr.Eval(vector.NewView(elseIndex, this))
+	}
+	combiner := vector.NewCombiner(base)
+	if len(thenIndex) > 0 {
+		combiner.Add(thenIndex, c.thenExpr.Eval(vector.NewView(thenIndex, this)))
+	}
+	if len(errIndex) > 0 {
+		combiner.Add(errIndex, vector.Apply(false, c.predicateError, vector.NewView(errIndex, predVec)))
+	}
+	return combiner.Result()
+}
+
+func (c *conditional) predicateError(vecs ...vector.Any) vector.Any {
+	return vector.NewWrappedError(c.zctx, "?-operator: bool predicate required", vecs[0])
+}
@@ -46,6 +46,8 @@ func (d *DotExpr) eval(vecs ...vector.Any) vector.Any {
 			return vector.NewMissing(d.zctx, val.Len())
 		}
 		return val.Fields[i]
+	case *vector.View:
+		return vector.NewView(val.Index, d.eval(val.Any))
 	case *vector.TypeValue:
 		panic("vam.DotExpr TypeValue TBD")
 	case *vector.Map:
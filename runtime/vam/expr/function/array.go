@@ -0,0 +1,569 @@
+package function
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/order"
+	samexpr "github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/expr/function"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
+)
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#array_contains
+type ArrayContains struct {
+	zctx *zed.Context
+}
+
+func (a *ArrayContains) Call(args ...vector.Any) vector.Any {
+	indexes, nulls, errVec := indexOf(a.zctx, "array_contains", args)
+	if errVec != nil {
+		return errVec
+	}
+	out := vector.NewBoolEmpty(uint32(len(indexes)), nulls)
+	for i, index := range indexes {
+		if index >= 0 {
+			out.Set(uint32(i))
+		}
+	}
+	return out
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#array_index_of
+type ArrayIndexOf struct {
+	zctx *zed.Context
+}
+
+func (a *ArrayIndexOf) Call(args ...vector.Any) vector.Any {
+	indexes, nulls, errVec := indexOf(a.zctx, "array_index_of", args)
+	if errVec != nil {
+		return errVec
+	}
+	return vector.NewInt(zed.TypeInt64, indexes, nulls)
+}
+
+// indexOf returns for each slot the index of the first element of the list in
+// args[0] equal to the value in args[1] or -1 if there is no such element.
+func indexOf(zctx *zed.Context, name string, args []vector.Any) ([]int64, *vector.Bool, vector.Any) {
+	list, val := listVector(args[0]), args[1]
+	if list == nil {
+		return nil, nil, vector.NewWrappedError(zctx, name+": array or set required for first arg", args[0])
+	}
+	n := list.Len()
+	inner := vector.Inner(list)
+	indexes := make([]int64, n)
+	nulls := vector.NewBoolEmpty(n, nil)
+	var elemBuilder, valBuilder zcode.Builder
+	for i := range n {
+		off, end, null := vector.ContainerOffset(list, i)
+		if null {
+			nulls.Set(i)
+			continue
+		}
+		indexes[i] = -1
+		key := function.NewElemKey(vector.ValueAt(&valBuilder, val, i))
+		for k := off; k < end; k++ {
+			if function.NewElemKey(vector.ValueAt(&elemBuilder, inner, k)) == key {
+				indexes[i] = int64(k - off)
+				break
+			}
+		}
+	}
+	return indexes, nulls, nil
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#array_distinct
+type ArrayDistinct struct {
+	zctx    *zed.Context
+	builder zcode.Builder
+	seen    map[function.ElemKey]struct{}
+}
+
+func (a *ArrayDistinct) Call(args ...vector.Any) vector.Any {
+	list := listVector(args[0])
+	if list == nil {
+		return vector.NewWrappedError(a.zctx, "array_distinct: array or set required", args[0])
+	}
+	if a.seen == nil {
+		a.seen = make(map[function.ElemKey]struct{})
+	}
+	inner := vector.Inner(list)
+	b := newListBuilder(list.Len())
+	for i := range list.Len() {
+		off, end, null := vector.ContainerOffset(list, i)
+		clear(a.seen)
+		for k := off; k < end; k++ {
+			key := function.NewElemKey(vector.ValueAt(&a.builder, inner, k))
+			if _, ok := a.seen[key]; !ok {
+				a.seen[key] = struct{}{}
+				b.append(k)
+			}
+		}
+		b.end(i, null)
+	}
+	return b.build(args[0], list)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#array_sort
+type ArraySort struct {
+	zctx    *zed.Context
+	compare samexpr.CompareFn
+	builder zcode.Builder
+	elems   []zed.Value
+}
+
+func newArraySort(zctx *zed.Context) *ArraySort {
+	return &ArraySort{zctx: zctx, compare: samexpr.NewValueCompareFn(order.Asc, true)}
+}
+
+func (a *ArraySort) Call(args ...vector.Any) vector.Any {
+	list := listVector(args[0])
+	if list == nil {
+		return vector.NewWrappedError(a.zctx, "array_sort: array or set required", args[0])
+	}
+	inner := vector.Inner(list)
+	b := newListBuilder(list.Len())
+	for i := range list.Len() {
+		off, end, null := vector.ContainerOffset(list, i)
+		a.elems = a.elems[:0]
+		for k := off; k < end; k++ {
+			a.elems = append(a.elems, vector.ValueAt(&a.builder, inner, k).Under().Copy())
+			b.append(k)
+		}
+		index := b.index[len(b.index)-len(a.elems):]
+		elems := a.elems
+		slices.SortStableFunc(index, func(x, y uint32) int {
+			return a.compare(elems[x-off], elems[y-off])
+		})
+		b.end(i, null)
+	}
+	return b.build(args[0], list)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#array_reverse
+type ArrayReverse struct {
+	zctx *zed.Context
+}
+
+func (a *ArrayReverse) Call(args ...vector.Any) vector.Any {
+	list := listVector(args[0])
+	if list == nil {
+		return vector.NewWrappedError(a.zctx, "array_reverse: array or set required", args[0])
+	}
+	b := newListBuilder(list.Len())
+	for i := range list.Len() {
+		off, end, null := vector.ContainerOffset(list, i)
+		for k := end; k > off; k-- {
+			b.append(k - 1)
+		}
+		b.end(i, null)
+	}
+	return b.build(args[0], list)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#array_slice
+type ArraySlice struct {
+	zctx *zed.Context
+}
+
+func (a *ArraySlice) Call(args ...vector.Any) vector.Any {
+	list := listVector(args[0])
+	if list == nil {
+		return vector.NewWrappedError(a.zctx, "array_slice: array or set required for first arg", args[0])
+	}
+	indexArgs := underAll(args[1:])
+	for _, arg := range indexArgs {
+		if !zed.IsInteger(arg.Type().ID()) {
+			return vector.NewWrappedError(a.zctx, "array_slice: integer required for index arg", arg)
+		}
+	}
+	b := newListBuilder(list.Len())
+	for i := range list.Len() {
+		off, end, null := vector.ContainerOffset(list, i)
+		n := int(end - off)
+		from, to := 0, n
+		if v, isnull := intAt(indexArgs[0], i); !isnull {
+			from = function.SliceIndex(int(v), n)
+		}
+		if len(indexArgs) > 1 {
+			if v, isnull := intAt(indexArgs[1], i); !isnull {
+				to = function.SliceIndex(int(v), n)
+			}
+		}
+		for k := from; k < to; k++ {
+			b.append(off + uint32(k))
+		}
+		b.end(i, null)
+	}
+	return b.build(args[0], list)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#array_concat
+type ArrayConcat struct {
+	zctx *zed.Context
+}
+
+func (a *ArrayConcat) Call(args ...vector.Any) vector.Any {
+	lists, elems, bases, errVec := concatLists(a.zctx, "array_concat", args)
+	if errVec != nil {
+		return errVec
+	}
+	n := args[0].Len()
+	b := newListBuilder(n)
+	for i := range n {
+		for k, list := range lists {
+			off, end, _ := vector.ContainerOffset(list, i)
+			for slot := off; slot < end; slot++ {
+				b.append(bases[k] + slot)
+			}
+		}
+		b.end(i, false)
+	}
+	return b.buildList(a.zctx.LookupTypeArray(elems.Type()), elems)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#array_flatten
+type ArrayFlatten struct {
+	zctx *zed.Context
+}
+
+func (a *ArrayFlatten) Call(args ...vector.Any) vector.Any {
+	list := listVector(args[0])
+	if list == nil {
+		return vector.NewWrappedError(a.zctx, "array_flatten: array or set required", args[0])
+	}
+	innerType := zed.InnerType(list.Type())
+	typ := function.FlattenType(a.zctx, innerType)
+	// The elements of list are treated as a union with members, which are
+	// drawn from by tags and forward if list has a union element type.
+	members := []vector.Any{vector.Inner(list)}
+	var tags, forward []uint32
+	var nulls *vector.Bool
+	if _, ok := zed.TypeUnder(innerType).(*zed.TypeUnion); ok {
+		union := unionVector(vector.Inner(list))
+		members, tags, forward, nulls = union.Values, union.Tags, union.TagMap.Forward, union.Nulls
+	}
+	// Members that are lists contribute their elements and others themselves.
+	subs := make([]vector.Any, len(members))
+	srcs := make([]vector.Any, 0, len(members)+1)
+	for k, member := range members {
+		if sub := listVector(member); sub != nil {
+			subs[k] = sub
+			member = vector.Inner(sub)
+		}
+		srcs = append(srcs, member)
+	}
+	if tags != nil {
+		// Add a source for null union elements.
+		srcs = append(srcs, vector.NewConst(zed.NewValue(typ, nil), 1, nil))
+	}
+	elems, bases := concatElems(typ, srcs)
+	b := newListBuilder(list.Len())
+	for i := range list.Len() {
+		off, end, null := vector.ContainerOffset(list, i)
+		for k := off; k < end; k++ {
+			tag, slot := uint32(0), k
+			if tags != nil {
+				if nulls.Value(k) {
+					b.append(bases[len(members)])
+					continue
+				}
+				tag, slot = tags[k], forward[k]
+			}
+			if sub := subs[tag]; sub != nil {
+				subOff, subEnd, _ := vector.ContainerOffset(sub, slot)
+				for j := subOff; j < subEnd; j++ {
+					b.append(bases[tag] + j)
+				}
+			} else {
+				b.append(bases[tag] + slot)
+			}
+		}
+		b.end(i, null)
+	}
+	return b.buildList(a.zctx.LookupTypeArray(typ), elems)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#set_union
+type SetUnion struct {
+	zctx    *zed.Context
+	builder zcode.Builder
+	seen    map[function.ElemKey]struct{}
+}
+
+func newSetUnion(zctx *zed.Context) *SetUnion {
+	return &SetUnion{zctx: zctx, seen: make(map[function.ElemKey]struct{})}
+}
+
+func (s *SetUnion) Call(args ...vector.Any) vector.Any {
+	lists, elems, bases, errVec := concatLists(s.zctx, "set_union", args)
+	if errVec != nil {
+		return errVec
+	}
+	n := args[0].Len()
+	b := newListBuilder(n)
+	for i := range n {
+		clear(s.seen)
+		start := len(b.index)
+		for k, list := range lists {
+			off, end, _ := vector.ContainerOffset(list, i)
+			for slot := bases[k] + off; slot < bases[k]+end; slot++ {
+				key := function.NewElemKey(vector.ValueAt(&s.builder, elems, slot))
+				if _, ok := s.seen[key]; !ok {
+					s.seen[key] = struct{}{}
+					b.append(slot)
+				}
+			}
+		}
+		b.sortSet(start, elems)
+		b.end(i, false)
+	}
+	return b.buildList(s.zctx.LookupTypeSet(elems.Type()), elems)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#set_intersect
+type SetIntersect struct {
+	zctx       *zed.Context
+	name       string
+	difference bool
+	builder    zcode.Builder
+	elems      map[function.ElemKey]struct{}
+	seen       map[function.ElemKey]struct{}
+}
+
+func newSetIntersect(zctx *zed.Context, name string, difference bool) *SetIntersect {
+	return &SetIntersect{
+		zctx:       zctx,
+		name:       name,
+		difference: difference,
+		elems:      make(map[function.ElemKey]struct{}),
+		seen:       make(map[function.ElemKey]struct{}),
+	}
+}
+
+func (s *SetIntersect) Call(args ...vector.Any) vector.Any {
+	a, b := listVector(args[0]), listVector(args[1])
+	for k, list := range []vector.Any{a, b} {
+		if list == nil {
+			return vector.NewWrappedError(s.zctx, s.name+": array or set args required", args[k])
+		}
+	}
+	aInner, bInner := vector.Inner(a), vector.Inner(b)
+	out := newListBuilder(a.Len())
+	for i := range a.Len() {
+		aOff, aEnd, aNull := vector.ContainerOffset(a, i)
+		bOff, bEnd, bNull := vector.ContainerOffset(b, i)
+		if aNull || bNull {
+			out.end(i, true)
+			continue
+		}
+		clear(s.elems)
+		for k := bOff; k < bEnd; k++ {
+			s.elems[function.NewElemKey(vector.ValueAt(&s.builder, bInner, k))] = struct{}{}
+		}
+		clear(s.seen)
+		start := len(out.index)
+		for k := aOff; k < aEnd; k++ {
+			key := function.NewElemKey(vector.ValueAt(&s.builder, aInner, k))
+			if _, ok := s.seen[key]; ok {
+				continue
+			}
+			s.seen[key] = struct{}{}
+			if _, ok := s.elems[key]; ok != s.difference {
+				out.append(k)
+			}
+		}
+		out.sortSet(start, aInner)
+		out.end(i, false)
+	}
+	return out.buildList(s.zctx.LookupTypeSet(zed.InnerType(a.Type())), aInner)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#zip
+type Zip struct {
+	zctx *zed.Context
+}
+
+func (z *Zip) Call(args ...vector.Any) vector.Any {
+	left, right := listVector(args[0]), listVector(args[1])
+	for k, list := range []vector.Any{left, right} {
+		if list == nil {
+			return vector.NewWrappedError(z.zctx, "zip: array or set args required", args[k])
+		}
+	}
+	recType := z.zctx.MustLookupTypeRecord([]zed.Field{
+		zed.NewField("left", zed.InnerType(left.Type())),
+		zed.NewField("right", zed.InnerType(right.Type())),
+	})
+	// b holds the offsets and nulls of the result and the index of the
+	// left elements.
+	b := newListBuilder(left.Len())
+	var rightIndex []uint32
+	for i := range left.Len() {
+		lOff, lEnd, lNull := vector.ContainerOffset(left, i)
+		rOff, rEnd, rNull := vector.ContainerOffset(right, i)
+		if !lNull && !rNull {
+			for k := range min(lEnd-lOff, rEnd-rOff) {
+				b.append(lOff + k)
+				rightIndex = append(rightIndex, rOff+k)
+			}
+		}
+		b.end(i, lNull || rNull)
+	}
+	fields := []vector.Any{
+		vector.NewView(b.index, vector.Inner(left)),
+		vector.NewView(rightIndex, vector.Inner(right)),
+	}
+	rec := vector.NewRecord(recType, fields, uint32(len(rightIndex)), nil)
+	return vector.NewArray(z.zctx.LookupTypeArray(recType), b.offsets, rec, b.nulls)
+}
+
+// concatLists returns the list vectors in args, a vector holding all of
+// their elements converted to the element type of a list formed from them,
+// and the offset of the elements of each list in that vector.  If an arg is
+// not a list, it returns an error vector.
+func concatLists(zctx *zed.Context, name string, args []vector.Any) ([]vector.Any, vector.Any, []uint32, vector.Any) {
+	lists := make([]vector.Any, 0, len(args))
+	inners := make([]vector.Any, 0, len(args))
+	types := make([]zed.Type, 0, len(args))
+	for _, arg := range args {
+		list := listVector(arg)
+		if list == nil {
+			return nil, nil, nil, vector.NewWrappedError(zctx, name+": array or set args required", arg)
+		}
+		lists = append(lists, list)
+		inners = append(inners, vector.Inner(list))
+		types = append(types, zed.InnerType(list.Type()))
+	}
+	elems, bases := concatElems(function.ElemType(zctx, types), inners)
+	return lists, elems, bases, nil
+}
+
+// concatElems returns a vector of type typ holding the values of vecs, each
+// converted to typ as by function.AppendElem, and the offset of the values of
+// each of vecs in the result.
+func concatElems(typ zed.Type, vecs []vector.Any) (vector.Any, []uint32) {
+	bases := make([]uint32, len(vecs))
+	if len(vecs) == 1 && vecs[0].Type() == typ {
+		return vecs[0], bases
+	}
+	var n uint32
+	for k, vec := range vecs {
+		bases[k] = n
+		n += vec.Len()
+	}
+	if n == 0 {
+		return vector.NewEmpty(typ), bases
+	}
+	out := vector.NewDynamicBuilder()
+	var valBuilder, elemBuilder zcode.Builder
+	for _, vec := range vecs {
+		from := vec.Type()
+		for slot := range vec.Len() {
+			elemBuilder.Reset()
+			function.AppendElem(&elemBuilder, typ, from, vector.ValueAt(&valBuilder, vec, slot).Bytes())
+			out.Write(zed.NewValue(typ, elemBuilder.Bytes().Body()))
+		}
+	}
+	return out.Build(), bases
+}
+
+// unionVector returns vec, which has a union type, as a *vector.Union.
+func unionVector(vec vector.Any) *vector.Union {
+	vec = vector.Under(vec)
+	if union, ok := vec.(*vector.Union); ok {
+		return union
+	}
+	return materialize(vec).(*vector.Union)
+}
+
+// listVector returns vec as a vector accepted by vector.ContainerOffset and
+// vector.Inner if it is an array or set and nil otherwise.
+func listVector(vec vector.Any) vector.Any {
+	vec = vector.Under(vec)
+	switch vec.Type().(type) {
+	case *zed.TypeArray, *zed.TypeSet:
+	default:
+		return nil
+	}
+	switch vec.(type) {
+	case *vector.Array, *vector.Set, *vector.View:
+		return vec
+	}
+	return materialize(vec)
+}
+
+// listBuilder builds a list vector whose elements are selected by index from
+// the elements of another list vector.
+type listBuilder struct {
+	offsets []uint32
+	index   []uint32
+	nulls   *vector.Bool
+}
+
+func newListBuilder(n uint32) *listBuilder {
+	return &listBuilder{
+		offsets: make([]uint32, 1, n+1),
+		nulls:   vector.NewBoolEmpty(n, nil),
+	}
+}
+
+func (l *listBuilder) append(slot uint32) {
+	l.index = append(l.index, slot)
+}
+
+// end completes the list in slot i, which is null if null is true.
+func (l *listBuilder) end(i uint32, null bool) {
+	if null {
+		l.nulls.Set(i)
+	}
+	l.offsets = append(l.offsets, uint32(len(l.index)))
+}
+
+// sortSet sorts the elements of the list under construction from position
+// start, which are drawn from elems, in the order of zed.NormalizeSet.
+func (l *listBuilder) sortSet(start int, elems vector.Any) {
+	index := l.index[start:]
+	if len(index) < 2 {
+		return
+	}
+	type setElem struct {
+		slot  uint32
+		bytes string
+	}
+	var b zcode.Builder
+	sorted := make([]setElem, 0, len(index))
+	for _, slot := range index {
+		b.Reset()
+		elems.Serialize(&b, slot)
+		sorted = append(sorted, setElem{slot, string(b.Bytes())})
+	}
+	slices.SortFunc(sorted, func(x, y setElem) int {
+		return strings.Compare(x.bytes, y.bytes)
+	})
+	for k, elem := range sorted {
+		index[k] = elem.slot
+	}
+}
+
+// build returns a vector with the type of vec whose elements are drawn from
+// list, which is vec as returned by listVector.
+func (l *listBuilder) build(vec, list vector.Any) vector.Any {
+	out := l.buildList(list.Type(), vector.Inner(list))
+	if named, ok := vec.Type().(*zed.TypeNamed); ok {
+		return vector.NewNamed(named, out)
+	}
+	return out
+}
+
+// buildList returns a vector of typ, an array or set type, whose elements are
+// drawn from elems.
+func (l *listBuilder) buildList(typ zed.Type, elems vector.Any) vector.Any {
+	values := vector.NewView(l.index, elems)
+	if typ, ok := typ.(*zed.TypeSet); ok {
+		return vector.NewSet(typ, l.offsets, values, l.nulls)
+	}
+	return vector.NewArray(typ.(*zed.TypeArray), l.offsets, values, l.nulls)
+}
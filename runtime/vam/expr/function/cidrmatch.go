@@ -0,0 +1,49 @@
+package function
+
+import (
+	"errors"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
+)
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#cidr_match
+type CIDRMatch struct {
+	zctx *zed.Context
+}
+
+var errMatch = errors.New("match")
+
+func (c *CIDRMatch) Call(args ...vector.Any) vector.Any {
+	maskVec := vector.Under(args[0])
+	if maskVec.Type().ID() != zed.IDNet {
+		return vector.NewWrappedError(c.zctx, "cidr_match: not a net", maskVec)
+	}
+	vec := vector.Under(args[1])
+	out := vector.NewBoolEmpty(vec.Len(), nil)
+	if vec.Type().ID() == zed.IDIP {
+		// Fast path
+		for i := range vec.Len() {
+			prefix, _ := vector.NetValue(maskVec, i)
+			if ip, null := vector.IPValue(vec, i); !null && prefix.Contains(ip) {
+				out.Set(i)
+			}
+		}
+		return out
+	}
+	var b zcode.Builder
+	for i := range vec.Len() {
+		prefix, _ := vector.NetValue(maskVec, i)
+		err := vector.ValueAt(&b, vec, i).Walk(func(typ zed.Type, body zcode.Bytes) error {
+			if typ.ID() == zed.IDIP && prefix.Contains(zed.DecodeIP(body)) {
+				return errMatch
+			}
+			return nil
+		})
+		if err == errMatch {
+			out.Set(i)
+		}
+	}
+	return out
+}
@@ -0,0 +1,48 @@
+package function
+
+import (
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/runtime/vam/expr"
+	"github.com/brimdata/super/vector"
+)
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#compare
+type Compare struct {
+	zctx     *zed.Context
+	nullsMax *expr.Comparator
+	nullsMin *expr.Comparator
+}
+
+func NewCompare(zctx *zed.Context) *Compare {
+	return &Compare{
+		zctx:     zctx,
+		nullsMax: expr.NewComparator(order.Asc, true),
+		nullsMin: expr.NewComparator(order.Asc, false),
+	}
+}
+
+func (c *Compare) Call(args ...vector.Any) vector.Any {
+	a, b := args[0], args[1]
+	var nullsMaxVec vector.Any
+	if len(args) == 3 {
+		nullsMaxVec = vector.Under(args[2])
+		if nullsMaxVec.Type() != zed.TypeBool {
+			return vector.NewWrappedError(c.zctx, "compare: nullsMax arg is not bool", args[2])
+		}
+	}
+	n := a.Len()
+	out := vector.NewIntEmpty(zed.TypeInt64, n, nil)
+	for i := range n {
+		cmp := c.nullsMax
+		if nullsMaxVec != nil && !vector.BoolValue(nullsMaxVec, i) {
+			cmp = c.nullsMin
+		}
+		out.Append(int64(cmp.Compare(a, i, b, i)))
+	}
+	return out
+}
+
+func (*Compare) RipUnions() bool {
+	return false
+}
@@ -0,0 +1,180 @@
+package function
+
+import (
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
+)
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#flatten
+type Flatten struct {
+	zctx       *zed.Context
+	keyType    zed.Type
+	entryTypes map[zed.Type]zed.Type
+	builder    zcode.Builder
+
+	// These exist only to reduce memory allocations.
+	leaves []*flattenNode
+	sig    []byte
+	types  []zed.Type
+}
+
+func newFlatten(zctx *zed.Context) *Flatten {
+	return &Flatten{
+		zctx:       zctx,
+		keyType:    zctx.LookupTypeArray(zed.TypeString),
+		entryTypes: make(map[zed.Type]zed.Type),
+	}
+}
+
+// flattenNode is a field of the record being flattened.
+type flattenNode struct {
+	key    zcode.Bytes // body of the field's path as a [string]
+	typ    zed.Type
+	vec    vector.Any
+	rec    *vector.Record // vec if it is a record and nil otherwise
+	fields []*flattenNode
+}
+
+// flattenType is the type of the array of entries flattened from a record
+// whose nested records are null in the same places.
+type flattenType struct {
+	typ   *zed.TypeArray
+	union *zed.TypeUnion
+	tags  []int // union tag for each leaf
+}
+
+func (f *Flatten) Call(args ...vector.Any) vector.Any {
+	vec := args[0]
+	rec := recordVector(vec)
+	if rec == nil {
+		return vec
+	}
+	root := f.newNode(nil, rec.Typ, rec)
+	// The leaves of a record, and thus the type of its array of entries,
+	// depend on which of its nested records are null, so the types are
+	// cached by a signature of the nested records' nulls.
+	types := make(map[string]*flattenType)
+	out := vector.NewDynamicBuilder()
+	for i := range rec.Len() {
+		null := rec.Nulls.Value(i)
+		f.leaves, f.sig = f.appendLeaves(f.leaves[:0], f.sig[:0], root, i, !null)
+		typ, ok := types[string(f.sig)]
+		if !ok {
+			typ = f.typeOf(f.leaves)
+			types[string(f.sig)] = typ
+		}
+		if null {
+			out.Write(zed.NewValue(typ.typ, nil))
+			continue
+		}
+		b := &f.builder
+		b.Reset()
+		for k, leaf := range f.leaves {
+			if typ.union != nil {
+				b.BeginContainer()
+				b.Append(zed.EncodeInt(int64(typ.tags[k])))
+			}
+			b.BeginContainer()
+			b.Append(leaf.key)
+			leaf.vec.Serialize(b, i)
+			b.EndContainer()
+			if typ.union != nil {
+				b.EndContainer()
+			}
+		}
+		out.Write(zed.NewValue(typ.typ, b.Bytes()))
+	}
+	return out.Build()
+}
+
+func (f *Flatten) newNode(path []string, typ zed.Type, vec vector.Any) *flattenNode {
+	var b zcode.Builder
+	for _, name := range path {
+		b.Append(zed.EncodeString(name))
+	}
+	node := &flattenNode{key: b.Bytes(), typ: typ, vec: vec}
+	if zed.TypeRecordOf(typ) == nil {
+		return node
+	}
+	node.rec = recordVector(vec)
+	for k, field := range node.rec.Typ.Fields {
+		child := f.newNode(append(path[:len(path):len(path)], field.Name), field.Type, node.rec.Fields[k])
+		node.fields = append(node.fields, child)
+	}
+	return node
+}
+
+// appendLeaves appends the leaves of node at slot to leaves and the nulls of
+// its nested records to sig.  If descend is false, nested records are leaves.
+func (f *Flatten) appendLeaves(leaves []*flattenNode, sig []byte, node *flattenNode, slot uint32, descend bool) ([]*flattenNode, []byte) {
+	for _, field := range node.fields {
+		if field.rec != nil && descend {
+			if !field.rec.Nulls.Value(slot) {
+				sig = append(sig, 1)
+				leaves, sig = f.appendLeaves(leaves, sig, field, slot, true)
+				continue
+			}
+			sig = append(sig, 0)
+		}
+		leaves = append(leaves, field)
+	}
+	return leaves, sig
+}
+
+func (f *Flatten) typeOf(leaves []*flattenNode) *flattenType {
+	f.types = f.types[:0]
+	for _, leaf := range leaves {
+		typ, ok := f.entryTypes[leaf.typ]
+		if !ok {
+			typ = f.zctx.MustLookupTypeRecord([]zed.Field{
+				zed.NewField("key", f.keyType),
+				zed.NewField("value", leaf.typ),
+			})
+			f.entryTypes[leaf.typ] = typ
+		}
+		f.types = append(f.types, typ)
+	}
+	unique := zed.UniqueTypes(f.types)
+	if len(unique) == 1 {
+		return &flattenType{typ: f.zctx.LookupTypeArray(unique[0])}
+	}
+	union := f.zctx.LookupTypeUnion(unique)
+	tags := make([]int, len(leaves))
+	for k := range leaves {
+		tags[k] = union.TagOf(f.entryTypes[leaves[k].typ])
+	}
+	return &flattenType{typ: f.zctx.LookupTypeArray(union), union: union, tags: tags}
+}
+
+// recordVector returns vec as a *vector.Record if it is a record and nil
+// otherwise.
+func recordVector(vec vector.Any) *vector.Record {
+	vec = vector.Under(vec)
+	typ, ok := vec.Type().(*zed.TypeRecord)
+	if !ok {
+		return nil
+	}
+	switch vec := vec.(type) {
+	case *vector.Record:
+		return vec
+	case *vector.View:
+		if rec, ok := vec.Any.(*vector.Record); ok {
+			fields := make([]vector.Any, 0, len(rec.Fields))
+			for _, f := range rec.Fields {
+				fields = append(fields, vector.NewView(vec.Index, f))
+			}
+			var nulls *vector.Bool
+			if rec.Nulls != nil {
+				nulls = vector.NewBoolEmpty(vec.Len(), nil)
+				for k, slot := range vec.Index {
+					if rec.Nulls.Value(slot) {
+						nulls.Set(uint32(k))
+					}
+				}
+			}
+			return vector.NewRecord(typ, fields, vec.Len(), nulls)
+		}
+	}
+	return materialize(vec).(*vector.Record)
+}
@@ -4,10 +4,12 @@ import (
 	"slices"
 
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/anymath"
 	"github.com/brimdata/super/pkg/field"
 	"github.com/brimdata/super/runtime/sam/expr/function"
 	"github.com/brimdata/super/runtime/vam/expr"
 	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
 )
 
 func New(zctx *zed.Context, name string, narg int) (expr.Function, field.Path, error) {
@@ -16,49 +18,225 @@ func New(zctx *zed.Context, name string, narg int) (expr.Function, field.Path, e
 	var path field.Path
 	var f expr.Function
 	switch name {
+	case "abs":
+		f = &Abs{zctx}
+	case "array_concat":
+		argmax = -1
+		f = &ArrayConcat{zctx}
+	case "array_contains":
+		argmin, argmax = 2, 2
+		f = &ArrayContains{zctx}
+	case "array_distinct":
+		f = &ArrayDistinct{zctx: zctx}
+	case "array_flatten":
+		f = &ArrayFlatten{zctx}
+	case "array_index_of":
+		argmin, argmax = 2, 2
+		f = &ArrayIndexOf{zctx}
+	case "array_reverse":
+		f = &ArrayReverse{zctx}
+	case "array_slice":
+		argmin, argmax = 2, 3
+		f = &ArraySlice{zctx}
+	case "array_sort":
+		f = newArraySort(zctx)
+	case "asn":
+		argmax = 2
+		f = newASN(zctx, function.ASNDatabase)
 	case "base64":
 		f = &Base64{zctx}
+	case "bucket":
+		argmin, argmax = 2, 2
+		f = &Bucket{name: name, zctx: zctx}
+	case "ceil":
+		f = &Ceil{zctx}
+	case "cidr_match":
+		argmin, argmax = 2, 2
+		f = &CIDRMatch{zctx}
 	case "coalesce":
 		argmax = -1
 		f = &Coalesce{}
+	case "compare":
+		argmin, argmax = 2, 3
+		f = NewCompare(zctx)
+	case "date_add":
+		argmin, argmax = 3, 4
+		f = &DateAdd{zctx: zctx}
+	case "date_diff":
+		argmin, argmax = 3, 4
+		f = &DateDiff{zctx: zctx}
+	case "date_part":
+		argmin, argmax = 2, 3
+		f = &DatePart{zctx: zctx}
+	case "date_trunc":
+		argmin, argmax = 2, 3
+		f = &DateTrunc{zctx: zctx}
+	case "domain_parts":
+		f = &DomainParts{zctx}
+	case "error":
+		f = &Error{zctx}
+	case "every":
+		path = field.Path{"ts"}
+		f = &Bucket{name: name, zctx: zctx}
 	case "fields":
 		f = NewFields(zctx)
+	case "flatten":
+		f = newFlatten(zctx)
+	case "floor":
+		f = &Floor{zctx}
+	case "fnv", "md5", "sha1", "sha256", "xxhash":
+		f = newHash(name)
+	case "geoip":
+		argmax = 2
+		f = newGeoIP(zctx, function.GeoIPDatabase)
+	case "grep":
+		argmin, argmax = 2, 2
+		f = &Grep{zctx: zctx}
+	case "grok":
+		argmin, argmax = 2, 3
+		f = newGrok(zctx)
+	case "has":
+		argmax = -1
+		f = &Has{}
+	case "has_error":
+		f = NewHasError()
 	case "hex":
 		f = &Hex{zctx}
+	case "is":
+		argmax = 2
+		path = field.Path{}
+		f = &Is{zctx}
+	case "is_error":
+		f = &IsErr{}
 	case "join":
 		argmax = 2
 		f = &Join{zctx: zctx}
+	case "json_extract":
+		argmin, argmax = 2, 2
+		f = newJSONExtract(zctx)
 	case "kind":
 		f = &Kind{zctx: zctx}
+	case "ksuid":
+		argmin = 0
+		f = &KSUIDToString{zctx}
+		if narg == 0 {
+			path = field.Path{}
+			f = &KSUIDNew{}
+		}
 	case "len":
 		f = &Len{zctx}
 	case "levenshtein":
 		argmin, argmax = 2, 2
 		f = &Levenshtein{zctx}
+	case "log":
+		f = &Log{zctx}
 	case "lower":
 		f = &ToLower{zctx}
+	case "map_entries":
+		f = &MapEntries{zctx}
+	case "map_from_entries":
+		f = newMapFromEntries(zctx)
+	case "map_keys":
+		f = &MapKeys{zctx}
+	case "map_merge":
+		argmax = -1
+		f = newMapMerge(zctx)
+	case "map_values":
+		f = &MapValues{zctx}
+	case "max":
+		argmax = -1
+		f = &reducer{zctx: zctx, name: name, fn: anymath.Max}
+	case "min":
+		argmax = -1
+		f = &reducer{zctx: zctx, name: name, fn: anymath.Min}
+	case "missing":
+		argmax = -1
+		f = &Has{missing: true}
+	case "nameof":
+		f = &NameOf{zctx}
+	case "nest_dotted":
+		path = field.Path{}
+		argmin = 0
+		f = &NestDotted{zctx}
 	case "network_of":
 		argmax = 2
 		f = &NetworkOf{zctx}
+	case "now":
+		argmin, argmax = 0, 0
+		path = field.Path{}
+		f = &Now{}
+	case "parse_json":
+		f = newParseJSON(zctx)
+	case "parse_query":
+		f = newParseQuery(zctx)
+	case "parse_uri":
+		f = newParseURI(zctx)
+	case "parse_zson":
+		f = newParseZSON(zctx)
+	case "pow":
+		argmin, argmax = 2, 2
+		f = &Pow{zctx}
 	case "quiet":
 		f = &Quiet{zctx}
+	case "regexp":
+		argmin, argmax = 2, 2
+		f = &Regexp{zctx: zctx}
+	case "regexp_replace":
+		argmin, argmax = 3, 3
+		f = &RegexpReplace{zctx: zctx}
 	case "replace":
 		argmin, argmax = 3, 3
 		f = &Replace{zctx}
+	case "round":
+		f = &Round{zctx}
 	case "rune_len":
 		f = &RuneLen{zctx}
+	case "set_difference":
+		argmin, argmax = 2, 2
+		f = newSetIntersect(zctx, name, true)
+	case "set_intersect":
+		argmin, argmax = 2, 2
+		f = newSetIntersect(zctx, name, false)
+	case "set_union":
+		argmax = -1
+		f = newSetUnion(zctx)
 	case "split":
 		argmin, argmax = 2, 2
 		f = &Split{zctx}
+	case "sqrt":
+		f = &Sqrt{zctx}
 	case "strftime":
 		argmin, argmax = 2, 2
 		f = &Strftime{zctx: zctx}
+	case "strptime":
+		argmin, argmax = 2, 3
+		f = &Strptime{zctx: zctx}
+	case "to_json":
+		f = newToJSON(zctx)
+	case "to_zson":
+		f = newToZSON()
 	case "trim":
 		f = &Trim{zctx}
+	case "typename":
+		argmax = 2
+		f = &TypeName{zctx}
 	case "typeof":
 		f = &TypeOf{zctx}
+	case "typeunder":
+		f = &TypeUnder{zctx}
+	case "under":
+		f = &Under{zctx}
+	case "unflatten":
+		f = newUnflatten(zctx)
 	case "upper":
 		f = &ToUpper{zctx}
+	case "url_decode":
+		f = &URLDecode{zctx}
+	case "url_encode":
+		f = &URLEncode{zctx}
+	case "zip":
+		argmin, argmax = 2, 2
+		f = &Zip{zctx}
 	default:
 		return nil, nil, function.ErrNoSuchFunction
 	}
@@ -75,3 +253,18 @@ func underAll(args []vector.Any) []vector.Any {
 	}
 	return out
 }
+
+// materialize returns a vector built from the values of vec, which must all
+// have the same type.  It is used to flatten constants, dictionaries, and
+// views into the vector type of vec's values.
+func materialize(vec vector.Any) vector.Any {
+	if vec.Len() == 0 {
+		return vector.NewEmpty(vec.Type())
+	}
+	var b zcode.Builder
+	out := vector.NewDynamicBuilder()
+	for slot := range vec.Len() {
+		out.Write(vector.ValueAt(&b, vec, slot))
+	}
+	return out.Build()
+}
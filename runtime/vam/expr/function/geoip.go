@@ -0,0 +1,73 @@
+package function
+
+import (
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr/function"
+	"github.com/brimdata/super/runtime/vam/expr"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
+)
+
+// mmdbFunc is a function that looks up IP addresses in a MaxMind DB file.
+type mmdbFunc struct {
+	zctx    *zed.Context
+	name    string
+	db      *function.MMDB
+	value   func(any) zed.Value
+	builder zcode.Builder
+}
+
+// NewMMDBFunction returns the geoip or asn function that uses the database
+// at path, which is the constant path argument of the call.
+func NewMMDBFunction(zctx *zed.Context, name, path string) expr.Function {
+	if name == "asn" {
+		return newASN(zctx, path)
+	}
+	return newGeoIP(zctx, path)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#geoip
+func newGeoIP(zctx *zed.Context, path string) *mmdbFunc {
+	return &mmdbFunc{
+		zctx:  zctx,
+		name:  "geoip",
+		db:    function.NewMMDB("geoip", path),
+		value: function.NewGeoIP(zctx, path).Value,
+	}
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#asn
+func newASN(zctx *zed.Context, path string) *mmdbFunc {
+	return &mmdbFunc{
+		zctx:  zctx,
+		name:  "asn",
+		db:    function.NewMMDB("asn", path),
+		value: function.NewASN(zctx, path).Value,
+	}
+}
+
+func (m *mmdbFunc) Call(args ...vector.Any) vector.Any {
+	ipVec := vector.Under(args[0])
+	if ipVec.Type() != zed.TypeIP {
+		return vector.NewWrappedError(m.zctx, m.name+": IP arg required", args[0])
+	}
+	db, err := m.db.Open()
+	if err != nil {
+		return vector.NewWrappedError(m.zctx, m.name+": "+err.Error(), args[0])
+	}
+	out := vector.NewDynamicBuilder()
+	for i := range ipVec.Len() {
+		addr, null := vector.IPValue(ipVec, i)
+		if null {
+			out.Write(m.value(nil))
+			continue
+		}
+		data, err := db.Lookup(addr)
+		if err != nil {
+			out.Write(m.zctx.WrapError(m.name+": "+err.Error(), vector.ValueAt(&m.builder, args[0], i)))
+			continue
+		}
+		out.Write(m.value(data))
+	}
+	return out.Build()
+}
@@ -0,0 +1,60 @@
+package function
+
+import (
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/vam/expr"
+	"github.com/brimdata/super/vector"
+	"golang.org/x/text/unicode/norm"
+)
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#grep
+type Grep struct {
+	zctx    *zed.Context
+	grep    expr.Evaluator
+	pattern string
+}
+
+func (g *Grep) Call(args ...vector.Any) vector.Any {
+	patternVec, inputVec := vector.Under(args[0]), args[1]
+	if patternVec.Type() != zed.TypeString {
+		return vector.NewWrappedError(g.zctx, "grep(): pattern argument must be a string", args[0])
+	}
+	if c, ok := patternVec.(*vector.Const); ok {
+		pattern, _ := c.AsString()
+		return g.search(pattern).Eval(inputVec)
+	}
+	// Search the slots for each pattern together.
+	n := patternVec.Len()
+	var patterns []string
+	indexes := make(map[string][]uint32)
+	for i := range n {
+		pattern, _ := vector.StringValue(patternVec, i)
+		if _, ok := indexes[pattern]; !ok {
+			patterns = append(patterns, pattern)
+		}
+		indexes[pattern] = append(indexes[pattern], i)
+	}
+	out := vector.NewBoolEmpty(n, nil)
+	for _, pattern := range patterns {
+		index := indexes[pattern]
+		match := g.search(pattern).Eval(vector.NewView(index, inputVec)).(*vector.Bool)
+		for k, slot := range index {
+			if match.Value(uint32(k)) {
+				out.Set(slot)
+			}
+		}
+	}
+	return out
+}
+
+func (g *Grep) search(pattern string) expr.Evaluator {
+	if g.grep == nil || g.pattern != pattern {
+		g.pattern = pattern
+		g.grep = expr.NewSearchString(norm.NFC.String(pattern), &expr.This{})
+	}
+	return g.grep
+}
+
+func (*Grep) RipUnions() bool {
+	return false
+}
@@ -0,0 +1,79 @@
+package function
+
+import (
+	"errors"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr/function"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
+)
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#grok
+type Grok struct {
+	zctx    *zed.Context
+	builder zcode.Builder
+	hosts   function.GrokHosts
+	// fields is used as a scratch space to avoid allocating a new slice.
+	fields []zed.Field
+}
+
+func newGrok(zctx *zed.Context) *Grok {
+	return &Grok{
+		zctx:  zctx,
+		hosts: make(function.GrokHosts),
+	}
+}
+
+func (g *Grok) Call(args ...vector.Any) vector.Any {
+	args = underAll(args)
+	patternVec, inputVec := args[0], args[1]
+	var defVec vector.Any
+	if len(args) == 3 {
+		defVec = args[2]
+	}
+	switch {
+	case defVec != nil && defVec.Type() != zed.TypeString:
+		return vector.NewWrappedError(g.zctx, "grok(): definitions argument must be a string", defVec)
+	case patternVec.Type() != zed.TypeString:
+		return vector.NewWrappedError(g.zctx, "grok(): pattern argument must be a string", patternVec)
+	case inputVec.Type() != zed.TypeString:
+		return vector.NewWrappedError(g.zctx, "grok(): input argument must be a string", inputVec)
+	}
+	errs := newSlotErrors(g.zctx, "grok()", args)
+	out := vector.NewDynamicBuilder()
+	for i := range inputVec.Len() {
+		var defs string
+		if defVec != nil {
+			defs, _ = vector.StringValue(defVec, i)
+		}
+		h, err := g.hosts.Lookup(defs)
+		if err != nil {
+			errs.add(2, i, err)
+			continue
+		}
+		pattern, _ := vector.StringValue(patternVec, i)
+		p, err := h.Pattern(pattern)
+		if err != nil {
+			errs.add(0, i, err)
+			continue
+		}
+		input, _ := vector.StringValue(inputVec, i)
+		keys, vals := p.ParseKeyValues(input)
+		if vals == nil {
+			errs.add(1, i, errors.New("value does not match pattern"))
+			continue
+		}
+		g.fields = g.fields[:0]
+		for _, key := range keys {
+			g.fields = append(g.fields, zed.NewField(key, zed.TypeString))
+		}
+		typ := g.zctx.MustLookupTypeRecord(g.fields)
+		g.builder.Reset()
+		for _, s := range vals {
+			g.builder.Append([]byte(s))
+		}
+		out.Write(zed.NewValue(typ, g.builder.Bytes()))
+	}
+	return errs.combine(out.Build())
+}
@@ -0,0 +1,59 @@
+package function
+
+import (
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/vector"
+)
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#has
+type Has struct {
+	missing bool
+}
+
+func (h *Has) Call(args ...vector.Any) vector.Any {
+	n := args[0].Len()
+	// Each slot's result is taken from the first argument that is an error.
+	// Missing and quiet errors yield false and other errors are returned.
+	errIndexes := make([][]uint32, len(args))
+	var index []uint32
+	out := vector.NewBoolEmpty(n, nil)
+slots:
+	for slot := range n {
+		result := true
+		for k, arg := range args {
+			errvec, ok := vector.Under(arg).(*vector.Error)
+			if !ok {
+				continue
+			}
+			if !isMissingOrQuiet(errvec, slot) {
+				errIndexes[k] = append(errIndexes[k], slot)
+				continue slots
+			}
+			result = false
+			break
+		}
+		if result != h.missing {
+			out.Set(uint32(len(index)))
+		}
+		index = append(index, slot)
+	}
+	if len(index) == int(n) {
+		return out
+	}
+	out = vector.NewBool(out.Bits, uint32(len(index)), nil)
+	combiner := vector.NewCombiner(out)
+	for k, errIndex := range errIndexes {
+		if len(errIndex) > 0 {
+			combiner.Add(errIndex, vector.NewView(errIndex, args[k]))
+		}
+	}
+	return combiner.Result()
+}
+
+func isMissingOrQuiet(vec *vector.Error, slot uint32) bool {
+	if vec.Vals.Type() != zed.TypeString || vec.Nulls.Value(slot) {
+		return false
+	}
+	s, null := vector.StringValue(vec.Vals, slot)
+	return !null && (s == "missing" || s == "quiet")
+}
@@ -0,0 +1,68 @@
+package function
+
+import (
+	"hash"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr/function"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
+)
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#hash
+type Hash struct {
+	hash    hash.Hash
+	builder zcode.Builder
+	scratch zcode.Builder
+}
+
+func newHash(name string) *Hash {
+	return &Hash{hash: function.NewHasher(name)}
+}
+
+func (h *Hash) Call(args ...vector.Any) vector.Any {
+	vec := args[0]
+	if vec.Type().Kind() == zed.ErrorKind {
+		return vec
+	}
+	n := vec.Len()
+	nulls := vector.NewBoolEmpty(n, nil)
+	h64, is64 := h.hash.(hash.Hash64)
+	uints := vector.NewUintEmpty(zed.TypeUint64, n, nulls)
+	bytes := vector.NewBytesEmpty(n, nulls)
+	for i := range n {
+		b, isnull := h.input(vec, i)
+		if isnull {
+			nulls.Set(i)
+		} else {
+			h.hash.Reset()
+			h.hash.Write(b)
+		}
+		switch {
+		case isnull && is64:
+			uints.Append(0)
+		case isnull:
+			bytes.Append(nil)
+		case is64:
+			uints.Append(h64.Sum64())
+		default:
+			bytes.Append(h.hash.Sum(nil))
+		}
+	}
+	if is64 {
+		return uints
+	}
+	return bytes
+}
+
+func (h *Hash) input(vec vector.Any, slot uint32) ([]byte, bool) {
+	switch under := vector.Under(vec); under.Type().ID() {
+	case zed.IDString:
+		s, isnull := vector.StringValue(under, slot)
+		return []byte(s), isnull
+	case zed.IDBytes:
+		return vector.BytesValue(under, slot)
+	}
+	val := vector.ValueAt(&h.builder, vec, slot)
+	return function.HashInput(&h.scratch, val), val.IsNull()
+}
@@ -0,0 +1,102 @@
+package function
+
+import (
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr/function"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
+	"github.com/brimdata/super/zson"
+)
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#json_extract
+type JSONExtract struct {
+	zctx    *zed.Context
+	extract *function.JSONExtract
+	builder zcode.Builder
+}
+
+func newJSONExtract(zctx *zed.Context) *JSONExtract {
+	return &JSONExtract{zctx: zctx, extract: function.NewJSONExtract(zctx)}
+}
+
+func (j *JSONExtract) Call(args ...vector.Any) vector.Any {
+	inVec, pathVec := vector.Under(args[0]), vector.Under(args[1])
+	if inVec.Type() != zed.TypeString {
+		return vector.NewWrappedError(j.zctx, "json_extract: string value required for JSON arg", args[0])
+	}
+	if pathVec.Type() != zed.TypeString {
+		return vector.NewWrappedError(j.zctx, "json_extract: non-null string value required for path arg", args[1])
+	}
+	out := vector.NewDynamicBuilder()
+	for i := range inVec.Len() {
+		path, null := vector.StringValue(pathVec, i)
+		if null {
+			out.Write(j.zctx.WrapError("json_extract: non-null string value required for path arg", zed.NullString))
+			continue
+		}
+		if err := j.extract.SetPath(path); err != nil {
+			out.Write(j.zctx.WrapError("json_extract: "+err.Error(), zed.NewString(path)))
+			continue
+		}
+		s, null := vector.StringValue(inVec, i)
+		if null {
+			out.Write(zed.Null)
+			continue
+		}
+		val, err := j.extract.Extract(s)
+		if err != nil {
+			val = j.zctx.WrapError("json_extract: "+err.Error(), vector.ValueAt(&j.builder, inVec, i))
+		}
+		out.Write(val)
+	}
+	return out.Build()
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#to_json
+type ToJSON struct {
+	zctx    *zed.Context
+	format  *function.ToJSON
+	builder zcode.Builder
+}
+
+func newToJSON(zctx *zed.Context) *ToJSON {
+	return &ToJSON{zctx: zctx, format: function.NewToJSON(zctx)}
+}
+
+func (t *ToJSON) Call(args ...vector.Any) vector.Any {
+	vec := args[0]
+	out := vector.NewDynamicBuilder()
+	for i := range vec.Len() {
+		val := vector.ValueAt(&t.builder, vec, i)
+		s, err := t.format.Format(val)
+		if err != nil {
+			out.Write(t.zctx.WrapError("to_json: "+err.Error(), val))
+			continue
+		}
+		out.Write(zed.NewString(s))
+	}
+	return out.Build()
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#to_zson
+type ToZSON struct {
+	formatter *zson.Formatter
+	builder   zcode.Builder
+}
+
+func newToZSON() *ToZSON {
+	return &ToZSON{formatter: zson.NewFormatter(0, true, nil)}
+}
+
+func (t *ToZSON) Call(args ...vector.Any) vector.Any {
+	vec := args[0]
+	out := vector.NewStringEmpty(vec.Len(), nil)
+	for i := range vec.Len() {
+		out.Append(t.formatter.FormatRecord(vector.ValueAt(&t.builder, vec, i)))
+	}
+	return out
+}
+
+func (*ToZSON) RipUnions() bool {
+	return false
+}
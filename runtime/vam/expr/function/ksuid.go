@@ -0,0 +1,70 @@
+package function
+
+import (
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/vector"
+	"github.com/segmentio/ksuid"
+)
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#ksuid
+type KSUIDToString struct {
+	zctx *zed.Context
+}
+
+func (k *KSUIDToString) Call(args ...vector.Any) vector.Any {
+	val := vector.Under(args[0])
+	switch val.Type().ID() {
+	case zed.IDBytes:
+		var errcnt uint32
+		tags := make([]uint32, val.Len())
+		out := vector.NewStringEmpty(val.Len(), nil)
+		for i := range val.Len() {
+			bytes, null := vector.BytesValue(val, i)
+			if null {
+				errcnt++
+				tags[i] = 1
+				continue
+			}
+			id, err := ksuid.FromBytes(bytes)
+			if err != nil {
+				panic(err)
+			}
+			out.Append(id.String())
+		}
+		err := vector.NewStringError(k.zctx, "ksuid: illegal null argument", errcnt)
+		return vector.NewDynamic(tags, []vector.Any{out, err})
+	case zed.IDString:
+		var errs []uint32
+		errMsgs := vector.NewStringEmpty(0, nil)
+		out := vector.NewBytesEmpty(val.Len(), nil)
+		for i := range val.Len() {
+			s, _ := vector.StringValue(val, i)
+			id, err := ksuid.Parse(s)
+			if err != nil {
+				errs = append(errs, i)
+				errMsgs.Append("ksuid: " + err.Error())
+				continue
+			}
+			out.Append(id.Bytes())
+		}
+		if len(errs) > 0 {
+			errVec := vector.NewVecWrappedError(k.zctx, errMsgs, vector.NewView(errs, val))
+			return vector.Combine(out, errs, errVec)
+		}
+		return out
+	default:
+		return vector.NewWrappedError(k.zctx, "ksuid: argument must a bytes or string type", val)
+	}
+}
+
+// KSUIDNew implements ksuid() with no arguments.  Its argument is the
+// vector being evaluated and determines the length of the result.
+type KSUIDNew struct{}
+
+func (*KSUIDNew) Call(args ...vector.Any) vector.Any {
+	out := vector.NewBytesEmpty(args[0].Len(), nil)
+	for range args[0].Len() {
+		out.Append(ksuid.New().Bytes())
+	}
+	return out
+}
@@ -0,0 +1,132 @@
+package function
+
+import (
+	"github.com/brimdata/super"
+	samexpr "github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/expr/function"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
+)
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#map_keys
+type MapKeys struct {
+	zctx *zed.Context
+}
+
+func (m *MapKeys) Call(args ...vector.Any) vector.Any {
+	vec := mapVector(args[0])
+	if vec == nil {
+		return vector.NewWrappedError(m.zctx, "map_keys: map arg required", args[0])
+	}
+	typ := m.zctx.LookupTypeArray(vec.Typ.KeyType)
+	return vector.NewArray(typ, vec.Offsets, vec.Keys, vec.Nulls)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#map_values
+type MapValues struct {
+	zctx *zed.Context
+}
+
+func (m *MapValues) Call(args ...vector.Any) vector.Any {
+	vec := mapVector(args[0])
+	if vec == nil {
+		return vector.NewWrappedError(m.zctx, "map_values: map arg required", args[0])
+	}
+	typ := m.zctx.LookupTypeArray(vec.Typ.ValType)
+	return vector.NewArray(typ, vec.Offsets, vec.Values, vec.Nulls)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#map_entries
+type MapEntries struct {
+	zctx *zed.Context
+}
+
+func (m *MapEntries) Call(args ...vector.Any) vector.Any {
+	vec := mapVector(args[0])
+	if vec == nil {
+		return vector.NewWrappedError(m.zctx, "map_entries: map arg required", args[0])
+	}
+	entryType := samexpr.MapEntryType(m.zctx, vec.Typ)
+	entries := vector.NewRecord(entryType, []vector.Any{vec.Keys, vec.Values}, vec.Keys.Len(), nil)
+	return vector.NewArray(m.zctx.LookupTypeArray(entryType), vec.Offsets, entries, vec.Nulls)
+}
+
+// mapVector returns vec as a *vector.Map if it is a map and nil otherwise.
+func mapVector(vec vector.Any) *vector.Map {
+	vec = vector.Under(vec)
+	if _, ok := vec.Type().(*zed.TypeMap); !ok {
+		return nil
+	}
+	if m, ok := vec.(*vector.Map); ok {
+		return m
+	}
+	return materialize(vec).(*vector.Map)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#map_from_entries
+type MapFromEntries struct {
+	zctx    *zed.Context
+	build   *function.MapFromEntries
+	entries zcode.Builder
+}
+
+func newMapFromEntries(zctx *zed.Context) *MapFromEntries {
+	return &MapFromEntries{zctx: zctx, build: function.NewMapFromEntries(zctx)}
+}
+
+func (m *MapFromEntries) Call(args ...vector.Any) vector.Any {
+	list := listVector(args[0])
+	if list == nil {
+		return vector.NewWrappedError(m.zctx, "map_from_entries: array or set arg required", args[0])
+	}
+	inner := vector.Inner(list)
+	innerType := zed.InnerType(list.Type())
+	out := vector.NewDynamicBuilder()
+	for i := range list.Len() {
+		off, end, _ := vector.ContainerOffset(list, i)
+		m.entries.Reset()
+		for k := off; k < end; k++ {
+			inner.Serialize(&m.entries, k)
+		}
+		out.Write(m.build.Build(innerType, m.entries.Bytes()))
+	}
+	return out.Build()
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#map_merge
+type MapMerge struct {
+	zctx  *zed.Context
+	merge *function.MapMerge
+	maps  zcode.Builder
+}
+
+func newMapMerge(zctx *zed.Context) *MapMerge {
+	return &MapMerge{zctx: zctx, merge: function.NewMapMerge(zctx)}
+}
+
+func (m *MapMerge) Call(args ...vector.Any) vector.Any {
+	maps := make([]*vector.Map, 0, len(args))
+	for _, arg := range args {
+		vec := mapVector(arg)
+		if vec == nil {
+			return vector.NewWrappedError(m.zctx, "map_merge: map args required", arg)
+		}
+		maps = append(maps, vec)
+	}
+	out := vector.NewDynamicBuilder()
+	for i := range args[0].Len() {
+		// The merger retains the map bodies so they are serialized
+		// together before any are added.
+		m.maps.Reset()
+		for _, vec := range maps {
+			vec.Serialize(&m.maps, i)
+		}
+		m.merge.Reset()
+		it := m.maps.Bytes().Iter()
+		for _, vec := range maps {
+			m.merge.Add(vec.Typ, it.Next())
+		}
+		out.Write(m.merge.Map())
+	}
+	return out.Build()
+}
@@ -0,0 +1,255 @@
+package function
+
+import (
+	"math"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/anymath"
+	"github.com/brimdata/super/vector"
+)
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#abs.md
+type Abs struct {
+	zctx *zed.Context
+}
+
+func (a *Abs) Call(args ...vector.Any) vector.Any {
+	vec := vector.Under(args[0])
+	switch id := vec.Type().ID(); {
+	case zed.IsUnsigned(id):
+		return args[0]
+	case zed.IsSigned(id):
+		out := vector.NewIntEmpty(vec.Type(), vec.Len(), vector.NullsOf(vec))
+		for i := range vec.Len() {
+			x, _ := vector.IntValue(vec, i)
+			if x < 0 {
+				x = -x
+			}
+			out.Append(x)
+		}
+		return out
+	case zed.IsFloat(id):
+		return mapFloat(vec, math.Abs)
+	}
+	return vector.NewWrappedError(a.zctx, "abs: not a number", vec)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#ceil
+type Ceil struct {
+	zctx *zed.Context
+}
+
+func (c *Ceil) Call(args ...vector.Any) vector.Any {
+	vec := vector.Under(args[0])
+	switch id := vec.Type().ID(); {
+	case zed.IsUnsigned(id) || zed.IsSigned(id):
+		return args[0]
+	case zed.IsFloat(id):
+		return mapFloat(vec, math.Ceil)
+	}
+	return vector.NewWrappedError(c.zctx, "ceil: not a number", vec)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#floor
+type Floor struct {
+	zctx *zed.Context
+}
+
+func (f *Floor) Call(args ...vector.Any) vector.Any {
+	vec := vector.Under(args[0])
+	switch id := vec.Type().ID(); {
+	case zed.IsUnsigned(id) || zed.IsSigned(id):
+		return args[0]
+	case zed.IsFloat(id):
+		return mapFloat(vec, math.Floor)
+	}
+	return vector.NewWrappedError(f.zctx, "floor: not a number", vec)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#log
+type Log struct {
+	zctx *zed.Context
+}
+
+func (l *Log) Call(args ...vector.Any) vector.Any {
+	vec := vector.Under(args[0])
+	if !zed.IsNumber(vec.Type().ID()) {
+		return vector.NewWrappedError(l.zctx, "log: not a number", vec)
+	}
+	var errs []uint32
+	out := vector.NewFloatEmpty(zed.TypeFloat64, vec.Len(), vector.NewBoolEmpty(vec.Len(), nil))
+	for i := range vec.Len() {
+		x, null := floatAt(vec, i)
+		if null {
+			out.Nulls.Set(out.Len())
+			out.Append(0)
+			continue
+		}
+		if x <= 0 {
+			errs = append(errs, i)
+			continue
+		}
+		out.Append(math.Log(x))
+	}
+	if len(errs) > 0 {
+		return vector.Combine(out, errs, vector.NewWrappedError(l.zctx, "log: illegal argument", vector.NewView(errs, vec)))
+	}
+	return out
+}
+
+type reducer struct {
+	zctx *zed.Context
+	name string
+	fn   *anymath.Function
+}
+
+func (r *reducer) Call(args ...vector.Any) vector.Any {
+	args = underAll(args)
+	for _, arg := range args {
+		if !zed.IsNumber(arg.Type().ID()) {
+			return vector.NewWrappedError(r.zctx, r.name+": not a number", arg)
+		}
+	}
+	val0 := args[0]
+	n := val0.Len()
+	switch id := val0.Type().ID(); {
+	case zed.IsUnsigned(id):
+		out := vector.NewUintEmpty(zed.TypeUint64, n, nil)
+		for i := range n {
+			result, _ := vector.UintValue(val0, i)
+			for _, arg := range args[1:] {
+				v, _ := uintAt(arg, i)
+				result = r.fn.Uint64(result, v)
+			}
+			out.Append(result)
+		}
+		return out
+	case zed.IsSigned(id):
+		out := vector.NewIntEmpty(zed.TypeInt64, n, nil)
+		for i := range n {
+			result, _ := vector.IntValue(val0, i)
+			for _, arg := range args[1:] {
+				v, _ := intAt(arg, i)
+				result = r.fn.Int64(result, v)
+			}
+			out.Append(result)
+		}
+		return out
+	default:
+		out := vector.NewFloatEmpty(zed.TypeFloat64, n, nil)
+		for i := range n {
+			result, _ := vector.FloatValue(val0, i)
+			for _, arg := range args[1:] {
+				v, _ := floatAt(arg, i)
+				result = r.fn.Float64(result, v)
+			}
+			out.Append(result)
+		}
+		return out
+	}
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#round
+type Round struct {
+	zctx *zed.Context
+}
+
+func (r *Round) Call(args ...vector.Any) vector.Any {
+	vec := vector.Under(args[0])
+	switch id := vec.Type().ID(); {
+	case zed.IsUnsigned(id) || zed.IsSigned(id):
+		return args[0]
+	case zed.IsFloat(id):
+		return mapFloat(vec, math.Round)
+	}
+	return vector.NewWrappedError(r.zctx, "round: not a number", vec)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#pow
+type Pow struct {
+	zctx *zed.Context
+}
+
+func (p *Pow) Call(args ...vector.Any) vector.Any {
+	args = underAll(args)
+	for _, arg := range args {
+		if !zed.IsNumber(arg.Type().ID()) {
+			return vector.NewWrappedError(p.zctx, "pow: not a number", arg)
+		}
+	}
+	x, y := args[0], args[1]
+	out := vector.NewFloatEmpty(zed.TypeFloat64, x.Len(), vector.NewBoolEmpty(x.Len(), nil))
+	for i := range x.Len() {
+		xv, xnull := floatAt(x, i)
+		yv, ynull := floatAt(y, i)
+		if xnull || ynull {
+			out.Nulls.Set(i)
+		}
+		out.Append(math.Pow(xv, yv))
+	}
+	return out
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#sqrt
+type Sqrt struct {
+	zctx *zed.Context
+}
+
+func (s *Sqrt) Call(args ...vector.Any) vector.Any {
+	vec := vector.Under(args[0])
+	if !zed.IsNumber(vec.Type().ID()) {
+		return vector.NewWrappedError(s.zctx, "sqrt: not a number", vec)
+	}
+	out := vector.NewFloatEmpty(zed.TypeFloat64, vec.Len(), vector.NullsOf(vec))
+	for i := range vec.Len() {
+		x, _ := floatAt(vec, i)
+		out.Append(math.Sqrt(x))
+	}
+	return out
+}
+
+// mapFloat applies fn to each value of vec, which must be a float vector.
+func mapFloat(vec vector.Any, fn func(float64) float64) vector.Any {
+	out := vector.NewFloatEmpty(vec.Type(), vec.Len(), vector.NullsOf(vec))
+	for i := range vec.Len() {
+		x, _ := vector.FloatValue(vec, i)
+		out.Append(fn(x))
+	}
+	return out
+}
+
+func floatAt(vec vector.Any, slot uint32) (float64, bool) {
+	switch id := vec.Type().ID(); {
+	case zed.IsUnsigned(id):
+		v, null := vector.UintValue(vec, slot)
+		return float64(v), null
+	case zed.IsSigned(id):
+		v, null := vector.IntValue(vec, slot)
+		return float64(v), null
+	}
+	return vector.FloatValue(vec, slot)
+}
+
+func intAt(vec vector.Any, slot uint32) (int64, bool) {
+	switch id := vec.Type().ID(); {
+	case zed.IsUnsigned(id):
+		v, null := vector.UintValue(vec, slot)
+		return int64(v), null
+	case zed.IsFloat(id):
+		v, null := vector.FloatValue(vec, slot)
+		return int64(v), null
+	}
+	return vector.IntValue(vec, slot)
+}
+
+func uintAt(vec vector.Any, slot uint32) (uint64, bool) {
+	switch id := vec.Type().ID(); {
+	case zed.IsSigned(id):
+		v, null := vector.IntValue(vec, slot)
+		return uint64(v), null
+	case zed.IsFloat(id):
+		v, null := vector.FloatValue(vec, slot)
+		return uint64(v), null
+	}
+	return vector.UintValue(vec, slot)
+}
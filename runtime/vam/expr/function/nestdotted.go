@@ -0,0 +1,59 @@
+package function
+
+import (
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/vector"
+)
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#nest_dotted.md
+type NestDotted struct {
+	zctx *zed.Context
+}
+
+func (n *NestDotted) Call(args ...vector.Any) vector.Any {
+	vec := args[len(args)-1]
+	rec := recordVector(vec)
+	if rec == nil {
+		return vec
+	}
+	var foundDotted bool
+	var paths field.List
+	var types []zed.Type
+	for _, f := range rec.Typ.Fields {
+		dotted := field.Dotted(f.Name)
+		if len(dotted) > 1 {
+			foundDotted = true
+		}
+		paths = append(paths, dotted)
+		types = append(types, f.Type)
+	}
+	if !foundDotted {
+		return vec
+	}
+	b, err := zed.NewRecordBuilder(n.zctx, paths)
+	if err != nil {
+		return vector.NewWrappedError(n.zctx, "nest_dotted(): "+err.Error(), vec)
+	}
+	return nestDotted(b.Type(types), paths, 0, rec.Fields, rec.Len(), rec.Nulls)
+}
+
+// nestDotted returns a record vector of type typ whose leaves are fields,
+// which are at paths.  Paths with the same prefix are adjacent since
+// zed.NewRecordBuilder accepted them.
+func nestDotted(typ *zed.TypeRecord, paths field.List, depth int, fields []vector.Any, n uint32, nulls *vector.Bool) *vector.Record {
+	var out []vector.Any
+	for _, f := range typ.Fields {
+		end := 1
+		for end < len(paths) && paths[end][depth] == f.Name {
+			end++
+		}
+		if len(paths[0]) == depth+1 {
+			out = append(out, fields[0])
+		} else {
+			out = append(out, nestDotted(zed.TypeRecordOf(f.Type), paths[:end], depth+1, fields[:end], n, nil))
+		}
+		paths, fields = paths[end:], fields[end:]
+	}
+	return vector.NewRecord(typ, out, n, nulls)
+}
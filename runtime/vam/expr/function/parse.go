@@ -0,0 +1,85 @@
+package function
+
+import (
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr/function"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
+)
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#parse_uri
+type ParseURI struct {
+	zctx  *zed.Context
+	parse *function.ParseURI
+}
+
+func newParseURI(zctx *zed.Context) *ParseURI {
+	return &ParseURI{zctx, function.NewParseURI(zctx)}
+}
+
+func (p *ParseURI) Call(args ...vector.Any) vector.Any {
+	const msg = "parse_uri: non-empty string arg required"
+	vec := vector.Under(args[0])
+	if vec.Type() != zed.TypeString {
+		return vector.NewWrappedError(p.zctx, msg, args[0])
+	}
+	nullVal := p.zctx.WrapError(msg, zed.NullString)
+	return parseStrings(p.zctx, "parse_uri", vec, nullVal, p.parse.Parse)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#parse_zson
+type ParseZSON struct {
+	zctx  *zed.Context
+	parse *function.ParseZSON
+}
+
+func newParseZSON(zctx *zed.Context) *ParseZSON {
+	return &ParseZSON{zctx, function.NewParseZSON(zctx)}
+}
+
+func (p *ParseZSON) Call(args ...vector.Any) vector.Any {
+	vec := vector.Under(args[0])
+	if vec.Type() != zed.TypeString {
+		return vector.NewWrappedError(p.zctx, "parse_zson: string arg required", args[0])
+	}
+	return parseStrings(p.zctx, "parse_zson", vec, zed.Null, p.parse.Parse)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#parse_json
+type ParseJSON struct {
+	zctx  *zed.Context
+	parse *function.ParseJSON
+}
+
+func newParseJSON(zctx *zed.Context) *ParseJSON {
+	return &ParseJSON{zctx, function.NewParseJSON(zctx)}
+}
+
+func (p *ParseJSON) Call(args ...vector.Any) vector.Any {
+	vec := vector.Under(args[0])
+	if vec.Type() != zed.TypeString {
+		return vector.NewWrappedError(p.zctx, "parse_json: string arg required", args[0])
+	}
+	return parseStrings(p.zctx, "parse_json", vec, zed.Null, p.parse.Parse)
+}
+
+// parseStrings returns a vector holding the value returned by parse for each
+// string in vec or, where parse returns an error, an error wrapping the
+// string.  Null strings yield nullVal.
+func parseStrings(zctx *zed.Context, name string, vec vector.Any, nullVal zed.Value, parse func(string) (zed.Value, error)) vector.Any {
+	var b zcode.Builder
+	out := vector.NewDynamicBuilder()
+	for i := range vec.Len() {
+		s, null := vector.StringValue(vec, i)
+		if null {
+			out.Write(nullVal)
+			continue
+		}
+		val, err := parse(s)
+		if err != nil {
+			val = zctx.WrapError(name+": "+err.Error(), vector.ValueAt(&b, vec, i))
+		}
+		out.Write(val)
+	}
+	return out.Build()
+}
@@ -0,0 +1,129 @@
+package function
+
+import (
+	"regexp"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/vector"
+)
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#regexp
+type Regexp struct {
+	zctx  *zed.Context
+	re    *regexp.Regexp
+	restr string
+	err   error
+}
+
+func (r *Regexp) Call(args ...vector.Any) vector.Any {
+	args = underAll(args)
+	reVec, sVec := args[0], args[1]
+	if reVec.Type().ID() != zed.IDString {
+		return vector.NewWrappedError(r.zctx, "regexp: string required for first arg", reVec)
+	}
+	if sVec.Type().ID() != zed.IDString {
+		return vector.NewWrappedError(r.zctx, "regexp: string required for second arg", sVec)
+	}
+	var errs, nulls, innerNulls []uint32
+	errMsgs := vector.NewStringEmpty(0, nil)
+	offsets := []uint32{0}
+	inner := vector.NewStringEmpty(0, nil)
+	for i := range sVec.Len() {
+		re, _ := vector.StringValue(reVec, i)
+		if r.restr != re || r.re == nil && r.err == nil {
+			r.restr = re
+			r.re, r.err = regexp.Compile(re)
+		}
+		if r.err != nil {
+			errs = append(errs, i)
+			errMsgs.Append("regexp: " + r.err.Error())
+			continue
+		}
+		s, _ := vector.StringValue(sVec, i)
+		match := r.re.FindStringSubmatchIndex(s)
+		if match == nil {
+			// No match is null.
+			nulls = append(nulls, uint32(len(offsets)-1))
+		}
+		for k := 0; k < len(match); k += 2 {
+			if match[k] < 0 {
+				// Unmatched subexpressions are null.
+				innerNulls = append(innerNulls, inner.Len())
+				inner.Append("")
+				continue
+			}
+			inner.Append(s[match[k]:match[k+1]])
+		}
+		offsets = append(offsets, inner.Len())
+	}
+	inner.Nulls = newNulls(inner.Len(), innerNulls)
+	typ := r.zctx.LookupTypeArray(zed.TypeString)
+	out := vector.NewArray(typ, offsets, inner, newNulls(uint32(len(offsets)-1), nulls))
+	if len(errs) > 0 {
+		errVec := vector.NewError(r.zctx.LookupTypeError(zed.TypeString), errMsgs, nil)
+		return vector.Combine(out, errs, errVec)
+	}
+	return out
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#regexp_replace
+type RegexpReplace struct {
+	zctx  *zed.Context
+	re    *regexp.Regexp
+	restr string
+	err   error
+}
+
+func (r *RegexpReplace) Call(args ...vector.Any) vector.Any {
+	args = underAll(args)
+	for _, arg := range args {
+		if arg.Type().ID() != zed.IDString {
+			return vector.NewWrappedError(r.zctx, "regexp_replace: string arg required", arg)
+		}
+	}
+	sVec, reVec, newVec := args[0], args[1], args[2]
+	var errs, nulls []uint32
+	errMsgs := vector.NewStringEmpty(0, nil)
+	out := vector.NewStringEmpty(sVec.Len(), nil)
+	for i := range sVec.Len() {
+		s, snull := vector.StringValue(sVec, i)
+		re, renull := vector.StringValue(reVec, i)
+		new, newnull := vector.StringValue(newVec, i)
+		if snull {
+			nulls = append(nulls, i)
+			continue
+		}
+		if renull || newnull {
+			errs = append(errs, i)
+			errMsgs.Append("regexp_replace: 2nd and 3rd args cannot be null")
+			continue
+		}
+		if r.restr != re || r.re == nil && r.err == nil {
+			r.restr = re
+			r.re, r.err = regexp.Compile(re)
+		}
+		if r.err != nil {
+			errs = append(errs, i)
+			errMsgs.Append("regexp_replace: " + r.err.Error())
+			continue
+		}
+		out.Append(r.re.ReplaceAllString(s, new))
+	}
+	combiner := vector.NewCombiner(out)
+	combiner.Add(nulls, vector.NewConst(zed.Null, uint32(len(nulls)), nil))
+	combiner.Add(errs, vector.NewError(r.zctx.LookupTypeError(zed.TypeString), errMsgs, nil))
+	return combiner.Result()
+}
+
+// newNulls returns a vector.Bool of length n with the slots in index set or
+// nil if index is empty.
+func newNulls(n uint32, index []uint32) *vector.Bool {
+	if len(index) == 0 {
+		return nil
+	}
+	nulls := vector.NewBoolEmpty(n, nil)
+	for _, slot := range index {
+		nulls.Set(slot)
+	}
+	return nulls
+}
@@ -1,12 +1,66 @@
 package function
 
 import (
+	"time"
+
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/pkg/nano"
 	"github.com/brimdata/super/vector"
 	"github.com/lestrrat-go/strftime"
 )
 
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#now
+type Now struct{}
+
+func (n *Now) Call(args ...vector.Any) vector.Any {
+	return vector.NewConst(zed.NewTime(nano.Now()), args[0].Len(), nil)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#bucket
+type Bucket struct {
+	name string
+	zctx *zed.Context
+}
+
+func (b *Bucket) Call(args ...vector.Any) vector.Any {
+	args = underAll(args)
+	tsArg, binArg := args[0], args[1]
+	tsID, binID := tsArg.Type().ID(), binArg.Type().ID()
+	if tsID == zed.IDNull || binID == zed.IDNull {
+		return vector.NewConst(zed.NullTime, tsArg.Len(), nil)
+	}
+	if binID != zed.IDDuration && !zed.IsNumber(binID) {
+		return vector.NewWrappedError(b.zctx, b.name+": second argument is not a duration or number", binArg)
+	}
+	if !zed.IsNumber(tsID) {
+		return vector.NewWrappedError(b.zctx, b.name+": first argument is not a time", tsArg)
+	}
+	nulls := vector.Or(vector.NullsOf(tsArg), vector.NullsOf(binArg))
+	isDur := tsID == zed.IDDuration
+	typ := zed.Type(zed.TypeTime)
+	if isDur {
+		typ = zed.TypeDuration
+	}
+	out := vector.NewIntEmpty(typ, tsArg.Len(), nulls)
+	for i := range tsArg.Len() {
+		var bin nano.Duration
+		if binID == zed.IDDuration {
+			d, _ := vector.IntValue(binArg, i)
+			bin = nano.Duration(d)
+		} else {
+			d, _ := intAt(binArg, i)
+			bin = nano.Duration(d) * nano.Second
+		}
+		ts, _ := intAt(tsArg, i)
+		if isDur {
+			out.Append(int64(nano.Duration(ts).Trunc(bin)))
+		} else {
+			out.Append(int64(nano.Ts(ts).Trunc(bin)))
+		}
+	}
+	return out
+}
+
 // https://github.com/brimdata/super/blob/main/docs/language/functions.md#strftime
 type Strftime struct {
 	zctx *zed.Context
@@ -93,3 +147,296 @@ func (s *Strftime) slowPath(fvec vector.Any, tvec vector.Any) vector.Any {
 	}
 	return out
 }
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#strptime
+type Strptime struct {
+	zctx *zed.Context
+}
+
+func (s *Strptime) Call(args ...vector.Any) vector.Any {
+	args = underAll(args)
+	formatVec, strVec := args[0], args[1]
+	if formatVec.Type().ID() != zed.IDString {
+		return vector.NewWrappedError(s.zctx, "strptime: string value required for format arg", formatVec)
+	}
+	if strVec.Type().ID() != zed.IDString {
+		return vector.NewWrappedError(s.zctx, "strptime: string value required for value arg", strVec)
+	}
+	tzVec, errVec := timeZoneArg(s.zctx, "strptime", args[2:])
+	if errVec != nil {
+		return errVec
+	}
+	errs := newSlotErrors(s.zctx, "strptime", args)
+	n := strVec.Len()
+	out := vector.NewIntEmpty(zed.TypeTime, n, vector.NewBoolEmpty(n, nil))
+	for i := range n {
+		loc, err := timeZoneAt(tzVec, i)
+		if err != nil {
+			errs.add(2, i, err)
+			continue
+		}
+		format, fnull := vector.StringValue(formatVec, i)
+		str, snull := vector.StringValue(strVec, i)
+		if fnull || snull {
+			out.Nulls.Set(out.Len())
+			out.Append(0)
+			continue
+		}
+		ts, err := nano.Strptime(format, str, loc)
+		if err != nil {
+			errs.add(1, i, err)
+			continue
+		}
+		out.Append(int64(ts))
+	}
+	return errs.combine(out)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#date_part
+type DatePart struct {
+	zctx *zed.Context
+}
+
+func (d *DatePart) Call(args ...vector.Any) vector.Any {
+	args = underAll(args)
+	partVec, timeVec := args[0], args[1]
+	if partVec.Type().ID() != zed.IDString {
+		return vector.NewWrappedError(d.zctx, "date_part: string value required for part arg", partVec)
+	}
+	if timeVec.Type().ID() != zed.IDTime {
+		return vector.NewWrappedError(d.zctx, "date_part: time value required for time arg", timeVec)
+	}
+	tzVec, errVec := timeZoneArg(d.zctx, "date_part", args[2:])
+	if errVec != nil {
+		return errVec
+	}
+	errs := newSlotErrors(d.zctx, "date_part", args)
+	n := timeVec.Len()
+	out := vector.NewIntEmpty(zed.TypeInt64, n, vector.NewBoolEmpty(n, nil))
+	for i := range n {
+		loc, err := timeZoneAt(tzVec, i)
+		if err != nil {
+			errs.add(2, i, err)
+			continue
+		}
+		part, pnull := vector.StringValue(partVec, i)
+		t, tnull := vector.IntValue(timeVec, i)
+		if pnull || tnull {
+			out.Nulls.Set(out.Len())
+			out.Append(0)
+			continue
+		}
+		v, err := nano.Ts(t).Part(part, loc)
+		if err != nil {
+			errs.add(0, i, err)
+			continue
+		}
+		out.Append(v)
+	}
+	return errs.combine(out)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#date_trunc
+type DateTrunc struct {
+	zctx *zed.Context
+}
+
+func (d *DateTrunc) Call(args ...vector.Any) vector.Any {
+	args = underAll(args)
+	unitVec, timeVec := args[0], args[1]
+	if unitVec.Type().ID() != zed.IDString {
+		return vector.NewWrappedError(d.zctx, "date_trunc: string value required for unit arg", unitVec)
+	}
+	if timeVec.Type().ID() != zed.IDTime {
+		return vector.NewWrappedError(d.zctx, "date_trunc: time value required for time arg", timeVec)
+	}
+	tzVec, errVec := timeZoneArg(d.zctx, "date_trunc", args[2:])
+	if errVec != nil {
+		return errVec
+	}
+	errs := newSlotErrors(d.zctx, "date_trunc", args)
+	n := timeVec.Len()
+	out := vector.NewIntEmpty(zed.TypeTime, n, vector.NewBoolEmpty(n, nil))
+	for i := range n {
+		loc, err := timeZoneAt(tzVec, i)
+		if err != nil {
+			errs.add(2, i, err)
+			continue
+		}
+		unit, unull := vector.StringValue(unitVec, i)
+		t, tnull := vector.IntValue(timeVec, i)
+		if unull || tnull {
+			out.Nulls.Set(out.Len())
+			out.Append(0)
+			continue
+		}
+		ts, err := nano.Ts(t).TruncUnit(unit, loc)
+		if err != nil {
+			errs.add(0, i, err)
+			continue
+		}
+		out.Append(int64(ts))
+	}
+	return errs.combine(out)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#date_add
+type DateAdd struct {
+	zctx *zed.Context
+}
+
+func (d *DateAdd) Call(args ...vector.Any) vector.Any {
+	args = underAll(args)
+	unitVec, countVec, timeVec := args[0], args[1], args[2]
+	if unitVec.Type().ID() != zed.IDString {
+		return vector.NewWrappedError(d.zctx, "date_add: string value required for unit arg", unitVec)
+	}
+	if !zed.IsInteger(countVec.Type().ID()) {
+		return vector.NewWrappedError(d.zctx, "date_add: integer value required for count arg", countVec)
+	}
+	if timeVec.Type().ID() != zed.IDTime {
+		return vector.NewWrappedError(d.zctx, "date_add: time value required for time arg", timeVec)
+	}
+	tzVec, errVec := timeZoneArg(d.zctx, "date_add", args[3:])
+	if errVec != nil {
+		return errVec
+	}
+	errs := newSlotErrors(d.zctx, "date_add", args)
+	n := timeVec.Len()
+	out := vector.NewIntEmpty(zed.TypeTime, n, vector.NewBoolEmpty(n, nil))
+	for i := range n {
+		loc, err := timeZoneAt(tzVec, i)
+		if err != nil {
+			errs.add(3, i, err)
+			continue
+		}
+		unit, unull := vector.StringValue(unitVec, i)
+		count, cnull := intAt(countVec, i)
+		t, tnull := vector.IntValue(timeVec, i)
+		if unull || cnull || tnull {
+			out.Nulls.Set(out.Len())
+			out.Append(0)
+			continue
+		}
+		ts, err := nano.Ts(t).AddUnits(unit, count, loc)
+		if err != nil {
+			errs.add(0, i, err)
+			continue
+		}
+		out.Append(int64(ts))
+	}
+	return errs.combine(out)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#date_diff
+type DateDiff struct {
+	zctx *zed.Context
+}
+
+func (d *DateDiff) Call(args ...vector.Any) vector.Any {
+	args = underAll(args)
+	unitVec, startVec, endVec := args[0], args[1], args[2]
+	if unitVec.Type().ID() != zed.IDString {
+		return vector.NewWrappedError(d.zctx, "date_diff: string value required for unit arg", unitVec)
+	}
+	if startVec.Type().ID() != zed.IDTime {
+		return vector.NewWrappedError(d.zctx, "date_diff: time value required for start arg", startVec)
+	}
+	if endVec.Type().ID() != zed.IDTime {
+		return vector.NewWrappedError(d.zctx, "date_diff: time value required for end arg", endVec)
+	}
+	tzVec, errVec := timeZoneArg(d.zctx, "date_diff", args[3:])
+	if errVec != nil {
+		return errVec
+	}
+	errs := newSlotErrors(d.zctx, "date_diff", args)
+	n := startVec.Len()
+	out := vector.NewIntEmpty(zed.TypeInt64, n, vector.NewBoolEmpty(n, nil))
+	for i := range n {
+		loc, err := timeZoneAt(tzVec, i)
+		if err != nil {
+			errs.add(3, i, err)
+			continue
+		}
+		unit, unull := vector.StringValue(unitVec, i)
+		start, snull := vector.IntValue(startVec, i)
+		end, enull := vector.IntValue(endVec, i)
+		if unull || snull || enull {
+			out.Nulls.Set(out.Len())
+			out.Append(0)
+			continue
+		}
+		v, err := nano.DiffUnits(unit, nano.Ts(start), nano.Ts(end), loc)
+		if err != nil {
+			errs.add(0, i, err)
+			continue
+		}
+		out.Append(v)
+	}
+	return errs.combine(out)
+}
+
+// timeZoneArg returns the optional time zone argument in args or nil if args
+// is empty.
+func timeZoneArg(zctx *zed.Context, name string, args []vector.Any) (vector.Any, vector.Any) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+	if args[0].Type().ID() != zed.IDString {
+		return nil, vector.NewWrappedError(zctx, name+": string value required for time zone arg", args[0])
+	}
+	return args[0], nil
+}
+
+// timeZoneAt returns the location named by slot i of vec or UTC if vec is nil
+// or the slot is null.
+func timeZoneAt(vec vector.Any, i uint32) (*time.Location, error) {
+	if vec == nil {
+		return time.UTC, nil
+	}
+	name, isnull := vector.StringValue(vec, i)
+	if isnull {
+		return time.UTC, nil
+	}
+	return nano.LoadLocation(name)
+}
+
+// slotErrors collects errors for individual slots of a function's result,
+// each wrapping the slot of the argument that caused it.
+type slotErrors struct {
+	zctx    *zed.Context
+	name    string
+	args    []vector.Any
+	indexes [][]uint32
+	msgs    []*vector.String
+}
+
+func newSlotErrors(zctx *zed.Context, name string, args []vector.Any) *slotErrors {
+	return &slotErrors{
+		zctx:    zctx,
+		name:    name,
+		args:    args,
+		indexes: make([][]uint32, len(args)),
+		msgs:    make([]*vector.String, len(args)),
+	}
+}
+
+func (s *slotErrors) add(arg int, slot uint32, err error) {
+	if s.msgs[arg] == nil {
+		s.msgs[arg] = vector.NewStringEmpty(0, nil)
+	}
+	s.indexes[arg] = append(s.indexes[arg], slot)
+	s.msgs[arg].Append(s.name + ": " + err.Error())
+}
+
+// combine returns out, which holds a value for each slot without an error,
+// combined with the errors.
+func (s *slotErrors) combine(out vector.Any) vector.Any {
+	c := vector.NewCombiner(out)
+	for k, index := range s.indexes {
+		if len(index) > 0 {
+			c.Add(index, vector.NewVecWrappedError(s.zctx, s.msgs[k], vector.NewView(index, s.args[k])))
+		}
+	}
+	return c.Result()
+}
@@ -2,7 +2,10 @@ package function
 
 import (
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr/function"
 	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
+	"github.com/brimdata/super/zson"
 )
 
 // https://github.com/brimdata/super/blob/main/docs/language/functions.md#typeof
@@ -48,3 +51,377 @@ func (k *Kind) Call(args ...vector.Any) vector.Any {
 func (*Kind) RipUnions() bool {
 	return false
 }
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#typeunder
+type TypeUnder struct {
+	zctx *zed.Context
+}
+
+func (t *TypeUnder) Call(args ...vector.Any) vector.Any {
+	val := t.zctx.LookupTypeValue(zed.TypeUnder(args[0].Type()))
+	return vector.NewConst(val, args[0].Len(), nil)
+}
+
+func (*TypeUnder) RipUnions() bool {
+	return false
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#nameof
+type NameOf struct {
+	zctx *zed.Context
+}
+
+func (n *NameOf) Call(args ...vector.Any) vector.Any {
+	vec := args[0]
+	if named, ok := vec.Type().(*zed.TypeNamed); ok {
+		return vector.NewConst(zed.NewString(named.Name), vec.Len(), nil)
+	}
+	if vec.Type().ID() != zed.IDType {
+		return vector.NewMissing(n.zctx, vec.Len())
+	}
+	var errs []uint32
+	out := vector.NewStringEmpty(vec.Len(), nil)
+	for i := range vec.Len() {
+		var named *zed.TypeNamed
+		if bytes, null := vector.TypeValueValue(vec, i); !null {
+			typ, err := n.zctx.LookupByValue(bytes)
+			if err != nil {
+				panic(err)
+			}
+			named, _ = typ.(*zed.TypeNamed)
+		}
+		if named == nil {
+			errs = append(errs, i)
+			continue
+		}
+		out.Append(named.Name)
+	}
+	if len(errs) > 0 {
+		return vector.Combine(out, errs, vector.NewMissing(n.zctx, uint32(len(errs))))
+	}
+	return out
+}
+
+func (*NameOf) RipUnions() bool {
+	return false
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#typename
+type TypeName struct {
+	zctx *zed.Context
+}
+
+func (t *TypeName) Call(args ...vector.Any) vector.Any {
+	nameVec := vector.Under(args[0])
+	if nameVec.Type() != zed.TypeString {
+		return vector.NewWrappedError(t.zctx, "typename: first argument not a string", args[0])
+	}
+	n := nameVec.Len()
+	out := vector.NewTypeValueEmpty(n, nil)
+	if len(args) == 1 {
+		var missing []uint32
+		for i := range n {
+			name, _ := vector.StringValue(nameVec, i)
+			typ := t.zctx.LookupTypeDef(name)
+			if typ == nil {
+				missing = append(missing, i)
+				continue
+			}
+			out.Append(t.zctx.LookupTypeValue(typ).Bytes())
+		}
+		if len(missing) > 0 {
+			return vector.Combine(out, missing, vector.NewMissing(t.zctx, uint32(len(missing))))
+		}
+		return out
+	}
+	typeVec := vector.Under(args[1])
+	if typeVec.Type() != zed.TypeType {
+		return vector.NewWrappedError(t.zctx, "typename: second argument not a type value", args[1])
+	}
+	errs := newSlotErrors(t.zctx, "typename", args)
+	for i := range n {
+		bytes, _ := vector.TypeValueValue(typeVec, i)
+		typ, err := t.zctx.LookupByValue(bytes)
+		if err != nil {
+			errs.add(1, i, err)
+			continue
+		}
+		out.Append(t.zctx.LookupTypeValue(typ).Bytes())
+	}
+	return errs.combine(out)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#error
+type Error struct {
+	zctx *zed.Context
+}
+
+func (e *Error) Call(args ...vector.Any) vector.Any {
+	vec := args[0]
+	return vector.NewError(e.zctx.LookupTypeError(vec.Type()), vec, nil)
+}
+
+func (*Error) RipUnions() bool {
+	return false
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#iserr
+type IsErr struct{}
+
+func (*IsErr) Call(args ...vector.Any) vector.Any {
+	_, ok := vector.Under(args[0]).(*vector.Error)
+	return vector.NewConst(zed.NewBool(ok), args[0].Len(), nil)
+}
+
+func (*IsErr) RipUnions() bool {
+	return false
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#has_error
+type HasError struct {
+	// types memoizes whether values of a type can contain an error.
+	types map[zed.Type]bool
+	// value handles constants, which are evaluated as a single value.
+	value *function.HasError
+}
+
+func NewHasError() *HasError {
+	return &HasError{
+		types: make(map[zed.Type]bool),
+		value: function.NewHasError(),
+	}
+}
+
+func (h *HasError) Call(args ...vector.Any) vector.Any {
+	return h.hasError(args[0])
+}
+
+// hasError returns a vector.Bool indicating which values of vec are errors or
+// contain an error.  As in the sequential runtime, a null value of error type
+// is an error but the fields and elements of null containers are not
+// examined.
+func (h *HasError) hasError(vec vector.Any) *vector.Bool {
+	n := vec.Len()
+	if d, ok := vec.(*vector.Dynamic); ok {
+		out := vector.NewBoolEmpty(n, nil)
+		for tag, values := range d.Values {
+			b := h.hasError(values)
+			for k, slot := range d.TagMap.Reverse[tag] {
+				if b.Value(uint32(k)) {
+					out.Set(slot)
+				}
+			}
+		}
+		return out
+	}
+	typ := vec.Type()
+	if _, ok := zed.TypeUnder(typ).(*zed.TypeError); ok {
+		out := vector.NewBoolEmpty(n, nil)
+		for slot := range n {
+			out.Set(slot)
+		}
+		return out
+	}
+	if !h.canHaveError(typ) {
+		return vector.NewBoolEmpty(n, nil)
+	}
+	switch vec := vec.(type) {
+	case *vector.Named:
+		return h.hasError(vec.Any)
+	case *vector.View:
+		b := h.hasError(vec.Any)
+		out := vector.NewBoolEmpty(n, nil)
+		for k, slot := range vec.Index {
+			if b.Value(slot) {
+				out.Set(uint32(k))
+			}
+		}
+		return out
+	case *vector.Dict:
+		b := h.hasError(vec.Any)
+		out := vector.NewBoolEmpty(n, nil)
+		for k, idx := range vec.Index {
+			if !vec.Nulls.Value(uint32(k)) && b.Value(uint32(idx)) {
+				out.Set(uint32(k))
+			}
+		}
+		return out
+	case *vector.Const:
+		out := vector.NewBoolEmpty(n, nil)
+		if !h.value.Call(nil, []zed.Value{vec.Value()}).Ptr().AsBool() {
+			return out
+		}
+		for slot := range n {
+			if !vec.Nulls.Value(slot) {
+				out.Set(slot)
+			}
+		}
+		return out
+	case *vector.Union:
+		b := h.hasError(vec.Dynamic)
+		if vec.Nulls != nil && vec.Nulls.Len() != n {
+			// The tags of a union loaded from VNG omit its null slots.
+			out := vector.NewBoolEmpty(n, nil)
+			var off uint32
+			for slot := range n {
+				if vec.Nulls.Value(slot) {
+					continue
+				}
+				if b.Value(off) {
+					out.Set(slot)
+				}
+				off++
+			}
+			return out
+		}
+		return clearNulls(b, vec.Nulls)
+	case *vector.Record:
+		out := vector.NewBoolEmpty(n, nil)
+		for _, f := range vec.Fields {
+			out = vector.Or(out, h.hasError(f))
+		}
+		return clearNulls(out, vec.Nulls)
+	case *vector.Array:
+		return anyInList(n, vec.Offsets, h.hasError(vec.Values))
+	case *vector.Set:
+		return anyInList(n, vec.Offsets, h.hasError(vec.Values))
+	case *vector.Map:
+		b := vector.Or(h.hasError(vec.Keys), h.hasError(vec.Values))
+		return anyInList(n, vec.Offsets, b)
+	}
+	panic(vec)
+}
+
+// canHaveError returns true if typ is an error type or a complex type that
+// can contain an error.
+func (h *HasError) canHaveError(typ zed.Type) bool {
+	if b, ok := h.types[typ]; ok {
+		return b
+	}
+	var b bool
+	switch typ := zed.TypeUnder(typ).(type) {
+	case *zed.TypeError:
+		b = true
+	case *zed.TypeRecord:
+		for _, f := range typ.Fields {
+			b = b || h.canHaveError(f.Type)
+		}
+	case *zed.TypeArray:
+		b = h.canHaveError(typ.Type)
+	case *zed.TypeSet:
+		b = h.canHaveError(typ.Type)
+	case *zed.TypeMap:
+		b = h.canHaveError(typ.KeyType) || h.canHaveError(typ.ValType)
+	case *zed.TypeUnion:
+		for _, t := range typ.Types {
+			b = b || h.canHaveError(t)
+		}
+	}
+	h.types[typ] = b
+	return b
+}
+
+// clearNulls returns b with the values that are set in nulls cleared.
+func clearNulls(b, nulls *vector.Bool) *vector.Bool {
+	if nulls == nil {
+		return b
+	}
+	out := vector.NewBoolEmpty(b.Len(), nil)
+	for slot := range b.Len() {
+		if b.Value(slot) && !nulls.Value(slot) {
+			out.Set(slot)
+		}
+	}
+	return out
+}
+
+// anyInList returns a vector.Bool of length n whose value at each slot is
+// true if any value of b between offsets[slot] and offsets[slot+1] is true.
+func anyInList(n uint32, offsets []uint32, b *vector.Bool) *vector.Bool {
+	out := vector.NewBoolEmpty(n, nil)
+	for slot := range n {
+		for k := offsets[slot]; k < offsets[slot+1]; k++ {
+			if b.Value(k) {
+				out.Set(slot)
+				break
+			}
+		}
+	}
+	return out
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#is
+type Is struct {
+	zctx *zed.Context
+}
+
+func (i *Is) Call(args ...vector.Any) vector.Any {
+	vec, typeVec := args[0], args[1]
+	if len(args) == 3 {
+		vec, typeVec = args[1], args[2]
+	}
+	typeVec = vector.Under(typeVec)
+	if cnst, ok := typeVec.(*vector.Const); ok {
+		// Fast path
+		typ, err := i.lookup(cnst.Value())
+		return vector.NewConst(zed.NewBool(err == nil && typ == vec.Type()), vec.Len(), nil)
+	}
+	out := vector.NewBoolEmpty(vec.Len(), nil)
+	var b zcode.Builder
+	for k := range vec.Len() {
+		typ, err := i.lookup(vector.ValueAt(&b, typeVec, k))
+		if err == nil && typ == vec.Type() {
+			out.Set(k)
+		}
+	}
+	return out
+}
+
+func (i *Is) lookup(val zed.Value) (zed.Type, error) {
+	if val.IsString() {
+		return zson.ParseType(i.zctx, string(val.Bytes()))
+	}
+	return i.zctx.LookupByValue(val.Bytes())
+}
+
+func (*Is) RipUnions() bool {
+	return false
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#under
+type Under struct {
+	zctx *zed.Context
+}
+
+func (u *Under) Call(args ...vector.Any) vector.Any {
+	switch vec := args[0].(type) {
+	case *vector.Named:
+		return vec.Any
+	case *vector.Error:
+		return vec.Vals
+	case *vector.Union:
+		return vec.Dynamic
+	}
+	vec := args[0]
+	if vec.Type().ID() != zed.IDType {
+		return vec
+	}
+	out := vector.NewTypeValueEmpty(vec.Len(), vector.NullsOf(vec))
+	for i := range vec.Len() {
+		bytes, null := vector.TypeValueValue(vec, i)
+		if null {
+			out.Append(nil)
+			continue
+		}
+		typ, err := u.zctx.LookupByValue(bytes)
+		if err != nil {
+			panic(err)
+		}
+		out.Append(u.zctx.LookupTypeValue(zed.TypeUnder(typ)).Bytes())
+	}
+	return out
+}
+
+func (*Under) RipUnions() bool {
+	return false
+}
@@ -0,0 +1,54 @@
+package function
+
+import (
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr/function"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
+)
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#unflatten
+type Unflatten struct {
+	zctx      *zed.Context
+	unflatten *function.Unflatten
+	builder   zcode.Builder
+	elems     zcode.Builder
+}
+
+func newUnflatten(zctx *zed.Context) *Unflatten {
+	return &Unflatten{zctx: zctx, unflatten: function.NewUnflatten(zctx)}
+}
+
+func (u *Unflatten) Call(args ...vector.Any) vector.Any {
+	vec := args[0]
+	typ, ok := vector.Under(vec).Type().(*zed.TypeArray)
+	if !ok {
+		return vec
+	}
+	list := listVector(vec)
+	inner := vector.Inner(list)
+	out := vector.NewDynamicBuilder()
+slots:
+	for i := range list.Len() {
+		off, end, _ := vector.ContainerOffset(list, i)
+		// The unflattener retains the element bodies so they are
+		// serialized together before any are added.
+		u.elems.Reset()
+		for k := off; k < end; k++ {
+			inner.Serialize(&u.elems, k)
+		}
+		u.unflatten.Reset()
+		for it := u.elems.Bytes().Iter(); !it.Done(); {
+			if errVal, ok := u.unflatten.Add(typ.Type, it.Next()); !ok {
+				out.Write(errVal)
+				continue slots
+			}
+		}
+		val, err := u.unflatten.Build()
+		if err != nil {
+			val = u.zctx.WrapError(err.Error(), vector.ValueAt(&u.builder, vec, i))
+		}
+		out.Write(val)
+	}
+	return out.Build()
+}
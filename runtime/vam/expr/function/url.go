@@ -0,0 +1,112 @@
+package function
+
+import (
+	"net/url"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr/function"
+	"github.com/brimdata/super/vector"
+)
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#url_encode
+type URLEncode struct {
+	zctx *zed.Context
+}
+
+func (u *URLEncode) Call(args ...vector.Any) vector.Any {
+	val := vector.Under(args[0])
+	if val.Type() != zed.TypeString {
+		return vector.NewWrappedError(u.zctx, "url_encode: string arg required", val)
+	}
+	n := val.Len()
+	out := vector.NewStringEmpty(n, vector.NewBoolEmpty(n, nil))
+	for i := range n {
+		s, null := vector.StringValue(val, i)
+		if null {
+			out.Nulls.Set(i)
+		}
+		out.Append(url.QueryEscape(s))
+	}
+	return out
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#url_decode
+type URLDecode struct {
+	zctx *zed.Context
+}
+
+func (u *URLDecode) Call(args ...vector.Any) vector.Any {
+	val := vector.Under(args[0])
+	if val.Type() != zed.TypeString {
+		return vector.NewWrappedError(u.zctx, "url_decode: string arg required", val)
+	}
+	errs := newSlotErrors(u.zctx, "url_decode", args)
+	n := val.Len()
+	out := vector.NewStringEmpty(n, vector.NewBoolEmpty(n, nil))
+	for i := range n {
+		s, null := vector.StringValue(val, i)
+		if null {
+			out.Nulls.Set(out.Len())
+			out.Append("")
+			continue
+		}
+		s, err := url.QueryUnescape(s)
+		if err != nil {
+			errs.add(0, i, err)
+			continue
+		}
+		out.Append(s)
+	}
+	return errs.combine(out)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#domain_parts
+type DomainParts struct {
+	zctx *zed.Context
+}
+
+func (d *DomainParts) Call(args ...vector.Any) vector.Any {
+	val := vector.Under(args[0])
+	if val.Type() != zed.TypeString {
+		return vector.NewWrappedError(d.zctx, "domain_parts: string arg required", val)
+	}
+	n := val.Len()
+	nulls := vector.NewBoolEmpty(n, nil)
+	var fields [3]*vector.String
+	for k := range fields {
+		fields[k] = vector.NewStringEmpty(n, vector.NewBoolEmpty(n, nil))
+	}
+	for i := range n {
+		s, null := vector.StringValue(val, i)
+		if null {
+			nulls.Set(i)
+		}
+		for k, part := range function.SplitDomain(s) {
+			if part == "" {
+				fields[k].Nulls.Set(i)
+			}
+			fields[k].Append(part)
+		}
+	}
+	typ := function.DomainPartsType(d.zctx)
+	return vector.NewRecord(typ, []vector.Any{fields[0], fields[1], fields[2]}, n, nulls)
+}
+
+// https://github.com/brimdata/super/blob/main/docs/language/functions.md#parse_query
+type ParseQuery struct {
+	zctx  *zed.Context
+	parse *function.ParseQuery
+}
+
+func newParseQuery(zctx *zed.Context) *ParseQuery {
+	return &ParseQuery{zctx, function.NewParseQuery(zctx)}
+}
+
+func (p *ParseQuery) Call(args ...vector.Any) vector.Any {
+	vec := vector.Under(args[0])
+	if vec.Type() != zed.TypeString {
+		return vector.NewWrappedError(p.zctx, "parse_query: string arg required", args[0])
+	}
+	nullVal := zed.NewValue(p.parse.Type(), nil)
+	return parseStrings(p.zctx, "parse_query", vec, nullVal, p.parse.Parse)
+}
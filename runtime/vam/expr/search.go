@@ -0,0 +1,328 @@
+package expr
+
+import (
+	"bytes"
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/byteconv"
+	samexpr "github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
+)
+
+// search evaluates to true for each value of expr that contains a value
+// (at any depth) satisfying pred or that contains a record type whose field
+// names satisfy fieldPred.  If fieldPred is nil, field names are not searched.
+// Values of expr that are errors do not match.
+type search struct {
+	expr      Evaluator
+	pred      samexpr.Boolean
+	fieldPred func([]byte) bool
+	types     map[zed.Type]bool
+	builder   zcode.Builder
+}
+
+// NewSearch creates a filter that searches values for searchval, which must
+// be of a type other than string, or for the string representation of
+// searchval inside string values.  See expr.NewSearch in the sequential
+// runtime.
+func NewSearch(searchtext string, searchval zed.Value, e Evaluator) (Evaluator, error) {
+	if zed.TypeUnder(searchval.Type()) == zed.TypeNet {
+		net := zed.DecodeNet(searchval.Bytes())
+		pred := func(val zed.Value) bool {
+			switch val.Type().ID() {
+			case zed.IDNet:
+				return bytes.Equal(val.Bytes(), searchval.Bytes())
+			case zed.IDIP:
+				return net.Contains(zed.DecodeIP(val.Bytes()))
+			}
+			return false
+		}
+		return newSearch(e, pred, nil), nil
+	}
+	typedCompare, err := samexpr.Comparison("==", searchval)
+	if err != nil {
+		return nil, err
+	}
+	pred := func(val zed.Value) bool {
+		if val.Type().ID() == zed.IDString {
+			return stringSearch(byteconv.UnsafeString(val.Bytes()), searchtext)
+		}
+		return typedCompare(val)
+	}
+	return newSearch(e, pred, nil), nil
+}
+
+// NewSearchString is like NewSearch but handles the special case of matching
+// field names in addition to string values.
+func NewSearchString(term string, e Evaluator) Evaluator {
+	pred := func(val zed.Value) bool {
+		return val.Type().ID() == zed.IDString && stringSearch(byteconv.UnsafeString(val.Bytes()), term)
+	}
+	fieldPred := func(name []byte) bool {
+		return stringSearch(byteconv.UnsafeString(name), term)
+	}
+	return newSearch(e, pred, fieldPred)
+}
+
+// NewSearchRegexp creates a filter that matches values containing a string
+// or field name matched by re.
+func NewSearchRegexp(re *regexp.Regexp, e Evaluator) Evaluator {
+	pred := samexpr.Contains(samexpr.NewRegexpBoolean(re))
+	fieldPred := func(name []byte) bool {
+		return pred(zed.NewString(string(name)))
+	}
+	return newSearch(e, pred, fieldPred)
+}
+
+func newSearch(e Evaluator, pred samexpr.Boolean, fieldPred func([]byte) bool) *search {
+	return &search{
+		expr:      e,
+		pred:      pred,
+		fieldPred: fieldPred,
+		types:     make(map[zed.Type]bool),
+	}
+}
+
+func (s *search) Eval(this vector.Any) vector.Any {
+	return s.eval(s.expr.Eval(this), true)
+}
+
+// eval returns a vector.Bool indicating which values of vec match.  If top
+// is true, values of vec that are errors do not match.
+func (s *search) eval(vec vector.Any, top bool) *vector.Bool {
+	n := vec.Len()
+	switch vec := vec.(type) {
+	case *vector.Dynamic:
+		out := vector.NewBoolEmpty(n, nil)
+		for tag, values := range vec.Values {
+			match := s.eval(values, top)
+			for k, slot := range vec.TagMap.Reverse[tag] {
+				if match.Value(uint32(k)) {
+					out.Set(slot)
+				}
+			}
+		}
+		return out
+	case *vector.View:
+		match := s.eval(vec.Any, top)
+		out := vector.NewBoolEmpty(n, nil)
+		for k, slot := range vec.Index {
+			if match.Value(slot) {
+				out.Set(uint32(k))
+			}
+		}
+		return out
+	case *vector.Dict:
+		match := s.eval(vec.Any, top)
+		out := vector.NewBoolEmpty(n, nil)
+		for k, idx := range vec.Index {
+			if !vec.Nulls.Value(uint32(k)) && match.Value(uint32(idx)) {
+				out.Set(uint32(k))
+			}
+		}
+		return out
+	}
+	typ := vec.Type()
+	if top {
+		if _, ok := zed.TypeUnder(typ).(*zed.TypeError); ok {
+			return vector.NewBoolEmpty(n, nil)
+		}
+	}
+	if s.searchType(typ) {
+		return trueBool(n)
+	}
+	switch vec := vec.(type) {
+	case *vector.Named:
+		return s.eval(vec.Any, false)
+	case *vector.Error:
+		return andNot(s.eval(vec.Vals, false), vec.Nulls)
+	case *vector.Union:
+		match := s.eval(vec.Dynamic, false)
+		if vec.Nulls != nil && vec.Nulls.Len() != n {
+			// The tags of a union loaded from VNG omit its null slots.
+			return spreadDense(vec.Nulls.Len(), match, vec.Nulls)
+		}
+		return andNot(match, vec.Nulls)
+	case *vector.Record:
+		out := vector.NewBoolEmpty(n, nil)
+		for _, field := range vec.Fields {
+			out = vector.Or(out, s.eval(field, false))
+		}
+		return andNot(out, vec.Nulls)
+	case *vector.Array:
+		return anyInRange(n, vec.Offsets, s.eval(vec.Values, false))
+	case *vector.Set:
+		return anyInRange(n, vec.Offsets, s.eval(vec.Values, false))
+	case *vector.Map:
+		match := vector.Or(s.eval(vec.Keys, false), s.eval(vec.Values, false))
+		return anyInRange(n, vec.Offsets, match)
+	case *vector.Const:
+		var match, nullMatch bool
+		if val := vec.Value(); s.match(val) {
+			match = true
+		}
+		if vec.Nulls != nil && s.match(zed.NewValue(typ, nil)) {
+			nullMatch = true
+		}
+		out := vector.NewBoolEmpty(n, nil)
+		for slot := range n {
+			if vec.Nulls.Value(slot) && nullMatch || !vec.Nulls.Value(slot) && match {
+				out.Set(slot)
+			}
+		}
+		return out
+	}
+	out := vector.NewBoolEmpty(n, nil)
+	for slot := range n {
+		if s.pred(vector.ValueAt(&s.builder, vec, slot)) {
+			out.Set(slot)
+		}
+	}
+	return out
+}
+
+var errMatch = errors.New("match")
+
+// match is like eval but for a single value.
+func (s *search) match(val zed.Value) bool {
+	return errMatch == val.Walk(func(typ zed.Type, body zcode.Bytes) error {
+		if s.searchType(typ) || s.pred(zed.NewValue(typ, body)) {
+			return errMatch
+		}
+		return nil
+	})
+}
+
+// searchType returns true if typ is a record type with a field name
+// satisfying s.fieldPred.  Results are memoized for each unique type.
+func (s *search) searchType(typ zed.Type) bool {
+	if s.fieldPred == nil {
+		return false
+	}
+	if match, ok := s.types[typ]; ok {
+		return match
+	}
+	var match bool
+	if recType := zed.TypeRecordOf(typ); recType != nil {
+		var nameIter samexpr.FieldNameIter
+		nameIter.Init(recType)
+		for !nameIter.Done() {
+			if s.fieldPred(nameIter.Next()) {
+				match = true
+				break
+			}
+		}
+	}
+	s.types[typ] = match
+	return match
+}
+
+func trueBool(n uint32) *vector.Bool {
+	out := vector.NewBoolEmpty(n, nil)
+	for slot := range n {
+		out.Set(slot)
+	}
+	return out
+}
+
+// andNot clears the bits of b that are set in nulls.
+func andNot(b, nulls *vector.Bool) *vector.Bool {
+	if nulls == nil {
+		return b
+	}
+	bits := make([]uint64, len(b.Bits))
+	for k := range bits {
+		bits[k] = b.Bits[k] &^ nulls.Bits[k]
+	}
+	return b.CopyWithBits(bits)
+}
+
+// spreadDense returns a vector.Bool of length n whose values are the values
+// of match at the slots that are not null.
+func spreadDense(n uint32, match, nulls *vector.Bool) *vector.Bool {
+	out := vector.NewBoolEmpty(n, nil)
+	var off uint32
+	for slot := range n {
+		if nulls.Value(slot) {
+			continue
+		}
+		if match.Value(off) {
+			out.Set(slot)
+		}
+		off++
+	}
+	return out
+}
+
+// anyInRange returns a vector.Bool of length n whose value at each slot is
+// true if any value of match between offsets[slot] and offsets[slot+1] is
+// true.
+func anyInRange(n uint32, offsets []uint32, match *vector.Bool) *vector.Bool {
+	out := vector.NewBoolEmpty(n, nil)
+	for slot := range n {
+		for k := offsets[slot]; k < offsets[slot+1]; k++ {
+			if match.Value(k) {
+				out.Set(slot)
+				break
+			}
+		}
+	}
+	return out
+}
+
+// stringSearch is like strings.Contains() but with case-insensitive
+// comparison.
+func stringSearch(a, b string) bool {
+	alen := len(a)
+	blen := len(b)
+
+	if blen > alen {
+		return false
+	}
+
+	end := alen - blen + 1
+	i := 0
+	for i < end {
+		if strings.EqualFold(a[i:i+blen], b) {
+			return true
+		}
+		i++
+	}
+	return false
+}
+
+type regexpMatch struct {
+	re   *regexp.Regexp
+	expr Evaluator
+}
+
+// NewRegexpMatch returns an Evaluator that is true for each value of e that
+// is a string matched by re.
+func NewRegexpMatch(re *regexp.Regexp, e Evaluator) Evaluator {
+	return &regexpMatch{re, e}
+}
+
+func (r *regexpMatch) Eval(this vector.Any) vector.Any {
+	vec := r.expr.Eval(this)
+	out := vector.NewBoolEmpty(vec.Len(), nil)
+	if s, ok := vec.(*vector.String); ok {
+		for slot := range vec.Len() {
+			if r.re.MatchString(s.Value(slot)) {
+				out.Set(slot)
+			}
+		}
+		return out
+	}
+	var b zcode.Builder
+	for slot := range vec.Len() {
+		val := vector.ValueAt(&b, vec, slot)
+		if val.Type().ID() == zed.IDString && r.re.Match(val.Bytes()) {
+			out.Set(slot)
+		}
+	}
+	return out
+}
@@ -0,0 +1,163 @@
+package expr
+
+import (
+	"bytes"
+	"cmp"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/order"
+	samexpr "github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
+)
+
+// Comparator compares values held in vectors with the same ordering as
+// samexpr.Comparator.  Numbers, strings, bytes, and IP addresses are compared
+// directly from their vectors while values of other types are materialized
+// and compared as zed.Values.
+type Comparator struct {
+	desc          bool
+	nullsMax      bool
+	missingAsNull bool
+	compare       samexpr.CompareFn
+	a             zcode.Builder
+	b             zcode.Builder
+}
+
+// NewComparator returns a Comparator for values sorted in order o.  nullsMax
+// determines whether a null value compares larger (if true) or smaller (if
+// false) than a non-null value before o is applied.
+func NewComparator(o order.Which, nullsMax bool) *Comparator {
+	return &Comparator{
+		desc:     o == order.Desc,
+		nullsMax: nullsMax,
+		compare:  samexpr.NewValueCompareFn(order.Asc, nullsMax),
+	}
+}
+
+// WithMissingAsNull returns the receiver after modifying it to treat missing
+// values as the null value in comparisons.
+func (c *Comparator) WithMissingAsNull() *Comparator {
+	c.missingAsNull = true
+	return c
+}
+
+// Compare returns an integer comparing the value in slot i of a to the
+// value in slot j of b.  The result will be 0 if they are equal, -1 if the
+// first precedes the second, and +1 otherwise.
+func (c *Comparator) Compare(a vector.Any, i uint32, b vector.Any, j uint32) int {
+	if c.desc {
+		a, i, b, j = b, j, a, i
+	}
+	a, i, nullA := c.deref(a, i)
+	b, j, nullB := c.deref(b, j)
+	switch {
+	case nullA && nullB:
+		return 0
+	case nullA:
+		if c.nullsMax {
+			return 1
+		}
+		return -1
+	case nullB:
+		if c.nullsMax {
+			return -1
+		}
+		return 1
+	}
+	aid, bid := a.Type().ID(), b.Type().ID()
+	switch {
+	case isNumberKind(a) && isNumberKind(b):
+		return compareNumbers(a, i, aid, b, j, bid)
+	case aid != bid:
+		// Let the fallback order mismatched types.
+	case aid == zed.IDString:
+		as, _ := vector.StringValue(a, i)
+		bs, _ := vector.StringValue(b, j)
+		return cmp.Compare(as, bs)
+	case aid == zed.IDBytes:
+		ab, _ := vector.BytesValue(a, i)
+		bb, _ := vector.BytesValue(b, j)
+		return bytes.Compare(ab, bb)
+	case aid == zed.IDIP:
+		aip, _ := vector.IPValue(a, i)
+		bip, _ := vector.IPValue(b, j)
+		return aip.Compare(bip)
+	}
+	return c.compare(vector.ValueAt(&c.a, a, i), vector.ValueAt(&c.b, b, j))
+}
+
+// deref follows slot of vec through any Dict, Dynamic, and View vectors and
+// returns the vector and slot holding its value along with whether the value
+// is to be compared as null.
+func (c *Comparator) deref(vec vector.Any, slot uint32) (vector.Any, uint32, bool) {
+	for {
+		switch v := vec.(type) {
+		case *vector.Dict:
+			if v.Nulls.Value(slot) {
+				return vec, slot, true
+			}
+			vec, slot = v.Any, uint32(v.Index[slot])
+		case *vector.Dynamic:
+			vec, slot = v.Values[v.Tags[slot]], v.TagMap.Forward[slot]
+		case *vector.View:
+			vec, slot = v.Any, v.Index[slot]
+		case *vector.Const:
+			return vec, slot, v.Nulls.Value(slot) || v.Value().IsNull()
+		case *vector.Error:
+			val := vector.ValueAt(&c.a, vec, slot)
+			return vec, slot, val.IsNull() || c.missingAsNull && val.IsMissing()
+		default:
+			return vec, slot, vector.NullsOf(vec).Value(slot)
+		}
+	}
+}
+
+func isNumberKind(vec vector.Any) bool {
+	switch vector.KindOf(vec) {
+	case vector.KindInt, vector.KindUint, vector.KindFloat:
+		return true
+	}
+	return false
+}
+
+func compareNumbers(a vector.Any, i uint32, aid int, b vector.Any, j uint32, bid int) int {
+	switch {
+	case zed.IsFloat(aid) || zed.IsFloat(bid):
+		return cmp.Compare(toFloat(a, i, aid), toFloat(b, j, bid))
+	case zed.IsSigned(aid):
+		av, _ := vector.IntValue(a, i)
+		if zed.IsUnsigned(bid) {
+			if av < 0 {
+				return -1
+			}
+			bv, _ := vector.UintValue(b, j)
+			return cmp.Compare(uint64(av), bv)
+		}
+		bv, _ := vector.IntValue(b, j)
+		return cmp.Compare(av, bv)
+	case zed.IsSigned(bid):
+		bv, _ := vector.IntValue(b, j)
+		if bv < 0 {
+			return 1
+		}
+		av, _ := vector.UintValue(a, i)
+		return cmp.Compare(av, uint64(bv))
+	}
+	av, _ := vector.UintValue(a, i)
+	bv, _ := vector.UintValue(b, j)
+	return cmp.Compare(av, bv)
+}
+
+func toFloat(vec vector.Any, slot uint32, id int) float64 {
+	switch {
+	case zed.IsFloat(id):
+		f, _ := vector.FloatValue(vec, slot)
+		return f
+	case zed.IsSigned(id):
+		i, _ := vector.IntValue(vec, slot)
+		return float64(i)
+	}
+	u, _ := vector.UintValue(vec, slot)
+	return float64(u)
+}
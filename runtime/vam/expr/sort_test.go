@@ -0,0 +1,55 @@
+package expr
+
+import (
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/order"
+	samexpr "github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
+	"github.com/brimdata/super/zson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test that Comparator orders values the same way as samexpr.Comparator.
+func TestComparatorMatchesSam(t *testing.T) {
+	zctx := zed.NewContext()
+	var vals []zed.Value
+	for _, s := range []string{
+		"1", "-1", "2(uint8)", "1.5", "-2.5(float32)", "null(int64)", "null",
+		`"a"`, `"B"`, `0x0102`, "10.0.0.1", "::1", "true", "[1,2]",
+		"{a:1}", `error("missing")`, `error("x")`, "1s", "2024-01-01T00:00:00Z",
+	} {
+		val, err := zson.ParseValue(zctx, s)
+		require.NoError(t, err)
+		vals = append(vals, val)
+	}
+	b := vector.NewDynamicBuilder()
+	for _, val := range vals {
+		b.Write(val)
+	}
+	vec := b.Build()
+	var builder zcode.Builder
+	for _, o := range []order.Which{order.Asc, order.Desc} {
+		for _, nullsMax := range []bool{true, false} {
+			for _, missingAsNull := range []bool{true, false} {
+				expected := samexpr.NewComparator(nullsMax, samexpr.NewSortEvaluator(&samexpr.This{}, o))
+				c := NewComparator(o, nullsMax)
+				if missingAsNull {
+					expected.WithMissingAsNull()
+					c.WithMissingAsNull()
+				}
+				for i := range vec.Len() {
+					for j := range vec.Len() {
+						a := vector.ValueAt(&builder, vec, i).Copy()
+						b := vector.ValueAt(&builder, vec, j)
+						assert.Equal(t, expected.Compare(a, b), c.Compare(vec, i, vec, j),
+							"%s %s %s nullsMax=%t missingAsNull=%t", zson.FormatValue(a), zson.FormatValue(b), o, nullsMax, missingAsNull)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,358 @@
+package expr
+
+import (
+	"github.com/brimdata/super"
+	samexpr "github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
+)
+
+type VectorElem struct {
+	Value  Evaluator
+	Spread Evaluator
+}
+
+type listExpr struct {
+	zctx  *zed.Context
+	elems []VectorElem
+	set   bool
+
+	vecs       []vector.Any
+	builders   []zcode.Builder
+	collection collection
+	builder    zcode.Builder
+}
+
+func NewArrayExpr(zctx *zed.Context, elems []VectorElem) Evaluator {
+	return &listExpr{zctx: zctx, elems: elems, builders: make([]zcode.Builder, len(elems))}
+}
+
+func NewSetExpr(zctx *zed.Context, elems []VectorElem) Evaluator {
+	return &listExpr{zctx: zctx, elems: elems, set: true, builders: make([]zcode.Builder, len(elems))}
+}
+
+func (l *listExpr) Eval(this vector.Any) vector.Any {
+	l.vecs = l.vecs[:0]
+	for _, e := range l.elems {
+		if e.Value != nil {
+			l.vecs = append(l.vecs, e.Value.Eval(this))
+		} else {
+			l.vecs = append(l.vecs, e.Spread.Eval(this))
+		}
+	}
+	out := vector.NewDynamicBuilder()
+	for slot := range this.Len() {
+		l.collection.reset()
+		for k, e := range l.elems {
+			val := vector.ValueAt(&l.builders[k], l.vecs[k], slot)
+			if e.Value != nil {
+				l.collection.append(val)
+				continue
+			}
+			inner := zed.InnerType(val.Type())
+			if inner == nil {
+				// Treat non-list spread values values like missing.
+				continue
+			}
+			l.collection.appendSpread(inner, val.Bytes())
+		}
+		out.Write(l.build())
+	}
+	return out.Build()
+}
+
+func (l *listExpr) build() zed.Value {
+	if len(l.collection.types) == 0 {
+		if l.set {
+			return zed.NewValue(l.zctx.LookupTypeSet(zed.TypeNull), []byte{})
+		}
+		return zed.NewValue(l.zctx.LookupTypeArray(zed.TypeNull), []byte{})
+	}
+	l.builder.Reset()
+	typ := l.collection.build(l.zctx, &l.builder)
+	if l.set {
+		return zed.NewValue(l.zctx.LookupTypeSet(typ), zed.NormalizeSet(l.builder.Bytes()))
+	}
+	return zed.NewValue(l.zctx.LookupTypeArray(typ), l.builder.Bytes())
+}
+
+type Entry struct {
+	Key Evaluator
+	Val Evaluator
+}
+
+type mapExpr struct {
+	zctx    *zed.Context
+	entries []Entry
+
+	vecs     []vector.Any
+	builders []zcode.Builder
+	keys     collection
+	vals     collection
+	builder  zcode.Builder
+}
+
+func NewMapExpr(zctx *zed.Context, entries []Entry) Evaluator {
+	return &mapExpr{
+		zctx:     zctx,
+		entries:  entries,
+		builders: make([]zcode.Builder, 2*len(entries)),
+	}
+}
+
+func (m *mapExpr) Eval(this vector.Any) vector.Any {
+	m.vecs = m.vecs[:0]
+	for _, e := range m.entries {
+		m.vecs = append(m.vecs, e.Key.Eval(this), e.Val.Eval(this))
+	}
+	out := vector.NewDynamicBuilder()
+	for slot := range this.Len() {
+		m.keys.reset()
+		m.vals.reset()
+		for k := 0; k < len(m.vecs); k += 2 {
+			m.keys.append(vector.ValueAt(&m.builders[k], m.vecs[k], slot))
+			m.vals.append(vector.ValueAt(&m.builders[k+1], m.vecs[k+1], slot))
+		}
+		if len(m.keys.types) == 0 {
+			typ := m.zctx.LookupTypeMap(zed.TypeNull, zed.TypeNull)
+			out.Write(zed.NewValue(typ, []byte{}))
+			continue
+		}
+		m.builder.Reset()
+		keyType, valType := m.keys.unionOf(m.zctx), m.vals.unionOf(m.zctx)
+		for k := range m.keys.types {
+			m.keys.appendTo(&m.builder, keyType, k)
+			m.vals.appendTo(&m.builder, valType, k)
+		}
+		typ := m.zctx.LookupTypeMap(keyType, valType)
+		out.Write(zed.NewValue(typ, zed.NormalizeMap(m.builder.Bytes())))
+	}
+	return out.Build()
+}
+
+type mapCall struct {
+	zctx  *zed.Context
+	expr  Evaluator
+	inner Evaluator
+
+	builder    zcode.Builder
+	collection collection
+}
+
+// NewMapCall returns an Evaluator for the map() function, which applies inner
+// to each element of the arrays or sets that are the values of e.
+func NewMapCall(zctx *zed.Context, e, inner Evaluator) Evaluator {
+	return &mapCall{zctx: zctx, expr: e, inner: inner}
+}
+
+func (m *mapCall) Eval(this vector.Any) vector.Any {
+	vec := m.expr.Eval(this)
+	// Gather the elements of every container into a single vector so inner
+	// is evaluated just once.
+	elems := vector.NewDynamicBuilder()
+	counts := make([]int, vec.Len())
+	var total int
+	for slot := range vec.Len() {
+		val := vector.ValueAt(&m.builder, vec, slot)
+		inner := zed.InnerType(val.Type())
+		if inner == nil {
+			continue
+		}
+		for it := val.Bytes().Iter(); !it.Done(); {
+			elems.Write(zed.NewValue(inner, it.Next()))
+			counts[slot]++
+		}
+		total += counts[slot]
+	}
+	var results vector.Any
+	if total > 0 {
+		results = m.inner.Eval(elems.Build())
+	}
+	var b zcode.Builder
+	var off uint32
+	out := vector.NewDynamicBuilder()
+	for slot := range vec.Len() {
+		val := vector.ValueAt(&m.builder, vec, slot)
+		if val.IsError() {
+			out.Write(val)
+			continue
+		}
+		typ := zed.InnerType(val.Type())
+		if typ == nil {
+			out.Write(m.zctx.WrapError(zed.ErrNotContainer.Error(), vector.ValueAt(&b, this, slot)))
+			continue
+		}
+		if counts[slot] == 0 {
+			out.Write(val)
+			continue
+		}
+		m.collection.reset()
+		for range counts[slot] {
+			m.collection.append(vector.ValueAt(&b, results, off).Copy())
+			off++
+		}
+		innerType := m.collection.uniqueUnionOf(m.zctx)
+		b.Reset()
+		for k := range m.collection.types {
+			m.collection.appendTo(&b, innerType, k)
+		}
+		if _, ok := zed.TypeUnder(val.Type()).(*zed.TypeSet); ok {
+			out.Write(zed.NewValue(m.zctx.LookupTypeSet(innerType), zed.NormalizeSet(b.Bytes())))
+		} else {
+			out.Write(zed.NewValue(m.zctx.LookupTypeArray(innerType), b.Bytes()))
+		}
+	}
+	return out.Build()
+}
+
+type mapFilter struct {
+	zctx  *zed.Context
+	expr  Evaluator
+	inner Evaluator
+
+	builder zcode.Builder
+	entry   zcode.Builder
+}
+
+// NewMapFilter returns an Evaluator for the map_filter() function, which keeps
+// the entries of the maps that are the values of e for which inner, applied
+// to a record with fields key and value, is true.
+func NewMapFilter(zctx *zed.Context, e, inner Evaluator) Evaluator {
+	return &mapFilter{zctx: zctx, expr: e, inner: inner}
+}
+
+func (m *mapFilter) Eval(this vector.Any) vector.Any {
+	vec := m.expr.Eval(this)
+	// Gather the entries of every map into a single vector so inner is
+	// evaluated just once.
+	entries := vector.NewDynamicBuilder()
+	var total uint32
+	for slot := range vec.Len() {
+		val := vector.ValueAt(&m.builder, vec, slot).Under()
+		typ, ok := val.Type().(*zed.TypeMap)
+		if !ok {
+			continue
+		}
+		entryType := samexpr.MapEntryType(m.zctx, typ)
+		for it := val.Bytes().Iter(); !it.Done(); {
+			m.entry.Reset()
+			m.entry.Append(it.Next())
+			m.entry.Append(it.Next())
+			entries.Write(zed.NewValue(entryType, m.entry.Bytes()))
+			total++
+		}
+	}
+	var results vector.Any
+	if total > 0 {
+		results = m.inner.Eval(entries.Build())
+	}
+	var b zcode.Builder
+	var off uint32
+	out := vector.NewDynamicBuilder()
+	for slot := range vec.Len() {
+		val := vector.ValueAt(&m.builder, vec, slot)
+		if val.IsError() {
+			out.Write(val)
+			continue
+		}
+		val = val.Under()
+		typ, ok := val.Type().(*zed.TypeMap)
+		if !ok {
+			out.Write(m.zctx.WrapError("map_filter: map arg required", val))
+			continue
+		}
+		if val.IsNull() {
+			out.Write(val)
+			continue
+		}
+		b.Reset()
+		for it := val.Bytes().Iter(); !it.Done(); off++ {
+			key, value := it.Next(), it.Next()
+			if vector.BoolValue(results, off) {
+				b.Append(key)
+				b.Append(value)
+			}
+		}
+		bytes := b.Bytes()
+		if bytes == nil {
+			bytes = zcode.Bytes{}
+		}
+		out.Write(zed.NewValue(typ, bytes))
+	}
+	return out.Build()
+}
+
+// collection accumulates the elements of a container value under
+// construction.  See collectionBuilder in the sequential runtime.
+type collection struct {
+	types       []zed.Type
+	uniqueTypes []zed.Type
+	bytes       []zcode.Bytes
+}
+
+func (c *collection) reset() {
+	c.types = c.types[:0]
+	c.bytes = c.bytes[:0]
+}
+
+func (c *collection) append(val zed.Value) {
+	c.types = append(c.types, val.Type())
+	c.bytes = append(c.bytes, val.Bytes())
+}
+
+func (c *collection) appendSpread(inner zed.Type, b zcode.Bytes) {
+	union, _ := zed.TypeUnder(inner).(*zed.TypeUnion)
+	for it := b.Iter(); !it.Done(); {
+		typ := inner
+		bytes := it.Next()
+		if union != nil {
+			typ, bytes = union.Untag(bytes)
+		}
+		c.types = append(c.types, typ)
+		c.bytes = append(c.bytes, bytes)
+	}
+}
+
+// build appends the elements of c to b and returns their type.
+func (c *collection) build(zctx *zed.Context, b *zcode.Builder) zed.Type {
+	typ := c.unionOf(zctx)
+	for k := range c.types {
+		c.appendTo(b, typ, k)
+	}
+	return typ
+}
+
+// unionOf returns the type of the elements of c, which is a union if there
+// is more than one type of element other than null.
+func (c *collection) unionOf(zctx *zed.Context) zed.Type {
+	c.uniqueTypes = c.uniqueTypes[:0]
+	for _, t := range zed.UniqueTypes(append(c.uniqueTypes, c.types...)) {
+		if t != zed.TypeNull {
+			c.uniqueTypes = append(c.uniqueTypes, t)
+		}
+	}
+	switch len(c.uniqueTypes) {
+	case 0:
+		return zed.TypeNull
+	case 1:
+		return c.uniqueTypes[0]
+	}
+	return zctx.LookupTypeUnion(c.uniqueTypes)
+}
+
+// uniqueUnionOf is like unionOf but does not treat null specially.
+func (c *collection) uniqueUnionOf(zctx *zed.Context) zed.Type {
+	c.uniqueTypes = zed.UniqueTypes(append(c.uniqueTypes[:0], c.types...))
+	if len(c.uniqueTypes) == 1 {
+		return c.uniqueTypes[0]
+	}
+	return zctx.LookupTypeUnion(c.uniqueTypes)
+}
+
+func (c *collection) appendTo(b *zcode.Builder, typ zed.Type, k int) {
+	if union, ok := typ.(*zed.TypeUnion); ok && c.types[k] != typ {
+		zed.BuildUnion(b, union.TagOf(c.types[k]), c.bytes[k])
+	} else {
+		b.Append(c.bytes[k])
+	}
+}
@@ -0,0 +1,35 @@
+package expr
+
+import (
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/vector"
+)
+
+// Vars holds the values of the variables bound by the lateral expressions
+// enclosing an expression.  Since a lateral subquery is run once for each
+// value of its input, each variable is constant while the subquery runs.
+type Vars struct {
+	vals []zed.Value
+}
+
+func (v *Vars) Set(slot int, val zed.Value) {
+	for slot >= len(v.vals) {
+		v.vals = append(v.vals, zed.Null)
+	}
+	v.vals[slot] = val
+}
+
+type Var struct {
+	vars *Vars
+	slot int
+}
+
+var _ Evaluator = (*Var)(nil)
+
+func NewVar(vars *Vars, slot int) *Var {
+	return &Var{vars, slot}
+}
+
+func (v *Var) Eval(this vector.Any) vector.Any {
+	return vector.NewConst(v.vars.vals[v.slot], this.Len(), nil)
+}
@@ -139,82 +139,3 @@ func (c *countByString) materialize(zctx *zed.Context, name string) *vector.Reco
 	countVec := vector.NewUint(zed.TypeUint64, counts, nil)
 	return vector.NewRecord(typ, []vector.Any{keyVec, countVec}, uint32(length), nil)
 }
-
-type Sum struct {
-	parent vector.Puller
-	zctx   *zed.Context
-	field  expr.Evaluator
-	name   string
-	sum    int64
-	done   bool
-}
-
-func NewSum(zctx *zed.Context, parent vector.Puller, name string) *Sum {
-	return &Sum{
-		parent: parent,
-		zctx:   zctx,
-		field:  expr.NewDotExpr(zctx, &expr.This{}, name),
-		name:   name,
-	}
-}
-
-func (c *Sum) Pull(done bool) (vector.Any, error) {
-	if done {
-		_, err := c.parent.Pull(done)
-		return nil, err
-	}
-	if c.done {
-		return nil, nil
-	}
-	for {
-		//XXX check context Done
-		// XXX PullVec returns a single vector and enumerates through the
-		// different underlying types that match a particular projection
-		vec, err := c.parent.Pull(false)
-		if err != nil {
-			return nil, err
-		}
-		if vec == nil {
-			c.done = true
-			return c.materialize(c.zctx, c.name), nil
-		}
-		c.update(vec)
-	}
-}
-
-func (c *Sum) update(vec vector.Any) {
-	if vec, ok := vec.(*vector.Dynamic); ok {
-		for _, vec := range vec.Values {
-			c.update(vec)
-		}
-		return
-	}
-	switch vec := c.field.Eval(vec).(type) {
-	case *vector.Int:
-		for _, x := range vec.Values {
-			c.sum += x
-		}
-	case *vector.Uint:
-		for _, x := range vec.Values {
-			c.sum += int64(x)
-		}
-	case *vector.Dict:
-		switch number := vec.Any.(type) {
-		case *vector.Int:
-			for k, val := range number.Values {
-				c.sum += val * int64(vec.Counts[k])
-			}
-		case *vector.Uint:
-			for k, val := range number.Values {
-				c.sum += int64(val) * int64(vec.Counts[k])
-			}
-		}
-	}
-}
-
-func (c *Sum) materialize(zctx *zed.Context, name string) *vector.Record {
-	typ := zctx.MustLookupTypeRecord([]zed.Field{
-		{Type: zed.TypeInt64, Name: "sum"},
-	})
-	return vector.NewRecord(typ, []vector.Any{vector.NewInt(zed.TypeInt64, []int64{c.sum}, nil)}, 1, nil)
-}
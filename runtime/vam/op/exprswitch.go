@@ -0,0 +1,91 @@
+package op
+
+import (
+	"context"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/vam/expr"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
+)
+
+// ExprSwitch sends each value to the case whose value equals the value of
+// expr or, if there is no such case, to the default case.  Values for which
+// expr is missing are dropped.
+type ExprSwitch struct {
+	*Router
+	expr        expr.Evaluator
+	cases       map[string]*exprSwitchCase
+	defaultCase *exprSwitchCase
+	builder     zcode.Builder
+}
+
+var _ Selector = (*ExprSwitch)(nil)
+
+type exprSwitchCase struct {
+	route vector.Puller
+	index []uint32
+}
+
+func NewExprSwitch(ctx context.Context, parent vector.Puller, e expr.Evaluator) *ExprSwitch {
+	router := NewRouter(ctx, parent)
+	s := &ExprSwitch{
+		Router: router,
+		expr:   e,
+		cases:  make(map[string]*exprSwitchCase),
+	}
+	router.Link(s)
+	return s
+}
+
+// AddCase adds a case for val or, if val is nil, the default case.
+func (s *ExprSwitch) AddCase(val *zed.Value) vector.Puller {
+	route := s.Router.AddRoute()
+	if val == nil {
+		s.defaultCase = &exprSwitchCase{route: route}
+	} else {
+		s.cases[string(val.Bytes())] = &exprSwitchCase{route: route}
+	}
+	return route
+}
+
+func (s *ExprSwitch) Forward(router *Router, vec vector.Any) bool {
+	exprVec := s.expr.Eval(vec)
+	for slot := range vec.Len() {
+		s.builder.Truncate()
+		exprVec.Serialize(&s.builder, slot)
+		bytes := s.builder.Bytes().Body()
+		if typ, ok := vector.TypeOf(exprVec, slot).(*zed.TypeError); ok && typ.IsMissing(bytes) {
+			continue
+		}
+		which, ok := s.cases[string(bytes)]
+		if !ok {
+			which = s.defaultCase
+		}
+		if which == nil {
+			continue
+		}
+		which.index = append(which.index, slot)
+	}
+	for _, c := range s.cases {
+		if !s.send(router, c, vec) {
+			return false
+		}
+	}
+	if c := s.defaultCase; c != nil {
+		return s.send(router, c, vec)
+	}
+	return true
+}
+
+func (s *ExprSwitch) send(router *Router, c *exprSwitchCase, vec vector.Any) bool {
+	if len(c.index) == 0 {
+		return true
+	}
+	out := vec
+	if len(c.index) < int(vec.Len()) {
+		out = vector.NewView(c.index, vec)
+	}
+	c.index = nil
+	return router.Send(c.route, out)
+}
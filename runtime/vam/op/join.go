@@ -0,0 +1,477 @@
+package op
+
+import (
+	"fmt"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+	"github.com/brimdata/super/runtime/vam/expr"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
+)
+
+// Join is a hash join.  It builds a hash table from all of the vectors
+// of the right parent and then streams the left parent through the table,
+// so unlike the sequence join, neither input needs to be sorted.  Values
+// whose keys are equal are joined only if cond, when present, is true for
+// the record {left:<left value>,right:<right value>}.
+type Join struct {
+	rctx        *runtime.Context
+	anti        bool
+	inner       bool
+	full        bool
+	left        vector.Puller
+	right       vector.Puller
+	leftKeys    []expr.Evaluator
+	rightKeys   []expr.Evaluator
+	cond        expr.Evaluator
+	condSwapped bool
+	cutter      expr.Evaluator
+
+	builder *zcode.Builder
+	buildCh chan error
+	stopCh  chan struct{}
+	built   bool
+	leftEOS bool
+	flushed bool
+	pending []vector.Any
+	table   map[string][]joinRef
+	vecs    []vector.Any
+	rights  []vector.Any
+	types   map[int]map[int]*zed.TypeRecord
+	// For a full join, matched tracks which values of rights have been
+	// joined.  Values with a missing key are marked as matched so they are
+	// never output.
+	matched [][]bool
+}
+
+// joinRef locates a value of the build side by the vector that holds it
+// and its slot within that vector.
+type joinRef struct {
+	vec  uint32
+	slot uint32
+}
+
+// NewJoin returns a Join.  If condSwapped is true, the parents have been
+// swapped (as they are for a right join) so the values are presented to
+// cond in the opposite order.
+func NewJoin(rctx *runtime.Context, anti, inner, full bool, left, right vector.Puller, leftKeys, rightKeys []expr.Evaluator,
+	cond expr.Evaluator, condSwapped bool, cutter expr.Evaluator) *Join {
+	return &Join{
+		rctx:        rctx,
+		anti:        anti,
+		inner:       inner,
+		full:        full,
+		left:        left,
+		right:       right,
+		leftKeys:    leftKeys,
+		rightKeys:   rightKeys,
+		cond:        cond,
+		condSwapped: condSwapped,
+		cutter:      cutter,
+		builder:     zcode.NewBuilder(),
+		types:       make(map[int]map[int]*zed.TypeRecord),
+	}
+}
+
+func (j *Join) Pull(done bool) (vector.Any, error) {
+	if done {
+		var err error
+		if j.buildCh == nil {
+			_, err = j.right.Pull(true)
+		} else {
+			err = j.stopBuild()
+		}
+		if !j.leftEOS {
+			if _, pullErr := j.left.Pull(true); err == nil {
+				err = pullErr
+			}
+		}
+		j.reset()
+		return nil, err
+	}
+	if j.buildCh == nil {
+		j.buildCh = make(chan error, 1)
+		j.stopCh = make(chan struct{})
+		go func() { j.buildCh <- j.build() }()
+	}
+	// Pull from the left while the table is being built since both
+	// parents may be fed by the same upstream operator (e.g., a fork),
+	// which would block if we didn't make progress on both of them.
+	for !j.built {
+		if j.leftEOS {
+			if err := j.waitForBuild(); err != nil {
+				return nil, j.fail(err)
+			}
+			break
+		}
+		vec, err := j.left.Pull(false)
+		if err != nil {
+			return nil, j.fail(err)
+		}
+		if vec == nil {
+			j.leftEOS = true
+			continue
+		}
+		j.pending = append(j.pending, vec)
+		select {
+		case err := <-j.buildCh:
+			j.built = true
+			if err != nil {
+				return nil, j.fail(err)
+			}
+		default:
+		}
+	}
+	for {
+		var vec vector.Any
+		if len(j.pending) > 0 {
+			vec = j.pending[0]
+			j.pending = j.pending[1:]
+		} else if !j.leftEOS {
+			var err error
+			vec, err = j.left.Pull(false)
+			if err != nil {
+				return nil, j.fail(err)
+			}
+			j.leftEOS = vec == nil
+		}
+		if vec == nil {
+			if j.full && !j.flushed {
+				j.flushed = true
+				if out := j.unmatched(); out != nil {
+					return out, nil
+				}
+			}
+			j.reset()
+			return nil, nil
+		}
+		if out := j.probe(vec); out != nil {
+			return out, nil
+		}
+	}
+}
+
+// waitForBuild waits for the build goroutine to finish the hash table.  If
+// the context is canceled first, the build is stopped.
+func (j *Join) waitForBuild() error {
+	if j.built {
+		return nil
+	}
+	select {
+	case err := <-j.buildCh:
+		j.built = true
+		return err
+	case <-j.rctx.Done():
+		j.stopBuild()
+		return j.rctx.Err()
+	}
+}
+
+// stopBuild tells the build goroutine, if it is still running, to send
+// done to the right parent and waits for it to exit.
+func (j *Join) stopBuild() error {
+	if j.built {
+		return nil
+	}
+	close(j.stopCh)
+	j.built = true
+	return <-j.buildCh
+}
+
+// fail stops the build and resets the join after an error so that no
+// goroutine is left pulling the right parent.
+func (j *Join) fail(err error) error {
+	j.stopBuild()
+	j.reset()
+	return err
+}
+
+func (j *Join) reset() {
+	j.buildCh = nil
+	j.stopCh = nil
+	j.built = false
+	j.leftEOS = false
+	j.flushed = false
+	j.pending = nil
+	j.table = nil
+	j.vecs = nil
+	j.rights = nil
+	j.matched = nil
+}
+
+// build pulls the right parent to EOS and enters each value into the hash
+// table by its key.  Values whose key is missing are dropped.  If stopCh is
+// closed, build sends done to the right parent and returns.
+func (j *Join) build() error {
+	j.table = make(map[string][]joinRef)
+	b := zcode.NewBuilder()
+	for {
+		select {
+		case <-j.stopCh:
+			_, err := j.right.Pull(true)
+			return err
+		default:
+		}
+		vec, err := j.right.Pull(false)
+		if vec == nil || err != nil {
+			return err
+		}
+		keys := evalKeys(j.rightKeys, vec)
+		n := uint32(len(j.vecs))
+		j.vecs = append(j.vecs, j.cutter.Eval(vec))
+		j.rights = append(j.rights, vec)
+		var matched []bool
+		if j.full {
+			matched = make([]bool, vec.Len())
+			j.matched = append(j.matched, matched)
+		}
+		for slot := range vec.Len() {
+			key, ok := hashKey(b, keys, slot)
+			if !ok {
+				if matched != nil {
+					matched[slot] = true
+				}
+				continue
+			}
+			j.table[key] = append(j.table[key], joinRef{n, slot})
+		}
+	}
+}
+
+// probe looks up each value of vec in the hash table and returns the
+// joined result, which preserves the order of vec.
+func (j *Join) probe(vec vector.Any) vector.Any {
+	keys := evalKeys(j.leftKeys, vec)
+	// Find the candidate pairs of each probed slot.  The candidates of
+	// probed[k] are at the offsets in slots and refs that end at ends[k].
+	var probed, slots []uint32
+	var ends []int
+	var refs []joinRef
+	for slot := range vec.Len() {
+		key, ok := hashKey(j.builder, keys, slot)
+		if !ok {
+			// If the left key isn't present (which is not a thing
+			// in a sql join), then drop the value.
+			continue
+		}
+		for _, ref := range j.table[key] {
+			slots = append(slots, slot)
+			refs = append(refs, ref)
+		}
+		probed = append(probed, slot)
+		ends = append(ends, len(refs))
+	}
+	pass := j.evalCond(vec, slots, refs)
+	var tags, unmatched, matched []uint32
+	var matchedRefs []joinRef
+	var off int
+	for k, slot := range probed {
+		var hit bool
+		for ; off < ends[k]; off++ {
+			if pass != nil && !vector.BoolValue(pass, uint32(off)) {
+				continue
+			}
+			hit = true
+			ref := refs[off]
+			if j.full {
+				j.matched[ref.vec][ref.slot] = true
+			}
+			if j.anti {
+				continue
+			}
+			tags = append(tags, 1)
+			matched = append(matched, slot)
+			matchedRefs = append(matchedRefs, ref)
+		}
+		if !hit && !j.inner {
+			tags = append(tags, 0)
+			unmatched = append(unmatched, slot)
+		}
+	}
+	var joined vector.Any
+	if len(matched) > 0 {
+		joined = vector.Apply(false, j.splice, vector.NewView(matched, vec), lookup(j.vecs, matchedRefs))
+	}
+	switch {
+	case len(matched) == 0 && len(unmatched) == 0:
+		return nil
+	case len(matched) == 0:
+		return vector.NewView(unmatched, vec)
+	case len(unmatched) == 0:
+		return joined
+	}
+	return vector.NewDynamic(tags, []vector.Any{vector.NewView(unmatched, vec), joined})
+}
+
+// unmatched returns a vector of the build-side values that were not
+// joined with any probe-side value or nil if there are none.
+func (j *Join) unmatched() vector.Any {
+	var tags []uint32
+	var vecs []vector.Any
+	for k, matched := range j.matched {
+		var index []uint32
+		for slot, ok := range matched {
+			if !ok {
+				index = append(index, uint32(slot))
+			}
+		}
+		if len(index) == 0 {
+			continue
+		}
+		for range index {
+			tags = append(tags, uint32(len(vecs)))
+		}
+		vecs = append(vecs, vector.NewView(index, j.rights[k]))
+	}
+	switch len(vecs) {
+	case 0:
+		return nil
+	case 1:
+		return vecs[0]
+	}
+	return vector.NewDynamic(tags, vecs)
+}
+
+// evalCond evaluates the join condition on the candidate pairs comprising
+// the values in slots of vec and the build-side values referenced by refs.
+// It returns a Boolean vector with an element for each pair or nil if
+// there is no condition.
+func (j *Join) evalCond(vec vector.Any, slots []uint32, refs []joinRef) vector.Any {
+	if j.cond == nil || len(refs) == 0 {
+		return nil
+	}
+	left, right := vector.NewView(slots, vec), lookup(j.rights, refs)
+	if j.condSwapped {
+		left, right = right, left
+	}
+	return vector.Apply(false, func(vecs ...vector.Any) vector.Any {
+		fields := []zed.Field{
+			zed.NewField("left", vecs[0].Type()),
+			zed.NewField("right", vecs[1].Type()),
+		}
+		typ, err := j.rctx.Zctx.LookupTypeRecord(fields)
+		if err != nil {
+			return vector.NewStringError(j.rctx.Zctx, err.Error(), vecs[0].Len())
+		}
+		return j.cond.Eval(vector.NewRecord(typ, vecs, vecs[0].Len(), nil))
+	}, left, right)
+}
+
+// lookup returns a vector of the values of vecs referenced by refs.
+func lookup(vecs []vector.Any, refs []joinRef) vector.Any {
+	indexes := make([][]uint32, len(vecs))
+	tags := make([]uint32, 0, len(refs))
+	for _, ref := range refs {
+		indexes[ref.vec] = append(indexes[ref.vec], ref.slot)
+		tags = append(tags, ref.vec)
+	}
+	if len(indexes[refs[0].vec]) == len(refs) {
+		// All values came from the same vector.
+		return vector.NewView(indexes[refs[0].vec], vecs[refs[0].vec])
+	}
+	views := make([]vector.Any, len(vecs))
+	for k, index := range indexes {
+		views[k] = vector.NewView(index, vecs[k])
+	}
+	return vector.NewDynamic(tags, views)
+}
+
+func (j *Join) splice(vecs ...vector.Any) vector.Any {
+	left, ok := recordOf(vecs[0])
+	if !ok {
+		return vector.NewWrappedError(j.rctx.Zctx, "join: left value is not a record", vecs[0])
+	}
+	right, ok := recordOf(vecs[1])
+	if !ok {
+		return vector.NewWrappedError(j.rctx.Zctx, "join: right value is not a record", vecs[1])
+	}
+	typ, err := j.combinedType(left.Typ, right.Typ)
+	if err != nil {
+		return vector.NewStringError(j.rctx.Zctx, err.Error(), left.Len())
+	}
+	fields := make([]vector.Any, 0, len(left.Fields)+len(right.Fields))
+	fields = append(fields, left.Fields...)
+	fields = append(fields, right.Fields...)
+	return vector.NewRecord(typ, fields, left.Len(), nil)
+}
+
+func (j *Join) combinedType(left, right *zed.TypeRecord) (*zed.TypeRecord, error) {
+	table, ok := j.types[left.ID()]
+	if !ok {
+		table = make(map[int]*zed.TypeRecord)
+		j.types[left.ID()] = table
+	}
+	if typ, ok := table[right.ID()]; ok {
+		return typ, nil
+	}
+	fields := make([]zed.Field, 0, len(left.Fields)+len(right.Fields))
+	fields = append(fields, left.Fields...)
+	for _, f := range right.Fields {
+		name := f.Name
+		for k := 2; left.HasField(name); k++ {
+			name = fmt.Sprintf("%s_%d", f.Name, k)
+		}
+		fields = append(fields, zed.NewField(name, f.Type))
+	}
+	typ, err := j.rctx.Zctx.LookupTypeRecord(fields)
+	if err != nil {
+		return nil, err
+	}
+	table[right.ID()] = typ
+	return typ, nil
+}
+
+// recordOf returns vec as a record vector, pushing any view down into
+// the record's fields.
+func recordOf(vec vector.Any) (*vector.Record, bool) {
+	switch vec := vector.Under(vec).(type) {
+	case *vector.Record:
+		return vec, true
+	case *vector.View:
+		rec, ok := vector.Under(vec.Any).(*vector.Record)
+		if !ok {
+			return nil, false
+		}
+		fields := make([]vector.Any, 0, len(rec.Fields))
+		for _, f := range rec.Fields {
+			fields = append(fields, vector.NewView(vec.Index, f))
+		}
+		var nulls *vector.Bool
+		for k, slot := range vec.Index {
+			if rec.Nulls.Value(slot) {
+				if nulls == nil {
+					nulls = vector.NewBoolEmpty(vec.Len(), nil)
+				}
+				nulls.Set(uint32(k))
+			}
+		}
+		return vector.NewRecord(rec.Typ, fields, vec.Len(), nulls), true
+	}
+	return nil, false
+}
+
+func evalKeys(keys []expr.Evaluator, vec vector.Any) []vector.Any {
+	out := make([]vector.Any, 0, len(keys))
+	for _, e := range keys {
+		out = append(out, e.Eval(vec))
+	}
+	return out
+}
+
+// hashKey returns the hash table key for the values in slot of keys, which
+// comprises their encodings by coerce.AppendKey, so keys match when the
+// merge join's comparator would find them equal.  The boolean result is
+// false if any value is missing.
+func hashKey(b *zcode.Builder, keys []vector.Any, slot uint32) (string, bool) {
+	var key []byte
+	for _, vec := range keys {
+		val := vector.ValueAt(b, vec, slot)
+		if val.IsMissing() {
+			return "", false
+		}
+		key = coerce.AppendKey(key, val)
+	}
+	return string(key), true
+}
@@ -0,0 +1,276 @@
+package op
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+
+	"github.com/brimdata/super/runtime/vam/expr"
+	"github.com/brimdata/super/vector"
+)
+
+// Merge merges multiple upstream pullers into one downstream puller.  If the
+// input streams are ordered according to cmp applied to the values of key,
+// the output of Merge will have the same order.  Each parent is run in its
+// own goroutine so that deadlock is avoided when the upstream pullers would
+// otherwise block waiting for an adjacent puller to finish but Merge is
+// waiting on the upstream puller.
+type Merge struct {
+	ctx context.Context
+	key expr.Evaluator
+	cmp *expr.Comparator
+
+	once sync.Once
+	// parents holds all of the upstream pullers and never changes.
+	parents []*mergeParent
+	// The head-of-line (hol) queue is maintained as a min-heap on cmp of
+	// the first key of each parent's current vector (see Less).
+	hol []*mergeParent
+}
+
+var _ vector.Puller = (*Merge)(nil)
+
+func NewMerge(ctx context.Context, parents []vector.Puller, key expr.Evaluator, cmp *expr.Comparator) *Merge {
+	m := &Merge{
+		ctx: ctx,
+		key: key,
+		cmp: cmp,
+	}
+	for _, p := range parents {
+		m.parents = append(m.parents, &mergeParent{
+			merge:    m,
+			parent:   p,
+			resultCh: make(chan result),
+			doneCh:   make(chan struct{}),
+		})
+	}
+	return m
+}
+
+func (m *Merge) Pull(done bool) (vector.Any, error) {
+	var err error
+	m.once.Do(func() {
+		// Start up all the goroutines before initializing the heap.
+		// If we do one at a time, there is a deadlock for an upstream
+		// fork because the fork waits for Pulls to arrive before
+		// responding.
+		for _, p := range m.parents {
+			go p.run()
+		}
+		err = m.start()
+	})
+	if err != nil {
+		return nil, err
+	}
+	if done {
+		return nil, m.propagateDone()
+	}
+	if m.Len() == 0 {
+		// No more vectors in head of line.  So, let's resume
+		// everything and return an EOS.
+		return nil, m.start()
+	}
+	p := heap.Pop(m).(*mergeParent)
+	if m.Len() == 0 || m.compare(p, p.vec.Len()-1, m.hol[0], uint32(m.hol[0].off)) <= 0 {
+		// Either p is the only upstream or p's last value is less
+		// than or equal to the next upstream's first value.  Either
+		// way, it's safe to return p's remaining values.
+		out := p.remaining()
+		if err := m.replenish(p); err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+	heap.Push(m, p)
+	return m.mergeHOL()
+}
+
+// mergeHOL merges values from the head-of-line vectors until one of them
+// is exhausted, at which point its parent is replenished and the merged
+// values are returned.
+func (m *Merge) mergeHOL() (vector.Any, error) {
+	type source struct {
+		parent *mergeParent
+		tag    uint32
+	}
+	var tags []uint32
+	var vecs []vector.Any
+	var indexes [][]uint32
+	tagOf := make(map[source]uint32)
+	for {
+		p := m.hol[0]
+		// Values of a Dynamic are merged from its underlying vectors
+		// so that the result does not contain nested Dynamics.
+		src, vec, slot := source{parent: p}, p.vec, uint32(p.off)
+		if d, ok := vec.(*vector.Dynamic); ok {
+			src.tag = d.Tags[slot]
+			vec, slot = d.Values[src.tag], d.TagMap.Forward[slot]
+		}
+		tag, ok := tagOf[src]
+		if !ok {
+			tag = uint32(len(vecs))
+			tagOf[src] = tag
+			vecs = append(vecs, vec)
+			indexes = append(indexes, nil)
+		}
+		tags = append(tags, tag)
+		indexes[tag] = append(indexes[tag], slot)
+		p.off++
+		if p.off < int(p.vec.Len()) {
+			heap.Fix(m, 0)
+			continue
+		}
+		heap.Pop(m)
+		if err := m.replenish(p); err != nil {
+			return nil, err
+		}
+		break
+	}
+	if len(vecs) == 1 {
+		return vector.NewView(indexes[0], vecs[0]), nil
+	}
+	for k, vec := range vecs {
+		vecs[k] = vector.NewView(indexes[k], vec)
+	}
+	return vector.NewDynamic(tags, vecs), nil
+}
+
+// replenish receives the next vector of p and pushes p onto the
+// head-of-line queue unless p is at EOS.
+func (m *Merge) replenish(p *mergeParent) error {
+	ok, err := p.replenish()
+	if ok {
+		heap.Push(m, p)
+	}
+	return err
+}
+
+// start replenishes each parent's head-of-line vector either at
+// initialization or after an EOS.
+func (m *Merge) start() error {
+	m.hol = m.hol[:0]
+	for _, p := range m.parents {
+		ok, err := p.replenish()
+		if err != nil {
+			return err
+		}
+		if ok {
+			m.hol = append(m.hol, p)
+		}
+	}
+	heap.Init(m)
+	return nil
+}
+
+func (m *Merge) propagateDone() error {
+	// For everything in the HOL queue (i.e., not already at EOS),
+	// propagate a done.  This will result in all parents at EOS;
+	// then we can resume everything together.
+	for len(m.hol) > 0 {
+		p := m.Pop().(*mergeParent)
+		select {
+		case p.doneCh <- struct{}{}:
+			p.vec = nil
+		case <-m.ctx.Done():
+			return m.ctx.Err()
+		}
+	}
+	return m.start()
+}
+
+func (m *Merge) Len() int { return len(m.hol) }
+
+func (m *Merge) Less(i, j int) bool {
+	p, q := m.hol[i], m.hol[j]
+	return m.compare(p, uint32(p.off), q, uint32(q.off)) < 0
+}
+
+// compare compares the key of the value in slot i of p's current vector
+// with that in slot j of q's.
+func (m *Merge) compare(p *mergeParent, i uint32, q *mergeParent, j uint32) int {
+	return m.cmp.Compare(p.keys, i, q.keys, j)
+}
+
+func (m *Merge) Swap(i, j int) { m.hol[i], m.hol[j] = m.hol[j], m.hol[i] }
+
+func (m *Merge) Push(x any) { m.hol = append(m.hol, x.(*mergeParent)) }
+
+func (m *Merge) Pop() any {
+	x := m.hol[len(m.hol)-1]
+	m.hol = m.hol[:len(m.hol)-1]
+	return x
+}
+
+type mergeParent struct {
+	merge    *Merge
+	parent   vector.Puller
+	resultCh chan result
+	doneCh   chan struct{}
+	// vec is the current vector, keys holds the merge key of each of its
+	// values, and off is the offset of the next value to be merged.
+	vec  vector.Any
+	keys vector.Any
+	off  int
+}
+
+func (p *mergeParent) run() {
+	for {
+		vec, err := p.parent.Pull(false)
+		select {
+		case p.resultCh <- result{vec, err}:
+			if err != nil {
+				return
+			}
+		case <-p.doneCh:
+			// Drop the pending vector and initiate a done.
+			if _, err := p.parent.Pull(true); err != nil {
+				select {
+				case p.resultCh <- result{nil, err}:
+				case <-p.merge.ctx.Done():
+				}
+				return
+			}
+		case <-p.merge.ctx.Done():
+			return
+		}
+	}
+}
+
+// replenish receives the next vector.  It returns false when EOS is
+// encountered and its goroutine will then block until resumed or canceled.
+func (p *mergeParent) replenish() (bool, error) {
+	for {
+		select {
+		case r := <-p.resultCh:
+			if r.err != nil {
+				return false, r.err
+			}
+			p.vec = r.vector
+			if p.vec == nil {
+				return false, nil
+			}
+			if p.vec.Len() == 0 {
+				continue
+			}
+			p.keys = p.merge.key.Eval(p.vec)
+			p.off = 0
+			return true, nil
+		case <-p.merge.ctx.Done():
+			return false, p.merge.ctx.Err()
+		}
+	}
+}
+
+// remaining returns the values of the current vector that have not been
+// merged.
+func (p *mergeParent) remaining() vector.Any {
+	if p.off == 0 {
+		return p.vec
+	}
+	n := int(p.vec.Len())
+	index := make([]uint32, 0, n-p.off)
+	for slot := p.off; slot < n; slot++ {
+		index = append(index, uint32(slot))
+	}
+	return vector.NewView(index, p.vec)
+}
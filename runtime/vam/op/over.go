@@ -4,15 +4,21 @@ import (
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/runtime/vam/expr"
 	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
 )
 
 type Over struct {
 	zctx   *zed.Context
 	parent vector.Puller
 	exprs  []expr.Evaluator
+	vars   *expr.Vars
+	base   int
+	defs   []expr.Evaluator
 
-	vecs []vector.Any
-	idx  uint32
+	vecs    []vector.Any
+	defVecs []vector.Any
+	idx     uint32
+	builder zcode.Builder
 }
 
 func NewOver(zctx *zed.Context, parent vector.Puller, exprs []expr.Evaluator) *Over {
@@ -23,6 +29,14 @@ func NewOver(zctx *zed.Context, parent vector.Puller, exprs []expr.Evaluator) *O
 	}
 }
 
+// Bind arranges for the values of defs to be bound to the variables of vars
+// beginning at base as each value is traversed.
+func (o *Over) Bind(vars *expr.Vars, base int, defs []expr.Evaluator) {
+	o.vars = vars
+	o.base = base
+	o.defs = defs
+}
+
 func (o *Over) Pull(done bool) (vector.Any, error) {
 	if done {
 		o.vecs = nil
@@ -34,35 +48,57 @@ func (o *Over) Pull(done bool) (vector.Any, error) {
 			if vec == nil || err != nil {
 				return nil, err
 			}
-			o.vecs = o.vecs[:0]
-			for _, e := range o.exprs {
-				vec2 := e.Eval(vec)
-				vec2 = vector.Apply(true, func(vecs ...vector.Any) vector.Any { return vecs[0] }, vec2)
-				o.vecs = append(o.vecs, vec2)
-			}
-			o.idx = 0
-		}
-		var out vector.Any
-		if len(o.vecs) == 1 {
-			out = o.flatten(o.vecs[0], o.idx)
-		} else {
-			var tags []uint32
-			var vecs []vector.Any
-			for i, vec := range o.vecs {
-				vec := o.flatten(vec, o.idx)
-				for range vec.Len() {
-					tags = append(tags, uint32(i))
-				}
-				vecs = append(vecs, vec)
-			}
-			out = vector.NewDynamic(tags, vecs)
+			o.eval(vec)
 		}
+		out := o.over(o.idx)
 		o.idx++
 		if out != nil {
 			return out, nil
 		}
+	}
+}
 
+// eval evaluates o's expressions and definitions over vec in preparation
+// for traversing its values.
+func (o *Over) eval(vec vector.Any) {
+	o.vecs = o.vecs[:0]
+	for _, e := range o.exprs {
+		vec2 := e.Eval(vec)
+		vec2 = vector.Apply(true, func(vecs ...vector.Any) vector.Any { return vecs[0] }, vec2)
+		o.vecs = append(o.vecs, vec2)
+	}
+	o.defVecs = o.defVecs[:0]
+	for _, e := range o.defs {
+		o.defVecs = append(o.defVecs, e.Eval(vec))
+	}
+	o.idx = 0
+}
+
+// over binds the variables for slot and returns the values traversed at slot
+// or nil if there are none.
+func (o *Over) over(slot uint32) vector.Any {
+	for k, vec := range o.defVecs {
+		o.vars.Set(o.base+k, vector.ValueAt(&o.builder, vec, slot).Copy())
+	}
+	if len(o.vecs) == 1 {
+		return o.flatten(o.vecs[0], slot)
+	}
+	var tags []uint32
+	var flattened []vector.Any
+	for _, vec := range o.vecs {
+		vec := o.flatten(vec, slot)
+		if vec == nil {
+			continue
+		}
+		for range vec.Len() {
+			tags = append(tags, uint32(len(flattened)))
+		}
+		flattened = append(flattened, vec)
+	}
+	if len(flattened) == 0 {
+		return nil
 	}
+	return vector.NewDynamic(tags, flattened)
 }
 
 func (o *Over) flatten(vec vector.Any, slot uint32) vector.Any {
@@ -0,0 +1,99 @@
+package op
+
+import (
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/vam/expr"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
+)
+
+// OverExpr provides glue to run a lateral subquery in expression context.
+// It implements vector.Puller so it can serve as the data source to the
+// subquery as well as expr.Evaluator so it can be called from an expression.
+// For each slot of the vector passed to Eval, it binds the slot's variables,
+// sends the values traversed at the slot through the subquery, and collects
+// the results.  If there is just one result, then the value is returned.  If
+// there are multiple results, then they are returned in an array (with union
+// elements if the type varies).
+type OverExpr struct {
+	zctx *zed.Context
+	over *Over
+	exit vector.Puller
+
+	vec     vector.Any
+	vals    []zed.Value
+	builder zcode.Builder
+}
+
+var _ expr.Evaluator = (*OverExpr)(nil)
+var _ vector.Puller = (*OverExpr)(nil)
+
+// NewOverExpr returns an OverExpr that traverses values with over, which
+// must have been created with a nil parent.
+func NewOverExpr(zctx *zed.Context, over *Over) *OverExpr {
+	return &OverExpr{
+		zctx: zctx,
+		over: over,
+	}
+}
+
+func (o *OverExpr) SetExit(exit vector.Puller) {
+	o.exit = exit
+}
+
+func (o *OverExpr) Eval(this vector.Any) vector.Any {
+	o.over.eval(this)
+	out := vector.NewDynamicBuilder()
+	for slot := range this.Len() {
+		o.vec = o.over.over(slot)
+		o.vals = o.vals[:0]
+		for {
+			vec, err := o.exit.Pull(false)
+			if err != nil {
+				panic(err)
+			}
+			if vec == nil {
+				break
+			}
+			for slot := range vec.Len() {
+				o.vals = append(o.vals, vector.ValueAt(&o.builder, vec, slot).Copy())
+			}
+		}
+		out.Write(o.combine(o.vals))
+	}
+	return out.Build()
+}
+
+func (o *OverExpr) combine(vals []zed.Value) zed.Value {
+	switch len(vals) {
+	case 0:
+		return zed.Null
+	case 1:
+		return vals[0]
+	}
+	var types []zed.Type
+	for _, val := range vals {
+		types = append(types, val.Type())
+	}
+	types = zed.UniqueTypes(types)
+	var b zcode.Builder
+	if len(types) == 1 {
+		for _, val := range vals {
+			b.Append(val.Bytes())
+		}
+		return zed.NewValue(o.zctx.LookupTypeArray(types[0]), b.Bytes())
+	}
+	union := o.zctx.LookupTypeUnion(types)
+	for _, val := range vals {
+		zed.BuildUnion(&b, union.TagOf(val.Type()), val.Bytes())
+	}
+	return zed.NewValue(o.zctx.LookupTypeArray(union), b.Bytes())
+}
+
+// Pull returns the values traversed at the slot being evaluated followed by
+// an EOS.
+func (o *OverExpr) Pull(done bool) (vector.Any, error) {
+	vec := o.vec
+	o.vec = nil
+	return vec, nil
+}
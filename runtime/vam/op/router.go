@@ -0,0 +1,161 @@
+package op
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/brimdata/super/vector"
+)
+
+// Selector decides which values of each vector pulled by a Router are sent
+// to which of the Router's routes.
+type Selector interface {
+	Forward(*Router, vector.Any) bool
+}
+
+type Router struct {
+	ctx      context.Context
+	parent   vector.Puller
+	selector Selector
+	routes   []*route
+	once     sync.Once
+}
+
+func NewRouter(ctx context.Context, parent vector.Puller) *Router {
+	return &Router{
+		ctx:    ctx,
+		parent: parent,
+	}
+}
+
+func (r *Router) Link(s Selector) {
+	r.selector = s
+}
+
+func (r *Router) AddRoute() vector.Puller {
+	child := &route{
+		router:   r,
+		resultCh: make(chan result),
+		doneCh:   make(chan struct{}),
+	}
+	r.routes = append(r.routes, child)
+	return child
+}
+
+func (r *Router) run() {
+	for {
+		if r.blocked() {
+			// If everything is blocked, send a done upstream
+			// and unblock everything to resume the next platoon.
+			if _, err := r.parent.Pull(true); err != nil {
+				if ok := r.sendEOS(err); !ok {
+					return
+				}
+				continue
+			}
+			r.unblock()
+		}
+		vec, err := r.parent.Pull(false)
+		if err != nil || vec == nil {
+			if ok := r.sendEOS(err); !ok {
+				return
+			}
+			r.unblock()
+			continue
+		}
+		// The selector decides what if any of the vector it wants to
+		// send to which downstream operators by calling back the
+		// Router.Send() method.
+		if ok := r.selector.Forward(r, vec); !ok {
+			return
+		}
+	}
+}
+
+func (r *Router) blocked() bool {
+	return !slices.ContainsFunc(r.routes, func(route *route) bool {
+		return !route.blocked
+	})
+}
+
+// sendEOS sends an EOS (or err if non-nil) to each unblocked route.  On
+// return everything is unblocked and everything downstream has been sent an
+// EOS.  If a route is sending done concurrently with the EOS being sent to
+// it, we resolve the done with its matching EOS.
+func (r *Router) sendEOS(err error) bool {
+	for _, p := range r.routes {
+		if p.blocked {
+			continue
+		}
+		select {
+		case p.resultCh <- result{nil, err}:
+			p.blocked = true
+		case <-p.doneCh:
+			// This route was about to be blocked with a done so
+			// just mark it blocked now.
+			p.blocked = true
+		case <-r.ctx.Done():
+			return false
+		}
+	}
+	r.unblock()
+	return true
+}
+
+func (r *Router) unblock() {
+	for _, p := range r.routes {
+		p.blocked = false
+	}
+}
+
+// Send sends vec to the route p, which must have been returned by AddRoute.
+// It returns false if the context was canceled.
+func (r *Router) Send(p vector.Puller, vec vector.Any) bool {
+	if vec == nil {
+		panic("EOS sent through router send API")
+	}
+	to := p.(*route)
+	if to.blocked {
+		return true
+	}
+	select {
+	case to.resultCh <- result{vec, nil}:
+		return true
+	case <-to.doneCh:
+		// If we get a done while trying to write, mark this route
+		// blocked and drop the vector being sent.
+		to.blocked = true
+		return true
+	case <-r.ctx.Done():
+		return false
+	}
+}
+
+type route struct {
+	router   *Router
+	resultCh chan result
+	doneCh   chan struct{}
+	// Used only by Router
+	blocked bool
+}
+
+func (r *route) Pull(done bool) (vector.Any, error) {
+	r.router.once.Do(func() {
+		go r.router.run()
+	})
+	if done {
+		select {
+		case r.doneCh <- struct{}{}:
+			return nil, nil
+		case <-r.router.ctx.Done():
+			return nil, r.router.ctx.Err()
+		}
+	}
+	select {
+	case r := <-r.resultCh:
+		return r.vector, r.err
+	case <-r.router.ctx.Done():
+		return nil, r.router.ctx.Err()
+	}
+}
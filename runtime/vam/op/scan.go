@@ -3,6 +3,7 @@ package op
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"sync"
 
 	"github.com/brimdata/super"
@@ -11,6 +12,8 @@ import (
 	"github.com/brimdata/super/pkg/field"
 	"github.com/brimdata/super/runtime"
 	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/op/meta"
+	vamexpr "github.com/brimdata/super/runtime/vam/expr"
 	"github.com/brimdata/super/runtime/vcache"
 	"github.com/brimdata/super/vector"
 	"github.com/brimdata/super/zbuf"
@@ -28,12 +31,15 @@ type Scanner struct {
 	progress   *zbuf.Progress
 	resultCh   chan result
 	doneCh     chan struct{}
+	// key and cmp order the values of overlapping objects by the pool key.
+	key vamexpr.Evaluator
+	cmp *vamexpr.Comparator
 }
 
 var _ vector.Puller = (*Scanner)(nil)
 
 func NewScanner(rctx *runtime.Context, cache *vcache.Cache, parent zbuf.Puller, pool *lake.Pool, paths []field.Path, pruner expr.Evaluator, progress *zbuf.Progress) *Scanner {
-	return &Scanner{
+	s := &Scanner{
 		cache:      cache,
 		rctx:       rctx,
 		parent:     newObjectPuller(parent),
@@ -44,6 +50,12 @@ func NewScanner(rctx *runtime.Context, cache *vcache.Cache, parent zbuf.Puller,
 		doneCh:     make(chan struct{}),
 		resultCh:   make(chan result),
 	}
+	if !pool.SortKeys.IsNil() {
+		sortKey := pool.SortKeys.Primary()
+		s.key = vamexpr.NewDottedExpr(rctx.Zctx, sortKey.Key)
+		s.cmp = vamexpr.NewComparator(sortKey.Order, true).WithMissingAsNull()
+	}
+	return s
 }
 
 // XXX we need vector scannerstats and means to update them here.
@@ -75,24 +87,93 @@ func (s *Scanner) Pull(done bool) (vector.Any, error) {
 
 func (s *Scanner) run() {
 	for {
-		meta, err := s.parent.Pull(false)
-		if meta == nil {
-			s.sendResult(nil, err)
-			return
+		objects, err := s.parent.Pull(false)
+		if objects == nil {
+			// Keep running after an EOS since a downstream merge
+			// pulls again to resume its parents.
+			if _, ok := s.sendResult(nil, err); !ok || err != nil {
+				return
+			}
+			continue
 		}
-		object, err := s.cache.Fetch(s.rctx.Context, meta.VectorURI(s.pool.DataPath), meta.ID)
-		if err != nil {
-			s.sendResult(nil, err)
-			return
+		vecs := make([]vector.Any, 0, len(objects))
+		for _, o := range objects {
+			object, err := s.cache.Fetch(s.rctx.Context, o.VectorURI(s.pool.DataPath), o.ID)
+			if err != nil {
+				s.sendResult(nil, err)
+				return
+			}
+			vec, err := object.Fetch(s.rctx.Zctx, s.projection)
+			if err != nil {
+				s.sendResult(nil, err)
+				return
+			}
+			vecs = append(vecs, vec)
 		}
-		vec, err := object.Fetch(s.rctx.Zctx, s.projection)
-		s.sendResult(vec, err)
-		if err != nil {
-			return
+		if len(vecs) > 1 && s.key != nil {
+			vecs = []vector.Any{s.merge(vecs)}
+		}
+		for _, vec := range vecs {
+			if done, ok := s.sendResult(vec, nil); !ok {
+				return
+			} else if done {
+				break
+			}
 		}
 	}
 }
 
+// merge returns the values of vecs, which hold the objects of a partition,
+// in the order of the pool key.  The objects of a partition overlap in
+// their key ranges but each is sorted by the key.
+func (s *Scanner) merge(vecs []vector.Any) vector.Any {
+	type slot struct {
+		vec  int
+		slot uint32
+	}
+	var slots []slot
+	keys := make([]vector.Any, 0, len(vecs))
+	for k, vec := range vecs {
+		keys = append(keys, s.key.Eval(vec))
+		for i := range vec.Len() {
+			slots = append(slots, slot{k, i})
+		}
+	}
+	slices.SortStableFunc(slots, func(a, b slot) int {
+		return s.cmp.Compare(keys[a.vec], a.slot, keys[b.vec], b.slot)
+	})
+	// Values of a Dynamic are merged from its underlying vectors so that
+	// the result does not contain nested Dynamics.  See Merge.mergeHOL.
+	type source struct {
+		vec int
+		tag uint32
+	}
+	var tags []uint32
+	var values []vector.Any
+	var indexes [][]uint32
+	tagOf := make(map[source]uint32)
+	for _, sl := range slots {
+		src, vec, i := source{vec: sl.vec}, vecs[sl.vec], sl.slot
+		if d, ok := vec.(*vector.Dynamic); ok {
+			src.tag = d.Tags[i]
+			vec, i = d.Values[src.tag], d.TagMap.Forward[i]
+		}
+		tag, ok := tagOf[src]
+		if !ok {
+			tag = uint32(len(values))
+			tagOf[src] = tag
+			values = append(values, vec)
+			indexes = append(indexes, nil)
+		}
+		tags = append(tags, tag)
+		indexes[tag] = append(indexes[tag], i)
+	}
+	for k, vec := range values {
+		values[k] = vector.NewView(indexes[k], vec)
+	}
+	return vector.NewDynamic(tags, values)
+}
+
 func (s *Scanner) sendResult(vec vector.Any, err error) (bool, bool) {
 	select {
 	case s.resultCh <- result{vec, err}:
@@ -133,7 +214,10 @@ func newObjectPuller(parent zbuf.Puller) *objectPuller {
 	}
 }
 
-func (p *objectPuller) Pull(done bool) (*data.Object, error) {
+// Pull returns the data objects of the next value from the parent, which is
+// either a data.Object from a lister or a partition of overlapping objects
+// from a slicer.
+func (p *objectPuller) Pull(done bool) ([]*data.Object, error) {
 	batch, err := p.parent.Pull(false)
 	if batch == nil || err != nil {
 		return nil, err
@@ -148,12 +232,19 @@ func (p *objectPuller) Pull(done bool) (*data.Object, error) {
 	if !ok {
 		return nil, fmt.Errorf("system error: vam.objectPuller encountered unnamed object: %s", zson.String(vals[0]))
 	}
-	if named.Name != "data.Object" {
-		return nil, fmt.Errorf("system error: vam.objectPuller encountered unnamed object: %q", named.Name)
-	}
-	var meta data.Object
-	if err := p.unmarshaler.Unmarshal(vals[0], &meta); err != nil {
-		return nil, fmt.Errorf("system error: vam.objectPuller could not unmarshal value: %q", zson.String(vals[0]))
+	switch named.Name {
+	case "data.Object":
+		var object data.Object
+		if err := p.unmarshaler.Unmarshal(vals[0], &object); err != nil {
+			return nil, fmt.Errorf("system error: vam.objectPuller could not unmarshal value: %q", zson.String(vals[0]))
+		}
+		return []*data.Object{&object}, nil
+	case "meta.Partition":
+		var part meta.Partition
+		if err := p.unmarshaler.Unmarshal(vals[0], &part); err != nil {
+			return nil, fmt.Errorf("system error: vam.objectPuller could not unmarshal value: %q", zson.String(vals[0]))
+		}
+		return part.Objects, nil
 	}
-	return &meta, nil
+	return nil, fmt.Errorf("system error: vam.objectPuller encountered unnamed object: %q", named.Name)
 }
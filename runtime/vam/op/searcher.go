@@ -57,27 +57,29 @@ func (s *Searcher) Pull(done bool) (*data.Object, *vector.Bool, error) {
 
 func (s *Searcher) run() {
 	for {
-		meta, err := s.parent.Pull(false)
-		if meta == nil {
+		objects, err := s.parent.Pull(false)
+		if objects == nil {
 			s.sendResult(nil, nil, err)
 			return
 		}
-		object, err := s.cache.Fetch(s.rctx.Context, meta.VectorURI(s.pool.DataPath), meta.ID)
-		if err != nil {
-			s.sendResult(nil, nil, err)
-			return
-		}
-		vec, err := object.Fetch(s.rctx.Zctx, s.projection)
-		if err != nil {
-			s.sendResult(nil, nil, err)
-			return
-		}
-		b, ok := s.filter.Eval(vec).(*vector.Bool)
-		if !ok {
-			s.sendResult(nil, nil, errors.New("system error: vam.Searcher encountered a non-boolean filter result"))
-			return
+		for _, meta := range objects {
+			object, err := s.cache.Fetch(s.rctx.Context, meta.VectorURI(s.pool.DataPath), meta.ID)
+			if err != nil {
+				s.sendResult(nil, nil, err)
+				return
+			}
+			vec, err := object.Fetch(s.rctx.Zctx, s.projection)
+			if err != nil {
+				s.sendResult(nil, nil, err)
+				return
+			}
+			b, ok := s.filter.Eval(vec).(*vector.Bool)
+			if !ok {
+				s.sendResult(nil, nil, errors.New("system error: vam.Searcher encountered a non-boolean filter result"))
+				return
+			}
+			s.sendResult(meta, b, nil)
 		}
-		s.sendResult(meta, b, nil)
 	}
 }
 
@@ -0,0 +1,325 @@
+package op
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/runtime"
+	samagg "github.com/brimdata/super/runtime/sam/expr/agg"
+	"github.com/brimdata/super/runtime/vam/expr"
+	"github.com/brimdata/super/runtime/vam/expr/agg"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
+)
+
+// Summarize computes aggregations grouped by the values of its key
+// expressions.  Groups are emitted in the order in which they are first
+// encountered once the input is exhausted.
+type Summarize struct {
+	parent      vector.Puller
+	rctx        *runtime.Context
+	keyExprs    []expr.Evaluator
+	aggs        []*expr.Aggregator
+	aggRefs     []expr.Evaluator
+	builder     *zed.RecordBuilder
+	partialsIn  bool
+	partialsOut bool
+
+	// keyTypes maps a vector of key types to a small int that is appended
+	// to each group's key so that keys of different types are distinct.
+	keyTypes    *zed.TypeVectorTable
+	outTypes    *zed.TypeVectorTable
+	recordTypes map[int]*zed.TypeRecord
+	// table maps a key to its group, which indexes groups and each
+	// element of funcs.
+	table  map[string]uint32
+	groups []summarizeGroup
+	funcs  []agg.Func
+	done   bool
+
+	// These exist only to reduce memory allocations.
+	keyBuilder zcode.Builder
+	typeCache  []zed.Type
+}
+
+type summarizeGroup struct {
+	key     string
+	keyType int
+}
+
+// quietGroup is the group of a slot whose key is quiet.
+const quietGroup = math.MaxUint32
+
+func NewSummarize(rctx *runtime.Context, parent vector.Puller, keyNames []field.Path, keyExprs []expr.Evaluator, aggNames []field.Path, aggs []*expr.Aggregator, partialsIn, partialsOut bool) (*Summarize, error) {
+	names := make(field.List, 0, len(keyNames)+len(aggNames))
+	names = append(names, keyNames...)
+	names = append(names, aggNames...)
+	builder, err := zed.NewRecordBuilder(rctx.Zctx, names)
+	if err != nil {
+		return nil, err
+	}
+	aggRefs := make([]expr.Evaluator, 0, len(aggNames))
+	for _, name := range aggNames {
+		aggRefs = append(aggRefs, expr.NewDottedExpr(rctx.Zctx, name))
+	}
+	s := &Summarize{
+		parent:      parent,
+		rctx:        rctx,
+		keyExprs:    keyExprs,
+		aggs:        aggs,
+		aggRefs:     aggRefs,
+		builder:     builder,
+		partialsIn:  partialsIn,
+		partialsOut: partialsOut,
+		keyTypes:    zed.NewTypeVectorTable(),
+		outTypes:    zed.NewTypeVectorTable(),
+		recordTypes: make(map[int]*zed.TypeRecord),
+		typeCache:   make([]zed.Type, 0, len(keyExprs)+len(aggs)),
+	}
+	s.reset()
+	return s, nil
+}
+
+func (s *Summarize) Pull(done bool) (vector.Any, error) {
+	if done {
+		s.reset()
+		_, err := s.parent.Pull(done)
+		return nil, err
+	}
+	if s.done {
+		// Send EOS following the results.
+		s.done = false
+		return nil, nil
+	}
+	for {
+		if err := s.rctx.Err(); err != nil {
+			s.reset()
+			return nil, err
+		}
+		vec, err := s.parent.Pull(false)
+		if err != nil {
+			return nil, err
+		}
+		if vec == nil {
+			out, err := s.materialize()
+			s.reset()
+			if out != nil {
+				s.done = true
+			}
+			return out, err
+		}
+		s.consume(vec)
+	}
+}
+
+func (s *Summarize) reset() {
+	for _, f := range s.funcs {
+		if c, ok := f.(samagg.Cleaner); ok {
+			c.Cleanup()
+		}
+	}
+	s.table = make(map[string]uint32)
+	s.groups = nil
+	s.funcs = make([]agg.Func, 0, len(s.aggs))
+	for _, a := range s.aggs {
+		s.funcs = append(s.funcs, a.NewFunction())
+	}
+}
+
+func (s *Summarize) consume(vec vector.Any) {
+	if d, ok := vec.(*vector.Dynamic); ok && s.partialsIn {
+		// Partials of different types are consumed separately since
+		// ConsumeAsPartial expects the partials of a single type.
+		for _, vec := range d.Values {
+			s.consume(vec)
+		}
+		return
+	}
+	keys := make([]vector.Any, 0, len(s.keyExprs))
+	for _, e := range s.keyExprs {
+		keys = append(keys, e.Eval(vec))
+	}
+	groups := s.groupsOf(keys, vec.Len())
+	for k, a := range s.aggs {
+		var arg vector.Any
+		var mask *vector.Bool
+		if s.partialsIn {
+			arg = s.aggRefs[k].Eval(vec)
+		} else {
+			arg, mask = a.Eval(vec)
+		}
+		arg, argGroups := selectSlots(arg, groups, mask)
+		if s.partialsIn {
+			s.funcs[k].ConsumeAsPartial(arg, argGroups)
+		} else {
+			s.funcs[k].Consume(arg, argGroups)
+		}
+	}
+}
+
+// groupsOf returns the group of the key in each of the n slots of keys or
+// quietGroup if the slot's key is quiet.
+func (s *Summarize) groupsOf(keys []vector.Any, n uint32) []uint32 {
+	groups := make([]uint32, n)
+	if n == 0 {
+		return groups
+	}
+	if len(keys) == 0 {
+		// All slots are in the one group with the empty key.
+		g := s.lookupGroup(nil, s.keyTypes.Lookup(nil))
+		for i := range groups {
+			groups[i] = g
+		}
+		return groups
+	}
+	if len(keys) == 1 {
+		switch key := keys[0].(type) {
+		case *vector.Const:
+			g := s.groupAt(keys, 0)
+			for i := range groups {
+				groups[i] = g
+			}
+			return groups
+		case *vector.Dict:
+			// Look up the group of each dictionary entry and of null
+			// only once.
+			entries := make([]uint32, key.Any.Len())
+			found := make([]bool, key.Any.Len())
+			var null uint32
+			var foundNull bool
+			for i := range n {
+				if key.Nulls.Value(i) {
+					if !foundNull {
+						null, foundNull = s.groupAt(keys, i), true
+					}
+					groups[i] = null
+					continue
+				}
+				entry := key.Index[i]
+				if !found[entry] {
+					entries[entry], found[entry] = s.groupAt(keys, i), true
+				}
+				groups[i] = entries[entry]
+			}
+			return groups
+		}
+	}
+	for i := range n {
+		groups[i] = s.groupAt(keys, i)
+	}
+	return groups
+}
+
+// groupAt returns the group of the key in slot of keys or quietGroup if the
+// key is quiet.
+func (s *Summarize) groupAt(keys []vector.Any, slot uint32) uint32 {
+	types := s.typeCache[:0]
+	b := &s.keyBuilder
+	b.Truncate()
+	for _, key := range keys {
+		typ := vector.TypeOf(key, slot)
+		if _, ok := zed.TypeUnder(typ).(*zed.TypeError); ok {
+			var vb zcode.Builder
+			if vector.ValueAt(&vb, key, slot).IsQuiet() {
+				return quietGroup
+			}
+		}
+		types = append(types, typ)
+		// Append each value to the key as a flat value,
+		// independent of whether this is a primitive or container.
+		key.Serialize(b, slot)
+	}
+	s.typeCache = types
+	return s.lookupGroup(b.Bytes(), s.keyTypes.Lookup(types))
+}
+
+func (s *Summarize) lookupGroup(keyBytes zcode.Bytes, keyType int) uint32 {
+	// Put the key type code at the end of the key so that
+	// materialize can iterate over the key values without
+	// skipping over it.
+	key := string(binary.AppendUvarint(keyBytes, uint64(keyType)))
+	g, ok := s.table[key]
+	if !ok {
+		g = uint32(len(s.groups))
+		s.table[key] = g
+		s.groups = append(s.groups, summarizeGroup{key, keyType})
+	}
+	return g
+}
+
+// selectSlots returns the slots of vec that are selected by mask, or all of
+// them if mask is nil, and whose group is not quietGroup along with the
+// groups of those slots.
+func selectSlots(vec vector.Any, groups []uint32, mask *vector.Bool) (vector.Any, []uint32) {
+	var index []uint32
+	for i, g := range groups {
+		if g == quietGroup || mask != nil && !mask.Value(uint32(i)) {
+			if index == nil {
+				index = make([]uint32, 0, len(groups))
+				for k := range i {
+					index = append(index, uint32(k))
+				}
+			}
+			continue
+		}
+		if index != nil {
+			index = append(index, uint32(i))
+		}
+	}
+	if index == nil {
+		return vec, groups
+	}
+	selected := make([]uint32, 0, len(index))
+	for _, slot := range index {
+		selected = append(selected, groups[slot])
+	}
+	return vector.NewView(index, vec), selected
+}
+
+func (s *Summarize) materialize() (vector.Any, error) {
+	if len(s.groups) == 0 {
+		return nil, nil
+	}
+	out := vector.NewDynamicBuilder()
+	for g, group := range s.groups {
+		// To build the output record, we spin over the key values and
+		// append them with the builder, then spin over the aggregations
+		// and append each value.
+		types := s.typeCache[:0]
+		it := zcode.Bytes(group.key).Iter()
+		s.builder.Reset()
+		for _, typ := range s.keyTypes.Types(group.keyType) {
+			s.builder.Append(it.Next())
+			types = append(types, typ)
+		}
+		for _, f := range s.funcs {
+			var val zed.Value
+			if s.partialsOut {
+				val = f.ResultAsPartial(s.rctx.Zctx, uint32(g))
+			} else {
+				val = f.Result(s.rctx.Zctx, uint32(g))
+			}
+			types = append(types, val.Type())
+			s.builder.Append(val.Bytes())
+		}
+		typ := s.lookupRecordType(types)
+		bytes, err := s.builder.Encode()
+		if err != nil {
+			return nil, err
+		}
+		out.Write(zed.NewValue(typ, bytes))
+	}
+	return out.Build(), nil
+}
+
+func (s *Summarize) lookupRecordType(types []zed.Type) *zed.TypeRecord {
+	id := s.outTypes.Lookup(types)
+	typ, ok := s.recordTypes[id]
+	if !ok {
+		typ = s.builder.Type(types)
+		s.recordTypes[id] = typ
+	}
+	return typ
+}
@@ -0,0 +1,103 @@
+package op
+
+import (
+	"context"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/vam/expr"
+	"github.com/brimdata/super/vector"
+	"github.com/brimdata/super/zcode"
+)
+
+// Switch sends each value to the first case whose filter is true for the
+// value.  Values for which a filter evaluates to an error other than
+// missing cause the error to be sent to that case while the value is
+// considered by the subsequent cases.
+type Switch struct {
+	*Router
+	cases   []*switchCase
+	builder zcode.Builder
+}
+
+var _ Selector = (*Switch)(nil)
+
+type switchCase struct {
+	filter expr.Evaluator
+	route  vector.Puller
+}
+
+func NewSwitch(ctx context.Context, parent vector.Puller) *Switch {
+	router := NewRouter(ctx, parent)
+	s := &Switch{Router: router}
+	router.Link(s)
+	return s
+}
+
+func (s *Switch) AddCase(f expr.Evaluator) vector.Puller {
+	route := s.Router.AddRoute()
+	s.cases = append(s.cases, &switchCase{filter: f, route: route})
+	return route
+}
+
+func (s *Switch) Forward(router *Router, vec vector.Any) bool {
+	for _, c := range s.cases {
+		mask := c.filter.Eval(vec)
+		var index, errs, rest []uint32
+		var tags []uint32
+		for slot := range vec.Len() {
+			if vector.BoolValue(mask, slot) {
+				index = append(index, slot)
+				tags = append(tags, 0)
+				continue
+			}
+			if s.isError(mask, slot) {
+				// XXX should use structured here to wrap
+				// the input value with the error
+				errs = append(errs, slot)
+				tags = append(tags, 1)
+			}
+			rest = append(rest, slot)
+		}
+		if out := s.output(vec, mask, index, errs, tags); out != nil {
+			if !router.Send(c.route, out) {
+				return false
+			}
+		}
+		if len(rest) == 0 {
+			break
+		}
+		if len(rest) < int(vec.Len()) {
+			vec = vector.NewView(rest, vec)
+		}
+	}
+	return true
+}
+
+// isError returns true if the value in slot of vec is an error other than
+// missing.
+func (s *Switch) isError(vec vector.Any, slot uint32) bool {
+	typ, ok := zed.TypeUnder(vector.TypeOf(vec, slot)).(*zed.TypeError)
+	if !ok {
+		return false
+	}
+	s.builder.Truncate()
+	vec.Serialize(&s.builder, slot)
+	return !typ.IsMissing(s.builder.Bytes().Body())
+}
+
+// output returns the values of vec in index interleaved according to tags
+// with the errors of mask in errs, or nil if there are no such values.
+func (s *Switch) output(vec, mask vector.Any, index, errs, tags []uint32) vector.Any {
+	switch {
+	case len(errs) == 0 && len(index) == 0:
+		return nil
+	case len(errs) == 0:
+		if len(index) == int(vec.Len()) {
+			return vec
+		}
+		return vector.NewView(index, vec)
+	case len(index) == 0:
+		return vector.NewView(errs, mask)
+	}
+	return vector.NewDynamic(tags, []vector.Any{vector.NewView(index, vec), vector.NewView(errs, mask)})
+}
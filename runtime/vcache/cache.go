@@ -30,21 +30,24 @@ func NewCache(engine storage.Engine) *Cache {
 	}
 }
 
+// lock locks the mutex for id.  c.mu is not held while waiting for the
+// mutex since its holder needs c.mu to finish fetching the object.
 func (c *Cache) lock(id ksuid.KSUID) {
 	c.mu.Lock()
-	defer c.mu.Unlock()
 	mu, ok := c.locks[id]
 	if !ok {
 		mu = &sync.Mutex{}
 		c.locks[id] = mu
 	}
+	c.mu.Unlock()
 	mu.Lock()
 }
 
 func (c *Cache) unlock(id ksuid.KSUID) {
 	c.mu.Lock()
-	c.locks[id].Unlock()
+	mu := c.locks[id]
 	c.mu.Unlock()
+	mu.Unlock()
 }
 
 func (c *Cache) Fetch(ctx context.Context, uri *storage.URI, id ksuid.KSUID) (*Object, error) {
@@ -426,7 +426,7 @@ func handleBranchLoad(c *Core, w *ResponseWriter, r *Request) {
 		w.Error(err)
 		return
 	}
-	reader, err := anyio.GzipReader(r.Body)
+	reader, err := anyio.DecompressReader(r.Body)
 	if err != nil {
 		w.Error(err)
 		return
@@ -21,3 +21,19 @@ type Puller interface {
 }
 
 type Builder func(*zcode.Builder) bool
+
+// TypeOf returns the type of the value in slot of vec.
+func TypeOf(vec Any, slot uint32) zed.Type {
+	if d, ok := vec.(*Dynamic); ok {
+		return d.TypeOf(slot)
+	}
+	return vec.Type()
+}
+
+// ValueAt returns the value in slot of vec.  The bytes of the returned value
+// reference b and are valid only until b is next modified.
+func ValueAt(b *zcode.Builder, vec Any, slot uint32) zed.Value {
+	b.Truncate()
+	vec.Serialize(b, slot)
+	return zed.NewValue(TypeOf(vec, slot), b.Bytes().Body())
+}
@@ -82,7 +82,7 @@ func Or(a, b *Bool) *Bool {
 		panic("or'ing two different length bool vectors")
 	}
 	out := NewBoolEmpty(a.Len(), nil)
-	for i := range a.Len() {
+	for i := range out.Bits {
 		out.Bits[i] = a.Bits[i] | b.Bits[i]
 	}
 	return out
@@ -111,6 +111,8 @@ func NullsOf(v Any) *Bool {
 	switch v := v.(type) {
 	case *Array:
 		return v.Nulls
+	case *Bool:
+		return v.Nulls
 	case *Bytes:
 		return v.Nulls
 	case *Const:
@@ -123,6 +125,8 @@ func NullsOf(v Any) *Bool {
 		return v.Nulls
 	case *Int:
 		return v.Nulls
+	case *IP:
+		return v.Nulls
 	case *Map:
 		return v.Nulls
 	case *Named:
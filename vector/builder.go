@@ -0,0 +1,403 @@
+package vector
+
+import (
+	"fmt"
+	"net/netip"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/zcode"
+)
+
+// DynamicBuilder builds a vector from a sequence of values of arbitrary
+// type.  It is used where the vector runtime must compute results one value
+// at a time (e.g., when constructing arrays of heterogeneous values).
+type DynamicBuilder struct {
+	tags     []uint32
+	builders []valueBuilder
+	which    map[zed.Type]uint32
+}
+
+func NewDynamicBuilder() *DynamicBuilder {
+	return &DynamicBuilder{which: make(map[zed.Type]uint32)}
+}
+
+func (d *DynamicBuilder) Write(val zed.Value) {
+	tag, ok := d.which[val.Type()]
+	if !ok {
+		tag = uint32(len(d.builders))
+		d.which[val.Type()] = tag
+		d.builders = append(d.builders, newValueBuilder(val.Type()))
+	}
+	d.tags = append(d.tags, tag)
+	d.builders[tag].Write(val.Bytes())
+}
+
+// Build returns the vector of values written so far.  If all of the values
+// have the same type, the result is not a Dynamic.
+func (d *DynamicBuilder) Build() Any {
+	if len(d.builders) == 1 {
+		return d.builders[0].Build()
+	}
+	vecs := make([]Any, 0, len(d.builders))
+	for _, b := range d.builders {
+		vecs = append(vecs, b.Build())
+	}
+	return NewDynamic(d.tags, vecs)
+}
+
+// NewEmpty returns a vector of type typ with no values.
+func NewEmpty(typ zed.Type) Any {
+	return newValueBuilder(typ).Build()
+}
+
+// valueBuilder builds a vector of a single type from a sequence of values of
+// that type in their ZNG encoding, where nil denotes null.
+type valueBuilder interface {
+	Write(zcode.Bytes)
+	Build() Any
+}
+
+func newValueBuilder(typ zed.Type) valueBuilder {
+	switch typ := typ.(type) {
+	case *zed.TypeNamed:
+		return &namedBuilder{typ: typ, valueBuilder: newValueBuilder(typ.Type)}
+	case *zed.TypeError:
+		return &errorBuilder{typ: typ, valueBuilder: newValueBuilder(typ.Type)}
+	case *zed.TypeRecord:
+		var fields []valueBuilder
+		for _, f := range typ.Fields {
+			fields = append(fields, newValueBuilder(f.Type))
+		}
+		return &recordBuilder{typ: typ, fields: fields}
+	case *zed.TypeArray:
+		return &listBuilder{typ: typ, offsets: []uint32{0}, values: newValueBuilder(typ.Type)}
+	case *zed.TypeSet:
+		return &listBuilder{typ: typ, offsets: []uint32{0}, values: newValueBuilder(typ.Type)}
+	case *zed.TypeMap:
+		return &mapBuilder{typ: typ, offsets: []uint32{0}, keys: newValueBuilder(typ.KeyType), values: newValueBuilder(typ.ValType)}
+	case *zed.TypeUnion:
+		var values []valueBuilder
+		for _, t := range typ.Types {
+			values = append(values, newValueBuilder(t))
+		}
+		return &unionBuilder{typ: typ, values: values}
+	case *zed.TypeEnum:
+		return &uintBuilder{typ: typ}
+	}
+	switch id := typ.ID(); {
+	case zed.IsUnsigned(id):
+		return &uintBuilder{typ: typ}
+	case zed.IsSigned(id):
+		return &intBuilder{typ: typ}
+	case zed.IsFloat(id):
+		return &floatBuilder{typ: typ}
+	}
+	switch typ.ID() {
+	case zed.IDBool:
+		return &boolBuilder{}
+	case zed.IDBytes:
+		return &bytesBuilder{offsets: []uint32{0}}
+	case zed.IDString:
+		return &bytesBuilder{offsets: []uint32{0}, string: true}
+	case zed.IDIP:
+		return &ipBuilder{}
+	case zed.IDNet:
+		return &netBuilder{}
+	case zed.IDType:
+		return &bytesBuilder{offsets: []uint32{0}, typeValue: true}
+	case zed.IDNull:
+		return &nullBuilder{}
+	}
+	panic(fmt.Sprintf("vector.newValueBuilder: unknown type %T", typ))
+}
+
+// nullsBuilder tracks the null slots of a vector under construction.
+type nullsBuilder struct {
+	len   uint32
+	slots []uint32
+}
+
+func (n *nullsBuilder) write(null bool) {
+	if null {
+		n.slots = append(n.slots, n.len)
+	}
+	n.len++
+}
+
+func (n *nullsBuilder) build() *Bool {
+	if len(n.slots) == 0 {
+		return nil
+	}
+	nulls := NewBoolEmpty(n.len, nil)
+	for _, slot := range n.slots {
+		nulls.Set(slot)
+	}
+	return nulls
+}
+
+type namedBuilder struct {
+	valueBuilder
+	typ *zed.TypeNamed
+}
+
+func (n *namedBuilder) Build() Any {
+	return NewNamed(n.typ, n.valueBuilder.Build())
+}
+
+type errorBuilder struct {
+	valueBuilder
+	typ *zed.TypeError
+}
+
+func (e *errorBuilder) Build() Any {
+	return NewError(e.typ, e.valueBuilder.Build(), nil)
+}
+
+type recordBuilder struct {
+	typ    *zed.TypeRecord
+	fields []valueBuilder
+	nulls  nullsBuilder
+}
+
+func (r *recordBuilder) Write(bytes zcode.Bytes) {
+	r.nulls.write(bytes == nil)
+	it := bytes.Iter()
+	for _, f := range r.fields {
+		if bytes == nil {
+			f.Write(nil)
+		} else {
+			f.Write(it.Next())
+		}
+	}
+}
+
+func (r *recordBuilder) Build() Any {
+	var fields []Any
+	for _, f := range r.fields {
+		fields = append(fields, f.Build())
+	}
+	return NewRecord(r.typ, fields, r.nulls.len, r.nulls.build())
+}
+
+type listBuilder struct {
+	typ     zed.Type
+	offsets []uint32
+	values  valueBuilder
+	nulls   nullsBuilder
+}
+
+func (l *listBuilder) Write(bytes zcode.Bytes) {
+	l.nulls.write(bytes == nil)
+	off := l.offsets[len(l.offsets)-1]
+	for it := bytes.Iter(); !it.Done(); {
+		l.values.Write(it.Next())
+		off++
+	}
+	l.offsets = append(l.offsets, off)
+}
+
+func (l *listBuilder) Build() Any {
+	if typ, ok := l.typ.(*zed.TypeSet); ok {
+		return NewSet(typ, l.offsets, l.values.Build(), l.nulls.build())
+	}
+	return NewArray(l.typ.(*zed.TypeArray), l.offsets, l.values.Build(), l.nulls.build())
+}
+
+type mapBuilder struct {
+	typ     *zed.TypeMap
+	offsets []uint32
+	keys    valueBuilder
+	values  valueBuilder
+	nulls   nullsBuilder
+}
+
+func (m *mapBuilder) Write(bytes zcode.Bytes) {
+	m.nulls.write(bytes == nil)
+	off := m.offsets[len(m.offsets)-1]
+	for it := bytes.Iter(); !it.Done(); {
+		m.keys.Write(it.Next())
+		m.values.Write(it.Next())
+		off++
+	}
+	m.offsets = append(m.offsets, off)
+}
+
+func (m *mapBuilder) Build() Any {
+	return NewMap(m.typ, m.offsets, m.keys.Build(), m.values.Build(), m.nulls.build())
+}
+
+type unionBuilder struct {
+	typ    *zed.TypeUnion
+	tags   []uint32
+	values []valueBuilder
+	nulls  nullsBuilder
+}
+
+func (u *unionBuilder) Write(bytes zcode.Bytes) {
+	u.nulls.write(bytes == nil)
+	if bytes == nil {
+		u.tags = append(u.tags, 0)
+		u.values[0].Write(nil)
+		return
+	}
+	it := bytes.Iter()
+	tag := uint32(zed.DecodeInt(it.Next()))
+	u.tags = append(u.tags, tag)
+	u.values[tag].Write(it.Next())
+}
+
+func (u *unionBuilder) Build() Any {
+	var vecs []Any
+	for _, v := range u.values {
+		vecs = append(vecs, v.Build())
+	}
+	return NewUnion(u.typ, u.tags, vecs, u.nulls.build())
+}
+
+type intBuilder struct {
+	typ    zed.Type
+	values []int64
+	nulls  nullsBuilder
+}
+
+func (i *intBuilder) Write(bytes zcode.Bytes) {
+	i.nulls.write(bytes == nil)
+	i.values = append(i.values, zed.DecodeInt(bytes))
+}
+
+func (i *intBuilder) Build() Any {
+	return NewInt(i.typ, i.values, i.nulls.build())
+}
+
+type uintBuilder struct {
+	typ    zed.Type
+	values []uint64
+	nulls  nullsBuilder
+}
+
+func (u *uintBuilder) Write(bytes zcode.Bytes) {
+	u.nulls.write(bytes == nil)
+	u.values = append(u.values, zed.DecodeUint(bytes))
+}
+
+func (u *uintBuilder) Build() Any {
+	return NewUint(u.typ, u.values, u.nulls.build())
+}
+
+type floatBuilder struct {
+	typ    zed.Type
+	values []float64
+	nulls  nullsBuilder
+}
+
+func (f *floatBuilder) Write(bytes zcode.Bytes) {
+	f.nulls.write(bytes == nil)
+	var v float64
+	if bytes != nil {
+		switch f.typ.ID() {
+		case zed.IDFloat16:
+			v = float64(zed.DecodeFloat16(bytes))
+		case zed.IDFloat32:
+			v = float64(zed.DecodeFloat32(bytes))
+		default:
+			v = zed.DecodeFloat64(bytes)
+		}
+	}
+	f.values = append(f.values, v)
+}
+
+func (f *floatBuilder) Build() Any {
+	return NewFloat(f.typ, f.values, f.nulls.build())
+}
+
+type boolBuilder struct {
+	values []bool
+	nulls  nullsBuilder
+}
+
+func (b *boolBuilder) Write(bytes zcode.Bytes) {
+	b.nulls.write(bytes == nil)
+	b.values = append(b.values, zed.DecodeBool(bytes))
+}
+
+func (b *boolBuilder) Build() Any {
+	vec := NewBoolEmpty(uint32(len(b.values)), b.nulls.build())
+	for slot, v := range b.values {
+		if v {
+			vec.Set(uint32(slot))
+		}
+	}
+	return vec
+}
+
+type bytesBuilder struct {
+	offsets   []uint32
+	bytes     []byte
+	nulls     nullsBuilder
+	string    bool
+	typeValue bool
+}
+
+func (b *bytesBuilder) Write(bytes zcode.Bytes) {
+	b.nulls.write(bytes == nil)
+	b.bytes = append(b.bytes, bytes...)
+	b.offsets = append(b.offsets, uint32(len(b.bytes)))
+}
+
+func (b *bytesBuilder) Build() Any {
+	switch {
+	case b.string:
+		return NewString(b.offsets, b.bytes, b.nulls.build())
+	case b.typeValue:
+		return NewTypeValue(b.offsets, b.bytes, b.nulls.build())
+	}
+	return NewBytes(b.offsets, b.bytes, b.nulls.build())
+}
+
+type ipBuilder struct {
+	values []netip.Addr
+	nulls  nullsBuilder
+}
+
+func (i *ipBuilder) Write(bytes zcode.Bytes) {
+	i.nulls.write(bytes == nil)
+	var v netip.Addr
+	if bytes != nil {
+		v = zed.DecodeIP(bytes)
+	}
+	i.values = append(i.values, v)
+}
+
+func (i *ipBuilder) Build() Any {
+	return NewIP(i.values, i.nulls.build())
+}
+
+type netBuilder struct {
+	values []netip.Prefix
+	nulls  nullsBuilder
+}
+
+func (n *netBuilder) Write(bytes zcode.Bytes) {
+	n.nulls.write(bytes == nil)
+	var v netip.Prefix
+	if bytes != nil {
+		v = zed.DecodeNet(bytes)
+	}
+	n.values = append(n.values, v)
+}
+
+func (n *netBuilder) Build() Any {
+	return NewNet(n.values, n.nulls.build())
+}
+
+type nullBuilder struct {
+	len uint32
+}
+
+func (n *nullBuilder) Write(zcode.Bytes) {
+	n.len++
+}
+
+func (n *nullBuilder) Build() Any {
+	return NewConst(zed.Null, n.len, nil)
+}
@@ -22,6 +22,10 @@ func (u *Union) Type() zed.Type {
 }
 
 func (u *Union) Serialize(b *zcode.Builder, slot uint32) {
+	if u.Nulls.Value(slot) {
+		b.Append(nil)
+		return
+	}
 	b.BeginContainer()
 	b.Append(zed.EncodeInt(int64(u.Tags[slot])))
 	u.Dynamic.Serialize(b, slot)
@@ -17,11 +17,11 @@ type ArrayEncoder struct {
 
 var _ Encoder = (*ArrayEncoder)(nil)
 
-func NewArrayEncoder(typ *zed.TypeArray) *ArrayEncoder {
+func NewArrayEncoder(typ *zed.TypeArray, opts WriterOpts) *ArrayEncoder {
 	return &ArrayEncoder{
 		typ:     typ.Type,
-		values:  NewEncoder(typ.Type),
-		lengths: NewInt64Encoder(),
+		values:  NewEncoder(typ.Type, opts),
+		lengths: NewInt64Encoder(opts),
 	}
 }
 
@@ -95,12 +95,12 @@ type SetEncoder struct {
 	ArrayEncoder
 }
 
-func NewSetEncoder(typ *zed.TypeSet) *SetEncoder {
+func NewSetEncoder(typ *zed.TypeSet, opts WriterOpts) *SetEncoder {
 	return &SetEncoder{
 		ArrayEncoder{
 			typ:     typ.Type,
-			values:  NewEncoder(typ.Type),
-			lengths: NewInt64Encoder(),
+			values:  NewEncoder(typ.Type, opts),
+			lengths: NewInt64Encoder(opts),
 		},
 	}
 }
@@ -11,6 +11,7 @@ import (
 )
 
 type DynamicEncoder struct {
+	opts   WriterOpts
 	tags   *Int64Encoder
 	values []Encoder
 	which  map[zed.Type]int
@@ -19,9 +20,10 @@ type DynamicEncoder struct {
 
 var _ zio.Writer = (*DynamicEncoder)(nil)
 
-func NewDynamicEncoder() *DynamicEncoder {
+func NewDynamicEncoder(opts WriterOpts) *DynamicEncoder {
 	return &DynamicEncoder{
-		tags:  NewInt64Encoder(),
+		opts:  opts,
+		tags:  NewInt64Encoder(opts),
 		which: make(map[zed.Type]int),
 	}
 }
@@ -35,7 +37,7 @@ func (d *DynamicEncoder) Write(val zed.Value) error {
 	tag, ok := d.which[typ]
 	if !ok {
 		tag = len(d.values)
-		d.values = append(d.values, NewEncoder(typ))
+		d.values = append(d.values, NewEncoder(typ, d.opts))
 		d.which[typ] = tag
 	}
 	d.tags.Write(int64(tag))
@@ -28,29 +28,29 @@ type Encoder interface {
 	Emit(w io.Writer) error
 }
 
-func NewEncoder(typ zed.Type) Encoder {
+func NewEncoder(typ zed.Type, opts WriterOpts) Encoder {
 	switch typ := typ.(type) {
 	case *zed.TypeNamed:
-		return &NamedEncoder{NewEncoder(typ.Type), typ.Name}
+		return &NamedEncoder{NewEncoder(typ.Type, opts), typ.Name}
 	case *zed.TypeError:
-		return &ErrorEncoder{NewEncoder(typ.Type)}
+		return &ErrorEncoder{NewEncoder(typ.Type, opts)}
 	case *zed.TypeRecord:
-		return NewNullsEncoder(NewRecordEncoder(typ))
+		return NewNullsEncoder(NewRecordEncoder(typ, opts), opts)
 	case *zed.TypeArray:
-		return NewNullsEncoder(NewArrayEncoder(typ))
+		return NewNullsEncoder(NewArrayEncoder(typ, opts), opts)
 	case *zed.TypeSet:
 		// Sets encode the same way as arrays but behave
 		// differently semantically, and we don't care here.
-		return NewNullsEncoder(NewSetEncoder(typ))
+		return NewNullsEncoder(NewSetEncoder(typ, opts), opts)
 	case *zed.TypeMap:
-		return NewNullsEncoder(NewMapEncoder(typ))
+		return NewNullsEncoder(NewMapEncoder(typ, opts), opts)
 	case *zed.TypeUnion:
-		return NewNullsEncoder(NewUnionEncoder(typ))
+		return NewNullsEncoder(NewUnionEncoder(typ, opts), opts)
 	default:
 		if !zed.IsPrimitiveType(typ) {
 			panic(fmt.Sprintf("unsupported type in VNG file: %T", typ))
 		}
-		return NewNullsEncoder(NewPrimitiveEncoder(typ, true))
+		return NewNullsEncoder(NewPrimitiveEncoder(typ, true, opts), opts)
 	}
 }
 
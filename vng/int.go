@@ -10,8 +10,8 @@ type Int64Encoder struct {
 	PrimitiveEncoder
 }
 
-func NewInt64Encoder() *Int64Encoder {
-	return &Int64Encoder{*NewPrimitiveEncoder(zed.TypeInt64, false)}
+func NewInt64Encoder(opts WriterOpts) *Int64Encoder {
+	return &Int64Encoder{*NewPrimitiveEncoder(zed.TypeInt64, false, opts)}
 }
 
 func (p *Int64Encoder) Write(v int64) {
@@ -33,3 +33,13 @@ func (p *Int64Decoder) Next() (int64, error) {
 	}
 	return zed.DecodeInt(zv), err
 }
+
+// isDeltaType returns true if values of typ are encoded as zcode-encoded
+// signed integers and thus may be delta encoded.
+func isDeltaType(typ zed.Type) bool {
+	switch typ.ID() {
+	case zed.IDInt8, zed.IDInt16, zed.IDInt32, zed.IDInt64, zed.IDDuration, zed.IDTime:
+		return true
+	}
+	return false
+}
@@ -15,11 +15,11 @@ type MapEncoder struct {
 	count   uint32
 }
 
-func NewMapEncoder(typ *zed.TypeMap) *MapEncoder {
+func NewMapEncoder(typ *zed.TypeMap, opts WriterOpts) *MapEncoder {
 	return &MapEncoder{
-		keys:    NewEncoder(typ.KeyType),
-		values:  NewEncoder(typ.ValType),
-		lengths: NewInt64Encoder(),
+		keys:    NewEncoder(typ.KeyType, opts),
+		values:  NewEncoder(typ.ValType, opts),
+		lengths: NewInt64Encoder(opts),
 	}
 }
 
@@ -18,10 +18,10 @@ type NullsEncoder struct {
 	count  uint32
 }
 
-func NewNullsEncoder(values Encoder) *NullsEncoder {
+func NewNullsEncoder(values Encoder, opts WriterOpts) *NullsEncoder {
 	return &NullsEncoder{
 		values: values,
-		runs:   *NewInt64Encoder(),
+		runs:   *NewInt64Encoder(opts),
 	}
 }
 
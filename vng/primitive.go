@@ -18,6 +18,7 @@ type PrimitiveEncoder struct {
 	typ      zed.Type
 	bytes    zcode.Bytes
 	bytesLen uint64
+	opts     WriterOpts
 	format   uint8
 	out      []byte
 	dict     map[string]uint32
@@ -27,7 +28,7 @@ type PrimitiveEncoder struct {
 	count    uint32
 }
 
-func NewPrimitiveEncoder(typ zed.Type, useDict bool) *PrimitiveEncoder {
+func NewPrimitiveEncoder(typ zed.Type, useDict bool, opts WriterOpts) *PrimitiveEncoder {
 	var dict map[string]uint32
 	if useDict {
 		// Don't bother using a dictionary (which takes 8-bit tags) to encode
@@ -38,6 +39,7 @@ func NewPrimitiveEncoder(typ zed.Type, useDict bool) *PrimitiveEncoder {
 	}
 	return &PrimitiveEncoder{
 		typ:  typ,
+		opts: opts,
 		dict: dict,
 		cmp:  expr.NewValueCompareFn(order.Asc, false),
 	}
@@ -70,10 +72,13 @@ func (p *PrimitiveEncoder) update(body zcode.Bytes) {
 
 func (p *PrimitiveEncoder) Encode(group *errgroup.Group) {
 	group.Go(func() error {
+		// Only integer values are delta encoded and dictionary
+		// vectors are not.
+		delta := p.dict == nil && isDeltaType(p.typ)
 		if p.dict != nil {
 			p.bytes = p.makeDictVector()
 		}
-		fmt, out, err := compressBuffer(p.bytes)
+		fmt, out, err := compress(p.bytes, p.opts, delta)
 		if err != nil {
 			return err
 		}
@@ -6,13 +6,17 @@ import (
 	"slices"
 	"sync"
 
+	"github.com/brimdata/super/zcode"
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4/v4"
 )
 
 // Values for [Segment.CompressionFormat].
 const (
-	CompressionFormatNone uint8 = 0 // No compression
-	CompressionFormatLZ4  uint8 = 1 // LZ4 compression
+	CompressionFormatNone      uint8 = 0 // No compression
+	CompressionFormatLZ4       uint8 = 1 // LZ4 compression
+	CompressionFormatZstd      uint8 = 2 // Zstandard compression
+	CompressionFormatDeltaZstd uint8 = 3 // Delta encoding and Zstandard compression
 )
 
 type Segment struct {
@@ -26,6 +30,18 @@ var zbufPool = sync.Pool{
 	New: func() any { return new([]byte) },
 }
 
+// zstdDecoder and zstdEncoder return a decoder and an encoder shared by all
+// readers and writers.  Their DecodeAll and EncodeAll methods may be called
+// concurrently.
+var (
+	zstdDecoder = sync.OnceValues(func() (*zstd.Decoder, error) {
+		return zstd.NewReader(nil, zstd.WithDecoderConcurrency(0), zstd.WithDecoderMaxMemory(MaxDataSize))
+	})
+	zstdEncoder = sync.OnceValues(func() (*zstd.Encoder, error) {
+		return zstd.NewWriter(nil)
+	})
+)
+
 // Read reads the segement r, uncompresses it if necessary, and stores it in the
 // first s.MemLength bytes of b. If the length of b is less than s.MemLength,
 // Read returns [io.ErrShortBuffer].
@@ -53,11 +69,105 @@ func (s *Segment) Read(r io.ReaderAt, b []byte) error {
 			return fmt.Errorf("vng: got %d uncompressed bytes, expected %d", n, s.MemLength)
 		}
 		return nil
+	case CompressionFormatZstd, CompressionFormatDeltaZstd:
+		zbuf := zbufPool.Get().(*[]byte)
+		defer zbufPool.Put(zbuf)
+		*zbuf = slices.Grow((*zbuf)[:0], int(s.Length))[:s.Length]
+		if _, err := r.ReadAt(*zbuf, int64(s.Offset)); err != nil {
+			return err
+		}
+		decoder, err := zstdDecoder()
+		if err != nil {
+			return err
+		}
+		if s.CompressionFormat == CompressionFormatDeltaZstd {
+			// The length of the delta-encoded sequence isn't known
+			// so decompress into a separate buffer.
+			dbuf := zbufPool.Get().(*[]byte)
+			defer zbufPool.Put(dbuf)
+			out, err := decoder.DecodeAll(*zbuf, (*dbuf)[:0])
+			if err != nil {
+				return err
+			}
+			*dbuf = out
+			b = deltaDecode(b[:0], out)
+		} else {
+			// DecodeAll decompresses into b unless the result is
+			// longer, which is caught below.
+			b, err = decoder.DecodeAll(*zbuf, b[:0])
+			if err != nil {
+				return err
+			}
+		}
+		if len(b) != int(s.MemLength) {
+			return fmt.Errorf("vng: got %d uncompressed bytes, expected %d", len(b), s.MemLength)
+		}
+		return nil
 	default:
 		return fmt.Errorf("vng: unknown compression format 0x%x", s.CompressionFormat)
 	}
 }
 
+// compress compresses b with the compression format in opts.  If delta is
+// true and opts.Delta is set, b must be a sequence of zcode-encoded integers,
+// which are delta encoded before compression.  If compression does not
+// reduce the size of b, b is returned with CompressionFormatNone.
+func compress(b []byte, opts WriterOpts, delta bool) (uint8, []byte, error) {
+	switch opts.CompressionFormat {
+	case CompressionFormatNone:
+		return CompressionFormatNone, b, nil
+	case CompressionFormatLZ4:
+		return compressBuffer(b)
+	case CompressionFormatZstd:
+		if len(b) == 0 {
+			return CompressionFormatNone, nil, nil
+		}
+		format := CompressionFormatZstd
+		in := b
+		if delta && opts.Delta {
+			format = CompressionFormatDeltaZstd
+			in = deltaEncode(nil, b)
+		}
+		encoder, err := zstdEncoder()
+		if err != nil {
+			return 0, nil, err
+		}
+		out := encoder.EncodeAll(in, nil)
+		if len(out) < len(b) {
+			return format, out, nil
+		}
+		return CompressionFormatNone, b, nil
+	default:
+		return 0, nil, fmt.Errorf("vng: unknown compression format 0x%x", opts.CompressionFormat)
+	}
+}
+
+// deltaEncode appends to dst the differences between successive integers in
+// src, a sequence of zcode-encoded integers.
+func deltaEncode(dst, src []byte) []byte {
+	var prev int64
+	var scratch []byte
+	for it := zcode.Bytes(src).Iter(); !it.Done(); {
+		v := zcode.DecodeCountedVarint(it.Next())
+		scratch = zcode.AppendCountedVarint(scratch[:0], v-prev)
+		dst = zcode.Append(dst, scratch)
+		prev = v
+	}
+	return dst
+}
+
+// deltaDecode reverses deltaEncode, appending the integers to dst.
+func deltaDecode(dst, src []byte) []byte {
+	var v int64
+	var scratch []byte
+	for it := zcode.Bytes(src).Iter(); !it.Done(); {
+		v += zcode.DecodeCountedVarint(it.Next())
+		scratch = zcode.AppendCountedVarint(scratch[:0], v)
+		dst = zcode.Append(dst, scratch)
+	}
+	return dst
+}
+
 // XXX for now we always compress, we should add a config option to
 // avoid compression when local storage is fast compared to compute
 func compressBuffer(b []byte) (uint8, []byte, error) {
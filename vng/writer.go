@@ -21,11 +21,27 @@ type Writer struct {
 
 var _ zio.Writer = (*Writer)(nil)
 
+type WriterOpts struct {
+	// CompressionFormat is the compression format for segments:
+	// CompressionFormatNone, CompressionFormatLZ4, or CompressionFormatZstd.
+	CompressionFormat uint8
+	// Delta enables delta encoding of integer, duration, and time segments
+	// prior to compression.  It is supported only with CompressionFormatZstd.
+	Delta bool
+}
+
+// DefaultWriterOpts are the options used by NewWriter.
+var DefaultWriterOpts = WriterOpts{CompressionFormat: CompressionFormatLZ4}
+
 func NewWriter(w io.WriteCloser) *Writer {
+	return NewWriterWithOpts(w, DefaultWriterOpts)
+}
+
+func NewWriterWithOpts(w io.WriteCloser, opts WriterOpts) *Writer {
 	return &Writer{
 		zctx:    zed.NewContext(),
 		writer:  w,
-		dynamic: NewDynamicEncoder(),
+		dynamic: NewDynamicEncoder(opts),
 	}
 }
 
@@ -1,6 +1,7 @@
 package zfmt
 
 import (
+	"errors"
 	"fmt"
 	"slices"
 
@@ -85,6 +86,10 @@ func (c *canon) expr(e ast.Expr, parent string) {
 		if e.Expr != nil {
 			c.expr(e.Expr, "")
 		}
+		for _, arg := range e.Args {
+			c.write(", ")
+			c.expr(arg, "")
+		}
 		c.write(")")
 		if e.Where != nil {
 			c.write(" where ")
@@ -604,10 +609,19 @@ func (c *canon) op(p ast.Op) {
 			c.write(") ")
 		}
 		c.write("on ")
-		c.expr(p.LeftKey, "")
-		if p.RightKey != nil {
-			c.write("=")
-			c.expr(p.RightKey, "")
+		for k, key := range p.Keys {
+			if k > 0 {
+				c.write(", ")
+			}
+			c.expr(key.Left, "")
+			if key.Right != nil {
+				c.write("=")
+				c.expr(key.Right, "")
+			}
+		}
+		if p.Cond != nil {
+			c.write(" where ")
+			c.expr(p.Cond, "")
 		}
 		if p.Args != nil {
 			c.write(" ")
@@ -628,6 +642,39 @@ func (c *canon) op(p ast.Op) {
 		c.expr(p.Expr, "")
 	case *ast.Over:
 		c.over(p)
+	case *ast.Window:
+		c.next()
+		c.write("window ")
+		for k, f := range p.Funcs {
+			if k > 0 {
+				c.write(",")
+			}
+			if f.LHS != nil {
+				c.expr(f.LHS, "")
+				c.write(":=")
+			}
+			if f.Running {
+				c.write("running ")
+			}
+			c.expr(f.Func, "")
+		}
+		if len(p.Keys) != 0 {
+			c.write(" by ")
+			c.exprs(p.Keys)
+		}
+		if len(p.Sort) != 0 {
+			c.write(" sort")
+			for k, s := range p.Sort {
+				if k > 0 {
+					c.write(",")
+				}
+				c.space()
+				c.expr(s.Expr, "")
+				if s.Order != nil {
+					c.write(" %s", s.Order.Name)
+				}
+			}
+		}
 	case *ast.Yield:
 		c.next()
 		c.write("yield ")
@@ -725,7 +772,7 @@ func isAggFunc(e ast.Expr) *ast.Summarize {
 	if !ok {
 		return nil
 	}
-	if _, err := agg.NewPattern(call.Name.Name, true); err != nil {
+	if _, err := agg.NewPattern(call.Name.Name, true, nil); errors.Is(err, agg.ErrUnknown) {
 		return nil
 	}
 	return &ast.Summarize{
@@ -73,6 +73,10 @@ func (c *canonDAG) expr(e dag.Expr, parent string) {
 		if e.Expr != nil {
 			c.expr(e.Expr, "")
 		}
+		for _, arg := range e.Args {
+			c.write(", ")
+			c.expr(arg, "")
+		}
 		c.write(")")
 		if e.Where != nil {
 			c.write(" where ")
@@ -453,10 +457,23 @@ func (c *canonDAG) op(p dag.Op) {
 		c.write("fuse")
 	case *dag.Join:
 		c.next()
-		c.open("join on ")
-		c.expr(p.LeftKey, "")
-		c.write("=")
-		c.expr(p.RightKey, "")
+		c.open("join ")
+		if p.Hash {
+			c.write("hash ")
+		}
+		c.write("on ")
+		for k := range p.LeftKeys {
+			if k > 0 {
+				c.write(", ")
+			}
+			c.expr(p.LeftKeys[k], "")
+			c.write("=")
+			c.expr(p.RightKeys[k], "")
+		}
+		if p.Cond != nil {
+			c.write(" where ")
+			c.expr(p.Cond, "")
+		}
 		if len(p.Args) != 0 {
 			c.write(" ")
 			c.assignments(p.Args)
@@ -493,6 +510,35 @@ func (c *canonDAG) op(p dag.Op) {
 		c.close()
 	case *dag.Over:
 		c.over(p)
+	case *dag.Window:
+		c.next()
+		c.write("window ")
+		for k, f := range p.Funcs {
+			if k > 0 {
+				c.write(",")
+			}
+			c.expr(f.LHS, "")
+			c.write(":=")
+			if f.Running {
+				c.write("running ")
+			}
+			c.expr(f.Func, "")
+		}
+		if len(p.Keys) != 0 {
+			c.write(" by ")
+			c.exprs(p.Keys)
+		}
+		if len(p.Sort) != 0 {
+			c.write(" sort")
+			for k, s := range p.Sort {
+				if k > 0 {
+					c.write(",")
+				}
+				c.space()
+				c.expr(s.Key, "")
+				c.write(" %s", s.Order)
+			}
+		}
 	case *dag.Yield:
 		c.next()
 		c.write("yield ")
@@ -520,6 +566,11 @@ func (c *canonDAG) op(p dag.Op) {
 				c.write(" %s  %s", s.Key, s.Order)
 			}
 		}
+		if p.Pruner != nil {
+			c.write(" pruner (")
+			c.expr(p.Pruner, "")
+			c.write(")")
+		}
 		if p.Filter != nil {
 			c.write(" filter (")
 			c.expr(p.Filter, "")
@@ -0,0 +1,65 @@
+package anyio
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/ulikunitz/xz"
+)
+
+// CompressExtension returns the file name extension for the compression
+// format or the empty string if format is not known.
+func CompressExtension(format string) string {
+	switch format {
+	case "gzip":
+		return ".gz"
+	case "xz":
+		return ".xz"
+	case "zstd":
+		return ".zst"
+	}
+	return ""
+}
+
+// isTextFormat returns true if format is a text format and thus may be
+// compressed by NewWriter.
+func isTextFormat(format string) bool {
+	switch format {
+	case "csv", "json", "lake", "logfmt", "table", "text", "tsv", "zeek", "zjson", "zson", "":
+		return true
+	}
+	return false
+}
+
+// newCompressor returns a WriteCloser that writes to w the compressed form
+// of what is written to it.  Closing it closes w.
+func newCompressor(format string, w io.WriteCloser) (io.WriteCloser, error) {
+	var cw io.WriteCloser
+	var err error
+	switch format {
+	case "gzip":
+		cw = gzip.NewWriter(w)
+	case "xz":
+		cw, err = xz.NewWriter(w)
+	case "zstd":
+		cw, err = zstd.NewWriter(w)
+	default:
+		return nil, fmt.Errorf("unknown compression format: %s", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &compressor{cw, w}, nil
+}
+
+type compressor struct {
+	io.WriteCloser
+	w io.WriteCloser
+}
+
+func (c *compressor) Close() error {
+	return errors.Join(c.WriteCloser.Close(), c.w.Close())
+}
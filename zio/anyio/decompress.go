@@ -0,0 +1,127 @@
+package anyio
+
+import (
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// maxDecompressWindow bounds the window of a zstd stream and the dictionary
+// of an xz stream, and so the memory used to decompress them, since input
+// such as the body of a load request may come from an untrusted client.  It
+// is large enough for the highest compression levels of the zstd and xz
+// command-line tools.
+const maxDecompressWindow = 128 * 1024 * 1024
+
+// DecompressReader returns a reader that decompresses r if it holds a
+// bzip2, gzip, xz, or zstd stream and that reads r unaltered otherwise.
+func DecompressReader(r io.Reader) (io.Reader, error) {
+	if rs, ok := r.(io.ReadSeeker); ok {
+		if n, err := rs.Seek(0, io.SeekCurrent); err == nil {
+			format := readCompressionMagic(rs)
+			if _, err := rs.Seek(n, io.SeekStart); err != nil {
+				return nil, err
+			}
+			if format == "" {
+				return rs, nil
+			}
+			if r, err := newDecompressor(format, rs); err == nil {
+				return r, nil
+			}
+			if _, err := rs.Seek(n, io.SeekStart); err != nil {
+				return nil, err
+			}
+			return rs, nil
+		}
+	}
+	track := NewTrack(r)
+	// Some decompressors block until they read a complete header.
+	// readCompressionMagic reads no more than it needs to rule out each
+	// format.
+	format := readCompressionMagic(track)
+	if format == "" {
+		return track.Reader(), nil
+	}
+	track.Reset()
+	if _, err := newDecompressor(format, track); err == nil {
+		return newDecompressor(format, track.Reader())
+	}
+	return track.Reader(), nil
+}
+
+func newDecompressor(format string, r io.Reader) (io.Reader, error) {
+	switch format {
+	case "bzip2":
+		return bzip2.NewReader(r), nil
+	case "gzip":
+		return gzip.NewReader(r)
+	case "xz":
+		return newXZReader(r)
+	case "zstd":
+		// With a concurrency of one, the decoder runs synchronously
+		// and so needs no Close to release its goroutines.
+		return zstd.NewReader(r, zstd.WithDecoderConcurrency(1),
+			zstd.WithDecoderMaxMemory(maxDecompressWindow),
+			zstd.WithDecoderMaxWindow(maxDecompressWindow))
+	}
+	panic(format)
+}
+
+type compressionMagic struct {
+	format string
+	magic  []byte
+}
+
+var compressionMagics = func() []compressionMagic {
+	magics := []compressionMagic{
+		// RFC 1952, Section 2.3.1
+		{"gzip", []byte{0x1f, 0x8b}},
+		// The .xz File Format, Section 2.1.1.1
+		{"xz", []byte{0xfd, '7', 'z', 'X', 'Z', 0x00}},
+		// RFC 8878, Section 3.1.1
+		{"zstd", []byte{0x28, 0xb5, 0x2f, 0xfd}},
+	}
+	// A bzip2 stream begins with "BZh" and a block size digit followed
+	// by the magic number of a compressed block or of the end of the
+	// stream.
+	for level := byte('1'); level <= '9'; level++ {
+		for _, m := range [][]byte{
+			{0x31, 0x41, 0x59, 0x26, 0x53, 0x59},
+			{0x17, 0x72, 0x45, 0x38, 0x50, 0x90},
+		} {
+			magic := append([]byte{'B', 'Z', 'h', level}, m...)
+			magics = append(magics, compressionMagic{"bzip2", magic})
+		}
+	}
+	return magics
+}()
+
+// readCompressionMagic returns the compression format whose magic number
+// begins r or the empty string if there is none.  It reads from r one byte
+// at a time and only while what it has read is a prefix of some magic
+// number.
+func readCompressionMagic(r io.Reader) string {
+	var buf []byte
+	for {
+		var b [1]byte
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return ""
+		}
+		buf = append(buf, b[0])
+		var isPrefix bool
+		for _, m := range compressionMagics {
+			if bytes.HasPrefix(m.magic, buf) {
+				if len(buf) == len(m.magic) {
+					return m.format
+				}
+				isPrefix = true
+			}
+		}
+		if !isPrefix {
+			return ""
+		}
+	}
+}
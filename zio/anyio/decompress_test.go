@@ -0,0 +1,105 @@
+package anyio
+
+import (
+	"bytes"
+	"io"
+	"slices"
+	"testing"
+
+	"github.com/brimdata/super/zio"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+	"github.com/ulikunitz/xz"
+)
+
+// TestDecompressReaderOnlyReadsTwoBytesIfNoMagic tests that DecompressReader
+// doesn't try to read more than two bytes from a non-io.ReadSeeker reader if
+// those bytes don't begin a compression format's magic number.
+func TestDecompressReaderOnlyReadsTwoBytesIfNoMagic(t *testing.T) {
+	pr, pw := io.Pipe()
+	ch := make(chan struct{})
+	var writeErr error
+	go func() {
+		// DecompressReader should return upon seeing this two-byte
+		// input.  It will block (and this test will time out) if it
+		// tries to read more than two bytes.
+		_, writeErr = pw.Write([]byte("1\n"))
+		close(ch)
+	}()
+	r, err := DecompressReader(pr)
+	require.NoError(t, err)
+	require.NotNil(t, r)
+	<-ch
+	require.NoError(t, writeErr)
+}
+
+func TestDecompressReader(t *testing.T) {
+	const expected = "hello, world\n"
+	for _, format := range []string{"gzip", "xz", "zstd"} {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			w, err := newCompressor(format, zio.NopCloser(&buf))
+			require.NoError(t, err)
+			_, err = w.Write([]byte(expected))
+			require.NoError(t, err)
+			require.NoError(t, w.Close())
+			// Check both the seekable and the non-seekable paths.
+			for _, r := range []io.Reader{bytes.NewReader(buf.Bytes()), bytes.NewBuffer(buf.Bytes())} {
+				r, err := DecompressReader(r)
+				require.NoError(t, err)
+				b, err := io.ReadAll(r)
+				require.NoError(t, err)
+				require.Equal(t, expected, string(b))
+			}
+		})
+	}
+}
+
+func TestDecompressReaderXZ(t *testing.T) {
+	data := bytes.Repeat([]byte("hello, world\n"), 20000)
+	for _, c := range []xz.WriterConfig{
+		{},
+		// Multiple blocks and uncompressed chunks
+		{BlockSize: 4096},
+		{BlockSize: 100000, CheckSum: xz.SHA256},
+	} {
+		var buf bytes.Buffer
+		w, err := c.NewWriter(&buf)
+		require.NoError(t, err)
+		_, err = w.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		// Concatenated streams
+		b := append(slices.Clone(buf.Bytes()), buf.Bytes()...)
+		r, err := DecompressReader(bytes.NewBuffer(b))
+		require.NoError(t, err)
+		out, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, append(slices.Clone(data), data...), out)
+	}
+}
+
+func TestDecompressReaderWindowLimit(t *testing.T) {
+	t.Run("xz", func(t *testing.T) {
+		var buf bytes.Buffer
+		w, err := xz.WriterConfig{DictCap: 2 * maxDecompressWindow}.NewWriter(&buf)
+		require.NoError(t, err)
+		_, err = w.Write([]byte("hello, world\n"))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		r, err := newDecompressor("xz", &buf)
+		if err == nil {
+			_, err = io.ReadAll(r)
+		}
+		require.ErrorContains(t, err, "dictionary size 268435456 exceeds limit")
+	})
+	t.Run("zstd", func(t *testing.T) {
+		// A frame with a 256 MiB window and an empty last block.  (The
+		// encoder shrinks the window to fit small input.)
+		frame := []byte{0x28, 0xb5, 0x2f, 0xfd, 0x00, 18 << 3, 0x01, 0x00, 0x00}
+		r, err := newDecompressor("zstd", bytes.NewReader(frame))
+		require.NoError(t, err)
+		_, err = io.ReadAll(r)
+		require.ErrorIs(t, err, zstd.ErrWindowSizeExceeded)
+	})
+}
@@ -8,10 +8,13 @@ import (
 	"github.com/brimdata/super/compiler/optimizer/demand"
 	"github.com/brimdata/super/zio"
 	"github.com/brimdata/super/zio/arrowio"
+	"github.com/brimdata/super/zio/avroio"
 	"github.com/brimdata/super/zio/csvio"
 	"github.com/brimdata/super/zio/jsonio"
 	"github.com/brimdata/super/zio/lineio"
+	"github.com/brimdata/super/zio/logfmtio"
 	"github.com/brimdata/super/zio/parquetio"
+	"github.com/brimdata/super/zio/syslogio"
 	"github.com/brimdata/super/zio/vngio"
 	"github.com/brimdata/super/zio/zeekio"
 	"github.com/brimdata/super/zio/zjsonio"
@@ -23,18 +26,26 @@ func lookupReader(zctx *zed.Context, r io.Reader, demandOut demand.Demand, opts
 	switch opts.Format {
 	case "arrows":
 		return arrowio.NewReader(zctx, r)
+	case "avro":
+		return avroio.NewReader(zctx, r)
+	case "cef":
+		return zio.NopReadCloser(syslogio.NewReader(zctx, r, syslogio.ReaderOpts{CEF: true})), nil
 	case "csv":
 		return zio.NopReadCloser(csvio.NewReader(zctx, r, opts.CSV)), nil
 	case "line":
 		return zio.NopReadCloser(lineio.NewReader(r)), nil
 	case "json":
 		return zio.NopReadCloser(jsonio.NewReader(zctx, r)), nil
+	case "logfmt":
+		return zio.NopReadCloser(logfmtio.NewReader(zctx, r)), nil
 	case "parquet":
-		zr, err := parquetio.NewReader(zctx, r)
+		zr, err := parquetio.NewReader(zctx, r, demandOut, opts.Parquet)
 		if err != nil {
 			return nil, err
 		}
 		return zio.NopReadCloser(zr), nil
+	case "syslog":
+		return zio.NopReadCloser(syslogio.NewReader(zctx, r, syslogio.ReaderOpts{})), nil
 	case "tsv":
 		opts.CSV.Delim = '\t'
 		return zio.NopReadCloser(csvio.NewReader(zctx, r, opts.CSV)), nil
@@ -12,9 +12,11 @@ import (
 	"github.com/brimdata/super/compiler/optimizer/demand"
 	"github.com/brimdata/super/zio"
 	"github.com/brimdata/super/zio/arrowio"
+	"github.com/brimdata/super/zio/avroio"
 	"github.com/brimdata/super/zio/csvio"
 	"github.com/brimdata/super/zio/jsonio"
 	"github.com/brimdata/super/zio/parquetio"
+	"github.com/brimdata/super/zio/syslogio"
 	"github.com/brimdata/super/zio/vngio"
 	"github.com/brimdata/super/zio/zeekio"
 	"github.com/brimdata/super/zio/zjsonio"
@@ -23,9 +25,10 @@ import (
 )
 
 type ReaderOpts struct {
-	Format string
-	CSV    csvio.ReaderOpts
-	ZNG    zngio.ReaderOpts
+	Format  string
+	CSV     csvio.ReaderOpts
+	Parquet parquetio.ReaderOpts
+	ZNG     zngio.ReaderOpts
 }
 
 func NewReader(zctx *zed.Context, r io.Reader, demandOut demand.Demand) (zio.ReadCloser, error) {
@@ -41,7 +44,7 @@ func NewReaderWithOpts(zctx *zed.Context, r io.Reader, demandOut demand.Demand,
 	if rs, ok := r.(io.ReadSeeker); ok {
 		if n, err := rs.Seek(0, io.SeekCurrent); err == nil {
 			var zr zio.Reader
-			zr, parquetErr = parquetio.NewReader(zctx, rs)
+			zr, parquetErr = parquetio.NewReader(zctx, rs, demandOut, opts.Parquet)
 			if parquetErr == nil {
 				return zio.NopReadCloser(zr), nil
 			}
@@ -75,6 +78,13 @@ func NewReaderWithOpts(zctx *zed.Context, r io.Reader, demandOut demand.Demand,
 	arrowsErr = fmt.Errorf("arrows: %w", arrowsErr)
 	track.Reset()
 
+	avroErr := isAvro(track)
+	if avroErr == nil {
+		return avroio.NewReader(zctx, track.Reader())
+	}
+	avroErr = fmt.Errorf("avro: %w", avroErr)
+	track.Reset()
+
 	zeekErr := match(zeekio.NewReader(zed.NewContext(), track), "zeek", 1)
 	if zeekErr == nil {
 		return zio.NopReadCloser(zeekio.NewReader(zctx, track.Reader())), nil
@@ -117,6 +127,19 @@ func NewReaderWithOpts(zctx *zed.Context, r io.Reader, demandOut demand.Demand,
 	}
 	track.Reset()
 
+	// CEF must come before syslog since it is a subset of syslog.
+	cefErr := match(syslogio.NewReader(zed.NewContext(), track, syslogio.ReaderOpts{CEF: true}), "cef", 1)
+	if cefErr == nil {
+		return zio.NopReadCloser(syslogio.NewReader(zctx, track.Reader(), syslogio.ReaderOpts{CEF: true})), nil
+	}
+	track.Reset()
+
+	syslogErr := match(syslogio.NewReader(zed.NewContext(), track, syslogio.ReaderOpts{}), "syslog", 1)
+	if syslogErr == nil {
+		return zio.NopReadCloser(syslogio.NewReader(zctx, track.Reader(), syslogio.ReaderOpts{})), nil
+	}
+	track.Reset()
+
 	csvErr := isCSVStream(track, ',', "csv")
 	if csvErr == nil {
 		return zio.NopReadCloser(csvio.NewReader(zctx, track.Reader(), csvio.ReaderOpts{Delim: ','})), nil
@@ -130,12 +153,17 @@ func NewReaderWithOpts(zctx *zed.Context, r io.Reader, demandOut demand.Demand,
 	track.Reset()
 
 	lineErr := errors.New("line: auto-detection not supported")
+	logfmtErr := errors.New("logfmt: auto-detection not supported")
 	return nil, joinErrs([]error{
 		arrowsErr,
+		avroErr,
+		cefErr,
 		csvErr,
 		jsonErr,
 		lineErr,
+		logfmtErr,
 		parquetErr,
+		syslogErr,
 		tsvErr,
 		vngErr,
 		zeekErr,
@@ -175,6 +203,16 @@ func isArrowStream(track *Track) error {
 	return err
 }
 
+func isAvro(track *Track) error {
+	zr, err := avroio.NewReader(zed.NewContext(), track)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+	_, err = zr.Read()
+	return err
+}
+
 func isCSVStream(track *Track, delim rune, name string) error {
 	if s, err := bufio.NewReader(track).ReadString('\n'); err != nil {
 		return fmt.Errorf("%s: line 1: %w", name, err)
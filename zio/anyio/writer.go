@@ -5,11 +5,14 @@ import (
 	"io"
 
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/vng"
 	"github.com/brimdata/super/zio"
 	"github.com/brimdata/super/zio/arrowio"
+	"github.com/brimdata/super/zio/avroio"
 	"github.com/brimdata/super/zio/csvio"
 	"github.com/brimdata/super/zio/jsonio"
 	"github.com/brimdata/super/zio/lakeio"
+	"github.com/brimdata/super/zio/logfmtio"
 	"github.com/brimdata/super/zio/parquetio"
 	"github.com/brimdata/super/zio/tableio"
 	"github.com/brimdata/super/zio/textio"
@@ -25,20 +28,42 @@ type WriterOpts struct {
 	Lake   lakeio.WriterOpts
 	CSV    csvio.WriterOpts
 	JSON   jsonio.WriterOpts
+	VNG    *vng.WriterOpts   // Nil means use defaults via vngio.NewWriter.
 	ZNG    *zngio.WriterOpts // Nil means use defaults via zngio.NewWriter.
 	ZSON   zsonio.WriterOpts
+
+	// Compress is the compression format for output in a text format.
+	// Empty means no compression.
+	Compress string
 }
 
 func NewWriter(w io.WriteCloser, opts WriterOpts) (zio.WriteCloser, error) {
+	if opts.Compress != "" {
+		if !isTextFormat(opts.Format) {
+			return nil, fmt.Errorf("compression not supported for %s format", opts.Format)
+		}
+		var err error
+		if w, err = newCompressor(opts.Compress, w); err != nil {
+			return nil, err
+		}
+	}
+	return newWriter(w, opts)
+}
+
+func newWriter(w io.WriteCloser, opts WriterOpts) (zio.WriteCloser, error) {
 	switch opts.Format {
 	case "arrows":
 		return arrowio.NewWriter(w), nil
+	case "avro":
+		return avroio.NewWriter(w), nil
 	case "csv":
 		return csvio.NewWriter(w, opts.CSV), nil
 	case "json":
 		return jsonio.NewWriter(w, opts.JSON), nil
 	case "lake":
 		return lakeio.NewWriter(w, opts.Lake), nil
+	case "logfmt":
+		return logfmtio.NewWriter(w), nil
 	case "null":
 		return &nullWriter{}, nil
 	case "parquet":
@@ -51,7 +76,10 @@ func NewWriter(w io.WriteCloser, opts WriterOpts) (zio.WriteCloser, error) {
 		opts.CSV.Delim = '\t'
 		return csvio.NewWriter(w, opts.CSV), nil
 	case "vng":
-		return vngio.NewWriter(w), nil
+		if opts.VNG == nil {
+			return vngio.NewWriter(w), nil
+		}
+		return vngio.NewWriterWithOpts(w, *opts.VNG), nil
 	case "zeek":
 		return zeekio.NewWriter(w), nil
 	case "zjson":
@@ -0,0 +1,232 @@
+package anyio
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ulikunitz/xz"
+)
+
+var errXZInvalid = errors.New("xz: invalid stream")
+
+// newXZReader returns a reader of the xz stream in r that fails if a block
+// has an LZMA2 dictionary larger than maxDecompressWindow.  The xz package
+// treats ReaderConfig.DictCap as a minimum and allocates whatever dictionary
+// a block header asks for, so the block headers are checked before the xz
+// reader sees them.
+func newXZReader(r io.Reader) (io.Reader, error) {
+	return xz.NewReader(&xzLimitReader{r: r, max: maxDecompressWindow, need: xzStreamHeaderLen})
+}
+
+const (
+	xzStreamHeader = iota
+	xzBlockStart
+	xzBlockHeader
+	xzChunk
+	xzIndex
+	xzStreamPadding
+)
+
+const (
+	xzStreamHeaderLen = 12
+	xzStreamFooterLen = 12
+	xzFilterLZMA2     = 0x21
+)
+
+// xzLimitReader follows the structure of the xz streams it reads, skipping
+// compressed data by way of the LZMA2 chunk headers, to check the
+// dictionary size in each block header.
+type xzLimitReader struct {
+	r   io.Reader
+	max int64
+	err error
+
+	state     int
+	buf       []byte // Bytes of the header being read.
+	need      int    // Length of the header being read.
+	skip      int64  // Bytes to pass through before the next header.
+	n         int64  // Bytes in the current block or index.
+	checkSize int64  // Size of the check field of each block.
+	nvarints  int64  // Index varints remaining.
+}
+
+func (x *xzLimitReader) Read(p []byte) (int, error) {
+	if x.err != nil {
+		return 0, x.err
+	}
+	n, err := x.r.Read(p)
+	for i := 0; i < n; {
+		if x.skip > 0 {
+			k := min(x.skip, int64(n-i))
+			x.skip -= k
+			x.n += k
+			i += int(k)
+			continue
+		}
+		x.n++
+		if x.err = x.step(p[i]); x.err != nil {
+			return 0, x.err
+		}
+		i++
+	}
+	return n, err
+}
+
+// step advances x past byte c, which is part of a header.
+func (x *xzLimitReader) step(c byte) error {
+	x.buf = append(x.buf, c)
+	if len(x.buf) < x.need {
+		return nil
+	}
+	switch x.state {
+	case xzStreamHeader:
+		if id := x.buf[7] & 0xf; id == 0 {
+			x.checkSize = 0
+		} else {
+			x.checkSize = int64(4) << ((id - 1) / 3)
+		}
+		x.next(xzBlockStart, 1)
+	case xzBlockStart:
+		x.n = 1
+		if c == 0 {
+			x.nvarints = -1
+			x.next(xzIndex, 1)
+			return nil
+		}
+		x.state = xzBlockHeader
+		x.need = (int(c) + 1) * 4
+	case xzBlockHeader:
+		if err := x.checkBlockHeader(); err != nil {
+			return err
+		}
+		x.next(xzChunk, 1)
+	case xzChunk:
+		control := x.buf[0]
+		switch {
+		case control == 0:
+			// End of the block's data, which is padded to a multiple
+			// of four bytes and followed by the check.
+			x.skip = (4-x.n%4)%4 + x.checkSize
+			x.next(xzBlockStart, 1)
+		case control <= 2:
+			// Uncompressed chunk
+			if len(x.buf) < 3 {
+				x.need = 3
+				return nil
+			}
+			x.skip = int64(binary.BigEndian.Uint16(x.buf[1:])) + 1
+			x.next(xzChunk, 1)
+		case control >= 0x80:
+			// LZMA chunk, with properties if the state is reset
+			need := 5
+			if control >= 0xc0 {
+				need = 6
+			}
+			if len(x.buf) < need {
+				x.need = need
+				return nil
+			}
+			x.skip = int64(binary.BigEndian.Uint16(x.buf[3:])) + 1
+			x.next(xzChunk, 1)
+		default:
+			return errXZInvalid
+		}
+	case xzIndex:
+		// The index is the number of records followed by two varints
+		// per record, padding to a multiple of four bytes, and a CRC32.
+		if c&0x80 != 0 {
+			if len(x.buf) > 9 {
+				return errXZInvalid
+			}
+			x.need++
+			return nil
+		}
+		if x.nvarints < 0 {
+			v, n := binary.Uvarint(x.buf)
+			if n <= 0 || v > 1<<61 {
+				return errXZInvalid
+			}
+			x.nvarints = int64(v) * 2
+		} else {
+			x.nvarints--
+		}
+		x.next(xzIndex, 1)
+		if x.nvarints == 0 {
+			x.skip = (4-x.n%4)%4 + 4 + xzStreamFooterLen
+			x.next(xzStreamPadding, 1)
+		}
+	case xzStreamPadding:
+		if c == 0 {
+			x.skip = 3
+			x.next(xzStreamPadding, 1)
+			return nil
+		}
+		x.state = xzStreamHeader
+		x.need = xzStreamHeaderLen
+	}
+	return nil
+}
+
+// next starts reading a header of length need in state.
+func (x *xzLimitReader) next(state, need int) {
+	x.state = state
+	x.buf = x.buf[:0]
+	x.need = need
+}
+
+// checkBlockHeader checks the dictionary size of the LZMA2 filter in the
+// block header in x.buf.
+func (x *xzLimitReader) checkBlockHeader() error {
+	flags := x.buf[1]
+	b := x.buf[2:]
+	varint := func() (uint64, error) {
+		v, n := binary.Uvarint(b)
+		if n <= 0 {
+			return 0, errXZInvalid
+		}
+		b = b[n:]
+		return v, nil
+	}
+	// Skip the optional compressed and uncompressed sizes.
+	for _, bit := range []byte{0x40, 0x80} {
+		if flags&bit != 0 {
+			if _, err := varint(); err != nil {
+				return err
+			}
+		}
+	}
+	for range int(flags&3) + 1 {
+		id, err := varint()
+		if err != nil {
+			return err
+		}
+		size, err := varint()
+		if err != nil {
+			return err
+		}
+		if size > uint64(len(b)) {
+			return errXZInvalid
+		}
+		if id == xzFilterLZMA2 {
+			if size != 1 || b[0] > 40 {
+				return errXZInvalid
+			}
+			if dictCap := xzDictCap(b[0]); dictCap > x.max {
+				return fmt.Errorf("xz: dictionary size %d exceeds limit of %d", dictCap, x.max)
+			}
+		}
+		b = b[size:]
+	}
+	return nil
+}
+
+// xzDictCap returns the dictionary size encoded by the LZMA2 filter
+// property d.
+func xzDictCap(d byte) int64 {
+	if d == 40 {
+		return 1<<32 - 1
+	}
+	return int64(2|d&1) << (d/2 + 11)
+}
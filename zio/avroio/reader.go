@@ -0,0 +1,475 @@
+package avroio
+
+import (
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"math"
+	"math/big"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/zcode"
+	"github.com/klauspost/compress/s2"
+	"github.com/klauspost/compress/zstd"
+)
+
+// magic begins an Avro Object Container File.
+const magic = "Obj\x01"
+
+const syncSize = 16
+
+// MaxBlockSize bounds the size of a data block, both as stored and when
+// decompressed, so a corrupt or hostile file cannot cause an enormous
+// allocation.
+var MaxBlockSize int64 = 1024 * 1024 * 1024
+
+// maxZstdWindow bounds the window of a zstandard-compressed block.
+const maxZstdWindow = 128 * 1024 * 1024
+
+// Reader is a zio.Reader for the Avro Object Container File format.  It maps
+// Avro types to Zed types as follows:
+//
+//   - Primitive types map to their Zed counterparts (e.g., int to int32).
+//   - A record maps to a record, an array to an array, a map to a map with
+//     string keys, and an enum to an enum.
+//   - A union of null and one other type maps to that other type.  Other
+//     unions map to unions.
+//   - A fixed maps to bytes named avro_fixed_N, where N is the size.
+//   - The timestamp-millis and timestamp-micros logical types map to time
+//     named avro_timestamp_millis and avro_timestamp_micros, and
+//     timestamp-nanos maps to time.
+//   - The date logical type maps to time named avro_date, and the
+//     time-millis and time-micros logical types map to duration named
+//     avro_time_millis and avro_time_micros.
+//   - The decimal logical type maps to float64 named
+//     avro_decimal_P_S, where P and S are the precision and scale.
+//   - The uuid logical type maps to string named avro_uuid.
+//
+// The type names allow Writer to recover the original Avro type.
+type Reader struct {
+	zctx   *zed.Context
+	r      *bufio.Reader
+	schema *schema
+	codec  string
+	sync   [syncSize]byte
+	zstd   *zstd.Decoder
+
+	buf     []byte
+	block   []byte
+	count   int64 // Values remaining in block.
+	builder zcode.Builder
+	val     zed.Value
+}
+
+func NewReader(zctx *zed.Context, r io.Reader) (*Reader, error) {
+	br := bufio.NewReader(r)
+	var hdr [len(magic)]byte
+	if _, err := io.ReadFull(br, hdr[:]); err != nil {
+		return nil, err
+	}
+	if string(hdr[:]) != magic {
+		return nil, errors.New("invalid magic")
+	}
+	meta, err := readMetadata(br)
+	if err != nil {
+		return nil, fmt.Errorf("header: %w", err)
+	}
+	schema, err := parseSchema(zctx, meta["avro.schema"])
+	if err != nil {
+		return nil, err
+	}
+	reader := &Reader{
+		zctx:   zctx,
+		r:      br,
+		schema: schema,
+		codec:  string(meta["avro.codec"]),
+	}
+	switch reader.codec {
+	case "", "null", "bzip2", "deflate", "snappy":
+	case "zstandard":
+		reader.zstd, err = zstd.NewReader(nil, zstd.WithDecoderMaxMemory(uint64(MaxBlockSize)), zstd.WithDecoderMaxWindow(maxZstdWindow))
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported codec %q", reader.codec)
+	}
+	if _, err := io.ReadFull(br, reader.sync[:]); err != nil {
+		return nil, fmt.Errorf("header: %w", err)
+	}
+	return reader, nil
+}
+
+// readMetadata reads the file metadata, which is encoded as an Avro map with
+// bytes values.
+func readMetadata(r *bufio.Reader) (map[string][]byte, error) {
+	meta := map[string][]byte{}
+	for {
+		n, err := binary.ReadVarint(r)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			return meta, nil
+		}
+		if n < 0 {
+			// A negative count is followed by the block size.
+			n = -n
+			if _, err := binary.ReadVarint(r); err != nil {
+				return nil, err
+			}
+		}
+		for range n {
+			k, err := readBytes(r)
+			if err != nil {
+				return nil, err
+			}
+			v, err := readBytes(r)
+			if err != nil {
+				return nil, err
+			}
+			meta[string(k)] = v
+		}
+	}
+}
+
+func readBytes(r *bufio.Reader) ([]byte, error) {
+	n, err := binary.ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if n < 0 || n > MaxBlockSize {
+		return nil, fmt.Errorf("invalid length %d", n)
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, noEOF(err)
+	}
+	return b, nil
+}
+
+func (r *Reader) Close() error {
+	if r.zstd != nil {
+		r.zstd.Close()
+	}
+	return nil
+}
+
+func (r *Reader) Read() (*zed.Value, error) {
+	for r.count == 0 {
+		if err := r.readBlock(); err != nil {
+			if err == io.EOF {
+				return nil, nil
+			}
+			return nil, err
+		}
+	}
+	r.builder.Truncate()
+	d := decoder{r.block}
+	if err := d.decode(&r.builder, r.schema); err != nil {
+		return nil, err
+	}
+	r.block = d.buf
+	r.count--
+	if r.count == 0 && len(r.block) > 0 {
+		return nil, errors.New("extra data at end of block")
+	}
+	r.val = zed.NewValue(r.schema.typ, r.builder.Bytes().Body())
+	return &r.val, nil
+}
+
+func (r *Reader) readBlock() error {
+	count, err := binary.ReadVarint(r.r)
+	if err != nil {
+		if err == io.EOF {
+			return err
+		}
+		return noEOF(err)
+	}
+	size, err := binary.ReadVarint(r.r)
+	if err != nil {
+		return noEOF(err)
+	}
+	if count < 0 || size < 0 || size > MaxBlockSize {
+		return fmt.Errorf("invalid block with count %d and size %d", count, size)
+	}
+	if cap(r.buf) < int(size) {
+		r.buf = make([]byte, size)
+	}
+	r.buf = r.buf[:size]
+	if _, err := io.ReadFull(r.r, r.buf); err != nil {
+		return noEOF(err)
+	}
+	var sync [syncSize]byte
+	if _, err := io.ReadFull(r.r, sync[:]); err != nil {
+		return noEOF(err)
+	}
+	if sync != r.sync {
+		return errors.New("sync marker mismatch")
+	}
+	if count == 0 {
+		r.block = nil
+		return nil
+	}
+	r.block, err = r.decompress(r.buf)
+	if err != nil {
+		return fmt.Errorf("%s codec: %w", r.codec, err)
+	}
+	r.count = count
+	return nil
+}
+
+func (r *Reader) decompress(b []byte) ([]byte, error) {
+	switch r.codec {
+	case "bzip2":
+		return readAllLimited(bzip2.NewReader(bytes.NewReader(b)))
+	case "deflate":
+		return readAllLimited(flate.NewReader(bytes.NewReader(b)))
+	case "snappy":
+		// The compressed data is followed by the CRC-32 checksum of
+		// the uncompressed data.
+		if len(b) < 4 {
+			return nil, errors.New("block too short")
+		}
+		if n, err := s2.DecodedLen(b[:len(b)-4]); err != nil {
+			return nil, err
+		} else if int64(n) > MaxBlockSize {
+			return nil, errBlockTooBig()
+		}
+		out, err := s2.Decode(nil, b[:len(b)-4])
+		if err != nil {
+			return nil, err
+		}
+		if crc32.ChecksumIEEE(out) != binary.BigEndian.Uint32(b[len(b)-4:]) {
+			return nil, errors.New("checksum mismatch")
+		}
+		return out, nil
+	case "zstandard":
+		return r.zstd.DecodeAll(b, nil)
+	}
+	return b, nil
+}
+
+// readAllLimited reads r to EOF and returns an error if it holds more than
+// MaxBlockSize bytes.
+func readAllLimited(r io.Reader) ([]byte, error) {
+	b, err := io.ReadAll(io.LimitReader(r, MaxBlockSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(b)) > MaxBlockSize {
+		return nil, errBlockTooBig()
+	}
+	return b, nil
+}
+
+func errBlockTooBig() error {
+	return fmt.Errorf("decompressed block exceeds %d bytes", MaxBlockSize)
+}
+
+func noEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+var errTruncated = errors.New("truncated value")
+
+// decoder decodes values in the Avro binary encoding.
+type decoder struct {
+	buf []byte
+}
+
+func (d *decoder) decode(b *zcode.Builder, s *schema) error {
+	switch s.kind {
+	case "null":
+		b.Append(nil)
+	case "boolean":
+		if len(d.buf) < 1 {
+			return errTruncated
+		}
+		b.Append(zed.EncodeBool(d.buf[0] != 0))
+		d.buf = d.buf[1:]
+	case "int", "long":
+		v, err := d.long()
+		if err != nil {
+			return err
+		}
+		switch s.logical {
+		case "date":
+			b.Append(zed.EncodeTime(nano.Ts(v * 86400 * 1_000_000_000)))
+		case "time-millis":
+			b.Append(zed.EncodeDuration(nano.Duration(v * 1_000_000)))
+		case "time-micros":
+			b.Append(zed.EncodeDuration(nano.Duration(v * 1_000)))
+		case "timestamp-millis":
+			b.Append(zed.EncodeTime(nano.Ts(v * 1_000_000)))
+		case "timestamp-micros":
+			b.Append(zed.EncodeTime(nano.Ts(v * 1_000)))
+		case "timestamp-nanos":
+			b.Append(zed.EncodeTime(nano.Ts(v)))
+		default:
+			b.Append(zed.EncodeInt(v))
+		}
+	case "float":
+		if len(d.buf) < 4 {
+			return errTruncated
+		}
+		f := math.Float32frombits(binary.LittleEndian.Uint32(d.buf))
+		b.Append(zed.EncodeFloat32(f))
+		d.buf = d.buf[4:]
+	case "double":
+		if len(d.buf) < 8 {
+			return errTruncated
+		}
+		f := math.Float64frombits(binary.LittleEndian.Uint64(d.buf))
+		b.Append(zed.EncodeFloat64(f))
+		d.buf = d.buf[8:]
+	case "bytes", "string":
+		v, err := d.bytes()
+		if err != nil {
+			return err
+		}
+		if s.logical == "decimal" {
+			b.Append(zed.EncodeFloat64(decodeDecimal(v, s.scale)))
+		} else {
+			b.Append(v)
+		}
+	case "fixed":
+		if len(d.buf) < s.size {
+			return errTruncated
+		}
+		v := d.buf[:s.size]
+		if s.logical == "decimal" {
+			b.Append(zed.EncodeFloat64(decodeDecimal(v, s.scale)))
+		} else {
+			b.Append(v)
+		}
+		d.buf = d.buf[s.size:]
+	case "enum":
+		v, err := d.long()
+		if err != nil {
+			return err
+		}
+		if v < 0 || v >= int64(len(s.symbols)) {
+			return fmt.Errorf("enum index %d out of range", v)
+		}
+		b.Append(zed.EncodeUint(uint64(v)))
+	case "record":
+		b.BeginContainer()
+		for _, f := range s.fields {
+			if err := d.decode(b, f.schema); err != nil {
+				return err
+			}
+		}
+		b.EndContainer()
+	case "array", "map":
+		b.BeginContainer()
+		for {
+			n, err := d.blockCount()
+			if err != nil {
+				return err
+			}
+			if n == 0 {
+				break
+			}
+			for range n {
+				if s.kind == "map" {
+					k, err := d.bytes()
+					if err != nil {
+						return err
+					}
+					b.Append(k)
+				}
+				if err := d.decode(b, s.items); err != nil {
+					return err
+				}
+			}
+		}
+		if s.kind == "map" {
+			b.TransformContainer(zed.NormalizeMap)
+		}
+		b.EndContainer()
+	case "union":
+		i, err := d.long()
+		if err != nil {
+			return err
+		}
+		if i < 0 || i >= int64(len(s.members)) {
+			return fmt.Errorf("union index %d out of range", i)
+		}
+		if tag := s.tags[i]; tag >= 0 {
+			b.BeginContainer()
+			b.Append(zed.EncodeInt(int64(tag)))
+			if err := d.decode(b, s.members[i]); err != nil {
+				return err
+			}
+			b.EndContainer()
+			return nil
+		}
+		return d.decode(b, s.members[i])
+	default:
+		panic(s.kind)
+	}
+	return nil
+}
+
+func (d *decoder) long() (int64, error) {
+	v, n := binary.Varint(d.buf)
+	if n <= 0 {
+		return 0, errTruncated
+	}
+	d.buf = d.buf[n:]
+	return v, nil
+}
+
+func (d *decoder) bytes() ([]byte, error) {
+	n, err := d.long()
+	if err != nil {
+		return nil, err
+	}
+	if n < 0 || n > int64(len(d.buf)) {
+		return nil, errTruncated
+	}
+	v := d.buf[:n]
+	d.buf = d.buf[n:]
+	return v, nil
+}
+
+// blockCount returns the item count of the next array or map block.
+func (d *decoder) blockCount() (int64, error) {
+	n, err := d.long()
+	if err != nil {
+		return 0, err
+	}
+	if n < 0 {
+		// A negative count is followed by the block size.
+		if _, err := d.long(); err != nil {
+			return 0, err
+		}
+		n = -n
+	}
+	if n < 0 || n > MaxBlockSize {
+		return 0, fmt.Errorf("invalid block count %d", n)
+	}
+	return n, nil
+}
+
+// decodeDecimal decodes the big-endian two's-complement integer in b and
+// divides it by 10^scale.
+func decodeDecimal(b []byte, scale int) float64 {
+	n := new(big.Int).SetBytes(b)
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		n.Sub(n, new(big.Int).Lsh(big.NewInt(1), uint(len(b)*8)))
+	}
+	f, _ := new(big.Rat).SetFrac(n, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)).Float64()
+	return f
+}
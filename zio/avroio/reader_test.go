@@ -0,0 +1,246 @@
+package avroio
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"hash/crc32"
+	"math"
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/zson"
+	"github.com/klauspost/compress/s2"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSchema = `{
+	"type": "record",
+	"name": "Event",
+	"namespace": "com.example",
+	"fields": [
+		{"name": "id", "type": {"type": "fixed", "name": "ID", "size": 2}},
+		{"name": "parent", "type": ["null", "ID"]},
+		{"name": "kind", "type": {"type": "enum", "name": "Kind", "symbols": ["A", "B"]}},
+		{"name": "value", "type": ["null", "long", "string", {"type": "record", "name": "other.Point", "fields": [{"name": "x", "type": "int"}]}]},
+		{"name": "point", "type": ["null", "other.Point"]},
+		{"name": "ts", "type": {"type": "long", "logicalType": "timestamp-millis"}},
+		{"name": "amount", "type": {"type": "fixed", "name": "Amount", "size": 3, "logicalType": "decimal", "precision": 6, "scale": 2}},
+		{"name": "tags", "type": {"type": "array", "items": "string"}},
+		{"name": "attrs", "type": {"type": "map", "values": "boolean"}},
+		{"name": "unknown", "type": {"type": "int", "logicalType": "unknown"}}
+	]
+}`
+
+var testValues = []string{
+	`{id:0x0001(=avro_fixed_2),parent:null(avro_fixed_2),kind:%A(enum(A,B)),value:1((int64,string,{x:int32})),point:{x:1(int32)},ts:2024-01-01T00:00:00.123Z(=avro_timestamp_millis),amount:-1.5(=avro_decimal_6_2),tags:["a","b"],attrs:|{"x":true,"y":false}|,unknown:1(int32)}`,
+	`{id:0x0002(=avro_fixed_2),parent:0x0001(avro_fixed_2),kind:%B(enum(A,B)),value:{x:2(int32)}((int64,string,{x:int32})),point:null({x:int32}),ts:1969-12-31T23:59:59.999Z(=avro_timestamp_millis),amount:1234.56(=avro_decimal_6_2),tags:[]([string]),attrs:|{}|(|{string:bool}|),unknown:-1(int32)}`,
+}
+
+func encodeTestValues(negativeCounts bool) []byte {
+	var b []byte
+	long := func(v int64) { b = binary.AppendVarint(b, v) }
+	str := func(s string) { long(int64(len(s))); b = append(b, s...) }
+	// First value.
+	b = append(b, 0x00, 0x01)       // id
+	long(0)                         // parent: null
+	long(0)                         // kind: A
+	long(1)                         // value: long
+	long(1)                         //
+	long(1)                         // point: Point
+	long(1)                         //   x
+	long(1704067200123)             // ts
+	b = append(b, 0xff, 0xff, 0x6a) // amount: -150
+	if negativeCounts {
+		long(-2)
+		long(4)
+	} else {
+		long(2)
+	}
+	str("a")
+	str("b")
+	long(0)
+	long(2) // attrs
+	str("y")
+	b = append(b, 0)
+	str("x")
+	b = append(b, 1)
+	long(0)
+	long(1) // unknown
+	// Second value.
+	b = append(b, 0x00, 0x02)       // id
+	long(1)                         // parent: ID
+	b = append(b, 0x00, 0x01)       //
+	long(1)                         // kind: B
+	long(3)                         // value: Point
+	long(2)                         //   x
+	long(0)                         // point: null
+	long(-1)                        // ts
+	b = append(b, 0x01, 0xe2, 0x40) // amount: 123456
+	long(0)                         // tags
+	long(0)                         // attrs
+	long(-1)                        // unknown
+	return b
+}
+
+func encodeTestFile(codec string, block []byte) []byte {
+	sync := []byte("0123456789abcdef")
+	var b []byte
+	long := func(v int64) { b = binary.AppendVarint(b, v) }
+	str := func(s string) { long(int64(len(s))); b = append(b, s...) }
+	b = append(b, magic...)
+	long(2)
+	str("avro.schema")
+	str(testSchema)
+	str("avro.codec")
+	str(codec)
+	long(0)
+	b = append(b, sync...)
+	// Follow the block holding the values with an empty block.
+	long(2)
+	str(string(block))
+	b = append(b, sync...)
+	long(0)
+	long(0)
+	b = append(b, sync...)
+	return b
+}
+
+func TestReader(t *testing.T) {
+	values := encodeTestValues(false)
+	var deflated bytes.Buffer
+	fw, err := flate.NewWriter(&deflated, flate.BestCompression)
+	require.NoError(t, err)
+	_, err = fw.Write(values)
+	require.NoError(t, err)
+	require.NoError(t, fw.Close())
+	snappy := binary.BigEndian.AppendUint32(s2.EncodeSnappy(nil, values), crc32.ChecksumIEEE(values))
+	zw, err := zstd.NewWriter(nil)
+	require.NoError(t, err)
+	cases := []struct {
+		codec string
+		block []byte
+	}{
+		{"null", values},
+		{"null", encodeTestValues(true)},
+		{"deflate", deflated.Bytes()},
+		{"snappy", snappy},
+		{"zstandard", zw.EncodeAll(values, nil)},
+	}
+	for _, c := range cases {
+		t.Run(c.codec, func(t *testing.T) {
+			r, err := NewReader(zed.NewContext(), bytes.NewReader(encodeTestFile(c.codec, c.block)))
+			require.NoError(t, err)
+			defer r.Close()
+			for _, expected := range testValues {
+				val, err := r.Read()
+				require.NoError(t, err)
+				require.NotNil(t, val)
+				assert.Equal(t, expected, zson.FormatValue(*val))
+			}
+			val, err := r.Read()
+			require.NoError(t, err)
+			assert.Nil(t, val)
+		})
+	}
+}
+
+func TestReaderErrors(t *testing.T) {
+	values := encodeTestValues(false)
+	badSync := encodeTestFile("null", values)
+	badSync[len(badSync)-1]++
+	cases := []struct {
+		name     string
+		file     []byte
+		expected string
+	}{
+		{"magic", []byte("Obj\x02"), "invalid magic"},
+		{"codec", encodeTestFile("lzo", values), `unsupported codec "lzo"`},
+		{"truncated block", encodeTestFile("null", values[:len(values)-1]), "truncated value"},
+		{"extra data", encodeTestFile("null", append(values, 0)), "extra data at end of block"},
+		{"sync", badSync, "sync marker mismatch"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r, err := NewReader(zed.NewContext(), bytes.NewReader(c.file))
+			if err == nil {
+				for {
+					var val *zed.Value
+					val, err = r.Read()
+					if val == nil || err != nil {
+						break
+					}
+				}
+			}
+			assert.ErrorContains(t, err, c.expected)
+		})
+	}
+}
+
+func TestReaderDecompressedSizeLimit(t *testing.T) {
+	saved := MaxBlockSize
+	MaxBlockSize = 1000
+	t.Cleanup(func() { MaxBlockSize = saved })
+	zeros := make([]byte, MaxBlockSize+1)
+	var deflated bytes.Buffer
+	fw, err := flate.NewWriter(&deflated, flate.BestCompression)
+	require.NoError(t, err)
+	_, err = fw.Write(zeros)
+	require.NoError(t, err)
+	require.NoError(t, fw.Close())
+	snappy := binary.BigEndian.AppendUint32(s2.EncodeSnappy(nil, zeros), crc32.ChecksumIEEE(zeros))
+	zw, err := zstd.NewWriter(nil)
+	require.NoError(t, err)
+	cases := []struct {
+		codec    string
+		block    []byte
+		expected string
+	}{
+		{"deflate", deflated.Bytes(), "decompressed block exceeds 1000 bytes"},
+		{"snappy", snappy, "decompressed block exceeds 1000 bytes"},
+		{"zstandard", zw.EncodeAll(zeros, nil), zstd.ErrWindowSizeExceeded.Error()},
+	}
+	for _, c := range cases {
+		t.Run(c.codec, func(t *testing.T) {
+			r, err := NewReader(zed.NewContext(), bytes.NewReader(encodeTestFile(c.codec, c.block)))
+			require.NoError(t, err)
+			defer r.Close()
+			_, err = r.Read()
+			assert.ErrorContains(t, err, c.expected)
+		})
+	}
+}
+
+func TestSchemaErrors(t *testing.T) {
+	cases := []struct {
+		schema   string
+		expected string
+	}{
+		{`{"type": "record", "name": "R", "fields": [{"name": "r", "type": ["null", "R"]}]}`, `recursive type "R" not supported`},
+		{`{"type": "record", "name": "R", "fields": [{"name": "r", "type": "S"}]}`, `unknown type "S"`},
+		{`["null", ["int"]]`, "union contains a union"},
+		{`[{"type": "enum", "name": "E", "symbols": []}, {"type": "enum", "name": "E", "symbols": []}]`, `type "E" redefined`},
+	}
+	for _, c := range cases {
+		_, err := parseSchema(zed.NewContext(), []byte(c.schema))
+		assert.ErrorContains(t, err, c.expected, c.schema)
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	for _, f := range []float64{0, 1, -1, 1.27, 1.28, -1.28, -1.29, 123456.78, -0.005} {
+		b, err := encodeDecimal(f, 2)
+		require.NoError(t, err)
+		assert.InDelta(t, f, decodeDecimal(b, 2), 0.005, "%v encoded as %x", f, b)
+	}
+	b, err := encodeDecimal(-1.28, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x80}, b)
+	b, err = encodeDecimal(1.28, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x00, 0x80}, b)
+	_, err = encodeDecimal(math.Inf(1), 2)
+	assert.Error(t, err)
+}
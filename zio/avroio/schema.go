@@ -0,0 +1,328 @@
+package avroio
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/brimdata/super"
+)
+
+// schema is a node of a parsed Avro schema.  The Zed type of the values it
+// describes is in typ.
+type schema struct {
+	kind    string // Avro primitive or complex type name
+	logical string // Avro logical type name
+	fields  []*field
+	symbols []string
+	items   *schema // Array items or map values
+	size    int     // Fixed size
+	scale   int     // Decimal scale
+	members []*schema
+	// tags maps a union member index to a Zed union tag or to -1 for the
+	// null member or if typ is not a union.
+	tags []int
+	typ  zed.Type
+}
+
+type field struct {
+	name   string
+	schema *schema
+}
+
+// schemaParser parses the JSON representation of an Avro schema.
+type schemaParser struct {
+	zctx  *zed.Context
+	names map[string]*schema // Named types by full name.
+}
+
+func parseSchema(zctx *zed.Context, b []byte) (*schema, error) {
+	var v any
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, fmt.Errorf("schema: %w", err)
+	}
+	p := &schemaParser{zctx: zctx, names: map[string]*schema{}}
+	s, err := p.parse(v, "")
+	if err != nil {
+		return nil, fmt.Errorf("schema: %w", err)
+	}
+	return s, nil
+}
+
+func (p *schemaParser) parse(v any, namespace string) (*schema, error) {
+	switch v := v.(type) {
+	case string:
+		if s, ok := newPrimitive(v); ok {
+			return s, nil
+		}
+		return p.lookup(v, namespace)
+	case []any:
+		return p.parseUnion(v, namespace)
+	case map[string]any:
+		kind, ok := v["type"].(string)
+		if !ok {
+			if _, ok := v["type"]; !ok {
+				return nil, errors.New("missing type attribute")
+			}
+			// The type attribute may hold any schema, in which
+			// case the other attributes are ignored.
+			return p.parse(v["type"], namespace)
+		}
+		switch kind {
+		case "record", "error":
+			return p.parseRecord(v, namespace)
+		case "enum":
+			return p.parseEnum(v, namespace)
+		case "fixed":
+			return p.parseFixed(v, namespace)
+		case "array":
+			items, err := p.parse(v["items"], namespace)
+			if err != nil {
+				return nil, err
+			}
+			return &schema{kind: kind, items: items, typ: p.zctx.LookupTypeArray(items.typ)}, nil
+		case "map":
+			values, err := p.parse(v["values"], namespace)
+			if err != nil {
+				return nil, err
+			}
+			return &schema{kind: kind, items: values, typ: p.zctx.LookupTypeMap(zed.TypeString, values.typ)}, nil
+		}
+		s, ok := newPrimitive(kind)
+		if !ok {
+			return p.lookup(kind, namespace)
+		}
+		logical, _ := v["logicalType"].(string)
+		return p.applyLogicalType(s, logical, v)
+	}
+	return nil, fmt.Errorf("invalid schema: %v", v)
+}
+
+func newPrimitive(kind string) (*schema, bool) {
+	var typ zed.Type
+	switch kind {
+	case "null":
+		typ = zed.TypeNull
+	case "boolean":
+		typ = zed.TypeBool
+	case "int":
+		typ = zed.TypeInt32
+	case "long":
+		typ = zed.TypeInt64
+	case "float":
+		typ = zed.TypeFloat32
+	case "double":
+		typ = zed.TypeFloat64
+	case "bytes":
+		typ = zed.TypeBytes
+	case "string":
+		typ = zed.TypeString
+	default:
+		return nil, false
+	}
+	return &schema{kind: kind, typ: typ}, true
+}
+
+// applyLogicalType sets the type of s according to the logical type, which
+// is ignored as required by the Avro specification if it is unknown or
+// invalid for s.
+func (p *schemaParser) applyLogicalType(s *schema, logical string, attrs map[string]any) (*schema, error) {
+	var name string
+	var typ zed.Type
+	switch {
+	case logical == "date" && s.kind == "int":
+		name, typ = "avro_date", zed.TypeTime
+	case logical == "time-millis" && s.kind == "int":
+		name, typ = "avro_time_millis", zed.TypeDuration
+	case logical == "time-micros" && s.kind == "long":
+		name, typ = "avro_time_micros", zed.TypeDuration
+	case logical == "timestamp-millis" && s.kind == "long":
+		name, typ = "avro_timestamp_millis", zed.TypeTime
+	case logical == "timestamp-micros" && s.kind == "long":
+		name, typ = "avro_timestamp_micros", zed.TypeTime
+	case logical == "timestamp-nanos" && s.kind == "long":
+		typ = zed.TypeTime
+	case logical == "uuid" && s.kind == "string":
+		name, typ = "avro_uuid", zed.TypeString
+	case logical == "decimal" && (s.kind == "bytes" || s.kind == "fixed"):
+		precision, ok1 := intAttr(attrs, "precision")
+		scale, ok2 := intAttr(attrs, "scale")
+		if !ok2 {
+			scale, ok2 = 0, true
+		}
+		if !ok1 || !ok2 || precision <= 0 || scale < 0 || scale > precision {
+			return s, nil
+		}
+		s.scale = scale
+		name, typ = fmt.Sprintf("avro_decimal_%d_%d", precision, scale), zed.TypeFloat64
+	default:
+		return s, nil
+	}
+	s.logical = logical
+	s.typ = typ
+	if name != "" {
+		named, err := p.zctx.LookupTypeNamed(name, typ)
+		if err != nil {
+			return nil, err
+		}
+		s.typ = named
+	}
+	return s, nil
+}
+
+func intAttr(attrs map[string]any, name string) (int, bool) {
+	f, ok := attrs[name].(float64)
+	if !ok || f != float64(int(f)) {
+		return 0, false
+	}
+	return int(f), true
+}
+
+func (p *schemaParser) parseUnion(v []any, namespace string) (*schema, error) {
+	s := &schema{kind: "union"}
+	var types []zed.Type
+	for _, m := range v {
+		member, err := p.parse(m, namespace)
+		if err != nil {
+			return nil, err
+		}
+		if member.kind == "union" {
+			return nil, errors.New("union contains a union")
+		}
+		s.members = append(s.members, member)
+		if member.kind != "null" && !slices.Contains(types, member.typ) {
+			types = append(types, member.typ)
+		}
+	}
+	switch len(types) {
+	case 0:
+		s.typ = zed.TypeNull
+	case 1:
+		s.typ = types[0]
+	default:
+		s.typ = p.zctx.LookupTypeUnion(types)
+	}
+	for _, m := range s.members {
+		tag := -1
+		if u, ok := s.typ.(*zed.TypeUnion); ok && m.kind != "null" {
+			tag = u.TagOf(m.typ)
+		}
+		s.tags = append(s.tags, tag)
+	}
+	return s, nil
+}
+
+func (p *schemaParser) parseRecord(v map[string]any, namespace string) (*schema, error) {
+	s := &schema{kind: "record"}
+	namespace, err := p.define(s, v, namespace)
+	if err != nil {
+		return nil, err
+	}
+	fields, ok := v["fields"].([]any)
+	if !ok {
+		return nil, errors.New("record fields attribute is not an array")
+	}
+	var zfields []zed.Field
+	for _, f := range fields {
+		attrs, ok := f.(map[string]any)
+		if !ok {
+			return nil, errors.New("record field is not an object")
+		}
+		name, ok := attrs["name"].(string)
+		if !ok {
+			return nil, errors.New("record field name is not a string")
+		}
+		fs, err := p.parse(attrs["type"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		s.fields = append(s.fields, &field{name, fs})
+		zfields = append(zfields, zed.NewField(name, fs.typ))
+	}
+	s.typ, err = p.zctx.LookupTypeRecord(zfields)
+	return s, err
+}
+
+func (p *schemaParser) parseEnum(v map[string]any, namespace string) (*schema, error) {
+	s := &schema{kind: "enum"}
+	if _, err := p.define(s, v, namespace); err != nil {
+		return nil, err
+	}
+	symbols, ok := v["symbols"].([]any)
+	if !ok {
+		return nil, errors.New("enum symbols attribute is not an array")
+	}
+	for _, sym := range symbols {
+		str, ok := sym.(string)
+		if !ok {
+			return nil, errors.New("enum symbol is not a string")
+		}
+		s.symbols = append(s.symbols, str)
+	}
+	s.typ = p.zctx.LookupTypeEnum(s.symbols)
+	return s, nil
+}
+
+func (p *schemaParser) parseFixed(v map[string]any, namespace string) (*schema, error) {
+	s := &schema{kind: "fixed"}
+	if _, err := p.define(s, v, namespace); err != nil {
+		return nil, err
+	}
+	size, ok := intAttr(v, "size")
+	if !ok || size < 0 {
+		return nil, errors.New("invalid fixed size")
+	}
+	s.size = size
+	typ, err := p.zctx.LookupTypeNamed("avro_fixed_"+strconv.Itoa(size), zed.TypeBytes)
+	if err != nil {
+		return nil, err
+	}
+	s.typ = typ
+	logical, _ := v["logicalType"].(string)
+	return p.applyLogicalType(s, logical, v)
+}
+
+// define registers the named type s and returns its namespace.
+func (p *schemaParser) define(s *schema, attrs map[string]any, namespace string) (string, error) {
+	name, ok := attrs["name"].(string)
+	if !ok {
+		return "", errors.New("named type has no name")
+	}
+	if ns, ok := attrs["namespace"].(string); ok && !strings.Contains(name, ".") {
+		namespace = ns
+	}
+	fullname := fullName(name, namespace)
+	if _, ok := p.names[fullname]; ok {
+		return "", fmt.Errorf("type %q redefined", fullname)
+	}
+	p.names[fullname] = s
+	if i := strings.LastIndexByte(fullname, '.'); i >= 0 {
+		return fullname[:i], nil
+	}
+	return "", nil
+}
+
+func (p *schemaParser) lookup(name, namespace string) (*schema, error) {
+	s, ok := p.names[fullName(name, namespace)]
+	if !ok {
+		s, ok = p.names[name]
+	}
+	if !ok {
+		return nil, fmt.Errorf("unknown type %q", name)
+	}
+	if s.typ == nil {
+		// s is a record whose fields are being parsed.
+		return nil, fmt.Errorf("recursive type %q not supported", name)
+	}
+	return s, nil
+}
+
+func fullName(name, namespace string) string {
+	if namespace == "" || strings.Contains(name, ".") {
+		return name
+	}
+	return namespace + "." + name
+}
@@ -0,0 +1,522 @@
+package avroio
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/zcode"
+	"github.com/brimdata/super/zson"
+)
+
+var (
+	ErrMultipleTypes   = errors.New("avroio: encountered multiple types (consider 'fuse')")
+	ErrNotRecord       = errors.New("avroio: not a record")
+	ErrUnsupportedType = errors.New("avroio: unsupported type")
+)
+
+// Writer is a zio.Writer for the Avro Object Container File format.  Since
+// every Zed value may be null, each Avro type other than that of the top-level
+// record is a union with null.  Values with types named as described for
+// Reader are written as the corresponding Avro types.
+type Writer struct {
+	w       io.WriteCloser
+	typ     *zed.TypeRecord
+	encoder *encoder
+	names   int
+	sync    [syncSize]byte
+
+	block  []byte
+	count  int
+	buf    bytes.Buffer
+	flater *flate.Writer
+}
+
+func NewWriter(w io.WriteCloser) *Writer {
+	return &Writer{w: w}
+}
+
+func (w *Writer) Close() error {
+	var err error
+	if w.encoder != nil {
+		err = w.flush()
+	}
+	if err2 := w.w.Close(); err == nil {
+		err = err2
+	}
+	return err
+}
+
+const (
+	blockCount = 1024
+	blockSize  = 1024 * 1024
+)
+
+func (w *Writer) Write(val zed.Value) error {
+	recType, ok := zed.TypeUnder(val.Type()).(*zed.TypeRecord)
+	if !ok || val.IsNull() {
+		return fmt.Errorf("%w: %s", ErrNotRecord, zson.FormatValue(val))
+	}
+	if w.encoder == nil {
+		if err := w.writeHeader(recType); err != nil {
+			return err
+		}
+	} else if w.typ != recType {
+		return fmt.Errorf("%w: %s and %s", ErrMultipleTypes, zson.FormatType(w.typ), zson.FormatType(recType))
+	}
+	var err error
+	w.block, err = w.encoder.encode(w.block, val.Bytes())
+	if err != nil {
+		return err
+	}
+	w.count++
+	if w.count >= blockCount || len(w.block) >= blockSize {
+		return w.flush()
+	}
+	return nil
+}
+
+func (w *Writer) writeHeader(typ *zed.TypeRecord) error {
+	// The top-level record is not nullable.
+	e, schema, err := w.newNonNullEncoder(typ)
+	if err != nil {
+		return err
+	}
+	schemaJSON, err := json.Marshal(schema)
+	if err != nil {
+		return err
+	}
+	if _, err := rand.Read(w.sync[:]); err != nil {
+		return err
+	}
+	w.flater, err = flate.NewWriter(&w.buf, flate.DefaultCompression)
+	if err != nil {
+		return err
+	}
+	b := []byte(magic)
+	// The metadata is an Avro map with bytes values.
+	b = binary.AppendVarint(b, 2)
+	b = appendString(b, "avro.codec")
+	b = appendString(b, "deflate")
+	b = appendString(b, "avro.schema")
+	b = appendBytes(b, schemaJSON)
+	b = binary.AppendVarint(b, 0)
+	b = append(b, w.sync[:]...)
+	if _, err := w.w.Write(b); err != nil {
+		return err
+	}
+	w.typ = typ
+	w.encoder = e
+	return nil
+}
+
+func (w *Writer) flush() error {
+	if w.count == 0 {
+		return nil
+	}
+	w.buf.Reset()
+	w.flater.Reset(&w.buf)
+	if _, err := w.flater.Write(w.block); err != nil {
+		return err
+	}
+	if err := w.flater.Close(); err != nil {
+		return err
+	}
+	b := binary.AppendVarint(nil, int64(w.count))
+	b = appendBytes(b, w.buf.Bytes())
+	b = append(b, w.sync[:]...)
+	w.block = w.block[:0]
+	w.count = 0
+	_, err := w.w.Write(b)
+	return err
+}
+
+func appendBytes(dst, b []byte) []byte {
+	dst = binary.AppendVarint(dst, int64(len(b)))
+	return append(dst, b...)
+}
+
+func appendString(dst []byte, s string) []byte {
+	dst = binary.AppendVarint(dst, int64(len(s)))
+	return append(dst, s...)
+}
+
+// encoder encodes values of a Zed type in the Avro binary encoding.
+type encoder struct {
+	kind     string // Avro type
+	logical  string // Avro logical type
+	nullable bool   // Avro type is a union of null and kind
+	size     int    // Fixed size
+	scale    int    // Decimal scale
+	fields   []*encoder
+	items    *encoder // Array items or map values
+	members  []*encoder
+	typ      zed.Type // Underlying Zed type
+}
+
+var nameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// newEncoder returns an encoder for the nullable Avro type corresponding to
+// typ and its Avro schema in a form suitable for json.Marshal.
+func (w *Writer) newEncoder(typ zed.Type) (*encoder, any, error) {
+	e, schema, err := w.newNonNullEncoder(typ)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch e.kind {
+	case "null":
+		return e, schema, nil
+	case "union":
+		// Add null to the union.
+		e.nullable = true
+		return e, append([]any{"null"}, schema.([]any)...), nil
+	}
+	e.nullable = true
+	return e, []any{"null", schema}, nil
+}
+
+// newNonNullEncoder is like newEncoder except the Avro type is not nullable.
+func (w *Writer) newNonNullEncoder(typ zed.Type) (*encoder, any, error) {
+	var name string
+	if n, ok := typ.(*zed.TypeNamed); ok {
+		name = n.Name
+		typ = zed.TypeUnder(n.Type)
+	}
+	e := &encoder{typ: typ}
+	logical := func(kind, logical string) (*encoder, any, error) {
+		e.kind, e.logical = kind, logical
+		return e, map[string]any{"type": kind, "logicalType": logical}, nil
+	}
+	primitive := func(kind string) (*encoder, any, error) {
+		e.kind = kind
+		return e, kind, nil
+	}
+	// Order here follows that of the zed.ID* and zed.TypeValue* constants.
+	switch typ := typ.(type) {
+	case *zed.TypeOfUint8, *zed.TypeOfUint16, *zed.TypeOfInt8, *zed.TypeOfInt16, *zed.TypeOfInt32:
+		return primitive("int")
+	case *zed.TypeOfUint32, *zed.TypeOfUint64, *zed.TypeOfInt64:
+		return primitive("long")
+	case *zed.TypeOfDuration:
+		switch name {
+		case "avro_time_millis":
+			return logical("int", "time-millis")
+		case "avro_time_micros":
+			return logical("long", "time-micros")
+		}
+		return primitive("long")
+	case *zed.TypeOfTime:
+		switch name {
+		case "avro_date":
+			return logical("int", "date")
+		case "avro_timestamp_millis":
+			return logical("long", "timestamp-millis")
+		case "avro_timestamp_micros":
+			return logical("long", "timestamp-micros")
+		}
+		return logical("long", "timestamp-nanos")
+	case *zed.TypeOfFloat16, *zed.TypeOfFloat32:
+		return primitive("float")
+	case *zed.TypeOfFloat64:
+		if precision, scale, ok := parseDecimalName(name); ok {
+			e.kind, e.logical, e.scale = "bytes", "decimal", scale
+			return e, map[string]any{"type": "bytes", "logicalType": "decimal", "precision": precision, "scale": scale}, nil
+		}
+		return primitive("double")
+	case *zed.TypeOfBool:
+		return primitive("boolean")
+	case *zed.TypeOfBytes:
+		if s, ok := strings.CutPrefix(name, "avro_fixed_"); ok {
+			if size, err := strconv.Atoi(s); err == nil && size >= 0 {
+				e.kind, e.size = "fixed", size
+				return e, map[string]any{"type": "fixed", "name": w.newName("f"), "size": size}, nil
+			}
+		}
+		return primitive("bytes")
+	case *zed.TypeOfString:
+		if name == "avro_uuid" {
+			return logical("string", "uuid")
+		}
+		return primitive("string")
+	case *zed.TypeOfIP, *zed.TypeOfNet, *zed.TypeOfType:
+		return primitive("string")
+	case *zed.TypeOfNull:
+		return primitive("null")
+	case *zed.TypeRecord:
+		var fields []any
+		for _, f := range typ.Fields {
+			if !nameRegexp.MatchString(f.Name) {
+				return nil, nil, fmt.Errorf("%w: field name %q", ErrUnsupportedType, f.Name)
+			}
+			fe, schema, err := w.newEncoder(f.Type)
+			if err != nil {
+				return nil, nil, err
+			}
+			e.fields = append(e.fields, fe)
+			fields = append(fields, map[string]any{"name": f.Name, "type": schema})
+		}
+		e.kind = "record"
+		return e, map[string]any{"type": "record", "name": w.newName("r"), "fields": fields}, nil
+	case *zed.TypeArray, *zed.TypeSet:
+		items, schema, err := w.newEncoder(zed.InnerType(typ))
+		if err != nil {
+			return nil, nil, err
+		}
+		e.kind, e.items = "array", items
+		return e, map[string]any{"type": "array", "items": schema}, nil
+	case *zed.TypeMap:
+		if zed.TypeUnder(typ.KeyType) != zed.TypeString {
+			return nil, nil, fmt.Errorf("%w: %s (map key type must be string)", ErrUnsupportedType, zson.FormatType(typ))
+		}
+		values, schema, err := w.newEncoder(typ.ValType)
+		if err != nil {
+			return nil, nil, err
+		}
+		e.kind, e.items = "map", values
+		return e, map[string]any{"type": "map", "values": schema}, nil
+	case *zed.TypeUnion:
+		var members []any
+		seen := map[string]bool{}
+		for _, t := range typ.Types {
+			me, schema, err := w.newNonNullEncoder(t)
+			if err != nil {
+				return nil, nil, err
+			}
+			if me.kind == "null" || me.kind == "union" {
+				return nil, nil, fmt.Errorf("%w: %s (union with %s)", ErrUnsupportedType, zson.FormatType(typ), me.kind)
+			}
+			// Unnamed Avro types may appear only once in a union.
+			if s, ok := unnamedSchemaKey(schema); ok {
+				if seen[s] {
+					return nil, nil, fmt.Errorf("%w: %s (union members with same Avro type)", ErrUnsupportedType, zson.FormatType(typ))
+				}
+				seen[s] = true
+			}
+			e.members = append(e.members, me)
+			members = append(members, schema)
+		}
+		e.kind = "union"
+		return e, members, nil
+	case *zed.TypeEnum:
+		for _, s := range typ.Symbols {
+			if !nameRegexp.MatchString(s) {
+				return nil, nil, fmt.Errorf("%w: enum symbol %q", ErrUnsupportedType, s)
+			}
+		}
+		e.kind = "enum"
+		return e, map[string]any{"type": "enum", "name": w.newName("e"), "symbols": typ.Symbols}, nil
+	case *zed.TypeError:
+		return primitive("string")
+	}
+	return nil, nil, fmt.Errorf("%w: %s", ErrUnsupportedType, zson.FormatType(typ))
+}
+
+// newName returns a unique name for an Avro record, enum, or fixed.
+func (w *Writer) newName(prefix string) string {
+	w.names++
+	return prefix + strconv.Itoa(w.names)
+}
+
+// unnamedSchemaKey returns a key identifying schema if it is not a named type.
+func unnamedSchemaKey(schema any) (string, bool) {
+	if m, ok := schema.(map[string]any); ok {
+		if _, ok := m["name"]; ok {
+			return "", false
+		}
+	}
+	b, err := json.Marshal(schema)
+	if err != nil {
+		panic(err)
+	}
+	return string(b), true
+}
+
+func parseDecimalName(name string) (int, int, bool) {
+	s, ok := strings.CutPrefix(name, "avro_decimal_")
+	if !ok {
+		return 0, 0, false
+	}
+	p, s, ok := strings.Cut(s, "_")
+	if !ok {
+		return 0, 0, false
+	}
+	precision, err1 := strconv.Atoi(p)
+	scale, err2 := strconv.Atoi(s)
+	if err1 != nil || err2 != nil || precision <= 0 || scale < 0 || scale > precision {
+		return 0, 0, false
+	}
+	return precision, scale, true
+}
+
+func (e *encoder) encode(dst []byte, b zcode.Bytes) ([]byte, error) {
+	if e.nullable {
+		if b == nil {
+			return binary.AppendVarint(dst, 0), nil
+		}
+		if e.kind != "union" {
+			dst = binary.AppendVarint(dst, 1)
+		}
+	} else if b == nil && e.kind != "null" {
+		return nil, errors.New("avroio: null value in union")
+	}
+	switch e.kind {
+	case "null":
+		return dst, nil
+	case "boolean":
+		if zed.DecodeBool(b) {
+			return append(dst, 1), nil
+		}
+		return append(dst, 0), nil
+	case "int", "long":
+		var v int64
+		switch e.typ.ID() {
+		case zed.IDUint8, zed.IDUint16, zed.IDUint32:
+			v = int64(zed.DecodeUint(b))
+		case zed.IDUint64:
+			u := zed.DecodeUint(b)
+			if u > math.MaxInt64 {
+				return nil, fmt.Errorf("avroio: uint64 value %d overflows long", u)
+			}
+			v = int64(u)
+		case zed.IDDuration:
+			v = int64(zed.DecodeDuration(b))
+			switch e.logical {
+			case "time-millis":
+				v /= 1_000_000
+			case "time-micros":
+				v /= 1_000
+			}
+		case zed.IDTime:
+			v = int64(zed.DecodeTime(b))
+			switch e.logical {
+			case "date":
+				v = floorDiv(v, 86400*1_000_000_000)
+			case "timestamp-millis":
+				v = floorDiv(v, 1_000_000)
+			case "timestamp-micros":
+				v = floorDiv(v, 1_000)
+			}
+		default:
+			v = zed.DecodeInt(b)
+		}
+		return binary.AppendVarint(dst, v), nil
+	case "float":
+		return binary.LittleEndian.AppendUint32(dst, math.Float32bits(float32(zed.DecodeFloat(b)))), nil
+	case "double":
+		return binary.LittleEndian.AppendUint64(dst, math.Float64bits(zed.DecodeFloat64(b))), nil
+	case "bytes":
+		if e.logical == "decimal" {
+			d, err := encodeDecimal(zed.DecodeFloat64(b), e.scale)
+			if err != nil {
+				return nil, err
+			}
+			return appendBytes(dst, d), nil
+		}
+		return appendBytes(dst, b), nil
+	case "fixed":
+		if len(b) != e.size {
+			return nil, fmt.Errorf("avroio: fixed value of length %d does not have size %d", len(b), e.size)
+		}
+		return append(dst, b...), nil
+	case "string":
+		switch typ := e.typ.(type) {
+		case *zed.TypeOfIP:
+			return appendString(dst, zed.DecodeIP(b).String()), nil
+		case *zed.TypeOfNet:
+			return appendString(dst, zed.DecodeNet(b).String()), nil
+		case *zed.TypeOfType:
+			return appendString(dst, zson.FormatTypeValue(b)), nil
+		case *zed.TypeError:
+			return appendString(dst, zson.FormatValue(zed.NewValue(typ, b))), nil
+		}
+		return appendBytes(dst, b), nil
+	case "enum":
+		return binary.AppendVarint(dst, int64(zed.DecodeUint(b))), nil
+	case "record":
+		it := b.Iter()
+		for _, f := range e.fields {
+			var err error
+			dst, err = f.encode(dst, it.Next())
+			if err != nil {
+				return nil, err
+			}
+		}
+		return dst, nil
+	case "array", "map":
+		var n int
+		for it := b.Iter(); !it.Done(); it.Next() {
+			n++
+		}
+		if e.kind == "map" {
+			n /= 2
+		}
+		if n > 0 {
+			dst = binary.AppendVarint(dst, int64(n))
+			for it := b.Iter(); !it.Done(); {
+				if e.kind == "map" {
+					dst = appendBytes(dst, it.Next())
+				}
+				var err error
+				dst, err = e.items.encode(dst, it.Next())
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+		return binary.AppendVarint(dst, 0), nil
+	case "union":
+		it := b.Iter()
+		tag := int(zed.DecodeInt(it.Next()))
+		if tag < 0 || tag >= len(e.members) {
+			return nil, fmt.Errorf("avroio: union tag %d out of range", tag)
+		}
+		// The null member comes first.
+		dst = binary.AppendVarint(dst, int64(tag+1))
+		return e.members[tag].encode(dst, it.Next())
+	}
+	panic(e.kind)
+}
+
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b < 0 {
+		q--
+	}
+	return q
+}
+
+// encodeDecimal returns the big-endian two's-complement representation of f
+// multiplied by 10^scale and rounded to the nearest integer.
+func encodeDecimal(f float64, scale int) ([]byte, error) {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return nil, fmt.Errorf("avroio: cannot encode %v as decimal", f)
+	}
+	r := new(big.Rat).SetFloat64(f)
+	r.Mul(r, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)))
+	// Round half away from zero.
+	half := big.NewRat(1, 2)
+	if r.Sign() < 0 {
+		half.Neg(half)
+	}
+	r.Add(r, half)
+	n := new(big.Int).Quo(r.Num(), r.Denom())
+	var size int
+	if n.Sign() < 0 {
+		size = new(big.Int).Not(n).BitLen()/8 + 1
+		n.Add(n, new(big.Int).Lsh(big.NewInt(1), uint(size*8)))
+	} else {
+		size = n.BitLen()/8 + 1
+	}
+	return n.FillBytes(make([]byte, size)), nil
+}
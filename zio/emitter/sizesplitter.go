@@ -39,6 +39,7 @@ func NewSizeSplitter(ctx context.Context, engine storage.Engine, dir *storage.UR
 	if ext == "" {
 		return nil, fmt.Errorf("unknown format: %s", opts.Format)
 	}
+	ext += anyio.CompressExtension(opts.Compress)
 	if prefix != "" {
 		prefix = prefix + "-"
 	}
@@ -30,6 +30,7 @@ func NewSplit(ctx context.Context, engine storage.Engine, dir *storage.URI, pref
 	if e == "" {
 		return nil, fmt.Errorf("unknown format: %s", opts.Format)
 	}
+	e += anyio.CompressExtension(opts.Compress)
 	if prefix != "" {
 		prefix = prefix + "-"
 	}
@@ -29,6 +29,11 @@ func NewReader(zctx *zed.Context, r io.Reader) *Reader {
 	}
 }
 
+// Reset discards any buffered input and resets r to read from rd.
+func (r *Reader) Reset(rd io.Reader) {
+	r.lexer.Reset(rd)
+}
+
 func (r *Reader) Read() (*zed.Value, error) {
 	t := r.lexer.Token()
 	if t == jsonlexer.TokenErr {
@@ -27,8 +27,12 @@ var (
 
 type Writer struct {
 	io.Closer
+	out    io.Writer
 	writer *bufio.Writer
 	tab    int
+	color  bool
+	// err is the first error encountered while writing a value.
+	err error
 
 	// Use json.Encoder for primitive Values. Have to use
 	// json.Encoder instead of json.Marshal because it's
@@ -38,14 +42,17 @@ type Writer struct {
 }
 
 type WriterOpts struct {
-	Pretty int
+	ColorDisabled bool
+	Pretty        int
 }
 
 func NewWriter(writer io.WriteCloser, opts WriterOpts) *Writer {
 	w := &Writer{
 		Closer: writer,
+		out:    writer,
 		writer: bufio.NewWriter(writer),
 		tab:    opts.Pretty,
+		color:  !opts.ColorDisabled,
 	}
 	w.primEnc = json.NewEncoder(&w.primBuf)
 	w.primEnc.SetEscapeHTML(false)
@@ -54,8 +61,15 @@ func NewWriter(writer io.WriteCloser, opts WriterOpts) *Writer {
 
 func (w *Writer) Write(val zed.Value) error {
 	// writeAny doesn't return an error because any error that occurs will be
-	// surfaced with w.writer.Flush is called.
+	// surfaced with w.writer.Flush is called or, if val can't be represented
+	// in JSON (e.g., a NaN or infinite float), saved in w.err.
+	w.err = nil
 	w.writeAny(0, val)
+	if w.err != nil {
+		// Discard the partially written value.
+		w.writer.Reset(w.out)
+		return w.err
+	}
 	w.writer.WriteByte('\n')
 	return w.writer.Flush()
 }
@@ -235,7 +249,10 @@ func (w *Writer) writePrimitive(val zed.Value) {
 func (w *Writer) marshalJSON(v any) []byte {
 	w.primBuf.Reset()
 	if err := w.primEnc.Encode(v); err != nil {
-		panic(err)
+		if w.err == nil {
+			w.err = err
+		}
+		return nil
 	}
 	return bytes.TrimSpace(w.primBuf.Bytes())
 }
@@ -245,7 +262,7 @@ func (w *Writer) punc(b byte) {
 }
 
 func (w *Writer) writeColor(b []byte, code []byte) {
-	if color.Enabled {
+	if color.Enabled && w.color {
 		w.writer.Write(code)
 		defer w.writer.WriteString(color.Reset.String())
 	}
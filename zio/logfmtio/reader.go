@@ -0,0 +1,193 @@
+package logfmtio
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/byteconv"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/pkg/skim"
+	"github.com/brimdata/super/zcode"
+)
+
+const (
+	ReadSize    = 64 * 1024
+	MaxLineSize = 50 * 1024 * 1024
+)
+
+// Reader reads logfmt, in which each line holds a record as a sequence of
+// key=value pairs separated by spaces.  A value may be double quoted, in
+// which case it is a string that may contain spaces and Go escape
+// sequences.  The type of an unquoted value is inferred as bool, int64,
+// float64, time (RFC 3339), duration, or string, in that order of
+// preference, and an empty value is null.  A key with no value is true.
+// If a key appears more than once in a line, its last value is kept.
+type Reader struct {
+	zctx    *zed.Context
+	scanner *skim.Scanner
+	builder zcode.Builder
+	fields  []zed.Field
+	values  []zcode.Bytes
+	keys    map[string]int
+	val     zed.Value
+}
+
+func NewReader(zctx *zed.Context, r io.Reader) *Reader {
+	buffer := make([]byte, ReadSize)
+	return &Reader{
+		zctx:    zctx,
+		scanner: skim.NewScanner(r, buffer, MaxLineSize),
+		keys:    make(map[string]int),
+	}
+}
+
+func (r *Reader) Read() (*zed.Value, error) {
+	for {
+		line, err := r.scanner.ScanLine()
+		if line == nil {
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", r.scanner.Stats.Lines, err)
+			}
+			return nil, nil
+		}
+		if err := r.parseLine(line); err != nil {
+			return nil, fmt.Errorf("line %d: %w", r.scanner.Stats.Lines, err)
+		}
+		if len(r.fields) == 0 {
+			// Line holds only whitespace.
+			continue
+		}
+		typ, err := r.zctx.LookupTypeRecord(r.fields)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", r.scanner.Stats.Lines, err)
+		}
+		r.builder.Reset()
+		for _, b := range r.values {
+			r.builder.Append(b)
+		}
+		r.val = zed.NewValue(typ, r.builder.Bytes())
+		return &r.val, nil
+	}
+}
+
+func (r *Reader) parseLine(line []byte) error {
+	r.fields = r.fields[:0]
+	r.values = r.values[:0]
+	clear(r.keys)
+	for {
+		line = bytes.TrimLeft(line, " \t\r\n")
+		if len(line) == 0 {
+			return nil
+		}
+		n := bytes.IndexAny(line, " \t\r\n=\"")
+		if n < 0 {
+			n = len(line)
+		}
+		key := line[:n]
+		line = line[n:]
+		if len(key) == 0 {
+			if line[0] == '"' {
+				return errors.New("quoted key")
+			}
+			return errors.New("missing key")
+		}
+		var typ zed.Type
+		var val zcode.Bytes
+		switch {
+		case len(line) == 0 || line[0] != '=':
+			if len(line) > 0 && line[0] == '"' {
+				return fmt.Errorf("unexpected quote after key %q", key)
+			}
+			typ, val = zed.TypeBool, zed.EncodeBool(true)
+		case len(line) > 1 && line[1] == '"':
+			s, n, err := unquote(line[1:])
+			if err != nil {
+				return fmt.Errorf("value of key %q: %w", key, err)
+			}
+			typ, val = zed.TypeString, zed.EncodeString(s)
+			line = line[1+n:]
+		default:
+			line = line[1:]
+			n := bytes.IndexAny(line, " \t\r\n")
+			if n < 0 {
+				n = len(line)
+			}
+			typ, val = inferValue(line[:n])
+			line = line[n:]
+		}
+		r.set(string(key), typ, val)
+	}
+}
+
+func (r *Reader) set(key string, typ zed.Type, val zcode.Bytes) {
+	if i, ok := r.keys[key]; ok {
+		r.fields[i].Type = typ
+		r.values[i] = val
+		return
+	}
+	r.keys[key] = len(r.fields)
+	r.fields = append(r.fields, zed.NewField(key, typ))
+	r.values = append(r.values, val)
+}
+
+// unquote returns the unquoted value of the double-quoted string at the
+// start of b and the length of its quoted form.
+func unquote(b []byte) (string, int, error) {
+	for i := 1; i < len(b); i++ {
+		switch b[i] {
+		case '\\':
+			i++
+		case '"':
+			s, err := strconv.Unquote(string(b[:i+1]))
+			if err != nil {
+				return "", 0, fmt.Errorf("invalid quoted string: %s", b[:i+1])
+			}
+			return s, i + 1, nil
+		}
+	}
+	return "", 0, errors.New("unterminated quoted string")
+}
+
+// inferValue returns the type and encoding of the unquoted value b.
+func inferValue(b []byte) (zed.Type, zcode.Bytes) {
+	s := byteconv.UnsafeString(b)
+	switch s {
+	case "":
+		return zed.TypeNull, nil
+	case "true":
+		return zed.TypeBool, zed.EncodeBool(true)
+	case "false":
+		return zed.TypeBool, zed.EncodeBool(false)
+	}
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return zed.TypeInt64, zed.EncodeInt(v)
+	}
+	if isDecimal(s) {
+		if v, err := strconv.ParseFloat(s, 64); err == nil {
+			return zed.TypeFloat64, zed.EncodeFloat64(v)
+		}
+	}
+	if ts, err := nano.ParseRFC3339Nano(b); err == nil {
+		return zed.TypeTime, zed.EncodeTime(ts)
+	}
+	if d, err := nano.ParseDuration(s); err == nil {
+		return zed.TypeDuration, zed.EncodeDuration(d)
+	}
+	return zed.TypeString, zed.EncodeString(s)
+}
+
+// isDecimal returns true if s contains only characters that may appear in
+// a decimal floating-point number, thereby excluding strings like "inf"
+// and "nan" that strconv.ParseFloat also accepts.
+func isDecimal(s string) bool {
+	for _, c := range []byte(s) {
+		if (c < '0' || c > '9') && c != '.' && c != 'e' && c != 'E' && c != '+' && c != '-' {
+			return false
+		}
+	}
+	return true
+}
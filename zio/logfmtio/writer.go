@@ -0,0 +1,107 @@
+package logfmtio
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/zcode"
+	"github.com/brimdata/super/zson"
+)
+
+// Writer writes records as logfmt.  Nested records are flattened into
+// dotted keys and null values are written as empty values.  A value is
+// double quoted if it contains spaces, quotes, equal signs, or unprintable
+// characters or if it is a string that would otherwise be read back as
+// another type.
+type Writer struct {
+	writer    io.WriteCloser
+	flattener *expr.Flattener
+	buf       []byte
+}
+
+func NewWriter(w io.WriteCloser) *Writer {
+	return &Writer{
+		writer:    w,
+		flattener: expr.NewFlattener(zed.NewContext()),
+	}
+}
+
+func (w *Writer) Close() error {
+	return w.writer.Close()
+}
+
+func (w *Writer) Write(rec zed.Value) error {
+	if rec.Type().Kind() != zed.RecordKind {
+		return fmt.Errorf("logfmt output encountered non-record value: %s", zson.FormatValue(rec))
+	}
+	rec, err := w.flattener.Flatten(rec)
+	if err != nil {
+		return err
+	}
+	w.buf = w.buf[:0]
+	fields := rec.Fields()
+	for i, it := 0, rec.Bytes().Iter(); i < len(fields) && !it.Done(); i++ {
+		name := fields[i].Name
+		if needsQuotes(name) {
+			return fmt.Errorf("logfmt output encountered invalid key: %q", name)
+		}
+		if i > 0 {
+			w.buf = append(w.buf, ' ')
+		}
+		w.buf = append(w.buf, name...)
+		w.buf = append(w.buf, '=')
+		zb := it.Next()
+		if zb == nil {
+			continue
+		}
+		val := zed.NewValue(fields[i].Type, zb).Under()
+		if val.Type().ID() == zed.IDString {
+			s := string(val.Bytes())
+			if needsQuotes(s) || inferValueType(s) != zed.TypeString {
+				w.buf = strconv.AppendQuote(w.buf, s)
+			} else {
+				w.buf = append(w.buf, s...)
+			}
+			continue
+		}
+		s := formatValue(val.Type(), val.Bytes())
+		if needsQuotes(s) {
+			w.buf = strconv.AppendQuote(w.buf, s)
+		} else {
+			w.buf = append(w.buf, s...)
+		}
+	}
+	w.buf = append(w.buf, '\n')
+	_, err = w.writer.Write(w.buf)
+	return err
+}
+
+func formatValue(typ zed.Type, bytes zcode.Bytes) string {
+	// Avoid ZSON decoration.
+	if typ.ID() < zed.IDTypeComplex {
+		return zson.FormatPrimitive(zed.TypeUnder(typ), bytes)
+	}
+	return zson.FormatValue(zed.NewValue(typ, bytes))
+}
+
+func inferValueType(s string) zed.Type {
+	typ, _ := inferValue([]byte(s))
+	return typ
+}
+
+func needsQuotes(s string) bool {
+	if s == "" {
+		return true
+	}
+	for _, r := range s {
+		if r <= ' ' || r == '=' || r == '"' || r == utf8.RuneError || !unicode.IsPrint(r) {
+			return true
+		}
+	}
+	return false
+}
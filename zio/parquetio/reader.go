@@ -8,12 +8,26 @@ import (
 	"github.com/apache/arrow/go/v14/arrow/memory"
 	"github.com/apache/arrow/go/v14/parquet"
 	"github.com/apache/arrow/go/v14/parquet/file"
+	"github.com/apache/arrow/go/v14/parquet/metadata"
 	"github.com/apache/arrow/go/v14/parquet/pqarrow"
+	"github.com/apache/arrow/go/v14/parquet/schema"
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/compiler/optimizer/demand"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/zcode"
 	"github.com/brimdata/super/zio/arrowio"
 )
 
-func NewReader(zctx *zed.Context, r io.Reader) (*arrowio.Reader, error) {
+type ReaderOpts struct {
+	// Pruner, if not nil, is evaluated for each row group with a record
+	// whose fields are the row group's top-level columns with min/max
+	// statistics and whose values are records with fields min and max.
+	// A row group is skipped if Pruner returns true.
+	Pruner expr.Evaluator
+}
+
+func NewReader(zctx *zed.Context, r io.Reader, demandOut demand.Demand, opts ReaderOpts) (*arrowio.Reader, error) {
 	ras, ok := r.(parquet.ReaderAtSeeker)
 	if !ok {
 		return nil, errors.New("reader cannot seek")
@@ -31,7 +45,15 @@ func NewReader(zctx *zed.Context, r io.Reader) (*arrowio.Reader, error) {
 		pr.Close()
 		return nil, err
 	}
-	rr, err := fr.GetRecordReader(context.TODO(), nil, nil)
+	var rowGroups []int
+	if opts.Pruner != nil {
+		rowGroups, err = pruneRowGroups(zctx, pr, opts.Pruner)
+		if err != nil {
+			pr.Close()
+			return nil, err
+		}
+	}
+	rr, err := fr.GetRecordReader(context.TODO(), columnIndices(pr.MetaData().Schema, demandOut), rowGroups)
 	if err != nil {
 		pr.Close()
 		return nil, err
@@ -43,3 +65,130 @@ func NewReader(zctx *zed.Context, r io.Reader) (*arrowio.Reader, error) {
 	}
 	return ar, nil
 }
+
+// columnIndices returns the indices of the leaf columns belonging to the
+// top-level columns in demandOut or nil if all columns should be read.
+func columnIndices(s *schema.Schema, demandOut demand.Demand) []int {
+	if demandOut == nil || demand.IsAll(demandOut) || demand.IsNone(demandOut) {
+		return nil
+	}
+	var indices []int
+	for i := range s.NumColumns() {
+		if d := demand.GetKey(demandOut, s.Column(i).ColumnPath()[0]); !demand.IsNone(d) {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// pruneRowGroups returns the indices of the row groups for which pruner
+// does not return true.
+func pruneRowGroups(zctx *zed.Context, pr *file.Reader, pruner expr.Evaluator) ([]int, error) {
+	ectx := expr.NewContext()
+	rowGroups := []int{}
+	var b zcode.Builder
+	for i := range pr.NumRowGroups() {
+		md := pr.MetaData().RowGroup(i)
+		var fields []zed.Field
+		b.Reset()
+		for j := range md.NumColumns() {
+			col := md.Schema.Column(j)
+			if len(col.ColumnPath()) != 1 {
+				// Skip columns nested in groups.
+				continue
+			}
+			cc, err := md.ColumnChunk(j)
+			if err != nil {
+				return nil, err
+			}
+			stats, err := cc.Statistics()
+			if err != nil {
+				return nil, err
+			}
+			if stats == nil || !stats.HasMinMax() {
+				continue
+			}
+			typ, min, max, ok := statsMinMax(col, stats)
+			if !ok {
+				continue
+			}
+			rangeType, err := zctx.LookupTypeRecord([]zed.Field{
+				zed.NewField("min", typ),
+				zed.NewField("max", typ),
+			})
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, zed.NewField(col.Name(), rangeType))
+			b.BeginContainer()
+			b.Append(min)
+			b.Append(max)
+			b.EndContainer()
+		}
+		typ, err := zctx.LookupTypeRecord(fields)
+		if err != nil {
+			// Duplicate column names.
+			rowGroups = append(rowGroups, i)
+			continue
+		}
+		val := pruner.Eval(ectx, zed.NewValue(typ, b.Bytes()))
+		if val.Type() == zed.TypeBool && val.Bool() {
+			continue
+		}
+		rowGroups = append(rowGroups, i)
+	}
+	return rowGroups, nil
+}
+
+// statsMinMax returns the Zed type and encoded minimum and maximum values
+// of stats for column types whose statistics are ordered as the
+// corresponding Zed values are.  It returns false for other column types.
+func statsMinMax(col *schema.Column, stats metadata.TypedStatistics) (zed.Type, zcode.Bytes, zcode.Bytes, bool) {
+	switch lt := col.LogicalType().(type) {
+	case schema.NoLogicalType, nil:
+		switch stats := stats.(type) {
+		case *metadata.Int32Statistics:
+			return zed.TypeInt64, zed.EncodeInt(int64(stats.Min())), zed.EncodeInt(int64(stats.Max())), true
+		case *metadata.Int64Statistics:
+			return zed.TypeInt64, zed.EncodeInt(stats.Min()), zed.EncodeInt(stats.Max()), true
+		case *metadata.Float32Statistics:
+			return zed.TypeFloat64, zed.EncodeFloat64(float64(stats.Min())), zed.EncodeFloat64(float64(stats.Max())), true
+		case *metadata.Float64Statistics:
+			return zed.TypeFloat64, zed.EncodeFloat64(stats.Min()), zed.EncodeFloat64(stats.Max()), true
+		}
+	case *schema.IntLogicalType:
+		switch stats := stats.(type) {
+		case *metadata.Int32Statistics:
+			if lt.IsSigned() {
+				return zed.TypeInt64, zed.EncodeInt(int64(stats.Min())), zed.EncodeInt(int64(stats.Max())), true
+			}
+			return zed.TypeUint64, zed.EncodeUint(uint64(uint32(stats.Min()))), zed.EncodeUint(uint64(uint32(stats.Max()))), true
+		case *metadata.Int64Statistics:
+			if lt.IsSigned() {
+				return zed.TypeInt64, zed.EncodeInt(stats.Min()), zed.EncodeInt(stats.Max()), true
+			}
+			return zed.TypeUint64, zed.EncodeUint(uint64(stats.Min())), zed.EncodeUint(uint64(stats.Max())), true
+		}
+	case schema.StringLogicalType:
+		if stats, ok := stats.(*metadata.ByteArrayStatistics); ok {
+			return zed.TypeString, zcode.Bytes(stats.Min()), zcode.Bytes(stats.Max()), true
+		}
+	case *schema.TimestampLogicalType:
+		if stats, ok := stats.(*metadata.Int64Statistics); ok {
+			var scale int64
+			switch lt.TimeUnit() {
+			case schema.TimeUnitMillis:
+				scale = 1_000_000
+			case schema.TimeUnitMicros:
+				scale = 1_000
+			case schema.TimeUnitNanos:
+				scale = 1
+			default:
+				return nil, nil, nil, false
+			}
+			min, max := nano.Ts(stats.Min()*scale), nano.Ts(stats.Max()*scale)
+			return zed.TypeTime, zed.EncodeTime(min), zed.EncodeTime(max), true
+		}
+	}
+	return nil, nil, nil, false
+}
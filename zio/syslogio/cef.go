@@ -0,0 +1,147 @@
+package syslogio
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/zcode"
+)
+
+var cefHeaderFields = []string{
+	"device_vendor",
+	"device_product",
+	"device_version",
+	"signature_id",
+	"name",
+	"severity",
+}
+
+// appendCEF appends to b a record decoded from the CEF payload in msg and
+// returns the record's type.  The record has fields version, the header
+// fields in cefHeaderFields, and extension, a record of strings whose
+// fields are the extension keys in order of first appearance.
+func (r *Reader) appendCEF(b *zcode.Builder, msg []byte) (zed.Type, error) {
+	if !bytes.HasPrefix(msg, []byte("CEF:")) {
+		return nil, errors.New("CEF header not found")
+	}
+	parts := splitCEFHeader(msg[len("CEF:"):])
+	if len(parts) != len(cefHeaderFields)+2 {
+		return nil, errors.New("malformed CEF header")
+	}
+	version, err := strconv.Atoi(string(parts[0]))
+	if err != nil {
+		return nil, errors.New("invalid CEF version")
+	}
+	fields := []zed.Field{zed.NewField("version", zed.TypeInt64)}
+	b.BeginContainer()
+	b.Append(zed.EncodeInt(int64(version)))
+	for k, name := range cefHeaderFields {
+		fields = append(fields, zed.NewField(name, zed.TypeString))
+		b.Append(unescapeCEF(parts[k+1], false))
+	}
+	keys, values := parseCEFExtension(parts[len(parts)-1])
+	var extFields []zed.Field
+	b.BeginContainer()
+	for k, key := range keys {
+		extFields = append(extFields, zed.NewField(key, zed.TypeString))
+		b.Append(values[k])
+	}
+	b.EndContainer()
+	b.EndContainer()
+	extType, err := r.zctx.LookupTypeRecord(extFields)
+	if err != nil {
+		return nil, err
+	}
+	fields = append(fields, zed.NewField("extension", extType))
+	return r.zctx.LookupTypeRecord(fields)
+}
+
+// splitCEFHeader splits b at unescaped pipes into at most eight parts,
+// the last of which holds the extension.
+func splitCEFHeader(b []byte) [][]byte {
+	var parts [][]byte
+	start := 0
+	for i := 0; i < len(b) && len(parts) < len(cefHeaderFields)+1; i++ {
+		switch b[i] {
+		case '\\':
+			i++
+		case '|':
+			parts = append(parts, b[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, b[start:])
+}
+
+// parseCEFExtension parses the space-separated key=value pairs in b.  A
+// value extends to the space preceding the next key, and a later value
+// for a key replaces an earlier one.
+func parseCEFExtension(b []byte) ([]string, []zcode.Bytes) {
+	var keys []string
+	var values []zcode.Bytes
+	set := func(key string, value []byte) {
+		value = unescapeCEF(bytes.TrimRight(value, " "), true)
+		for k := range keys {
+			if keys[k] == key {
+				values[k] = value
+				return
+			}
+		}
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+	var key string
+	var valueStart int
+	for i := 0; i < len(b); i++ {
+		switch b[i] {
+		case '\\':
+			i++
+		case '=':
+			// The key is the word preceding the equal sign.  An equal
+			// sign in a value not preceded by a word is part of the
+			// value.
+			keyStart := bytes.LastIndexByte(b[valueStart:i], ' ') + valueStart + 1
+			if key != "" && keyStart == valueStart {
+				continue
+			}
+			if key != "" {
+				set(key, b[valueStart:keyStart-1])
+			}
+			key = string(bytes.TrimLeft(b[keyStart:i], " "))
+			valueStart = i + 1
+		}
+	}
+	if key != "" {
+		set(key, b[valueStart:])
+	}
+	return keys, values
+}
+
+// unescapeCEF removes the backslash escapes from a CEF header field or, if
+// extension is true, from an extension value, in which case \n and \r are
+// also converted to newline and carriage return.
+func unescapeCEF(b []byte, extension bool) zcode.Bytes {
+	if bytes.IndexByte(b, '\\') < 0 {
+		return zcode.Bytes(b)
+	}
+	out := make([]byte, 0, len(b))
+	for i := 0; i < len(b); i++ {
+		c := b[i]
+		if c == '\\' && i+1 < len(b) {
+			i++
+			c = b[i]
+			if extension {
+				switch c {
+				case 'n':
+					c = '\n'
+				case 'r':
+					c = '\r'
+				}
+			}
+		}
+		out = append(out, c)
+	}
+	return out
+}
@@ -0,0 +1,376 @@
+package syslogio
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/pkg/skim"
+	"github.com/brimdata/super/zcode"
+)
+
+const (
+	ReadSize    = 64 * 1024
+	MaxLineSize = 50 * 1024 * 1024
+)
+
+type ReaderOpts struct {
+	// CEF, if true, requires each message to be a CEF payload, which
+	// replaces the msg field with a cef field holding the decoded payload.
+	// Lines holding a CEF payload without a syslog header are also
+	// accepted.
+	CEF bool
+}
+
+// Reader reads syslog messages, one per line, with RFC 3164 or RFC 5424
+// headers.  Each message is read as a record with fields priority,
+// facility, severity, version, ts, host, app, procid, msgid,
+// structured_data, and msg, where header fields absent from a message are
+// null.  structured_data is a map from SD-ID to a map of parameters.  RFC
+// 3164 timestamps, which lack a year and a time zone, are taken to be UTC
+// in the most recent year that does not put them more than a month in the
+// future.
+type Reader struct {
+	zctx    *zed.Context
+	scanner *skim.Scanner
+	opts    ReaderOpts
+	now     func() time.Time
+	builder zcode.Builder
+	typ     zed.Type
+	val     zed.Value
+}
+
+func NewReader(zctx *zed.Context, r io.Reader, opts ReaderOpts) *Reader {
+	buffer := make([]byte, ReadSize)
+	return &Reader{
+		zctx:    zctx,
+		scanner: skim.NewScanner(r, buffer, MaxLineSize),
+		opts:    opts,
+		now:     time.Now,
+	}
+}
+
+func (r *Reader) Read() (*zed.Value, error) {
+	line, err := r.scanner.ScanLine()
+	if line == nil {
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", r.scanner.Stats.Lines, err)
+		}
+		return nil, nil
+	}
+	line = bytes.TrimRight(line, "\r\n")
+	var m message
+	if !r.opts.CEF || len(line) > 0 && line[0] == '<' {
+		m, err = r.parseMessage(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", r.scanner.Stats.Lines, err)
+		}
+	} else {
+		m = message{priority: -1, version: -1, msg: line}
+	}
+	typ, err := r.recordType()
+	if err != nil {
+		return nil, err
+	}
+	r.builder.Reset()
+	m.appendHeader(&r.builder)
+	if r.opts.CEF {
+		cefType, err := r.appendCEF(&r.builder, m.msg)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", r.scanner.Stats.Lines, err)
+		}
+		fields := slices.Clone(zed.TypeRecordOf(typ).Fields)
+		fields[len(fields)-1] = zed.NewField("cef", cefType)
+		if typ, err = r.zctx.LookupTypeRecord(fields); err != nil {
+			return nil, err
+		}
+	} else {
+		r.builder.Append(m.msg)
+	}
+	r.val = zed.NewValue(typ, r.builder.Bytes())
+	return &r.val, nil
+}
+
+// recordType returns the type of records read when opts.CEF is false.  When
+// it is true, the msg field is replaced with a cef field.
+func (r *Reader) recordType() (zed.Type, error) {
+	if r.typ != nil {
+		return r.typ, nil
+	}
+	sdType := r.zctx.LookupTypeMap(zed.TypeString, r.zctx.LookupTypeMap(zed.TypeString, zed.TypeString))
+	typ, err := r.zctx.LookupTypeRecord([]zed.Field{
+		zed.NewField("priority", zed.TypeInt64),
+		zed.NewField("facility", zed.TypeInt64),
+		zed.NewField("severity", zed.TypeInt64),
+		zed.NewField("version", zed.TypeInt64),
+		zed.NewField("ts", zed.TypeTime),
+		zed.NewField("host", zed.TypeString),
+		zed.NewField("app", zed.TypeString),
+		zed.NewField("procid", zed.TypeString),
+		zed.NewField("msgid", zed.TypeString),
+		zed.NewField("structured_data", sdType),
+		zed.NewField("msg", zed.TypeString),
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.typ = typ
+	return typ, nil
+}
+
+// message holds the parts of a syslog message.  A nil slice denotes an
+// absent part.
+type message struct {
+	priority int // -1 if absent
+	version  int // -1 if absent
+	ts       *nano.Ts
+	host     []byte
+	app      []byte
+	procid   []byte
+	msgid    []byte
+	sd       zcode.Bytes // Encoded structured data map
+	msg      []byte
+}
+
+func (m *message) appendHeader(b *zcode.Builder) {
+	if m.priority < 0 {
+		b.Append(nil)
+		b.Append(nil)
+		b.Append(nil)
+	} else {
+		b.Append(zed.EncodeInt(int64(m.priority)))
+		b.Append(zed.EncodeInt(int64(m.priority / 8)))
+		b.Append(zed.EncodeInt(int64(m.priority % 8)))
+	}
+	if m.version < 0 {
+		b.Append(nil)
+	} else {
+		b.Append(zed.EncodeInt(int64(m.version)))
+	}
+	if m.ts == nil {
+		b.Append(nil)
+	} else {
+		b.Append(zed.EncodeTime(*m.ts))
+	}
+	b.Append(m.host)
+	b.Append(m.app)
+	b.Append(m.procid)
+	b.Append(m.msgid)
+	b.Append(m.sd)
+}
+
+func (r *Reader) parseMessage(line []byte) (message, error) {
+	m := message{priority: -1, version: -1}
+	pri, rest, err := parsePriority(line)
+	if err != nil {
+		return m, err
+	}
+	m.priority = pri
+	if i := bytes.IndexByte(rest, ' '); i > 0 && isDigits(rest[:i]) {
+		version, _ := strconv.Atoi(string(rest[:i]))
+		m.version = version
+		return m, parseRFC5424(&m, rest[i+1:])
+	}
+	r.parseRFC3164(&m, rest)
+	return m, nil
+}
+
+func parsePriority(line []byte) (int, []byte, error) {
+	end := bytes.IndexByte(line, '>')
+	if len(line) == 0 || line[0] != '<' || end < 2 || end > 4 || !isDigits(line[1:end]) {
+		return 0, nil, errors.New("missing syslog priority")
+	}
+	pri, _ := strconv.Atoi(string(line[1:end]))
+	if pri > 191 {
+		return 0, nil, fmt.Errorf("invalid syslog priority: %d", pri)
+	}
+	return pri, line[end+1:], nil
+}
+
+func (r *Reader) parseRFC3164(m *message, rest []byte) {
+	m.msg = rest
+	ts, n := r.parseRFC3164Timestamp(rest)
+	if n == 0 || len(rest) <= n || rest[n] != ' ' {
+		// Without a timestamp, the entire message is content.
+		return
+	}
+	m.ts = &ts
+	rest = rest[n+1:]
+	i := bytes.IndexByte(rest, ' ')
+	if i <= 0 {
+		m.msg = rest
+		return
+	}
+	m.host = rest[:i]
+	m.msg = rest[i+1:]
+	// The tag, if present, has the form "app:" or "app[procid]:" and is
+	// followed by a space or the end of the message.
+	rest = m.msg
+	i = bytes.IndexAny(rest, ":[ ")
+	if i <= 0 || rest[i] == ' ' {
+		return
+	}
+	app := rest[:i]
+	var procid []byte
+	if rest[i] == '[' {
+		end := bytes.IndexByte(rest[i:], ']')
+		if end < 0 {
+			return
+		}
+		procid = rest[i+1 : i+end]
+		i += end + 1
+		if i == len(rest) || rest[i] != ':' {
+			return
+		}
+	}
+	i++
+	if i < len(rest) && rest[i] != ' ' {
+		return
+	}
+	if i < len(rest) {
+		i++
+	}
+	m.app = app
+	m.procid = procid
+	m.msg = rest[i:]
+}
+
+// parseRFC3164Timestamp parses a timestamp of the form "Jan _2 15:04:05"
+// or an RFC 3339 timestamp at the start of b and returns its value and
+// length.  The returned length is zero if no timestamp was found.
+func (r *Reader) parseRFC3164Timestamp(b []byte) (nano.Ts, int) {
+	const layout = "Jan _2 15:04:05"
+	if len(b) >= len(layout) {
+		if t, err := time.Parse(layout, string(b[:len(layout)])); err == nil {
+			now := r.now().UTC()
+			t = t.AddDate(now.Year(), 0, 0)
+			if t.After(now.AddDate(0, 1, 0)) {
+				t = t.AddDate(-1, 0, 0)
+			}
+			return nano.TimeToTs(t), len(layout)
+		}
+	}
+	n := bytes.IndexByte(b, ' ')
+	if n < 0 {
+		n = len(b)
+	}
+	if ts, err := nano.ParseRFC3339Nano(b[:n]); err == nil {
+		return ts, n
+	}
+	return 0, 0
+}
+
+func parseRFC5424(m *message, rest []byte) error {
+	var fields [5][]byte
+	for k := range fields {
+		i := bytes.IndexByte(rest, ' ')
+		if i <= 0 {
+			return errors.New("truncated RFC 5424 header")
+		}
+		if field := rest[:i]; string(field) != "-" {
+			fields[k] = field
+		}
+		rest = rest[i+1:]
+	}
+	if fields[0] != nil {
+		ts, err := nano.ParseRFC3339Nano(fields[0])
+		if err != nil {
+			return fmt.Errorf("invalid RFC 5424 timestamp: %s", fields[0])
+		}
+		m.ts = &ts
+	}
+	m.host, m.app, m.procid, m.msgid = fields[1], fields[2], fields[3], fields[4]
+	sd, rest, err := parseStructuredData(rest)
+	if err != nil {
+		return err
+	}
+	m.sd = sd
+	if len(rest) > 0 {
+		if rest[0] != ' ' {
+			return errors.New("malformed structured data")
+		}
+		m.msg = bytes.TrimPrefix(rest[1:], []byte("\xef\xbb\xbf"))
+	}
+	return nil
+}
+
+// parseStructuredData parses the RFC 5424 structured data at the start
+// of b and returns it encoded as a map along with the rest of b.
+func parseStructuredData(b []byte) (zcode.Bytes, []byte, error) {
+	if len(b) > 0 && b[0] == '-' {
+		return nil, b[1:], nil
+	}
+	var sd zcode.Builder
+	for len(b) > 0 && b[0] == '[' {
+		end := bytes.IndexAny(b, " ]")
+		if end <= 1 {
+			return nil, nil, errors.New("malformed structured data")
+		}
+		sd.Append(b[1:end])
+		b = b[end:]
+		var params zcode.Builder
+		for len(b) > 0 && b[0] == ' ' {
+			eq := bytes.IndexByte(b, '=')
+			if eq <= 1 || len(b) < eq+2 || b[eq+1] != '"' {
+				return nil, nil, errors.New("malformed structured data")
+			}
+			params.Append(b[1:eq])
+			value, n, err := unescapeParamValue(b[eq+2:])
+			if err != nil {
+				return nil, nil, err
+			}
+			params.Append(value)
+			b = b[eq+2+n:]
+		}
+		if len(b) == 0 || b[0] != ']' {
+			return nil, nil, errors.New("malformed structured data")
+		}
+		b = b[1:]
+		if len(params.Bytes()) == 0 {
+			sd.Append(zcode.Bytes{})
+		} else {
+			sd.Append(zed.NormalizeMap(params.Bytes()))
+		}
+	}
+	if len(sd.Bytes()) == 0 {
+		return nil, nil, errors.New("malformed structured data")
+	}
+	return zed.NormalizeMap(sd.Bytes()), b, nil
+}
+
+// unescapeParamValue returns the unescaped value of the structured data
+// parameter value at the start of b, which is terminated by an unescaped
+// double quote, and the length of the value including the quote.
+func unescapeParamValue(b []byte) ([]byte, int, error) {
+	var value []byte
+	for i := 0; i < len(b); i++ {
+		switch c := b[i]; c {
+		case '"':
+			return value, i + 1, nil
+		case '\\':
+			if i+1 < len(b) && (b[i+1] == '"' || b[i+1] == '\\' || b[i+1] == ']') {
+				i++
+				c = b[i]
+			}
+			value = append(value, c)
+		default:
+			value = append(value, c)
+		}
+	}
+	return nil, 0, errors.New("unterminated structured data parameter value")
+}
+
+func isDigits(b []byte) bool {
+	for _, c := range b {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return len(b) > 0
+}
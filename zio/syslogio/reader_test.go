@@ -0,0 +1,32 @@
+package syslogio
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRFC3164Year(t *testing.T) {
+	now := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		line     string
+		expected time.Time
+	}{
+		{"<13>Jan 14 10:00:00 host msg", time.Date(2024, 1, 14, 10, 0, 0, 0, time.UTC)},
+		{"<13>Feb 10 10:00:00 host msg", time.Date(2024, 2, 10, 10, 0, 0, 0, time.UTC)},
+		{"<13>Dec 31 23:59:59 host msg", time.Date(2023, 12, 31, 23, 59, 59, 0, time.UTC)},
+	}
+	for _, c := range cases {
+		r := NewReader(zed.NewContext(), strings.NewReader(c.line), ReaderOpts{})
+		r.now = func() time.Time { return now }
+		val, err := r.Read()
+		require.NoError(t, err)
+		require.NotNil(t, val)
+		assert.Equal(t, nano.TimeToTs(c.expected), val.Deref("ts").AsTime(), c.line)
+	}
+}
@@ -10,3 +10,8 @@ import (
 func NewWriter(w io.WriteCloser) *vng.Writer {
 	return vng.NewWriter(w)
 }
+
+// NewWriterWithOpts returns a writer to w with opts.
+func NewWriterWithOpts(w io.WriteCloser, opts vng.WriterOpts) *vng.Writer {
+	return vng.NewWriterWithOpts(w, opts)
+}
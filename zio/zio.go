@@ -30,6 +30,10 @@ func Extension(format string) string {
 		return ".vng"
 	case "parquet":
 		return ".parquet"
+	case "avro":
+		return ".avro"
+	case "logfmt":
+		return ".logfmt"
 	default:
 		return ""
 	}
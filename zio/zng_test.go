@@ -27,13 +27,17 @@ func (o *Output) Close() error {
 
 // Send logs to ZSON reader -> ZNG writer -> ZNG reader -> ZSON writer.
 func boomerang(t *testing.T, logs string, compress bool) {
-	in := []byte(strings.TrimSpace(logs) + "\n")
-	zsonSrc := zsonio.NewReader(zed.NewContext(), bytes.NewReader(in))
-	var rawzng Output
-	rawDst := zngio.NewWriterWithOpts(&rawzng, zngio.WriterOpts{
+	boomerangWithOpts(t, logs, zngio.WriterOpts{
 		Compress:    compress,
 		FrameThresh: zngio.DefaultFrameThresh,
 	})
+}
+
+func boomerangWithOpts(t *testing.T, logs string, opts zngio.WriterOpts) {
+	in := []byte(strings.TrimSpace(logs) + "\n")
+	zsonSrc := zsonio.NewReader(zed.NewContext(), bytes.NewReader(in))
+	var rawzng Output
+	rawDst := zngio.NewWriterWithOpts(&rawzng, opts)
 	require.NoError(t, zio.Copy(rawDst, zsonSrc))
 	require.NoError(t, rawDst.Close())
 
@@ -119,6 +123,23 @@ func TestRawCompressed(t *testing.T) {
 	boomerang(t, zsonBig(), true)
 }
 
+func TestRawCompressedZstd(t *testing.T) {
+	opts := zngio.WriterOpts{
+		Compress:          true,
+		CompressionFormat: zngio.CompressionFormatZstd,
+		FrameThresh:       zngio.DefaultFrameThresh,
+	}
+	boomerangWithOpts(t, zson1, opts)
+	boomerangWithOpts(t, zson2, opts)
+	boomerangWithOpts(t, zson3, opts)
+	boomerangWithOpts(t, zson4, opts)
+	boomerangWithOpts(t, zson5, opts)
+	boomerangWithOpts(t, zson6, opts)
+	boomerangWithOpts(t, zson7, opts)
+	boomerangWithOpts(t, zson8, opts)
+	boomerangWithOpts(t, zsonBig(), opts)
+}
+
 func TestZjson(t *testing.T) {
 	boomerangZJSON(t, zson1)
 	boomerangZJSON(t, zson2)
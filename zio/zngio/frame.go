@@ -2,7 +2,9 @@ package zngio
 
 import (
 	"fmt"
+	"sync"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4/v4"
 )
 
@@ -23,7 +25,26 @@ const (
 
 type CompressionFormat int
 
-const CompressionFormatLZ4 CompressionFormat = 0x00
+const (
+	CompressionFormatLZ4  CompressionFormat = 0x00
+	CompressionFormatZstd CompressionFormat = 0x01
+)
+
+func (c CompressionFormat) String() string {
+	switch c {
+	case CompressionFormatLZ4:
+		return "lz4"
+	case CompressionFormatZstd:
+		return "zstd"
+	}
+	return fmt.Sprintf("0x%x", int(c))
+}
+
+// zstdDecoder returns a decoder shared by all readers.  Its DecodeAll method
+// may be called concurrently.
+var zstdDecoder = sync.OnceValues(func() (*zstd.Decoder, error) {
+	return zstd.NewReader(nil, zstd.WithDecoderConcurrency(0), zstd.WithDecoderMaxMemory(MaxSize))
+})
 
 type frame struct {
 	fmt  CompressionFormat
@@ -37,13 +58,29 @@ func (f *frame) free() {
 }
 
 func (f *frame) decompress() error {
-	if f.fmt != CompressionFormatLZ4 {
+	var n int
+	switch f.fmt {
+	case CompressionFormatLZ4:
+		var err error
+		n, err = lz4.UncompressBlock(f.zbuf.data, f.ubuf.data)
+		if err != nil {
+			return fmt.Errorf("zngio: %w", err)
+		}
+	case CompressionFormatZstd:
+		d, err := zstdDecoder()
+		if err != nil {
+			return fmt.Errorf("zngio: %w", err)
+		}
+		b, err := d.DecodeAll(f.zbuf.data, f.ubuf.data[:0])
+		if err != nil {
+			return fmt.Errorf("zngio: %w", err)
+		}
+		// DecodeAll decompresses into f.ubuf.data unless the
+		// result is longer, which is caught below.
+		n = len(b)
+	default:
 		return fmt.Errorf("zngio: unknown compression format 0x%x", f.fmt)
 	}
-	n, err := lz4.UncompressBlock(f.zbuf.data, f.ubuf.data)
-	if err != nil {
-		return fmt.Errorf("zngio: %w", err)
-	}
 	if n != len(f.ubuf.data) {
 		return fmt.Errorf("zngio: got %d uncompressed bytes, expected %d", n, len(f.ubuf.data))
 	}
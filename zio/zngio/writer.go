@@ -7,6 +7,7 @@ import (
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/zcode"
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4/v4"
 )
 
@@ -27,12 +28,15 @@ type Writer struct {
 
 type WriterOpts struct {
 	Compress bool
+	// CompressionFormat is the format used to compress frames when
+	// Compress is true.
+	CompressionFormat CompressionFormat
 	// FrameThresh is the minimum frame size in uncompressed bytes.
 	FrameThresh int
 }
 
 // NewWriter returns a writer to w with reasonable default options.
-// Specifically, it enables compression and sets the frame threshold to
+// Specifically, it enables LZ4 compression and sets the frame threshold to
 // DefaultFrameThresh.
 func NewWriter(w io.WriteCloser) *Writer {
 	return NewWriterWithOpts(w, WriterOpts{
@@ -45,7 +49,7 @@ func NewWriter(w io.WriteCloser) *Writer {
 func NewWriterWithOpts(w io.WriteCloser, opts WriterOpts) *Writer {
 	var comp *compressor
 	if opts.Compress {
-		comp = &compressor{}
+		comp = &compressor{format: opts.CompressionFormat}
 	}
 	return &Writer{
 		writer:     w,
@@ -153,7 +157,7 @@ func (w *Writer) writeBlock(blockType int, b []byte) error {
 			return err
 		}
 		if zbuf != nil {
-			if err := w.writeCompHeader(blockType, len(b), len(zbuf)); err != nil {
+			if err := w.writeCompHeader(blockType, len(b), len(zbuf), w.compressor.format); err != nil {
 				return err
 			}
 			return w.write(zbuf)
@@ -172,18 +176,20 @@ func (w *Writer) writeHeader(blockType, size int) error {
 	return w.write(w.header)
 }
 
-func (w *Writer) writeCompHeader(blockType, size, zlen int) error {
+func (w *Writer) writeCompHeader(blockType, size, zlen int, format CompressionFormat) error {
 	zlen += 1 + zcode.SizeOfUvarint(uint64(size))
 	code := (blockType << 4) | (zlen & 0xf) | 0x40
 	w.header = append(w.header[:0], byte(code))
 	w.header = binary.AppendUvarint(w.header, uint64(zlen>>4))
-	w.header = append(w.header, byte(CompressionFormatLZ4))
+	w.header = append(w.header, byte(format))
 	w.header = binary.AppendUvarint(w.header, uint64(size))
 	return w.write(w.header)
 }
 
 type compressor struct {
+	format     CompressionFormat
 	compressor lz4.Compressor
+	zstd       *zstd.Encoder
 	zbuf       []byte
 }
 
@@ -191,6 +197,9 @@ func (c *compressor) compress(b []byte) ([]byte, error) {
 	if c == nil || len(b) == 0 {
 		return nil, nil
 	}
+	if c.format == CompressionFormatZstd {
+		return c.compressZstd(b)
+	}
 	c.zbuf = slices.Grow(c.zbuf[:0], len(b))
 	zbuf := c.zbuf[:len(b)]
 	zlen, err := c.compressor.CompressBlock(b, zbuf)
@@ -205,3 +214,20 @@ func (c *compressor) compress(b []byte) ([]byte, error) {
 	}
 	return nil, nil
 }
+
+func (c *compressor) compressZstd(b []byte) ([]byte, error) {
+	if c.zstd == nil {
+		var err error
+		c.zstd, err = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+	}
+	c.zbuf = c.zstd.EncodeAll(b, c.zbuf[:0])
+	if len(c.zbuf) < len(b) {
+		// As with LZ4, write the compressed block only if it is
+		// smaller than the buffered messages.
+		return c.zbuf, nil
+	}
+	return nil, nil
+}
@@ -509,7 +509,7 @@ func runzq(path, zedProgram, input string, outputFlags []string, inputFlags []st
 	if err := flags.Parse(inputFlags); err != nil {
 		return "", "", err
 	}
-	r, err := anyio.GzipReader(strings.NewReader(input))
+	r, err := anyio.DecompressReader(strings.NewReader(input))
 	if err != nil {
 		return "", err.Error(), err
 	}
@@ -568,6 +568,9 @@ func runvec(zedProgram string, input string, outputFlags []string) (string, stri
 	if err := flags.Parse(outputFlags); err != nil {
 		return "", "", err
 	}
+	if err := outflags.Init(); err != nil {
+		return "", "", err
+	}
 	zctx := zed.NewContext()
 	local := storage.NewLocalEngine()
 	cache := vcache.NewCache(local)